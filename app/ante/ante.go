@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	pokertypes "github.com/block52/pokerchain/x/poker/types"
 )
@@ -24,8 +25,9 @@ type HandlerOptions struct {
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer. Poker game messages (PerformAction, JoinGame, LeaveGame, DealCards)
-// are processed with infinite gas meter, making them effectively gasless.
+// signer. Poker game messages (PerformAction, JoinGame, LeaveGame, DealCards),
+// including those a session key sends through authz MsgExec, are processed
+// with infinite gas meter, making them effectively gasless.
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.AccountKeeper == nil {
 		return nil, fmt.Errorf("account keeper is required")
@@ -57,8 +59,29 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
 
-// isPokerGaslessMessage returns true if the message should be processed gaslessly
+// isPokerGaslessMessage returns true if the message should be processed gaslessly:
+// a poker game message, or a session key's MsgExec of poker game messages.
+// Only one level of MsgExec is allowed; an exec nested in an exec pays gas.
 func isPokerGaslessMessage(msg sdk.Msg) bool {
+	exec, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return isPokerGameMessage(msg)
+	}
+	// A session key acting for a player through x/authz
+	msgs, err := exec.GetMessages()
+	if err != nil || len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if !isPokerGameMessage(msg) {
+			return false
+		}
+	}
+	return true
+}
+
+// isPokerGameMessage returns true for the poker messages that are gasless
+func isPokerGameMessage(msg sdk.Msg) bool {
 	switch msg.(type) {
 	case *pokertypes.MsgPerformAction,
 		*pokertypes.MsgJoinGame,
@@ -0,0 +1,42 @@
+package ante
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestContainsOnlyPokerGaslessMessages(t *testing.T) {
+	grantee := sdk.AccAddress("session_key_________")
+	action := &pokertypes.MsgPerformAction{Player: "b521player", GameId: "0xgame", Action: "check"}
+	send := &banktypes.MsgSend{FromAddress: "b521player", ToAddress: "b521other"}
+
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{action})
+	nested := authz.NewMsgExec(grantee, []sdk.Msg{&exec})
+	mixed := authz.NewMsgExec(grantee, []sdk.Msg{action, send})
+	empty := authz.NewMsgExec(grantee, nil)
+
+	tests := []struct {
+		name    string
+		msgs    []sdk.Msg
+		gasless bool
+	}{
+		{"poker message", []sdk.Msg{action}, true},
+		{"session key exec", []sdk.Msg{&exec}, true},
+		{"nested exec pays gas", []sdk.Msg{&nested}, false},
+		{"exec with a bank send", []sdk.Msg{&mixed}, false},
+		{"empty exec", []sdk.Msg{&empty}, false},
+		{"bank send", []sdk.Msg{send}, false},
+		{"no messages", nil, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.gasless, containsOnlyPokerGaslessMessages(tc.msgs))
+		})
+	}
+}
@@ -54,6 +54,7 @@ import (
 	"github.com/block52/pokerchain/docs"
 	"github.com/block52/pokerchain/pkg/wsserver"
 	pokermodulekeeper "github.com/block52/pokerchain/x/poker/keeper"
+	pokeroracle "github.com/block52/pokerchain/x/poker/oracle"
 )
 
 const (
@@ -218,6 +219,46 @@ func New(
 	// build app
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// Vote extension oracle for the Base block height and bridge deposits.
+	// Validators without an RPC endpoint still vote, with empty extensions.
+	// Vote extensions must be enabled via the consensus params
+	// (abci.vote_extensions_enable_height) before the oracle takes effect.
+	bridgeConfig := loadBridgeConfig(appOpts)
+	var (
+		ethReader      pokermodulekeeper.EthChainReader
+		oracleObserver pokeroracle.Observer
+	)
+	if bridgeConfig.EthereumRPCURL != "" {
+		client, err := pokermodulekeeper.DialEthChainReader(bridgeConfig.EthereumRPCURL)
+		if err != nil {
+			logger.Error("Failed to connect to Ethereum RPC, bridge reads disabled", "error", err)
+		} else {
+			ethReader = client
+			oracleObserver = pokeroracle.NewChainObserver(client)
+		}
+	}
+	oracleHandler := pokeroracle.NewHandler(
+		logger,
+		app.PokerKeeper,
+		app.StakingKeeper,
+		oracleObserver,
+		baseapp.NewDefaultProposalHandler(app.Mempool(), app.App.BaseApp),
+	)
+	app.SetExtendVoteHandler(oracleHandler.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(oracleHandler.VerifyVoteExtensionHandler())
+	app.SetPrepareProposal(oracleHandler.PrepareProposalHandler())
+	app.SetProcessProposal(oracleHandler.ProcessProposalHandler())
+	app.SetPreBlocker(func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		res, err := app.ModuleManager.PreBlock(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := oracleHandler.PreBlocker(ctx, req); err != nil {
+			return nil, err
+		}
+		return res, nil
+	})
+
 	// register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
 		panic(err)
@@ -244,28 +285,26 @@ func New(
 		return app.App.InitChainer(ctx, req)
 	})
 
+	// register upgrade handlers and the store loader for a pending upgrade
+	app.setUpgradeHandlers()
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
 
 	// Initialize and start the Ethereum bridge service
-	bridgeConfig := loadBridgeConfig(appOpts)
 	logger.Info("Bridge config loaded",
 		"enabled", bridgeConfig.Enabled,
 		"rpc_url", bridgeConfig.EthereumRPCURL,
 		"deposit_contract", bridgeConfig.DepositContractAddress,
 	)
 
-	// Set bridge config on poker keeper for MsgMint verification and withdrawal signing
-	app.PokerKeeper.SetBridgeConfig(
-		bridgeConfig.EthereumRPCURL,
-		bridgeConfig.DepositContractAddress,
-		bridgeConfig.ValidatorEthPrivateKey,
-	)
-	if bridgeConfig.ValidatorEthPrivateKey != "" {
-		logger.Info("✅ Bridge config set with validator signing key - automatic withdrawal signing ENABLED")
-	} else {
-		logger.Info("✅ Bridge config set for deposit verification - automatic withdrawal signing DISABLED (use MsgSignWithdrawal)")
+	// Set bridge config on poker keeper for MsgMint verification
+	app.PokerKeeper.SetBridgeConfig(ethReader, bridgeConfig.DepositContractAddress)
+	for _, key := range withdrawalSignerKeys {
+		if value, ok := appOpts.Get(key).(string); ok && value != "" {
+			logger.Warn("⚠️  Withdrawals are no longer signed by the node, run cmd/bridge-signer instead", "ignored", key)
+		}
 	}
 
 	// NOTE: Auto-sync bridge service removed (migrated to manual index-based processing)
@@ -321,12 +360,6 @@ func loadBridgeConfig(appOpts servertypes.AppOptions) BridgeConfig {
 			bridgeConfig.StartingBlock = uint64(val)
 		}
 	}
-	if validatorKey := appOpts.Get("bridge.validator_eth_private_key"); validatorKey != nil {
-		if val, ok := validatorKey.(string); ok {
-			bridgeConfig.ValidatorEthPrivateKey = val
-		}
-	}
-
 	return bridgeConfig
 }
 
@@ -30,6 +30,8 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	pokermodule "github.com/block52/pokerchain/x/poker/module"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -99,9 +101,12 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		govModuleAddr,
 	)
 
+	// IBC USDC withdrawals send through the transfer keeper
+	app.PokerKeeper.SetIBCTransferKeeper(app.TransferKeeper)
+
 	// create IBC module from bottom to top of stack
 	var (
-		transferStack      porttypes.IBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
+		transferStack      porttypes.IBCModule = pokermodule.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.PokerKeeper)
 		transferStackV2    ibcapi.IBCModule    = ibctransferv2.NewIBCModule(app.TransferKeeper)
 		icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
 		icaHostStack       porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"fmt"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/block52/pokerchain/app/upgrades"
+	v2 "github.com/block52/pokerchain/app/upgrades/v2"
+)
+
+// Upgrades are the software upgrades this binary can apply, oldest first.
+// Add a new upgrades/vN package here for every release with state changes.
+var Upgrades = []upgrades.Upgrade{
+	v2.Upgrade,
+}
+
+// setUpgradeHandlers registers a handler for every known upgrade plan and, if
+// the node is restarting at a planned upgrade, the store loader that applies
+// its store changes. It must run before the app is loaded.
+func (app *App) setUpgradeHandlers() {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.UpgradeName,
+			upgrade.CreateUpgradeHandler(app.ModuleManager, app.Configurator()),
+		)
+	}
+
+	// The upgrade module writes the plan to disk when it halts the chain
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Errorf("failed to read upgrade info from disk: %w", err))
+	}
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgrade.UpgradeName == upgradeInfo.Name {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Package upgrades defines the chain's software upgrades. Each upgrade lives
+// in its own vN package and is listed in app.Upgrades.
+package upgrades
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Upgrade is a software upgrade applied when a governance-approved plan of
+// the same name is reached
+type Upgrade struct {
+	// UpgradeName must match the name of the upgrade plan
+	UpgradeName string
+
+	// CreateUpgradeHandler returns the handler run at the upgrade height
+	CreateUpgradeHandler func(*module.Manager, module.Configurator) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades adds, renames or deletes module stores at the upgrade height
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// RunMigrationsHandler returns an upgrade handler that only runs the module
+// store migrations registered for the version changes since the last upgrade
+func RunMigrationsHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
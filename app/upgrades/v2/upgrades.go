@@ -0,0 +1,17 @@
+// Package v2 is the first in-place upgrade. It replaces resetting the chain
+// from a bridge state export: the poker store moves to consensus version 2,
+// which backfills the module params, table denominations and statuses.
+package v2
+
+import (
+	"github.com/block52/pokerchain/app/upgrades"
+)
+
+// UpgradeName is the name of the governance upgrade plan
+const UpgradeName = "v2"
+
+// Upgrade runs the poker v1 to v2 store migration. No stores are added.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: upgrades.RunMigrationsHandler,
+}
@@ -0,0 +1,121 @@
+// Command bridge-signer signs bridge withdrawals with a validator's Ethereum
+// key, outside the node. By default it watches the chain for withdrawals
+// awaiting a signature, signs them and submits the signatures with
+// MsgSignWithdrawal. With BRIDGE_SIGNER_LISTEN set it instead serves the key
+// as a remote signer, so that the key can live on a separate host from the
+// submitting process.
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/signer"
+)
+
+// Configuration
+var (
+	// Encrypted go-ethereum keystore file holding the validator's Ethereum key
+	keystorePath = getEnv("BRIDGE_SIGNER_KEYSTORE", "")
+
+	// File containing the keystore passphrase
+	passwordFile = getEnv("BRIDGE_SIGNER_PASSWORD_FILE", "")
+
+	// Remote signer (another bridge-signer serving the key) to sign through
+	// instead of a keystore, e.g. unix:///var/run/pokerchain/bridge-signer.sock
+	remoteAddress = getEnv("BRIDGE_SIGNER_REMOTE", "")
+
+	// Address to serve the key on. The signer signs any digest it is sent, so
+	// never expose it beyond a unix socket or a private network.
+	listenAddress = getEnv("BRIDGE_SIGNER_LISTEN", "")
+
+	// Node to watch and submit signatures to, and the key that pays for them
+	nodeAddress    = getEnv("BRIDGE_SIGNER_NODE", "localhost:9090")
+	nodeInsecure   = getEnv("BRIDGE_SIGNER_NODE_INSECURE", "true") == "true"
+	chainID        = getEnv("BRIDGE_SIGNER_CHAIN_ID", "pokerchain")
+	fromKey        = getEnv("BRIDGE_SIGNER_FROM", "")
+	keyringBackend = getEnv("BRIDGE_SIGNER_KEYRING_BACKEND", "os")
+	keyringDir     = getEnv("BRIDGE_SIGNER_KEYRING_DIR", defaultKeyringDir())
+
+	// Polling interval
+	pollingInterval = 5 * time.Second
+)
+
+func getEnv(key, defaultVal string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return defaultVal
+}
+
+func defaultKeyringDir() string {
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".pokerchain")
+}
+
+func main() {
+	log.Println("🔏 Bridge Signer Starting...")
+
+	withdrawalSigner, err := loadSigner()
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	log.Printf("   Signer Address: %s", withdrawalSigner.Address().Hex())
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if listenAddress != "" {
+		serve(ctx, withdrawalSigner)
+		return
+	}
+	if err := submit(ctx, withdrawalSigner); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+}
+
+// loadSigner opens the remote signer or keystore that holds the key
+func loadSigner() (keeper.WithdrawalSigner, error) {
+	if remoteAddress != "" {
+		return signer.DialRemote(context.Background(), remoteAddress)
+	}
+	if keystorePath == "" || passwordFile == "" {
+		return nil, errors.New("BRIDGE_SIGNER_KEYSTORE and BRIDGE_SIGNER_PASSWORD_FILE, or BRIDGE_SIGNER_REMOTE, must be set")
+	}
+	passphrase, err := signer.ReadPassphraseFile(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+	return signer.LoadKeystore(keystorePath, passphrase)
+}
+
+// serve serves the key as a remote signer until ctx is cancelled
+func serve(ctx context.Context, withdrawalSigner keeper.WithdrawalSigner) {
+	// Remove a stale socket left by a previous run
+	if path, ok := strings.CutPrefix(listenAddress, "unix://"); ok {
+		_ = os.Remove(path)
+	}
+	lis, err := signer.Listen(listenAddress)
+	if err != nil {
+		log.Fatalf("❌ Failed to listen on %s: %v", listenAddress, err)
+	}
+	log.Printf("   Listening: %s", listenAddress)
+
+	go func() {
+		<-ctx.Done()
+		log.Println("🛑 Shutting down...")
+		lis.Close()
+	}()
+
+	if err := signer.NewServer(withdrawalSigner).Serve(lis); err != nil {
+		log.Printf("Signer stopped: %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/pkg/pokerclient"
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// maxSignaturesPerTx keeps each MsgSignWithdrawal batch within the gas limit
+const maxSignaturesPerTx = 10
+
+// submit polls the node for withdrawals awaiting a signature, signs them and
+// submits the signatures, until ctx is cancelled
+func submit(ctx context.Context, withdrawalSigner keeper.WithdrawalSigner) error {
+	if fromKey == "" {
+		return fmt.Errorf("BRIDGE_SIGNER_FROM must name the key that submits signatures")
+	}
+	kr, err := pokerclient.OpenKeyring(keyringBackend, keyringDir, os.Stdin)
+	if err != nil {
+		return err
+	}
+	c, err := pokerclient.New(pokerclient.Config{
+		GRPCURL:  nodeAddress,
+		Insecure: nodeInsecure,
+		ChainID:  chainID,
+		GasLimit: pokerclient.DefaultGasLimit * maxSignaturesPerTx,
+		Keyring:  kr,
+		From:     fromKey,
+	})
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	log.Printf("   Cosmos Node: %s", nodeAddress)
+	log.Printf("   Chain ID: %s", chainID)
+	log.Printf("   Submitter: %s", c.Address())
+
+	ticker := time.NewTicker(pollingInterval)
+	defer ticker.Stop()
+	for {
+		if err := submitPending(ctx, c, withdrawalSigner); err != nil {
+			log.Printf("⚠️  %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			log.Println("🛑 Shutting down...")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// submitPending signs every withdrawal awaiting a signature and submits the
+// signatures in batches
+func submitPending(ctx context.Context, c *pokerclient.Client, withdrawalSigner keeper.WithdrawalSigner) error {
+	queryClient := types.NewQueryClient(c.Conn())
+	params, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to query params: %w", err)
+	}
+	if !params.Params.IsBridgeSigner(withdrawalSigner.Address()) {
+		return fmt.Errorf("%s is not a bridge signer", withdrawalSigner.Address().Hex())
+	}
+	res, err := queryClient.ListWithdrawalRequests(ctx, &types.QueryListWithdrawalRequestsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list withdrawals: %w", err)
+	}
+
+	var msgs []sdk.Msg
+	for _, request := range res.WithdrawalRequests {
+		if !keeper.WithdrawalNeedsSignature(*request, params.Params) {
+			continue
+		}
+		signature, err := keeper.SignWithdrawalRequest(ctx, *request, withdrawalSigner)
+		if err != nil {
+			log.Printf("⚠️  %v", err)
+			continue
+		}
+		msgs = append(msgs, types.NewMsgSignWithdrawal(c.Address().String(), request.Nonce, signature))
+	}
+
+	for len(msgs) > 0 {
+		batch := msgs[:min(len(msgs), maxSignaturesPerTx)]
+		msgs = msgs[len(batch):]
+
+		tx, err := c.Broadcast(ctx, batch...)
+		if err != nil {
+			return fmt.Errorf("failed to submit %d signatures: %w", len(batch), err)
+		}
+		// Wait for the batch so the next one is signed with the next sequence
+		if _, err := c.WaitForTx(ctx, tx.TxHash, time.Second); err != nil {
+			return fmt.Errorf("signature batch %s failed: %w", tx.TxHash, err)
+		}
+		log.Printf("✅ Submitted %d withdrawal signatures in %s", len(batch), tx.TxHash)
+	}
+	return nil
+}
@@ -3,24 +3,32 @@ package main
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math/big"
 	"os"
 	"os/exec"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
 )
 
 // Configuration
 var (
-	// Base chain RPC URL - defaults to public endpoint, override with ETH_RPC_URL env var
+	// Base chain RPC URL - defaults to public endpoint, override with ETH_RPC_URL env var.
+	// Proof generation needs debug_getRawReceipts, so the node must expose the debug namespace.
 	ethRPCURL = getEnv("ETH_RPC_URL", "https://mainnet.base.org")
 
 	// CosmosBridge contract on Base
@@ -31,9 +39,16 @@ var (
 	cosmosNode    = getEnv("COSMOS_NODE", "http://localhost:26657")
 	relayerKey    = getEnv("RELAYER_KEY", "relayer") // Key name in keyring
 
+	// Block to anchor the header chain at when the chain tracks no headers yet
+	// (defaults to the current finalized block)
+	startBlock = getEnv("START_BLOCK", "")
+
 	// Polling interval
 	pollingInterval = 15 * time.Second
 
+	// Maximum headers per MsgSubmitEthHeaders
+	headerBatchSize = uint64(50)
+
 	// Deposited event topic: keccak256("Deposited(string,uint256,uint256)")
 	depositedEventTopic = common.HexToHash("0x46008385c8bcecb546cb0a96e5b409f34ac1a8ece8f3ea98488282519372bdf2")
 )
@@ -57,22 +72,13 @@ func main() {
 	log.Printf("   Relayer Key: %s", relayerKey)
 
 	// Connect to Ethereum
-	ethClient, err := ethclient.Dial(ethRPCURL)
+	rpcClient, err := rpc.Dial(ethRPCURL)
 	if err != nil {
 		log.Fatalf("❌ Failed to connect to Ethereum: %v", err)
 	}
+	ethClient := ethclient.NewClient(rpcClient)
 	defer ethClient.Close()
 
-	// Get current block
-	currentBlock, err := ethClient.BlockNumber(context.Background())
-	if err != nil {
-		log.Fatalf("❌ Failed to get current block: %v", err)
-	}
-	log.Printf("✅ Connected to Base chain, current block: %d", currentBlock)
-
-	// Start from recent blocks
-	lastProcessedBlock := currentBlock - 100
-
 	// Handle graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -97,91 +103,228 @@ func main() {
 			log.Println("👋 Relayer stopped")
 			return
 		case <-ticker.C:
-			latestBlock, err := ethClient.BlockNumber(ctx)
-			if err != nil {
-				log.Printf("⚠️ Failed to get latest block: %v", err)
-				continue
+			if err := sync(ctx, rpcClient, ethClient); err != nil {
+				log.Printf("⚠️ Sync failed: %v", err)
 			}
+		}
+	}
+}
 
-			if lastProcessedBlock >= latestBlock {
-				continue // No new blocks
-			}
+// sync extends the on-chain header chain up to the finalized block and then
+// submits proofs for every deposit in the newly tracked blocks
+func sync(ctx context.Context, rpcClient *rpc.Client, ethClient keeper.EthChainReader) error {
+	finalized, err := ethClient.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return fmt.Errorf("failed to get finalized block: %w", err)
+	}
+
+	tip, found, err := queryHeaderTip()
+	if err != nil {
+		return err
+	}
 
-			// Process new blocks
-			err = processBlocks(ctx, ethClient, lastProcessedBlock+1, latestBlock)
-			if err != nil {
-				log.Printf("⚠️ Error processing blocks: %v", err)
-				continue
+	// Anchor the header chain on first run
+	from := tip + 1
+	if !found {
+		from = finalized.Number.Uint64()
+		if startBlock != "" {
+			if from, err = strconv.ParseUint(startBlock, 10, 64); err != nil {
+				return fmt.Errorf("invalid START_BLOCK: %w", err)
 			}
+		}
+		log.Printf("⚓ No headers tracked yet, anchoring at block %d", from)
+	}
+
+	for start := from; start <= finalized.Number.Uint64(); start += headerBatchSize {
+		end := min(start+headerBatchSize-1, finalized.Number.Uint64())
+
+		headers, err := fetchRawHeaders(ctx, ethClient, start, end)
+		if err != nil {
+			return err
+		}
+		if err := submitHeaders(headers, start, end); err != nil {
+			return err
+		}
+		if err := processBlocks(ctx, rpcClient, ethClient, start, end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-			lastProcessedBlock = latestBlock
+// fetchRawHeaders returns the RLP encoding of each header in the range. The
+// re-encoded header must hash to the block hash reported by the node.
+func fetchRawHeaders(ctx context.Context, ethClient keeper.EthChainReader, from, to uint64) ([][]byte, error) {
+	var headers [][]byte
+	for n := from; n <= to; n++ {
+		header, err := ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get header %d: %w", n, err)
 		}
+		raw, err := rlp.EncodeToBytes(header)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode header %d: %w", n, err)
+		}
+		headers = append(headers, raw)
 	}
+	return headers, nil
 }
 
-func processBlocks(ctx context.Context, ethClient *ethclient.Client, fromBlock, toBlock uint64) error {
-	const maxBlockRange = 999
+func processBlocks(ctx context.Context, rpcClient *rpc.Client, ethClient keeper.EthChainReader, fromBlock, toBlock uint64) error {
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []common.Address{common.HexToAddress(depositContractAddress)},
+		Topics:    [][]common.Hash{{depositedEventTopic}},
+	}
+
+	logs, err := ethClient.FilterLogs(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to filter logs: %w", err)
+	}
 
-	for startBlock := fromBlock; startBlock <= toBlock; startBlock += maxBlockRange + 1 {
-		endBlock := startBlock + maxBlockRange
-		if endBlock > toBlock {
-			endBlock = toBlock
+	for _, vLog := range logs {
+		// Event: Deposited(string indexed account, uint256 amount, uint256 index)
+		// Non-indexed: amount (32 bytes) + index (32 bytes)
+		if len(vLog.Data) < 64 {
+			log.Printf("⚠️ Invalid event data length: %d", len(vLog.Data))
+			continue
 		}
+		depositIndex := new(big.Int).SetBytes(vLog.Data[32:64]).Uint64()
 
-		// Query for Deposited events
-		query := ethereum.FilterQuery{
-			FromBlock: big.NewInt(int64(startBlock)),
-			ToBlock:   big.NewInt(int64(endBlock)),
-			Addresses: []common.Address{common.HexToAddress(depositContractAddress)},
-			Topics:    [][]common.Hash{{depositedEventTopic}},
+		// Skip if already processed
+		if processedIndices[depositIndex] {
+			continue
 		}
 
-		logs, err := ethClient.FilterLogs(ctx, query)
-		if err != nil {
-			return fmt.Errorf("failed to filter logs: %w", err)
+		log.Printf("📥 Found deposit: index=%d, tx=%s, block=%d",
+			depositIndex, vLog.TxHash.Hex(), vLog.BlockNumber)
+
+		if err := relayDeposit(ctx, rpcClient, ethClient, vLog.BlockNumber, vLog.TxHash, vLog.Index, depositIndex); err != nil {
+			log.Printf("⚠️ Failed to submit deposit %d: %v", depositIndex, err)
+			// Don't mark as processed, will retry next time
+			continue
 		}
 
-		for _, vLog := range logs {
-			// Parse deposit index from event data
-			// Event: Deposited(string indexed account, uint256 amount, uint256 index)
-			// Non-indexed: amount (32 bytes) + index (32 bytes)
-			if len(vLog.Data) < 64 {
-				log.Printf("⚠️ Invalid event data length: %d", len(vLog.Data))
-				continue
-			}
+		processedIndices[depositIndex] = true
+		log.Printf("✅ Deposit %d submitted successfully", depositIndex)
+	}
+	return nil
+}
+
+// relayDeposit builds the receipt proof for a deposit and submits it
+func relayDeposit(ctx context.Context, rpcClient *rpc.Client, ethClient keeper.EthChainReader, blockNumber uint64, txHash common.Hash, blockLogIndex uint, depositIndex uint64) error {
+	receipt, err := ethClient.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return fmt.Errorf("failed to get receipt: %w", err)
+	}
+	logIndex := -1
+	for i, l := range receipt.Logs {
+		if l.Index == blockLogIndex {
+			logIndex = i
+		}
+	}
+	if logIndex < 0 {
+		return fmt.Errorf("deposit log not found in receipt")
+	}
 
-			indexBytes := vLog.Data[32:64]
-			depositIndex := new(big.Int).SetBytes(indexBytes).Uint64()
+	// The recipient is only indexed by hash, so read it from the contract
+	verifier := keeper.NewBridgeVerifierWithReader(ethClient, depositContractAddress)
+	deposit, err := verifier.GetDepositByIndex(ctx, depositIndex, blockNumber)
+	if err != nil {
+		return fmt.Errorf("failed to read deposit: %w", err)
+	}
 
-			// Skip if already processed
-			if processedIndices[depositIndex] {
-				continue
-			}
+	var rawReceipts []hexutil.Bytes
+	if err := rpcClient.CallContext(ctx, &rawReceipts, "debug_getRawReceipts", hexutil.EncodeUint64(blockNumber)); err != nil {
+		return fmt.Errorf("failed to get raw receipts: %w", err)
+	}
+	encoded := make([][]byte, len(rawReceipts))
+	for i, r := range rawReceipts {
+		encoded[i] = r
+	}
 
-			log.Printf("📥 Found deposit: index=%d, tx=%s, block=%d",
-				depositIndex, vLog.TxHash.Hex(), vLog.BlockNumber)
+	root, proof, err := keeper.BuildReceiptProof(encoded, uint64(receipt.TransactionIndex))
+	if err != nil {
+		return err
+	}
+	header, err := ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return err
+	}
+	if root != header.ReceiptHash {
+		return fmt.Errorf("receipts root mismatch: built %s, header has %s", root.Hex(), header.ReceiptHash.Hex())
+	}
 
-			// Submit to Cosmos chain
-			err := submitDeposit(depositIndex, vLog.BlockNumber)
-			if err != nil {
-				log.Printf("⚠️ Failed to submit deposit %d: %v", depositIndex, err)
-				// Don't mark as processed, will retry next time
-				continue
-			}
+	return submitDeposit(depositIndex, blockNumber, deposit.Account, uint64(receipt.TransactionIndex), uint64(logIndex), proof)
+}
 
-			processedIndices[depositIndex] = true
-			log.Printf("✅ Deposit %d submitted successfully", depositIndex)
+// queryHeaderTip returns the latest header tracked by the chain
+func queryHeaderTip() (uint64, bool, error) {
+	cmd := exec.Command("pokerchaind", "query", "poker", "eth-header-tip",
+		"--node", cosmosNode,
+		"--output", "json",
+	)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if strings.Contains(string(output), "no eth headers tracked") {
+			return 0, false, nil
 		}
+		return 0, false, fmt.Errorf("failed to query header tip: %v, output: %s", err, string(output))
 	}
 
-	return nil
+	var resp struct {
+		Header struct {
+			Number string `json:"number"`
+		} `json:"header"`
+	}
+	if err := json.Unmarshal(output, &resp); err != nil {
+		return 0, false, fmt.Errorf("failed to parse header tip: %w", err)
+	}
+	number, err := strconv.ParseUint(resp.Header.Number, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid header tip number %q: %w", resp.Header.Number, err)
+	}
+	return number, true, nil
+}
+
+func submitHeaders(headers [][]byte, from, to uint64) error {
+	args := []string{"tx", "poker", "submit-eth-headers"}
+	for _, h := range headers {
+		args = append(args, hex.EncodeToString(h))
+	}
+	args = append(args, txFlags()...)
+
+	log.Printf("🚀 Submitting headers %d-%d", from, to)
+	return runTx(args)
 }
 
-func submitDeposit(depositIndex uint64, ethBlockHeight uint64) error {
-	// Build the command - eth-block-height is now a positional argument
-	cmd := exec.Command("pokerchaind", "tx", "poker", "process-deposit",
+func submitDeposit(depositIndex, ethBlockHeight uint64, recipient string, txIndex, logIndex uint64, proof [][]byte) error {
+	args := []string{"tx", "poker", "process-deposit",
 		fmt.Sprintf("%d", depositIndex),
 		fmt.Sprintf("%d", ethBlockHeight),
+		recipient,
+		fmt.Sprintf("%d", txIndex),
+		fmt.Sprintf("%d", logIndex),
+	}
+	for _, node := range proof {
+		args = append(args, "--receipt-proof", hex.EncodeToString(node))
+	}
+	args = append(args, txFlags()...)
+
+	log.Printf("🚀 Submitting: pokerchaind tx poker process-deposit %d %d %s %d %d (%d proof nodes)",
+		depositIndex, ethBlockHeight, recipient, txIndex, logIndex, len(proof))
+
+	err := runTx(args)
+	if err != nil && strings.Contains(err.Error(), "already processed") {
+		log.Printf("ℹ️ Deposit %d already processed", depositIndex)
+		return nil
+	}
+	return err
+}
+
+func txFlags() []string {
+	return []string{
 		"--from", relayerKey,
 		"--chain-id", cosmosChainID,
 		"--node", cosmosNode,
@@ -189,22 +332,14 @@ func submitDeposit(depositIndex uint64, ethBlockHeight uint64) error {
 		"--gas-adjustment", "1.5",
 		"--yes",
 		"--output", "json",
-	)
-
-	log.Printf("🚀 Submitting: pokerchaind tx poker process-deposit %d %d",
-		depositIndex, ethBlockHeight)
+	}
+}
 
-	output, err := cmd.CombinedOutput()
+func runTx(args []string) error {
+	output, err := exec.Command("pokerchaind", args...).CombinedOutput()
 	if err != nil {
-		// Check if it's already processed (not an error)
-		if strings.Contains(string(output), "already processed") ||
-			strings.Contains(string(output), "ErrTxAlreadyProcessed") {
-			log.Printf("ℹ️ Deposit %d already processed", depositIndex)
-			return nil
-		}
 		return fmt.Errorf("command failed: %v, output: %s", err, string(output))
 	}
-
 	log.Printf("📤 TX Output: %s", truncateString(string(output), 200))
 	return nil
 }
@@ -215,10 +350,3 @@ func truncateString(s string, maxLen int) string {
 	}
 	return s[:maxLen] + "..."
 }
-
-// Helper to decode hex string
-func decodeHex(s string) []byte {
-	s = strings.TrimPrefix(s, "0x")
-	b, _ := hex.DecodeString(s)
-	return b
-}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+func newActCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "act <game-id> [action] [amount]",
+		Short: "Perform a poker action, or list your legal actions",
+		Long: `Perform a poker action such as fold, check, call, bet, raise or all-in.
+Without an action, list the legal actions of --from (or --player).`,
+		Example: `  poker-cli --from alice act 0x89a7...             # legal actions
+  poker-cli --from alice act 0x89a7... call
+  poker-cli --from alice act 0x89a7... raise 4000000`,
+		Args: cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				return listLegalActions(cmd, args[0])
+			}
+
+			action := strings.ToLower(args[1])
+			var amount uint64
+			if len(args) == 3 {
+				var err error
+				if amount, err = strconv.ParseUint(args[2], 10, 64); err != nil {
+					return fmt.Errorf("invalid amount %q: %w", args[2], err)
+				}
+			}
+
+			c, err := newClient(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			res, err := c.PerformAction(cmd.Context(), args[0], action, amount)
+			done := fmt.Sprintf("Performed %s", action)
+			if amount > 0 {
+				done = fmt.Sprintf("Performed %s %d", action, amount)
+			}
+			return printTx(cmd, c, res, err, done)
+		},
+	}
+	cmd.Flags().String("player", "", "Address whose legal actions to list (default: --from)")
+	addTxFlags(cmd)
+	return cmd
+}
+
+func listLegalActions(cmd *cobra.Command, gameId string) error {
+	player, _ := cmd.Flags().GetString("player")
+	c, err := newClient(cmd, player == "")
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if player == "" {
+		player = c.Player().String()
+	}
+
+	actions, err := c.LegalActions(cmd.Context(), gameId, player)
+	if err != nil {
+		return err
+	}
+
+	return printOutput(cmd, actions, func(w io.Writer) {
+		printLegalActions(w, actions)
+	})
+}
+
+func printLegalActions(w io.Writer, actions []pokertypes.LegalActionDTO) {
+	if len(actions) == 0 {
+		fmt.Fprintln(w, "No legal actions (not your turn or not seated)")
+		return
+	}
+	for _, action := range actions {
+		switch {
+		case action.Min != nil && action.Max != nil && *action.Min != *action.Max:
+			fmt.Fprintf(w, "  %-12s %s-%s\n", action.Action, *action.Min, *action.Max)
+		case action.Min != nil && *action.Min != "0":
+			fmt.Fprintf(w, "  %-12s %s\n", action.Action, *action.Min)
+		default:
+			fmt.Fprintf(w, "  %s\n", action.Action)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+func newBalanceCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "balance [address]",
+		Short: "Show an account's balances (default: --from)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient(cmd, len(args) == 0)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			address := c.Player().String()
+			if len(args) == 1 {
+				address = args[0]
+			}
+			balances, err := c.Balances(cmd.Context(), address)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(cmd, balances, func(w io.Writer) {
+				if balances.IsZero() {
+					fmt.Fprintf(w, "%s has no balances\n", address)
+					return
+				}
+				for _, coin := range balances {
+					fmt.Fprintf(w, "%s %s\n", coin.Amount, coin.Denom)
+				}
+			})
+		},
+	}
+}
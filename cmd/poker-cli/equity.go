@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func newEquityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "equity <hand> <hand> [hand...]",
+		Short: "Calculate the equity of two or more hands",
+		Long: `Calculate each hand's chance of winning with the chain's Monte Carlo
+equity calculator. Hands are two cards written together, e.g. AsKd.`,
+		Example: "  poker-cli equity AsKd QhQc --board 2c7dJs",
+		Args:    cobra.RangeArgs(2, 9),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hands := make([][]string, 0, len(args))
+			for _, arg := range args {
+				hand := splitCards(arg)
+				if len(hand) != 2 {
+					return fmt.Errorf("hand %q must be two cards, e.g. AsKd", arg)
+				}
+				hands = append(hands, hand)
+			}
+			board, _ := cmd.Flags().GetString("board")
+			dead, _ := cmd.Flags().GetString("dead")
+			simulations, _ := cmd.Flags().GetInt32("simulations")
+
+			c, err := newClient(cmd, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			res, err := c.Equity(cmd.Context(), hands, splitCards(board), splitCards(dead), simulations)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(cmd, res, func(w io.Writer) {
+				fmt.Fprintf(w, "%s, %d simulations\n", res.Stage, res.Simulations)
+				for _, r := range res.Results {
+					fmt.Fprintf(w, "  %-6s  win %s  tie %s  total %s\n", strings.Join(r.Hand, ""), r.Equity, r.TieEquity, r.Total)
+				}
+			})
+		},
+	}
+	cmd.Flags().String("board", "", "Community cards, e.g. 2c7dJs")
+	cmd.Flags().String("dead", "", "Cards known to be out of the deck")
+	cmd.Flags().Int32("simulations", 0, "Number of simulations (0 = node default)")
+	return cmd
+}
+
+// splitCards splits "AsKd" into ["As", "Kd"]. Cards may also be separated
+// by spaces or commas.
+func splitCards(s string) []string {
+	s = strings.NewReplacer(" ", "", ",", "").Replace(s)
+	var cards []string
+	for len(s) >= 2 {
+		cards = append(cards, s[:2])
+		s = s[2:]
+	}
+	return cards
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/block52/pokerchain/pkg/pokerclient"
+)
+
+// txResult is the output of commands that broadcast a transaction
+type txResult struct {
+	TxHash  string `json:"txhash"`
+	Height  int64  `json:"height,omitempty"`
+	GasUsed int64  `json:"gas_used,omitempty"`
+	// GameId is the table the transaction acted on, from its events (set
+	// once the transaction is in a block)
+	GameId string `json:"game_id,omitempty"`
+}
+
+func jsonOutput(cmd *cobra.Command) bool {
+	output, _ := cmd.Flags().GetString(flagOutput)
+	return output == "json"
+}
+
+// printOutput writes v as a JSON line with --output json, or calls text
+func printOutput(cmd *cobra.Command, v any, text func(w io.Writer)) error {
+	if jsonOutput(cmd) {
+		return json.NewEncoder(cmd.OutOrStdout()).Encode(v)
+	}
+	text(cmd.OutOrStdout())
+	return nil
+}
+
+// printTx waits for a broadcast transaction if --wait is set and prints its
+// result. done describes the transaction for text output.
+func printTx(cmd *cobra.Command, c *pokerclient.Client, res *sdk.TxResponse, err error, done string) error {
+	if err != nil {
+		return err
+	}
+	if wait, _ := cmd.Flags().GetBool(flagWait); wait {
+		if res, err = c.WaitForTx(cmd.Context(), res.TxHash, time.Second); err != nil {
+			return err
+		}
+	}
+
+	result := txResult{TxHash: res.TxHash, Height: res.Height, GasUsed: res.GasUsed}
+	for _, event := range res.Events {
+		for _, attr := range event.Attributes {
+			if attr.Key == "game_id" && result.GameId == "" {
+				result.GameId = attr.Value
+			}
+		}
+	}
+
+	return printOutput(cmd, result, func(w io.Writer) {
+		fmt.Fprintf(w, "✅ %s\n", done)
+		if result.GameId != "" {
+			fmt.Fprintf(w, "Game ID:          %s\n", result.GameId)
+		}
+		fmt.Fprintf(w, "Transaction hash: %s\n", result.TxHash)
+		if result.Height > 0 {
+			fmt.Fprintf(w, "Height:           %d\n", result.Height)
+		}
+	})
+}
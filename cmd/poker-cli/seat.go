@@ -0,0 +1,228 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+func newSeatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seat",
+		Short: "Join, leave and top up at tables",
+	}
+	cmd.AddCommand(newSeatJoinCmd(), newSeatLeaveCmd(), newSeatTopUpCmd(), newSeatAutoTopUpCmd(), newSeatWaitCmd(), newSeatUnwaitCmd(), newSeatWaitlistCmd())
+	return cmd
+}
+
+func newSeatJoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "join <game-id>",
+		Short:   "Buy in at a seat",
+		Example: "  poker-cli --from alice seat join 0x89a7... --seat 1 --buy-in 500000000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			seat, _ := cmd.Flags().GetUint64("seat")
+			buyIn, _ := cmd.Flags().GetUint64("buy-in")
+			inviteCode, _ := cmd.Flags().GetString("invite-code")
+			res, err := c.JoinGame(cmd.Context(), args[0], seat, buyIn, inviteCode)
+			done := fmt.Sprintf("Joined with %d", buyIn)
+			if seat > 0 {
+				done = fmt.Sprintf("Joined at seat %d with %d", seat, buyIn)
+			}
+			return printTx(cmd, c, res, err, done)
+		},
+	}
+	cmd.Flags().Uint64("seat", 0, "Seat number (0 = any free seat)")
+	cmd.Flags().Uint64("buy-in", 0, "Buy-in amount in the table denom's base units")
+	cmd.Flags().String("invite-code", "", "Invite code of an invite-only table")
+	_ = cmd.MarkFlagRequired("buy-in")
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newSeatLeaveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leave <game-id>",
+		Short: "Leave a table and cash out",
+		Long:  "Leave a table and cash out. During a hand you are folded when it is your turn and cashed out once the hand is over.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			res, err := c.LeaveGame(cmd.Context(), args[0])
+			return printTx(cmd, c, res, err, "Left the table, or queued to leave after the current hand")
+		},
+	}
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newSeatTopUpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "topup <game-id> <amount>",
+		Short: "Add chips to your stack between hands",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[1], err)
+			}
+
+			c, err := newClient(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			res, err := c.TopUp(cmd.Context(), args[0], amount)
+			return printTx(cmd, c, res, err, fmt.Sprintf("Topped up %d", amount))
+		},
+	}
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newSeatAutoTopUpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "autotopup <game-id> <target-stack>",
+		Short: "Rebuy or top up to a target stack automatically between hands",
+		Long: `Rebuy or top up to a target stack automatically between hands, paid from
+your spendable balance. By default your stack is topped back up to the
+target whenever it is below it; with --rebuy you only buy back in for the
+target once you are busted. The chain cancels the instruction if you cannot
+pay for it. Pass --cancel to cancel it yourself.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cancel, _ := cmd.Flags().GetBool("cancel")
+			if cancel != (len(args) == 1) {
+				return fmt.Errorf("give either a target stack or --cancel")
+			}
+			var target uint64
+			if !cancel {
+				var err error
+				if target, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+					return fmt.Errorf("invalid target stack %q: %w", args[1], err)
+				}
+			}
+
+			c, err := newClient(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			if cancel {
+				res, err := c.CancelAutoTopUp(cmd.Context(), args[0])
+				return printTx(cmd, c, res, err, "Cancelled auto top-up")
+			}
+			mode := pokertypes.AutoTopUpTopUp
+			if rebuy, _ := cmd.Flags().GetBool("rebuy"); rebuy {
+				mode = pokertypes.AutoTopUpRebuy
+			}
+			res, err := c.SetAutoTopUp(cmd.Context(), args[0], string(mode), target)
+			return printTx(cmd, c, res, err, fmt.Sprintf("Set auto %s to %d", mode, target))
+		},
+	}
+	cmd.Flags().Bool("rebuy", false, "Only buy back in once busted")
+	cmd.Flags().Bool("cancel", false, "Cancel your auto top-up")
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newSeatWaitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait <game-id>",
+		Short: "Join the waitlist of a full table",
+		Long: `Join the waitlist of a full table. When a seat frees up it is held for the
+player at the head of the waitlist for the chain's seat reservation window;
+take it with "seat join" before it lapses.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			inviteCode, _ := cmd.Flags().GetString("invite-code")
+			res, err := c.JoinWaitlist(cmd.Context(), args[0], inviteCode)
+			return printTx(cmd, c, res, err, "Joined the waitlist")
+		},
+	}
+	cmd.Flags().String("invite-code", "", "Invite code of an invite-only table")
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newSeatUnwaitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unwait <game-id>",
+		Short: "Leave the waitlist of a table, giving up any seat held for you",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			res, err := c.LeaveWaitlist(cmd.Context(), args[0])
+			return printTx(cmd, c, res, err, "Left the waitlist")
+		},
+	}
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newSeatWaitlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "waitlist <game-id>",
+		Short: "Show your place in a table's waitlist",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			player, _ := cmd.Flags().GetString("player")
+			c, err := newClient(cmd, player == "")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if player == "" {
+				player = c.Player().String()
+			}
+
+			res, err := c.WaitlistPosition(cmd.Context(), args[0], player)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(cmd, res, func(w io.Writer) {
+				switch {
+				case res.ReservedSeat > 0:
+					fmt.Fprintf(w, "Seat %d is held for you until %s\n", res.ReservedSeat, time.Unix(res.ReservedUntil, 0).Format(time.RFC3339))
+				case res.Position > 0:
+					fmt.Fprintf(w, "Position %d of %d\n", res.Position, res.WaitlistSize)
+				default:
+					fmt.Fprintf(w, "Not on the waitlist (%d waiting)\n", res.WaitlistSize)
+				}
+			})
+		},
+	}
+	cmd.Flags().String("player", "", "Address whose place to show (default: --from)")
+	return cmd
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/block52/pokerchain/pkg/pokerclient"
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+func newSessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session",
+		Short: "Manage the session key that acts for you at tables",
+		Long: `Manage the session key that acts for you at tables.
+
+A session key is a key poker-cli keeps next to your --from key (named
+<from>-session). Once started, it may act and top up at the given tables up to
+a maximum amount until it expires, with fees paid by your account. Use it with
+--session:
+
+  poker-cli --from alice session start 0x89a7... --max-amount 10000000
+  poker-cli --from alice --session act call 0x89a7...`,
+	}
+	cmd.AddCommand(newSessionStartCmd(), newSessionRotateCmd(), newSessionEndCmd(), newSessionShowCmd())
+	return cmd
+}
+
+// newSessionClient connects with the player's own key, which grants and
+// revokes session keys
+func newSessionClient(cmd *cobra.Command) (*pokerclient.Client, error) {
+	if session, _ := cmd.Flags().GetBool(flagSession); session {
+		return nil, fmt.Errorf("session keys are managed with the --%s key; drop --%s", flagFrom, flagSession)
+	}
+	return newClient(cmd, true)
+}
+
+// sessionKey returns the address of the player's session key in the keyring
+func sessionKey(cmd *cobra.Command, c *pokerclient.Client) (sdk.AccAddress, error) {
+	kr, err := openKeyring(cmd)
+	if err != nil {
+		return nil, err
+	}
+	name := pokerclient.SessionKeyName(c.KeyName())
+	record, err := kr.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("no session key %s; start one with poker-cli session start: %w", name, err)
+	}
+	return record.GetAddress()
+}
+
+func newSessionStartCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "start <game-id>...",
+		Short:   "Create a session key and authorize it at tables",
+		Example: "  poker-cli --from alice session start 0x89a7... --max-amount 10000000 --duration 4h",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newSessionClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			s, err := sessionFromFlags(cmd, args)
+			if err != nil {
+				return err
+			}
+			kr, err := openKeyring(cmd)
+			if err != nil {
+				return err
+			}
+			name := pokerclient.SessionKeyName(c.KeyName())
+			if s.Key, err = pokerclient.NewSessionKey(kr, name); err != nil {
+				return err
+			}
+
+			res, err := c.StartSession(cmd.Context(), s)
+			return printTx(cmd, c, res, err, fmt.Sprintf("Session key %s (%s) may act until %s", name, s.Key, s.Expires.Format(time.RFC3339)))
+		},
+	}
+	addSessionFlags(cmd)
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newSessionRotateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Replace the session key with a new one on the same terms",
+		Long:  "Replace the session key with a new one, in one transaction. The new key may act at the same tables up to the same amounts, for --duration from now.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newSessionClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			oldKey, err := sessionKey(cmd, c)
+			if err != nil {
+				return err
+			}
+			old, err := c.Session(cmd.Context(), c.Address(), oldKey)
+			if err != nil {
+				return err
+			}
+			s, err := sessionFromFlags(cmd, old.GameIds)
+			if err != nil {
+				return err
+			}
+			s.MaxAmount = old.MaxAmount
+			s.FeeLimit = old.FeeLimit
+
+			// The new key is kept under a temporary name until the rotation
+			// is broadcast, so a failure leaves the old key usable
+			kr, err := openKeyring(cmd)
+			if err != nil {
+				return err
+			}
+			name := pokerclient.SessionKeyName(c.KeyName())
+			next := name + "-next"
+			if s.Key, err = pokerclient.NewSessionKey(kr, next); err != nil {
+				return err
+			}
+			res, err := c.RotateSession(cmd.Context(), oldKey, s)
+			if err != nil {
+				_ = kr.Delete(next)
+				return err
+			}
+			if err := kr.Delete(name); err != nil {
+				return fmt.Errorf("failed to delete old session key %s: %w", name, err)
+			}
+			if err := kr.Rename(next, name); err != nil {
+				return fmt.Errorf("failed to rename session key %s to %s: %w", next, name, err)
+			}
+			return printTx(cmd, c, res, nil, fmt.Sprintf("Session key %s is now %s, until %s", name, s.Key, s.Expires.Format(time.RFC3339)))
+		},
+	}
+	cmd.Flags().Duration("duration", 12*time.Hour, "How long the new session key may act")
+	cmd.Flags().String("fund", "1"+pokertypes.TokenDenom, "Coins sent to the new key to open its account")
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newSessionEndCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "end",
+		Short: "Revoke the session key and delete it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newSessionClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			key, err := sessionKey(cmd, c)
+			if err != nil {
+				return err
+			}
+			res, err := c.EndSession(cmd.Context(), key)
+			if err != nil {
+				return err
+			}
+			kr, err := openKeyring(cmd)
+			if err != nil {
+				return err
+			}
+			name := pokerclient.SessionKeyName(c.KeyName())
+			if err := kr.Delete(name); err != nil {
+				return fmt.Errorf("failed to delete session key %s: %w", name, err)
+			}
+			return printTx(cmd, c, res, nil, fmt.Sprintf("Session key %s revoked and deleted", key))
+		},
+	}
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newSessionShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Show what the session key may do",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newSessionClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			key, err := sessionKey(cmd, c)
+			if err != nil {
+				return err
+			}
+			s, err := c.Session(cmd.Context(), c.Address(), key)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(cmd, s, func(w io.Writer) {
+				fmt.Fprintf(w, "Session key: %s\n", s.Key)
+				fmt.Fprintf(w, "Tables:      %s\n", strings.Join(s.GameIds, ", "))
+				if s.MaxAmount > 0 {
+					fmt.Fprintf(w, "Max amount:  %d\n", s.MaxAmount)
+				} else {
+					fmt.Fprintln(w, "Max amount:  no limit")
+				}
+				if !s.FeeLimit.IsZero() {
+					fmt.Fprintf(w, "Fee limit:   %s\n", s.FeeLimit)
+				} else {
+					fmt.Fprintln(w, "Fee limit:   no limit")
+				}
+				fmt.Fprintf(w, "Expires:     %s\n", s.Expires.Format(time.RFC3339))
+			})
+		},
+	}
+}
+
+// addSessionFlags adds the flags setting a new session's terms
+func addSessionFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64("max-amount", 0, "Largest bet, raise or top-up the key may make (0 = no limit)")
+	cmd.Flags().String("fee-limit", "", "Most the key may spend on fees, e.g. 1000usdc (default no limit)")
+	cmd.Flags().Duration("duration", 12*time.Hour, "How long the session key may act")
+	cmd.Flags().String("fund", "1"+pokertypes.TokenDenom, "Coins sent to the new key to open its account")
+}
+
+// sessionFromFlags returns a session at gameIds on the terms of the flags;
+// the caller sets its key
+func sessionFromFlags(cmd *cobra.Command, gameIds []string) (pokerclient.Session, error) {
+	flags := cmd.Flags()
+	duration, _ := flags.GetDuration("duration")
+	if duration <= 0 {
+		return pokerclient.Session{}, errors.New("--duration must be positive")
+	}
+	s := pokerclient.Session{GameIds: gameIds, Expires: time.Now().Add(duration)}
+
+	fund, _ := flags.GetString("fund")
+	var err error
+	if s.Fund, err = sdk.ParseCoinsNormalized(fund); err != nil {
+		return s, fmt.Errorf("invalid --fund %q: %w", fund, err)
+	}
+	if flags.Lookup("max-amount") != nil {
+		s.MaxAmount, _ = flags.GetUint64("max-amount")
+		feeLimit, _ := flags.GetString("fee-limit")
+		if s.FeeLimit, err = sdk.ParseCoinsNormalized(feeLimit); err != nil {
+			return s, fmt.Errorf("invalid --fee-limit %q: %w", feeLimit, err)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+func newStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state <game-id>",
+		Short: "Show a table's game state",
+		Long: `Show a table's game state. With --from the query is signed and shows
+your hole cards; otherwise all cards are hidden.
+
+With --watch the state is printed again every time it changes, one JSON
+object per line with --output json, until interrupted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient(cmd, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			gameId := args[0]
+			watch, _ := cmd.Flags().GetBool("watch")
+			if !watch {
+				state, err := c.GameState(cmd.Context(), gameId)
+				if err != nil {
+					return err
+				}
+				return printState(cmd, state, c.Player().String())
+			}
+
+			interval, _ := cmd.Flags().GetDuration("interval")
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			var last []byte
+			for {
+				state, err := c.GameState(ctx, gameId)
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil
+					}
+					return err
+				}
+				current, err := json.Marshal(state)
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(current, last) {
+					if err := printState(cmd, state, c.Player().String()); err != nil {
+						return err
+					}
+					last = current
+				}
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+	cmd.Flags().Bool("watch", false, "Keep printing the state as it changes")
+	cmd.Flags().Duration("interval", 2*time.Second, "How often --watch polls the node")
+	return cmd
+}
+
+func printState(cmd *cobra.Command, state *pokertypes.TexasHoldemStateDTO, self string) error {
+	return printOutput(cmd, state, func(w io.Writer) {
+		fmt.Fprintf(w, "── %s ── hand %d, %s\n", state.Address, state.HandNumber, state.Round)
+
+		board := "(none)"
+		if len(state.CommunityCards) > 0 {
+			board = strings.Join(state.CommunityCards, " ")
+		}
+		fmt.Fprintf(w, "Board:       %s\n", board)
+		if len(state.Pots) > 0 {
+			fmt.Fprintf(w, "Pots:        %s\n", strings.Join(state.Pots, ", "))
+		}
+		fmt.Fprintf(w, "Next to act: seat %d\n", state.NextToAct)
+
+		var yours *pokertypes.PlayerDTO
+		fmt.Fprintf(w, "\n%-4s  %-44s  %12s  %10s  %-10s  %s\n", "SEAT", "PLAYER", "STACK", "BETS", "STATUS", "CARDS")
+		for i, p := range state.Players {
+			cards := ""
+			if p.HoleCards != nil {
+				cards = strings.Join(*p.HoleCards, " ")
+			}
+			marker := " "
+			if p.Seat == state.NextToAct {
+				marker = "*"
+			}
+			status := string(p.Status)
+			if p.LeavingAfterHand {
+				status = "leaving"
+			}
+			fmt.Fprintf(w, "%s%-3d  %-44s  %12s  %10s  %-10s  %s\n", marker, p.Seat, p.Address, p.Stack, p.SumOfBets, status, cards)
+			if p.Address == self {
+				yours = &state.Players[i]
+			}
+		}
+
+		for _, winner := range state.Winners {
+			fmt.Fprintf(w, "\n🏆 %s wins %s\n", winner.Address, winner.Amount)
+		}
+		if yours != nil {
+			fmt.Fprintln(w, "\nYour legal actions:")
+			printLegalActions(w, yours.LegalActions)
+		}
+		fmt.Fprintln(w)
+	})
+}
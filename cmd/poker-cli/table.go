@@ -0,0 +1,290 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+func newTableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "table",
+		Short: "Create and inspect tables",
+	}
+	cmd.AddCommand(newTableCreateCmd(), newTableListCmd(), newTableShowCmd(), newTableAllowlistCmd())
+	return cmd
+}
+
+func newTableCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a table",
+		Example: `  poker-cli --from alice table create --small-blind 500000 --big-blind 1000000 \
+    --min-buy-in 100000000 --max-buy-in 1000000000`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := newClient(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			flags := cmd.Flags()
+			msg := pokertypes.MsgCreateGame{}
+			msg.MinBuyIn, _ = flags.GetUint64("min-buy-in")
+			msg.MaxBuyIn, _ = flags.GetUint64("max-buy-in")
+			msg.SmallBlind, _ = flags.GetUint64("small-blind")
+			msg.BigBlind, _ = flags.GetUint64("big-blind")
+			msg.MinPlayers, _ = flags.GetInt64("min-players")
+			msg.MaxPlayers, _ = flags.GetInt64("max-players")
+			msg.Timeout, _ = flags.GetInt64("timeout")
+			msg.GameType, _ = flags.GetString("game-type")
+			msg.Denom, _ = flags.GetString("denom")
+			msg.RakePercentage, _ = flags.GetUint32("rake-percentage")
+			msg.RakeCap, _ = flags.GetUint64("rake-cap")
+			msg.RakeFreeThreshold, _ = flags.GetUint64("rake-free-threshold")
+			msg.RakeOwner, _ = flags.GetString("rake-owner")
+			msg.Visibility, _ = flags.GetString("visibility")
+			msg.Allowlist, _ = flags.GetStringSlice("allow")
+			msg.RequiredDenom, _ = flags.GetString("require-denom")
+			msg.RequiredAmount, _ = flags.GetUint64("require-amount")
+			msg.Ante, _ = flags.GetUint64("ante")
+			msg.AnteType, _ = flags.GetString("ante-type")
+			msg.Straddle, _ = flags.GetString("straddle")
+			msg.BombPotFrequency, _ = flags.GetUint64("bomb-pot-every")
+			msg.BombPotAnte, _ = flags.GetUint64("bomb-pot-ante")
+			msg.MaxSitOutHands, _ = flags.GetUint64("max-sit-out-hands")
+			msg.MaxSitOutMinutes, _ = flags.GetUint64("max-sit-out-minutes")
+			msg.PostMissedBlinds, _ = flags.GetBool("post-missed-blinds")
+			msg.Spectators, _ = flags.GetString("spectators")
+			msg.SpectatorDelayActions, _ = flags.GetUint64("spectator-delay-actions")
+			msg.SpectatorDelaySeconds, _ = flags.GetUint64("spectator-delay-seconds")
+			if inviteCode, _ := flags.GetString("invite-code"); inviteCode != "" {
+				msg.InvitePublicKey = pokertypes.InvitePublicKey(c.Player().String(), inviteCode)
+			}
+
+			res, err := c.CreateGame(cmd.Context(), msg)
+			return printTx(cmd, c, res, err, "Table created")
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.Uint64("small-blind", 0, "Small blind in the table denom's base units")
+	flags.Uint64("big-blind", 0, "Big blind in the table denom's base units")
+	flags.Uint64("min-buy-in", 0, "Minimum buy-in")
+	flags.Uint64("max-buy-in", 0, "Maximum buy-in")
+	flags.Int64("min-players", 2, "Players needed to deal")
+	flags.Int64("max-players", 9, "Seats at the table")
+	flags.Int64("timeout", 60, "Seconds each player has to act")
+	flags.String("game-type", "cash", "Game type (cash|sit-and-go|tournament)")
+	flags.String("denom", "", "Table currency (default: the chain's USDC)")
+	flags.Uint32("rake-percentage", 0, "Percentage of each pot taken as rake (0 disables rake)")
+	flags.Uint64("rake-cap", 0, "Maximum rake per hand")
+	flags.Uint64("rake-free-threshold", 0, "Pot size below which no rake is taken")
+	flags.String("rake-owner", "", "Address receiving rake (default: the creator)")
+	flags.String("visibility", "", "Who can find the table (public|unlisted|private, default public)")
+	flags.StringSlice("allow", nil, "Addresses allowed to sit")
+	flags.String("invite-code", "", "Invite code that lets anyone who knows it sit (only its hash is stored)")
+	flags.String("require-denom", "", "Denom players must hold to sit, e.g. a membership token")
+	flags.Uint64("require-amount", 0, "Amount of --require-denom players must hold")
+	flags.Uint64("ante", 0, "Ante posted each hand (0 disables antes)")
+	flags.String("ante-type", "", "Who posts the ante (per-player|big-blind|button, default per-player)")
+	flags.String("straddle", "", "Seat that straddles twice the big blind each hand (utg|button)")
+	flags.Uint64("bomb-pot-every", 0, "Play every nth hand as a bomb pot (0 disables bomb pots)")
+	flags.Uint64("bomb-pot-ante", 0, "What every player antes in a bomb pot (default: the big blind)")
+	flags.Uint64("max-sit-out-hands", 0, "Remove players who sit out this many hands (0 for no limit)")
+	flags.Uint64("max-sit-out-minutes", 0, "Remove players who sit out this many minutes (0 for no limit)")
+	flags.Bool("post-missed-blinds", false, "Have returning players post the blinds they missed as dead money")
+	flags.String("spectators", "", "Who may watch the table (off|live|delayed, default live)")
+	flags.Uint64("spectator-delay-actions", 0, "Actions delayed spectators trail the table by")
+	flags.Uint64("spectator-delay-seconds", 0, "Seconds delayed spectators trail the table by")
+	for _, name := range []string{"small-blind", "big-blind", "min-buy-in", "max-buy-in"} {
+		_ = cmd.MarkFlagRequired(name)
+	}
+	addTxFlags(cmd)
+	return cmd
+}
+
+func newTableListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List tables",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := newClient(cmd, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			denom, _ := cmd.Flags().GetString("denom")
+			games, err := c.ListGames(cmd.Context(), denom)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(cmd, games, func(w io.Writer) {
+				if len(games) == 0 {
+					fmt.Fprintln(w, "No tables")
+					return
+				}
+				fmt.Fprintf(w, "%-66s  %-8s  %-7s  %-15s  %-25s  %s\n", "GAME ID", "DENOM", "STATUS", "BLINDS", "BUY-IN", "PLAYERS")
+				for _, g := range games {
+					fmt.Fprintf(w, "%-66s  %-8s  %-7s  %-15s  %-25s  %d/%d\n",
+						g.GameId, g.TableDenom(), g.Status,
+						fmt.Sprintf("%d/%d", g.SmallBlind, g.BigBlind),
+						fmt.Sprintf("%d-%d", g.MinBuyIn, g.MaxBuyIn),
+						len(g.Players), g.MaxPlayers)
+				}
+			})
+		},
+	}
+	cmd.Flags().String("denom", "", "Only list tables in this currency")
+	return cmd
+}
+
+func newTableShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <game-id>",
+		Short: "Show a table's settings and seated players",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient(cmd, false)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			game, err := c.Game(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			return printOutput(cmd, game, func(w io.Writer) {
+				fmt.Fprintf(w, "Game ID:     %s\n", game.GameId)
+				fmt.Fprintf(w, "Creator:     %s\n", game.Creator)
+				fmt.Fprintf(w, "Type:        %s\n", game.GameType)
+				fmt.Fprintf(w, "Denom:       %s\n", game.TableDenom())
+				fmt.Fprintf(w, "Status:      %s\n", game.Status)
+				fmt.Fprintf(w, "Blinds:      %d/%d\n", game.SmallBlind, game.BigBlind)
+				if game.Ante > 0 {
+					fmt.Fprintf(w, "Ante:        %d (%s)\n", game.Ante, game.AnteType)
+				}
+				if game.Straddle != pokertypes.StraddleNone {
+					fmt.Fprintf(w, "Straddle:    %d (%s)\n", game.StraddleAmount(), game.Straddle)
+				}
+				if game.BombPotFrequency > 0 {
+					fmt.Fprintf(w, "Bomb pots:   every %d hands, %d ante\n", game.BombPotFrequency, game.BombPotAnte)
+				}
+				fmt.Fprintf(w, "Buy-in:      %d-%d\n", game.MinBuyIn, game.MaxBuyIn)
+				fmt.Fprintf(w, "Players:     %d/%d (min %d)\n", len(game.Players), game.MaxPlayers, game.MinPlayers)
+				fmt.Fprintf(w, "Timeout:     %ds\n", game.Timeout)
+				if !game.IsListed() {
+					fmt.Fprintf(w, "Visibility:  %s\n", game.Visibility)
+				}
+				if game.MaxSitOutHands > 0 || game.MaxSitOutMinutes > 0 || game.PostMissedBlinds {
+					fmt.Fprintf(w, "Sitting out: %s\n", sitOutRules(game.Game))
+				}
+				if game.HasAccessPolicy() {
+					fmt.Fprintf(w, "Access:      %s\n", accessPolicy(game.Game))
+				}
+				if game.SpectatorMode() != pokertypes.SpectatorsLive {
+					fmt.Fprintf(w, "Spectators:  %s\n", spectatorPolicy(game.Game))
+				}
+				if game.RakePercentage > 0 {
+					fmt.Fprintf(w, "Rake:        %d%% (cap %d, free below %d) to %s\n",
+						game.RakePercentage, game.RakeCap, game.RakeFreeThreshold, game.RakeOwner)
+				}
+				if len(game.Players) > 0 {
+					fmt.Fprintf(w, "Seated:      %s\n", strings.Join(game.Players, ", "))
+				}
+			})
+		},
+	}
+}
+
+func newTableAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowlist <game-id>",
+		Short:   "Add and remove the players allowed to sit at a table you created",
+		Example: "  poker-cli --from alice table allowlist 0x89a7... --add b521... --remove b521...",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			add, _ := cmd.Flags().GetStringSlice("add")
+			remove, _ := cmd.Flags().GetStringSlice("remove")
+			if len(add) == 0 && len(remove) == 0 {
+				return fmt.Errorf("nothing to do: pass --add or --remove")
+			}
+
+			c, err := newClient(cmd, true)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			res, err := c.UpdateAllowlist(cmd.Context(), args[0], add, remove)
+			return printTx(cmd, c, res, err, "Allowlist updated")
+		},
+	}
+	cmd.Flags().StringSlice("add", nil, "Addresses to allow")
+	cmd.Flags().StringSlice("remove", nil, "Addresses to remove")
+	addTxFlags(cmd)
+	return cmd
+}
+
+// sitOutRules describes how long players may sit out at a table and what
+// they owe when they return
+func sitOutRules(game pokertypes.Game) string {
+	var rules []string
+	if game.MaxSitOutHands > 0 {
+		rules = append(rules, fmt.Sprintf("%d hands", game.MaxSitOutHands))
+	}
+	if game.MaxSitOutMinutes > 0 {
+		rules = append(rules, fmt.Sprintf("%d minutes", game.MaxSitOutMinutes))
+	}
+	description := "no limit"
+	if len(rules) > 0 {
+		description = "removed after " + strings.Join(rules, " or ")
+	}
+	if game.PostMissedBlinds {
+		description += ", missed blinds posted dead"
+	}
+	return description
+}
+
+// spectatorPolicy describes who may watch a table, and how far behind
+func spectatorPolicy(game pokertypes.Game) string {
+	if game.SpectatorMode() != pokertypes.SpectatorsDelayed {
+		return string(game.SpectatorMode())
+	}
+	var delays []string
+	if game.SpectatorDelayActions > 0 {
+		delays = append(delays, fmt.Sprintf("%d actions", game.SpectatorDelayActions))
+	}
+	if game.SpectatorDelaySeconds > 0 {
+		delays = append(delays, fmt.Sprintf("%ds", game.SpectatorDelaySeconds))
+	}
+	return "delayed by " + strings.Join(delays, " and ")
+}
+
+// accessPolicy describes who may sit at a table with an access policy
+func accessPolicy(game pokertypes.Game) string {
+	var rules []string
+	if len(game.Allowlist) > 0 {
+		rules = append(rules, fmt.Sprintf("%d allowlisted", len(game.Allowlist)))
+	}
+	if game.Public().HasInviteCode {
+		rules = append(rules, "invite code")
+	}
+	if game.RequiredDenom != "" {
+		rules = append(rules, fmt.Sprintf("holding %d%s", game.RequiredAmount, game.RequiredDenom))
+	}
+	if len(rules) == 0 {
+		return "creator only"
+	}
+	return strings.Join(rules, " or ")
+}
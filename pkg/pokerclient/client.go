@@ -0,0 +1,273 @@
+// Package pokerclient is a Go client for pokerchain. It signs transactions
+// with keys from a Cosmos SDK keyring and queries the poker module over gRPC.
+// poker-cli, the single-purpose cmd tools and backend services share it.
+package pokerclient
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+const (
+	// AddressPrefix is the chain's bech32 account prefix
+	AddressPrefix = "b52"
+
+	// DefaultGRPCURL is the public node's TLS gRPC endpoint
+	DefaultGRPCURL = "node.texashodl.net:9443"
+
+	// DefaultChainID is the mainnet chain ID
+	DefaultChainID = "pokerchain"
+
+	// DefaultGasLimit covers game actions, which use ~203,000 gas.
+	// Transactions are gasless: the chain's minimum gas price is zero.
+	DefaultGasLimit = 250_000
+)
+
+// ErrNoSigner is returned by transaction methods on a client without a key
+var ErrNoSigner = errors.New("client has no signing key")
+
+// Config configures a Client
+type Config struct {
+	// GRPCURL is the node's gRPC host:port
+	GRPCURL string
+	// Insecure connects without TLS, e.g. to a local node on :9090
+	Insecure bool
+	// ChainID is signed into every transaction
+	ChainID string
+	// GasLimit is the gas limit of every transaction
+	GasLimit uint64
+
+	// Keyring holds the signing key. Leave it nil for a read-only client.
+	Keyring keyring.Keyring
+	// From is the name or address of the signing key in Keyring
+	From string
+	// Granter makes the signing key a session key acting for the player at
+	// this address: messages are sent through authz MsgExec, on the grants
+	// of StartSession, and the player pays their fees
+	Granter string
+}
+
+// DefaultConfig returns a read-only configuration for the public node
+func DefaultConfig() Config {
+	return Config{
+		GRPCURL:  DefaultGRPCURL,
+		ChainID:  DefaultChainID,
+		GasLimit: DefaultGasLimit,
+	}
+}
+
+// EncodingConfig holds the codecs needed to sign and decode poker transactions
+type EncodingConfig struct {
+	InterfaceRegistry codectypes.InterfaceRegistry
+	Codec             codec.Codec
+	TxConfig          client.TxConfig
+	Amino             *codec.LegacyAmino
+}
+
+// MakeEncodingConfig returns codecs with the SDK, auth, bank, authz, feegrant
+// and poker types registered
+func MakeEncodingConfig() EncodingConfig {
+	amino := codec.NewLegacyAmino()
+	// Signers and fee granters are decoded with the chain's prefixes, not
+	// the SDK's default cosmos ones
+	interfaceRegistry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          addresscodec.NewBech32Codec(AddressPrefix),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(AddressPrefix + "valoper"),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	txCfg := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	std.RegisterLegacyAminoCodec(amino)
+	std.RegisterInterfaces(interfaceRegistry)
+	authtypes.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	authz.RegisterInterfaces(interfaceRegistry)
+	feegrant.RegisterInterfaces(interfaceRegistry)
+	pokertypes.RegisterInterfaces(interfaceRegistry)
+
+	return EncodingConfig{
+		InterfaceRegistry: interfaceRegistry,
+		Codec:             cdc,
+		TxConfig:          txCfg,
+		Amino:             amino,
+	}
+}
+
+var setPrefixOnce sync.Once
+
+// SetAddressPrefix sets the SDK's global bech32 prefixes to the chain's. The
+// SDK formats every address with them, so this must happen before any
+// address is printed or parsed. It is a no-op once the config is sealed.
+func SetAddressPrefix() {
+	setPrefixOnce.Do(func() {
+		config := sdk.GetConfig()
+		if config.GetBech32AccountAddrPrefix() == AddressPrefix {
+			return
+		}
+		defer func() {
+			// A sealed config panics; its owner has already chosen the prefixes
+			_ = recover()
+		}()
+		config.SetBech32PrefixForAccount(AddressPrefix, AddressPrefix+"pub")
+		config.SetBech32PrefixForValidator(AddressPrefix+"valoper", AddressPrefix+"valoperpub")
+		config.SetBech32PrefixForConsensusNode(AddressPrefix+"valcons", AddressPrefix+"valconspub")
+	})
+}
+
+// Client is a pokerchain gRPC client
+type Client struct {
+	cfg       Config
+	conn      *grpc.ClientConn
+	encoding  EncodingConfig
+	clientCtx client.Context
+
+	keyName string
+	address sdk.AccAddress
+	granter sdk.AccAddress
+
+	query pokertypes.QueryClient
+}
+
+// New connects to the node in cfg. The connection is established lazily by
+// the first call.
+func New(cfg Config) (*Client, error) {
+	SetAddressPrefix()
+
+	if cfg.GRPCURL == "" {
+		cfg.GRPCURL = DefaultGRPCURL
+	}
+	if cfg.ChainID == "" {
+		cfg.ChainID = DefaultChainID
+	}
+	if cfg.GasLimit == 0 {
+		cfg.GasLimit = DefaultGasLimit
+	}
+
+	c := &Client{
+		cfg:      cfg,
+		encoding: MakeEncodingConfig(),
+	}
+
+	if cfg.Keyring != nil {
+		record, err := lookupKey(cfg.Keyring, cfg.From)
+		if err != nil {
+			return nil, err
+		}
+		address, err := record.GetAddress()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get address of key %s: %w", record.Name, err)
+		}
+		c.keyName = record.Name
+		c.address = address
+	}
+	if cfg.Granter != "" {
+		granter, err := sdk.AccAddressFromBech32(cfg.Granter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid granter %s: %w", cfg.Granter, err)
+		}
+		c.granter = granter
+	}
+
+	creds := credentials.NewTLS(nil)
+	if cfg.Insecure {
+		creds = insecure.NewCredentials()
+	}
+	conn, err := grpc.NewClient(cfg.GRPCURL, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client for %s: %w", cfg.GRPCURL, err)
+	}
+	c.conn = conn
+	c.query = pokertypes.NewQueryClient(conn)
+
+	c.clientCtx = client.Context{}.
+		WithCodec(c.encoding.Codec).
+		WithInterfaceRegistry(c.encoding.InterfaceRegistry).
+		WithTxConfig(c.encoding.TxConfig).
+		WithLegacyAmino(c.encoding.Amino).
+		WithChainID(cfg.ChainID).
+		WithGRPCClient(conn).
+		WithKeyring(cfg.Keyring).
+		WithAccountRetriever(authtypes.AccountRetriever{})
+
+	return c, nil
+}
+
+// Close closes the gRPC connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Conn returns the gRPC connection for queries this client does not wrap
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Address returns the signing key's address, or nil for a read-only client
+func (c *Client) Address() sdk.AccAddress {
+	return c.address
+}
+
+// KeyName returns the signing key's name in the keyring
+func (c *Client) KeyName() string {
+	return c.keyName
+}
+
+// Player returns the address the client plays as: the granter of a session
+// key, or else the signing key's
+func (c *Client) Player() sdk.AccAddress {
+	if c.granter != nil {
+		return c.granter
+	}
+	return c.address
+}
+
+// CanSign reports whether the client has a signing key
+func (c *Client) CanSign() bool {
+	return c.address != nil
+}
+
+// lookupKey finds a key by name, or by address if from is a bech32 address
+func lookupKey(kr keyring.Keyring, from string) (*keyring.Record, error) {
+	if from == "" {
+		return nil, errors.New("no signing key given")
+	}
+	if addr, err := sdk.AccAddressFromBech32(from); err == nil {
+		record, err := kr.KeyByAddress(addr)
+		if err != nil {
+			return nil, fmt.Errorf("no key with address %s in keyring: %w", from, err)
+		}
+		return record, nil
+	}
+	record, err := kr.Key(from)
+	if err != nil {
+		return nil, fmt.Errorf("no key named %s in keyring: %w", from, err)
+	}
+	return record, nil
+}
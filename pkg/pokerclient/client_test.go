@@ -0,0 +1,127 @@
+package pokerclient_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/pkg/pokerclient"
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+const testMnemonic = "grow broom cigar crime caught name charge today comfort tourist ethics erode sleep merge bring relax swap clog whale rent unable vehicle thought buddy"
+
+func newTestClient(t *testing.T) *pokerclient.Client {
+	t.Helper()
+	kr, err := pokerclient.MnemonicKeyring("alice", testMnemonic)
+	require.NoError(t, err)
+	// gRPC connects lazily, so signing works without a node
+	c, err := pokerclient.New(pokerclient.Config{GRPCURL: "localhost:9090", Insecure: true, Keyring: kr, From: "alice"})
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestSignTx(t *testing.T) {
+	c := newTestClient(t)
+	require.Contains(t, c.Address().String(), pokerclient.AddressPrefix+"1")
+
+	txBytes, err := c.SignTx(context.Background(), 7, 3, &pokertypes.MsgPerformAction{
+		Player: c.Address().String(), GameId: "0xgame", Action: "call",
+	})
+	require.NoError(t, err)
+
+	decoded, err := pokerclient.MakeEncodingConfig().TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	sigTx := decoded.(authsigning.SigVerifiableTx)
+	require.Equal(t, uint64(pokerclient.DefaultGasLimit), decoded.(authsigning.Tx).GetGas())
+	require.True(t, decoded.(authsigning.Tx).GetFee().IsZero())
+
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, uint64(3), sigs[0].Sequence)
+	require.Equal(t, c.Address().Bytes(), sigs[0].PubKey.Address().Bytes())
+
+	// A client without a key cannot sign
+	readOnly, err := pokerclient.New(pokerclient.DefaultConfig())
+	require.NoError(t, err)
+	defer readOnly.Close()
+	_, err = readOnly.SignTx(context.Background(), 7, 3, &pokertypes.MsgLeaveGame{})
+	require.ErrorIs(t, err, pokerclient.ErrNoSigner)
+}
+
+func TestSignTxWithSessionKey(t *testing.T) {
+	kr, err := pokerclient.MnemonicKeyring("alice", testMnemonic)
+	require.NoError(t, err)
+	player, err := kr.Key("alice")
+	require.NoError(t, err)
+	playerAddress, err := player.GetAddress()
+	require.NoError(t, err)
+	sessionKey, err := pokerclient.NewSessionKey(kr, pokerclient.SessionKeyName("alice"))
+	require.NoError(t, err)
+
+	c, err := pokerclient.New(pokerclient.Config{
+		GRPCURL: "localhost:9090", Insecure: true, Keyring: kr,
+		From: pokerclient.SessionKeyName("alice"), Granter: playerAddress.String(),
+	})
+	require.NoError(t, err)
+	defer c.Close()
+	require.Equal(t, sessionKey, c.Address())
+	require.Equal(t, playerAddress, c.Player())
+
+	action := &pokertypes.MsgPerformAction{Player: c.Player().String(), GameId: "0xgame", Action: "check"}
+	txBytes, err := c.SignTx(context.Background(), 7, 0, action)
+	require.NoError(t, err)
+
+	// The action is executed through the player's grant, with the player
+	// paying fees
+	decoded, err := pokerclient.MakeEncodingConfig().TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	msgs := decoded.GetMsgs()
+	require.Len(t, msgs, 1)
+	exec, ok := msgs[0].(*authz.MsgExec)
+	require.True(t, ok)
+	require.Equal(t, sessionKey.String(), exec.Grantee)
+	inner, err := exec.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{action}, inner)
+	require.Equal(t, sdk.AccAddress(playerAddress.Bytes()), sdk.AccAddress(decoded.(authsigning.Tx).FeeGranter()))
+
+	sigs, err := decoded.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, sessionKey.Bytes(), sigs[0].PubKey.Address().Bytes())
+}
+
+func TestSignQuery(t *testing.T) {
+	c := newTestClient(t)
+
+	signature, err := c.SignQuery(1_700_000_000)
+	require.NoError(t, err)
+	sig, err := hex.DecodeString(signature[2:])
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+
+	// The keeper recovers the signer from the personal_sign hash
+	message := "pokerchain-query:1700000000"
+	hash := ethcrypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	sig[64] -= 27
+	pubKey, err := ethcrypto.SigToPub(hash, sig)
+	require.NoError(t, err)
+
+	kr, err := pokerclient.MnemonicKeyring("alice", testMnemonic)
+	require.NoError(t, err)
+	record, err := kr.Key("alice")
+	require.NoError(t, err)
+	cosmosPubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, cosmosPubKey.Bytes(), ethcrypto.CompressPubkey(pubKey))
+}
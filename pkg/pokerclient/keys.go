@@ -0,0 +1,97 @@
+package pokerclient
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// OpenKeyring opens the keyring pokerchaind keys uses under dir, so keys
+// added with `pokerchaind keys add` can sign. backend is os, file or test;
+// input answers passphrase prompts of the file backend.
+func OpenKeyring(backend, dir string, input io.Reader) (keyring.Keyring, error) {
+	kr, err := keyring.New(sdk.KeyringServiceName(), backend, dir, input, MakeEncodingConfig().Codec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s keyring in %s: %w", backend, dir, err)
+	}
+	return kr, nil
+}
+
+// MnemonicKeyring returns an in-memory keyring holding the mnemonic's key
+// under name, for tools and tests that use a fixed test account
+func MnemonicKeyring(name, mnemonic string) (keyring.Keyring, error) {
+	SetAddressPrefix()
+	kr := keyring.NewInMemory(MakeEncodingConfig().Codec)
+	if _, err := kr.NewAccount(name, mnemonic, keyring.DefaultBIP39Passphrase, sdk.GetConfig().GetFullBIP44Path(), hd.Secp256k1); err != nil {
+		return nil, fmt.Errorf("failed to import mnemonic: %w", err)
+	}
+	return kr, nil
+}
+
+// SignQuery signs the authenticated game state query message
+// "pokerchain-query:<timestamp>" with the Ethereum personal_sign scheme the
+// keeper verifies, and returns the 0x-prefixed signature
+func (c *Client) SignQuery(timestamp int64) (string, error) {
+	if !c.CanSign() {
+		return "", ErrNoSigner
+	}
+
+	// personal_sign needs the raw secp256k1 key, which keyrings only export
+	// armored
+	const passphrase = "pokerclient"
+	armor, err := c.cfg.Keyring.ExportPrivKeyArmor(c.keyName, passphrase)
+	if err != nil {
+		return "", fmt.Errorf("failed to export key %s: %w", c.keyName, err)
+	}
+	privKey, _, err := sdkcrypto.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt key %s: %w", c.keyName, err)
+	}
+	ecdsaKey, err := ethcrypto.ToECDSA(privKey.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("key %s is not a secp256k1 key: %w", c.keyName, err)
+	}
+
+	message := fmt.Sprintf("pokerchain-query:%d", timestamp)
+	prefixed := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)
+	signature, err := ethcrypto.Sign(ethcrypto.Keccak256([]byte(prefixed)), ecdsaKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign query: %w", err)
+	}
+	// Ethereum's v is 27 or 28
+	signature[64] += 27
+
+	return "0x" + hex.EncodeToString(signature), nil
+}
+
+// SessionKeyName is the keyring name of the session key of the key name
+func SessionKeyName(name string) string {
+	return name + "-session"
+}
+
+// NewSessionKey adds a fresh key to kr under name for use as a session key,
+// replacing any earlier key of that name, and returns its address. The
+// mnemonic is not kept: a session key is disposable.
+func NewSessionKey(kr keyring.Keyring, name string) (sdk.AccAddress, error) {
+	SetAddressPrefix()
+	if _, err := kr.Key(name); err == nil {
+		if err := kr.Delete(name); err != nil {
+			return nil, fmt.Errorf("failed to delete old session key %s: %w", name, err)
+		}
+	}
+	record, _, err := kr.NewMnemonic(name, keyring.English, sdk.GetConfig().GetFullBIP44Path(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session key %s: %w", name, err)
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session key %s: %w", name, err)
+	}
+	return addr, nil
+}
@@ -0,0 +1,139 @@
+package pokerclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+// GameInfo is a table's settings with its public state, as returned by the
+// Game query
+type GameInfo struct {
+	pokertypes.Game
+	// GameState has every card masked; nil if the table has no state yet
+	GameState *pokertypes.TexasHoldemStateDTO `json:"gameState,omitempty"`
+}
+
+// ListGames returns every public table, or the public tables in denom if it
+// is not empty
+func (c *Client) ListGames(ctx context.Context, denom string) ([]pokertypes.Game, error) {
+	res, err := c.query.ListGames(ctx, &pokertypes.QueryListGamesRequest{Denom: denom})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list games: %w", err)
+	}
+	games := []pokertypes.Game{}
+	if err := json.Unmarshal([]byte(res.Games), &games); err != nil {
+		return nil, fmt.Errorf("failed to parse games: %w", err)
+	}
+	return games, nil
+}
+
+// Game returns a table's settings and public state
+func (c *Client) Game(ctx context.Context, gameId string) (*GameInfo, error) {
+	res, err := c.query.Game(ctx, &pokertypes.QueryGameRequest{GameId: gameId})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query game %s: %w", gameId, err)
+	}
+	var game GameInfo
+	if err := json.Unmarshal([]byte(res.Game), &game); err != nil {
+		return nil, fmt.Errorf("failed to parse game %s: %w", gameId, err)
+	}
+	return &game, nil
+}
+
+// GameState returns a table's state. A client with a key signs the query and
+// sees its own hole cards; otherwise every card is masked.
+func (c *Client) GameState(ctx context.Context, gameId string) (*pokertypes.TexasHoldemStateDTO, error) {
+	var raw string
+	if c.CanSign() {
+		timestamp := time.Now().Unix()
+		signature, err := c.SignQuery(timestamp)
+		if err != nil {
+			return nil, err
+		}
+		res, err := c.query.GameState(ctx, &pokertypes.QueryGameStateRequest{
+			GameId:        gameId,
+			PlayerAddress: c.address.String(),
+			Timestamp:     timestamp,
+			Signature:     signature,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query game state %s: %w", gameId, err)
+		}
+		raw = res.GameState
+	} else {
+		res, err := c.query.GameStatePublic(ctx, &pokertypes.QueryGameStatePublicRequest{GameId: gameId})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query game state %s: %w", gameId, err)
+		}
+		raw = res.GameState
+	}
+
+	var state pokertypes.TexasHoldemStateDTO
+	if err := json.Unmarshal([]byte(raw), &state); err != nil {
+		return nil, fmt.Errorf("failed to parse game state %s: %w", gameId, err)
+	}
+	return &state, nil
+}
+
+// LegalActions returns a player's legal actions at a table
+func (c *Client) LegalActions(ctx context.Context, gameId, player string) ([]pokertypes.LegalActionDTO, error) {
+	res, err := c.query.LegalActions(ctx, &pokertypes.QueryLegalActionsRequest{
+		GameId:        gameId,
+		PlayerAddress: player,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query legal actions: %w", err)
+	}
+	actions := []pokertypes.LegalActionDTO{}
+	if err := json.Unmarshal([]byte(res.Actions), &actions); err != nil {
+		return nil, fmt.Errorf("failed to parse legal actions: %w", err)
+	}
+	return actions, nil
+}
+
+// WaitlistPosition returns a player's place in a table's waitlist and any
+// seat held for them
+func (c *Client) WaitlistPosition(ctx context.Context, gameId, player string) (*pokertypes.QueryWaitlistPositionResponse, error) {
+	res, err := c.query.WaitlistPosition(ctx, &pokertypes.QueryWaitlistPositionRequest{
+		GameId: gameId,
+		Player: player,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query waitlist position: %w", err)
+	}
+	return res, nil
+}
+
+// Balances returns an account's balances
+func (c *Client) Balances(ctx context.Context, address string) (sdk.Coins, error) {
+	res, err := banktypes.NewQueryClient(c.conn).AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query balances of %s: %w", address, err)
+	}
+	return res.Balances, nil
+}
+
+// Equity runs the chain's Monte Carlo equity calculator. hands are two-card
+// hands such as ["As", "Kd"]; simulations of 0 uses the node's default.
+func (c *Client) Equity(ctx context.Context, hands [][]string, board, dead []string, simulations int32) (*pokertypes.QueryCalculateEquityResponse, error) {
+	req := &pokertypes.QueryCalculateEquityRequest{
+		Board:       board,
+		Dead:        dead,
+		Simulations: simulations,
+	}
+	for _, hand := range hands {
+		req.Hands = append(req.Hands, &pokertypes.HandCards{Cards: hand})
+	}
+	res, err := c.query.CalculateEquity(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate equity: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,161 @@
+package pokerclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+// Session is what a session key may do on a player's behalf. A session key
+// is a throwaway key the player's wallet authorizes once, so that checks and
+// folds are signed without a wallet confirmation each.
+type Session struct {
+	// Key is the session key's address
+	Key sdk.AccAddress
+	// GameIds are the tables the key may act and top up at
+	GameIds []string
+	// MaxAmount is the largest bet, raise or top-up the key may send in one
+	// message (0 for no limit)
+	MaxAmount uint64
+	// FeeLimit is the most the player pays in fees for the key's
+	// transactions. Poker actions are gasless; top-ups are not.
+	FeeLimit sdk.Coins
+	// Expires is when the key stops working
+	Expires time.Time
+	// Fund is sent to the key when it is granted, so that its account
+	// exists on chain. A new key cannot sign until it has received coins.
+	Fund sdk.Coins
+}
+
+// grantMsgs returns the authz grants of MsgPerformAction and MsgTopUp at the
+// session's tables and the fee allowance for its MsgExec transactions,
+// after funding the key
+func (s Session) grantMsgs(granter sdk.AccAddress) ([]sdk.Msg, error) {
+	if len(s.GameIds) == 0 {
+		return nil, errors.New("a session needs at least one game")
+	}
+	if !s.Expires.After(time.Now()) {
+		return nil, errors.New("a session must expire in the future")
+	}
+
+	var msgs []sdk.Msg
+	if !s.Fund.IsZero() {
+		msgs = append(msgs, banktypes.NewMsgSend(granter, s.Key, s.Fund))
+	}
+	for _, msgTypeURL := range pokertypes.SessionMsgTypeURLs() {
+		grant, err := authz.NewMsgGrant(granter, s.Key, pokertypes.NewGameAuthorization(msgTypeURL, s.GameIds, s.MaxAmount), &s.Expires)
+		if err != nil {
+			return nil, fmt.Errorf("failed to grant %s: %w", msgTypeURL, err)
+		}
+		msgs = append(msgs, grant)
+	}
+
+	allowance, err := feegrant.NewAllowedMsgAllowance(
+		&feegrant.BasicAllowance{SpendLimit: s.FeeLimit, Expiration: &s.Expires},
+		[]string{sdk.MsgTypeURL(&authz.MsgExec{})},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build fee allowance: %w", err)
+	}
+	grantAllowance, err := feegrant.NewMsgGrantAllowance(allowance, granter, s.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant fee allowance: %w", err)
+	}
+	return append(msgs, grantAllowance), nil
+}
+
+// revokeMsgs returns the messages revoking everything StartSession granted
+// a session key
+func revokeMsgs(granter, key sdk.AccAddress) []sdk.Msg {
+	var msgs []sdk.Msg
+	for _, msgTypeURL := range pokertypes.SessionMsgTypeURLs() {
+		revoke := authz.NewMsgRevoke(granter, key, msgTypeURL)
+		msgs = append(msgs, &revoke)
+	}
+	revokeAllowance := feegrant.NewMsgRevokeAllowance(granter, key)
+	return append(msgs, &revokeAllowance)
+}
+
+// StartSession authorizes a session key to act for the client's account.
+// Open a client for the key with Config.Granter set to use it.
+func (c *Client) StartSession(ctx context.Context, s Session) (*sdk.TxResponse, error) {
+	msgs, err := s.grantMsgs(c.address)
+	if err != nil {
+		return nil, err
+	}
+	return c.Broadcast(ctx, msgs...)
+}
+
+// RotateSession replaces one session key with another in a single
+// transaction, revoking the old key's grants and granting the new one
+func (c *Client) RotateSession(ctx context.Context, oldKey sdk.AccAddress, s Session) (*sdk.TxResponse, error) {
+	msgs, err := s.grantMsgs(c.address)
+	if err != nil {
+		return nil, err
+	}
+	return c.Broadcast(ctx, append(revokeMsgs(c.address, oldKey), msgs...)...)
+}
+
+// EndSession revokes a session key's grants and fee allowance before they
+// expire
+func (c *Client) EndSession(ctx context.Context, key sdk.AccAddress) (*sdk.TxResponse, error) {
+	return c.Broadcast(ctx, revokeMsgs(c.address, key)...)
+}
+
+// Session returns what a session key may still do for a player, from the
+// key's MsgPerformAction grant and fee allowance
+func (c *Client) Session(ctx context.Context, granter, key sdk.AccAddress) (*Session, error) {
+	res, err := authz.NewQueryClient(c.conn).Grants(ctx, &authz.QueryGrantsRequest{
+		Granter:    granter.String(),
+		Grantee:    key.String(),
+		MsgTypeUrl: sdk.MsgTypeURL(&pokertypes.MsgPerformAction{}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query grants of %s: %w", key, err)
+	}
+	if len(res.Grants) == 0 {
+		return nil, fmt.Errorf("%s has no session for %s", key, granter)
+	}
+	grant := res.Grants[0]
+	var authorization authz.Authorization
+	if err := c.encoding.InterfaceRegistry.UnpackAny(grant.Authorization, &authorization); err != nil {
+		return nil, fmt.Errorf("failed to decode grant: %w", err)
+	}
+	gameAuthorization, ok := authorization.(*pokertypes.GameAuthorization)
+	if !ok {
+		return nil, fmt.Errorf("%s holds a %T, not a session grant", key, authorization)
+	}
+
+	s := &Session{Key: key, GameIds: gameAuthorization.GameIds, MaxAmount: gameAuthorization.MaxAmount}
+	if grant.Expiration != nil {
+		s.Expires = *grant.Expiration
+	}
+
+	allowance, err := feegrant.NewQueryClient(c.conn).Allowance(ctx, &feegrant.QueryAllowanceRequest{
+		Granter: granter.String(),
+		Grantee: key.String(),
+	})
+	if err != nil {
+		// The fee allowance may have been used up
+		return s, nil
+	}
+	var feeAllowance feegrant.FeeAllowanceI
+	if err := c.encoding.InterfaceRegistry.UnpackAny(allowance.Allowance.Allowance, &feeAllowance); err == nil {
+		if allowed, ok := feeAllowance.(*feegrant.AllowedMsgAllowance); ok {
+			if inner, err := allowed.GetAllowance(); err == nil {
+				if basic, ok := inner.(*feegrant.BasicAllowance); ok {
+					s.FeeLimit = basic.SpendLimit
+				}
+			}
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,247 @@
+package pokerclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+// TxError is returned when the node rejects a broadcast transaction
+type TxError struct {
+	TxHash string
+	Code   uint32
+	RawLog string
+}
+
+func (e *TxError) Error() string {
+	return fmt.Sprintf("transaction %s failed with code %d: %s", e.TxHash, e.Code, e.RawLog)
+}
+
+// SignTx signs msgs with the client's key for the given account number and
+// sequence, and returns the encoded transaction. It needs no connection. A
+// session key's messages are wrapped in an authz MsgExec, with the player as
+// fee granter.
+func (c *Client) SignTx(ctx context.Context, accountNumber, sequence uint64, msgs ...sdk.Msg) ([]byte, error) {
+	if !c.CanSign() {
+		return nil, ErrNoSigner
+	}
+	if c.granter != nil {
+		exec := authz.NewMsgExec(c.address, msgs)
+		msgs = []sdk.Msg{&exec}
+	}
+
+	txf := tx.Factory{}.
+		WithTxConfig(c.encoding.TxConfig).
+		WithKeybase(c.cfg.Keyring).
+		WithChainID(c.cfg.ChainID).
+		WithAccountNumber(accountNumber).
+		WithSequence(sequence).
+		WithGas(c.cfg.GasLimit).
+		WithFees(""). // gasless: no fees required
+		WithFeeGranter(c.granter).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction: %w", err)
+	}
+	if err := tx.Sign(ctx, txf, c.keyName, txBuilder, true); err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	txBytes, err := c.encoding.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+	return txBytes, nil
+}
+
+// Broadcast signs msgs with the next sequence of the client's account and
+// broadcasts them in sync mode. A transaction the node rejects returns a
+// *TxError.
+func (c *Client) Broadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if !c.CanSign() {
+		return nil, ErrNoSigner
+	}
+
+	clientCtx := c.clientCtx.WithCmdContext(ctx)
+	account, err := clientCtx.AccountRetriever.GetAccount(clientCtx, c.address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %s: %w", c.address, err)
+	}
+
+	txBytes, err := c.SignTx(ctx, account.GetAccountNumber(), account.GetSequence(), msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := txtypes.NewServiceClient(c.conn).BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+		TxBytes: txBytes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to broadcast transaction: %w", err)
+	}
+	if res.TxResponse.Code != 0 {
+		return res.TxResponse, &TxError{TxHash: res.TxResponse.TxHash, Code: res.TxResponse.Code, RawLog: res.TxResponse.RawLog}
+	}
+	return res.TxResponse, nil
+}
+
+// WaitForTx polls until the transaction is in a block and returns its result.
+// A transaction that failed in the block returns a *TxError.
+func (c *Client) WaitForTx(ctx context.Context, txHash string, interval time.Duration) (*sdk.TxResponse, error) {
+	service := txtypes.NewServiceClient(c.conn)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		res, err := service.GetTx(ctx, &txtypes.GetTxRequest{Hash: txHash})
+		switch {
+		case err == nil:
+			if res.TxResponse.Code != 0 {
+				return res.TxResponse, &TxError{TxHash: txHash, Code: res.TxResponse.Code, RawLog: res.TxResponse.RawLog}
+			}
+			return res.TxResponse, nil
+		case status.Code(err) != codes.NotFound:
+			return nil, fmt.Errorf("failed to get transaction %s: %w", txHash, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CreateGame creates a table. Creator is set to the client's address.
+func (c *Client) CreateGame(ctx context.Context, msg pokertypes.MsgCreateGame) (*sdk.TxResponse, error) {
+	msg.Creator = c.Player().String()
+	return c.Broadcast(ctx, &msg)
+}
+
+// JoinGame buys in at a seat of a table. inviteCode is only needed at
+// invite-only tables, and is never sent (see inviteSignature).
+func (c *Client) JoinGame(ctx context.Context, gameId string, seat, buyIn uint64, inviteCode string) (*sdk.TxResponse, error) {
+	signature, err := c.inviteSignature(ctx, gameId, inviteCode)
+	if err != nil {
+		return nil, err
+	}
+	return c.Broadcast(ctx, &pokertypes.MsgJoinGame{
+		Player:          c.Player().String(),
+		GameId:          gameId,
+		Seat:            seat,
+		BuyInAmount:     buyIn,
+		InviteSignature: signature,
+	})
+}
+
+// LeaveGame leaves a table and cashes out the stack. During a hand the
+// player is folded on their turn and cashed out once it is over.
+func (c *Client) LeaveGame(ctx context.Context, gameId string) (*sdk.TxResponse, error) {
+	return c.Broadcast(ctx, &pokertypes.MsgLeaveGame{
+		Creator: c.Player().String(),
+		GameId:  gameId,
+	})
+}
+
+// UpdateAllowlist adds and removes the players allowed to sit at a table the
+// client's account created
+func (c *Client) UpdateAllowlist(ctx context.Context, gameId string, add, remove []string) (*sdk.TxResponse, error) {
+	return c.Broadcast(ctx, &pokertypes.MsgUpdateAllowlist{
+		Creator: c.Player().String(),
+		GameId:  gameId,
+		Add:     add,
+		Remove:  remove,
+	})
+}
+
+// JoinWaitlist queues the client's account for the next free seat at a full
+// table. inviteCode is only needed at invite-only tables, and is never sent
+// (see inviteSignature).
+func (c *Client) JoinWaitlist(ctx context.Context, gameId, inviteCode string) (*sdk.TxResponse, error) {
+	signature, err := c.inviteSignature(ctx, gameId, inviteCode)
+	if err != nil {
+		return nil, err
+	}
+	return c.Broadcast(ctx, &pokertypes.MsgJoinWaitlist{
+		Player:          c.Player().String(),
+		GameId:          gameId,
+		InviteSignature: signature,
+	})
+}
+
+// inviteSignature proves the client's player knows a table's invite code
+// without revealing it. The code's key is salted with the table creator's
+// address, so the table is looked up first. Returns nil for an empty code.
+func (c *Client) inviteSignature(ctx context.Context, gameId, inviteCode string) ([]byte, error) {
+	if inviteCode == "" {
+		return nil, nil
+	}
+	game, err := c.Game(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := pokertypes.SignInvite(gameId, game.Creator, inviteCode, c.Player().String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign invite: %w", err)
+	}
+	return signature, nil
+}
+
+// LeaveWaitlist takes the client's account off a table's waitlist
+func (c *Client) LeaveWaitlist(ctx context.Context, gameId string) (*sdk.TxResponse, error) {
+	return c.Broadcast(ctx, &pokertypes.MsgLeaveWaitlist{
+		Player: c.Player().String(),
+		GameId: gameId,
+	})
+}
+
+// TopUp adds chips to the client's stack at a table
+func (c *Client) TopUp(ctx context.Context, gameId string, amount uint64) (*sdk.TxResponse, error) {
+	return c.Broadcast(ctx, &pokertypes.MsgTopUp{
+		Player: c.Player().String(),
+		GameId: gameId,
+		Amount: amount,
+	})
+}
+
+// SetAutoTopUp has the chain rebuy or top up the client's stack at a table
+// to the target between hands; mode is "rebuy" or "top-up"
+func (c *Client) SetAutoTopUp(ctx context.Context, gameId, mode string, targetStack uint64) (*sdk.TxResponse, error) {
+	return c.Broadcast(ctx, &pokertypes.MsgSetAutoTopUp{
+		Player:      c.Player().String(),
+		GameId:      gameId,
+		Mode:        mode,
+		TargetStack: targetStack,
+	})
+}
+
+// CancelAutoTopUp cancels the client's automatic rebuy or top-up at a table
+func (c *Client) CancelAutoTopUp(ctx context.Context, gameId string) (*sdk.TxResponse, error) {
+	return c.Broadcast(ctx, &pokertypes.MsgCancelAutoTopUp{
+		Player: c.Player().String(),
+		GameId: gameId,
+	})
+}
+
+// PerformAction performs a poker action such as fold, call or raise
+func (c *Client) PerformAction(ctx context.Context, gameId, action string, amount uint64) (*sdk.TxResponse, error) {
+	return c.Broadcast(ctx, &pokertypes.MsgPerformAction{
+		Player: c.Player().String(),
+		GameId: gameId,
+		Action: action,
+		Amount: amount,
+	})
+}
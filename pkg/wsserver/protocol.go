@@ -7,6 +7,8 @@ const (
 	MsgTypeUnsubscribe = "unsubscribe"
 	MsgTypeAction      = "action"
 	MsgTypePing        = "ping"
+	MsgTypeSpectate    = "spectate"   // Watch a game through the spectator channel
+	MsgTypeUnspectate  = "unspectate" // Stop watching a game
 
 	// Server -> Client event types
 	EventState          = "state"           // Initial game state on subscribe
@@ -15,6 +17,7 @@ const (
 	EventActionAccepted = "action_accepted" // Acknowledgment to acting player
 	EventError          = "error"           // Error message
 	EventPong           = "pong"            // Response to ping
+	EventSeatReady      = "seat_ready"      // A seat is held for the waitlisted player
 )
 
 // Poker action types (matches Cosmos chain action types)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -47,6 +48,10 @@ type Hub struct {
 	mu          sync.RWMutex
 	grpcConn    *grpc.ClientConn
 	queryClient pokertypes.QueryClient
+
+	// Spectators of each game, fed through the spectator channel
+	spectators   map[string]*spectatorRoom
+	spectatorsMu sync.Mutex
 }
 
 // Subscription represents a client subscribing to a game
@@ -127,6 +132,7 @@ func newHub(grpcConn *grpc.ClientConn) *Hub {
 		unsubscribe: make(chan *Subscription),
 		grpcConn:    grpcConn,
 		queryClient: queryClient,
+		spectators:  make(map[string]*spectatorRoom),
 	}
 }
 
@@ -155,6 +161,7 @@ func (h *Hub) run() {
 				}
 				client.mu.RUnlock()
 
+				h.removeSpectator(client)
 				close(client.send)
 				h.mu.Unlock()
 				log.Printf("[WS-Server] Client unregistered. Total clients: %d", len(h.clients))
@@ -200,6 +207,7 @@ func (h *Hub) run() {
 					select {
 					case client.send <- message:
 					default:
+						h.removeSpectator(client)
 						close(client.send)
 						delete(h.clients, client)
 					}
@@ -265,6 +273,11 @@ func (h *Hub) sendGameState(client *Client, gameID string) {
 			log.Printf("[WS-Server] Error querying game state for %s: %v", gameID, err)
 			return
 		}
+		// Tables that hold spectators back are only watched live by players
+		if !liveToSpectators(res.Game) {
+			client.sendError(fmt.Sprintf("Game %s is not shown live to spectators; use the spectate channel", gameID))
+			return
+		}
 		gameData = res.Game
 	}
 
@@ -299,6 +312,9 @@ func (h *Hub) BroadcastGameUpdate(gameID string, event string) {
 		return
 	}
 
+	// Spectators are fed through their own, possibly delayed, channel
+	h.updateSpectators(gameID, event)
+
 	// Get all clients subscribed to this game
 	h.mu.RLock()
 	clients, exists := h.games[gameID]
@@ -366,6 +382,9 @@ func (h *Hub) sendPersonalizedUpdate(client *Client, gameID string, event string
 			log.Printf("[WS-Server] Error querying game for broadcast: %v", err)
 			return
 		}
+		if !liveToSpectators(res.Game) {
+			return
+		}
 		gameData = res.Game
 	}
 
@@ -385,6 +404,43 @@ func (h *Hub) sendPersonalizedUpdate(client *Client, gameID string, event string
 	}
 }
 
+// SeatReady is the data of a seat_ready message, sent to a waitlisted player
+// when a seat is held for them
+type SeatReady struct {
+	Player    string `json:"player"`
+	Seat      uint64 `json:"seat"`
+	ExpiresAt int64  `json:"expires_at"` // Unix time the reservation lapses
+}
+
+// NotifySeatReady tells every client subscribed as the player that a seat at
+// the game is held for them, whichever games the client is subscribed to
+func (h *Hub) NotifySeatReady(gameID string, ready SeatReady) {
+	data, _ := json.Marshal(ready)
+	message, _ := json.Marshal(&GameUpdate{
+		GameID:    gameID,
+		Timestamp: time.Now(),
+		Event:     EventSeatReady,
+		Data:      data,
+	})
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for client := range h.clients {
+		client.mu.RLock()
+		playerId := client.playerId
+		client.mu.RUnlock()
+		if playerId != ready.Player {
+			continue
+		}
+		select {
+		case client.send <- message:
+			log.Printf("[WS-Server] 🪑 Sent seat_ready for seat %d of game %s to %s", ready.Seat, gameID, ready.Player)
+		default:
+			log.Printf("[WS-Server] Failed to send seat_ready to client (channel full)")
+		}
+	}
+}
+
 // handleAction processes an action message from a client and broadcasts pending state
 // This enables optimistic updates - all subscribers see the action immediately
 func (h *Hub) handleAction(client *Client, msg ClientMessage) {
@@ -563,6 +619,14 @@ func (c *Client) readPump() {
 					gameID: msg.GameID,
 				}
 			}
+		case MsgTypeSpectate:
+			if msg.GameID != "" {
+				go c.hub.spectate(c, msg.GameID)
+			}
+		case MsgTypeUnspectate:
+			if msg.GameID != "" {
+				c.hub.unspectate(c, msg.GameID)
+			}
 		case "ping":
 			pong := map[string]string{"type": "pong"}
 			pongBytes, _ := json.Marshal(pong)
@@ -656,9 +720,20 @@ func subscribeTendermintEvents(hub *Hub, tendermintWSURL string) {
 
 		log.Println("[WS-Server] Connected to Tendermint WebSocket")
 
-		subscribeToEvent(conn, "action_performed", 1)
-		subscribeToEvent(conn, "player_joined_game", 2)
-		subscribeToEvent(conn, "game_created", 3)
+		subscribeToEvent(conn, "Tx", "action_performed", 1)
+		subscribeToEvent(conn, "Tx", "player_joined_game", 2)
+		subscribeToEvent(conn, "Tx", "game_created", 3)
+		// Hands started, blinds posted and timeouts taken by the module in
+		// EndBlock are block events, not transaction events
+		subscribeToEvent(conn, "NewBlockEvents", "action_performed", 4)
+		// Seats offered to waitlisted players in EndBlock
+		subscribeToEvent(conn, "NewBlockEvents", "seat_reserved", 5)
+		// Players leaving mid-hand are queued by a transaction and cashed out
+		// in EndBlock once the hand is over
+		subscribeToEvent(conn, "Tx", "leave_requested", 6)
+		subscribeToEvent(conn, "NewBlockEvents", "player_left_game", 7)
+		// Standing top-ups change stacks between hands in EndBlock
+		subscribeToEvent(conn, "NewBlockEvents", "auto_top_up_performed", 8)
 
 		for {
 			_, message, err := conn.ReadMessage()
@@ -683,7 +758,7 @@ func subscribeTendermintEvents(hub *Hub, tendermintWSURL string) {
 	}
 }
 
-func subscribeToEvent(conn *websocket.Conn, eventType string, id int) {
+func subscribeToEvent(conn *websocket.Conn, tmEvent string, eventType string, id int) {
 	query := fmt.Sprintf("%s.game_id EXISTS", eventType)
 
 	subscribeRequest := map[string]interface{}{
@@ -691,7 +766,7 @@ func subscribeToEvent(conn *websocket.Conn, eventType string, id int) {
 		"id":      id,
 		"method":  "subscribe",
 		"params": map[string]interface{}{
-			"query": fmt.Sprintf("tm.event='Tx' AND %s", query),
+			"query": fmt.Sprintf("tm.event='%s' AND %s", tmEvent, query),
 		},
 	}
 
@@ -699,7 +774,7 @@ func subscribeToEvent(conn *websocket.Conn, eventType string, id int) {
 	if err := conn.WriteMessage(websocket.TextMessage, requestBytes); err != nil {
 		log.Printf("[WS-Server] Failed to subscribe to %s events: %v", eventType, err)
 	} else {
-		log.Printf("[WS-Server] Subscribed to %s events", eventType)
+		log.Printf("[WS-Server] Subscribed to %s %s events", tmEvent, eventType)
 	}
 }
 
@@ -708,7 +783,9 @@ func processBlockEvent(hub *Hub, response TendermintEventResponse) {
 		return
 	}
 
-	eventTypes := []string{"action_performed", "player_joined_game", "game_created"}
+	processSeatReserved(hub, response.Result.Events)
+
+	eventTypes := []string{"action_performed", "player_joined_game", "game_created", "leave_requested", "player_left_game", "auto_top_up_performed"}
 
 	for _, eventType := range eventTypes {
 		gameIDKey := eventType + ".game_id"
@@ -721,6 +798,33 @@ func processBlockEvent(hub *Hub, response TendermintEventResponse) {
 	}
 }
 
+// processSeatReserved notifies the players seats were held for in a block.
+// The attributes of each seat_reserved event share an index across the
+// event map's lists.
+func processSeatReserved(hub *Hub, events map[string][]string) {
+	gameIDs := events["seat_reserved.game_id"]
+	players := events["seat_reserved.player"]
+	seats := events["seat_reserved.seat"]
+	expiries := events["seat_reserved.expires_at"]
+	if len(players) != len(gameIDs) || len(seats) != len(gameIDs) || len(expiries) != len(gameIDs) {
+		return
+	}
+
+	for i, gameID := range gameIDs {
+		seat, err := strconv.ParseUint(seats[i], 10, 64)
+		if err != nil {
+			continue
+		}
+		expiresAt, err := strconv.ParseInt(expiries[i], 10, 64)
+		if err != nil {
+			continue
+		}
+		log.Printf("[WS-Server] Tendermint event received: seat_reserved for game %s", gameID)
+		hub.NotifySeatReady(gameID, SeatReady{Player: players[i], Seat: seat, ExpiresAt: expiresAt})
+		hub.BroadcastGameUpdate(gameID, "seat_reserved")
+	}
+}
+
 // Start starts the WebSocket server with the given configuration
 // This function blocks, so call it in a goroutine if needed
 func Start(cfg Config) error {
@@ -759,6 +863,7 @@ func Start(cfg Config) error {
 
 	hub := newHub(grpcConn)
 	go hub.run()
+	go hub.runSpectatorClock()
 
 	// Start Tendermint event subscription
 	go subscribeTendermintEvents(hub, cfg.TendermintWSURL)
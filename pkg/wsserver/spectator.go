@@ -0,0 +1,288 @@
+package wsserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pokertypes "github.com/block52/pokerchain/x/poker/types"
+)
+
+// Spectators watch a table through their own channel rather than the player
+// subscription. Every frame of a table's spectator view is held back by the
+// table's spectator delay before it is sent, so the server, not the client,
+// decides how far behind the table spectators on this channel are.
+//
+// The delay is a presentation policy of this channel, not a secret: the
+// chain's public queries and the table's transactions show every action as
+// it happens, with hole cards hidden until they are shown.
+
+// maxHeldFrames bounds the frames held back for one table. The oldest are
+// dropped first, which never shortens the delay.
+const maxHeldFrames = 1024
+
+// maxPendingUpdates bounds the events queued for a table's spectators. Each
+// update queries the table's current state, so a dropped event is covered by
+// the next one.
+const maxPendingUpdates = 64
+
+// spectatorFrame is the spectator view of a table as it was after an event
+type spectatorFrame struct {
+	index   uint64 // the table's action index in the view (see actionIndex)
+	takenAt time.Time
+	event   string
+	data    string
+}
+
+// spectatorRoom holds the spectators of one table and the frames not yet
+// released to them. Updates after table events are queued on updates and
+// applied in order by the room's own goroutine (see runSpectatorUpdates).
+type spectatorRoom struct {
+	clients      map[*Client]bool
+	updates      chan string
+	delayActions uint64
+	delaySeconds uint64
+	index        uint64 // the action index of the latest view
+	held         []spectatorFrame
+	latest       string          // the most recent view taken, to skip repeats
+	released     *spectatorFrame // the most recent frame released
+}
+
+func newSpectatorRoom() *spectatorRoom {
+	return &spectatorRoom{
+		clients: make(map[*Client]bool),
+		updates: make(chan string, maxPendingUpdates),
+	}
+}
+
+// actionIndex returns the index of the last action in a view of a table's
+// state, which counts every action the table has taken
+func actionIndex(data string) (uint64, bool) {
+	var state struct {
+		ActionCount     int               `json:"actionCount"`
+		PreviousActions []json.RawMessage `json:"previousActions"`
+	}
+	if err := json.Unmarshal([]byte(data), &state); err != nil || state.ActionCount < 0 {
+		return 0, false
+	}
+	return uint64(state.ActionCount + len(state.PreviousActions)), true
+}
+
+// take records a new frame of the table's spectator view, unless nothing
+// changed since the last one or the view is older than the latest
+func (r *spectatorRoom) take(res *pokertypes.QueryGameStateSpectatorResponse, event string, now time.Time) {
+	r.delayActions = res.DelayActions
+	r.delaySeconds = res.DelaySeconds
+	if res.GameState == r.latest {
+		return
+	}
+	index, ok := actionIndex(res.GameState)
+	if !ok {
+		log.Printf("[WS-Server] Skipping spectator view without an action index")
+		return
+	}
+	if r.latest != "" && index < r.index {
+		return
+	}
+	r.latest = res.GameState
+	r.index = index
+	r.held = append(r.held, spectatorFrame{index: index, takenAt: now, event: event, data: res.GameState})
+	if len(r.held) > maxHeldFrames {
+		r.held = r.held[len(r.held)-maxHeldFrames:]
+	}
+}
+
+// release returns the held frames the delay no longer covers, oldest first.
+// A frame is held until the table is delayActions actions past it and
+// delaySeconds have passed since it was taken.
+func (r *spectatorRoom) release(now time.Time) []spectatorFrame {
+	n := 0
+	for _, frame := range r.held {
+		if r.index-frame.index < r.delayActions || now.Sub(frame.takenAt) < time.Duration(r.delaySeconds)*time.Second {
+			break
+		}
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+	due := r.held[:n]
+	r.held = append([]spectatorFrame(nil), r.held[n:]...)
+	r.released = &due[n-1]
+	return due
+}
+
+// send queues frames for a spectator
+func (r *spectatorRoom) send(client *Client, gameID string, frames []spectatorFrame) {
+	for _, frame := range frames {
+		message, _ := json.Marshal(&GameUpdate{
+			GameID:    gameID,
+			Timestamp: frame.takenAt,
+			Event:     frame.event,
+			Data:      json.RawMessage(fmt.Sprintf(`{"gameState":%s}`, frame.data)),
+		})
+		select {
+		case client.send <- message:
+		default:
+			log.Printf("[WS-Server] Failed to send spectator frame to client (channel full)")
+		}
+	}
+}
+
+// spectate adds a client to a table's spectators and sends them the most
+// recent frame the delay allows. It runs outside the hub loop, so it only
+// adds clients that are still registered: unregister closes client.send.
+func (h *Hub) spectate(client *Client, gameID string) {
+	if h.queryClient == nil {
+		client.sendError("Spectating is unavailable")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := h.queryClient.GameStateSpectator(ctx, &pokertypes.QueryGameStateSpectatorRequest{GameId: gameID})
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			client.sendError(fmt.Sprintf("Game %s does not allow spectators", gameID))
+		} else {
+			client.sendError(fmt.Sprintf("Cannot spectate game %s", gameID))
+		}
+		log.Printf("[WS-Server] Error querying spectator view of game %s: %v", gameID, err)
+		return
+	}
+
+	// Lock order is h.mu, then spectatorsMu, as in unregister
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if !h.clients[client] {
+		return
+	}
+
+	h.spectatorsMu.Lock()
+	defer h.spectatorsMu.Unlock()
+	room := h.spectators[gameID]
+	if room == nil {
+		room = newSpectatorRoom()
+		h.spectators[gameID] = room
+		go h.runSpectatorUpdates(gameID, room)
+	}
+	room.take(res, EventState, time.Now())
+	h.releaseSpectatorFrames(gameID, room, time.Now())
+	room.clients[client] = true
+	if room.released != nil {
+		room.send(client, gameID, []spectatorFrame{{takenAt: room.released.takenAt, event: EventState, data: room.released.data}})
+	}
+	log.Printf("[WS-Server] 👀 Client spectating game %s (%s). Spectators: %d", gameID, res.Spectators, len(room.clients))
+}
+
+// unspectate removes a client from a table's spectators
+func (h *Hub) unspectate(client *Client, gameID string) {
+	h.spectatorsMu.Lock()
+	defer h.spectatorsMu.Unlock()
+	if room := h.spectators[gameID]; room != nil {
+		delete(room.clients, client)
+		if len(room.clients) == 0 {
+			h.closeSpectatorRoom(gameID, room)
+		}
+	}
+}
+
+// removeSpectator removes a disconnecting client from every table it watches
+func (h *Hub) removeSpectator(client *Client) {
+	h.spectatorsMu.Lock()
+	defer h.spectatorsMu.Unlock()
+	for gameID, room := range h.spectators {
+		delete(room.clients, client)
+		if len(room.clients) == 0 {
+			h.closeSpectatorRoom(gameID, room)
+		}
+	}
+}
+
+// closeSpectatorRoom removes a table's room once its last spectator has
+// gone, which stops its update goroutine. The caller holds spectatorsMu.
+func (h *Hub) closeSpectatorRoom(gameID string, room *spectatorRoom) {
+	delete(h.spectators, gameID)
+	close(room.updates)
+}
+
+// updateSpectators queues an update of a table's spectator view after an
+// event, if anyone is watching the table
+func (h *Hub) updateSpectators(gameID string, event string) {
+	h.spectatorsMu.Lock()
+	defer h.spectatorsMu.Unlock()
+	room := h.spectators[gameID]
+	if room == nil {
+		return
+	}
+	select {
+	case room.updates <- event:
+	default:
+		log.Printf("[WS-Server] Spectator updates for game %s are backed up; dropping %s", gameID, event)
+	}
+}
+
+// runSpectatorUpdates applies a room's queued updates one at a time, so each
+// query sees at least the state the previous one did and frames are taken in
+// the order of the events. It returns once the room is closed.
+func (h *Hub) runSpectatorUpdates(gameID string, room *spectatorRoom) {
+	for event := range room.updates {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		res, err := h.queryClient.GameStateSpectator(ctx, &pokertypes.QueryGameStateSpectatorRequest{GameId: gameID})
+		cancel()
+		if err != nil {
+			log.Printf("[WS-Server] Error querying spectator view of game %s: %v", gameID, err)
+			continue
+		}
+
+		h.spectatorsMu.Lock()
+		if h.spectators[gameID] == room {
+			room.take(res, event, time.Now())
+			h.releaseSpectatorFrames(gameID, room, time.Now())
+		}
+		h.spectatorsMu.Unlock()
+	}
+}
+
+// releaseSpectatorFrames sends a table's spectators the frames the delay no
+// longer covers. The caller holds spectatorsMu.
+func (h *Hub) releaseSpectatorFrames(gameID string, room *spectatorRoom, now time.Time) {
+	frames := room.release(now)
+	if len(frames) == 0 {
+		return
+	}
+	for client := range room.clients {
+		room.send(client, gameID, frames)
+	}
+}
+
+// runSpectatorClock releases frames held back by a delay in seconds as the
+// delay passes, whether or not anything happens at the table
+func (h *Hub) runSpectatorClock() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		h.spectatorsMu.Lock()
+		for gameID, room := range h.spectators {
+			h.releaseSpectatorFrames(gameID, room, now)
+		}
+		h.spectatorsMu.Unlock()
+	}
+}
+
+// liveToSpectators reports whether the public Game query result of a table
+// may be shown to a subscriber who is not playing, as it happens
+func liveToSpectators(gameData string) bool {
+	var game struct {
+		Spectators pokertypes.SpectatorMode `json:"spectators"`
+	}
+	if err := json.Unmarshal([]byte(gameData), &game); err != nil {
+		return false
+	}
+	return game.Spectators == "" || game.Spectators == pokertypes.SpectatorsLive
+}
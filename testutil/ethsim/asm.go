@@ -0,0 +1,98 @@
+package ethsim
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// assembler builds EVM bytecode with named jump targets
+type assembler struct {
+	code   []byte
+	labels map[string]int
+	refs   map[int]string
+}
+
+func newAssembler() *assembler {
+	return &assembler{labels: map[string]int{}, refs: map[int]string{}}
+}
+
+// op appends opcodes
+func (a *assembler) op(ops ...vm.OpCode) *assembler {
+	for _, o := range ops {
+		a.code = append(a.code, byte(o))
+	}
+	return a
+}
+
+// push appends the shortest PUSH of value, or a PUSH of exactly len(bytes)
+// for a byte slice
+func (a *assembler) push(value any) *assembler {
+	var data []byte
+	switch v := value.(type) {
+	case int:
+		data = big.NewInt(int64(v)).Bytes()
+	case []byte:
+		data = v
+	default:
+		panic(fmt.Sprintf("unsupported push value %T", value))
+	}
+	if len(data) == 0 {
+		data = []byte{0}
+	}
+	a.code = append(a.code, byte(vm.PUSH1)+byte(len(data)-1))
+	a.code = append(a.code, data...)
+	return a
+}
+
+// label marks a jump destination
+func (a *assembler) label(name string) *assembler {
+	a.labels[name] = len(a.code)
+	return a.op(vm.JUMPDEST)
+}
+
+// pushLabel pushes the offset of a label, resolved by bytecode
+func (a *assembler) pushLabel(name string) *assembler {
+	a.code = append(a.code, byte(vm.PUSH2))
+	a.refs[len(a.code)] = name
+	a.code = append(a.code, 0, 0)
+	return a
+}
+
+// jumpi jumps to a label if the top of the stack is non-zero
+func (a *assembler) jumpi(name string) *assembler {
+	return a.pushLabel(name).op(vm.JUMPI)
+}
+
+// bytecode resolves the label references
+func (a *assembler) bytecode() []byte {
+	code := append([]byte(nil), a.code...)
+	for at, name := range a.refs {
+		offset, ok := a.labels[name]
+		if !ok {
+			panic(fmt.Sprintf("undefined label %s", name))
+		}
+		code[at] = byte(offset >> 8)
+		code[at+1] = byte(offset)
+	}
+	return code
+}
+
+// deployCode wraps runtime code in init code that returns it
+func deployCode(runtime []byte) []byte {
+	const initLen = 13
+	init := newAssembler().
+		push([]byte{byte(len(runtime) >> 8), byte(len(runtime))}).
+		op(vm.DUP1).
+		push([]byte{0, initLen}).
+		push(0).
+		op(vm.CODECOPY).
+		push(0).
+		op(vm.RETURN).
+		bytecode()
+	if len(init) != initLen {
+		panic("unexpected init code length")
+	}
+	return append(init, runtime...)
+}
@@ -0,0 +1,287 @@
+// Package ethsim runs a stand-in CosmosBridge contract on go-ethereum's
+// simulated backend, so bridge code can be tested end to end without a live
+// Base RPC endpoint.
+package ethsim
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+)
+
+// BridgeABI is the interface of the stand-in contract:
+//
+//	event Deposited(string indexed account, uint256 amount, uint256 index);
+//	event Withdrawn(address indexed receiver, uint256 amount, bytes32 indexed nonce);
+//
+//	// Records deposit index n for receiver. No token is pulled.
+//	function depositUnderlying(uint256 amount, string calldata receiver) external;
+//	function deposits(uint256 index) external view returns (string memory account, uint256 amount);
+//
+//	// Reverts unless the validator signed
+//	// keccak256("\x19Ethereum Signed Message:\n32", keccak256(abi.encodePacked(receiver, amount, nonce)))
+//	// and the nonce is unused. No token is paid out.
+//	function withdraw(address receiver, uint256 amount, bytes32 nonce, bytes calldata signature) external;
+const BridgeABI = `[
+	{"type":"event","name":"Deposited","anonymous":false,"inputs":[{"name":"account","type":"string","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"index","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Withdrawn","anonymous":false,"inputs":[{"name":"receiver","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"nonce","type":"bytes32","indexed":true}]},
+	{"type":"function","name":"depositUnderlying","stateMutability":"nonpayable","inputs":[{"name":"amount","type":"uint256"},{"name":"receiver","type":"string"}],"outputs":[]},
+	{"type":"function","name":"deposits","stateMutability":"view","inputs":[{"name":"index","type":"uint256"}],"outputs":[{"name":"account","type":"string"},{"name":"amount","type":"uint256"}]},
+	{"type":"function","name":"withdraw","stateMutability":"nonpayable","inputs":[{"name":"receiver","type":"address"},{"name":"amount","type":"uint256"},{"name":"nonce","type":"bytes32"},{"name":"signature","type":"bytes"}],"outputs":[]}
+]`
+
+// MaxReceiverLength is the longest deposit receiver the contract stores
+const MaxReceiverLength = 64
+
+var bridgeABI = mustParseABI(BridgeABI)
+
+func mustParseABI(def string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(def))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// bridgeRuntime assembles the stand-in contract. There is no compiler in the
+// test toolchain, so it is written directly in EVM assembly.
+//
+// Storage: slot 0 is the deposit count, deposit i lives in slots 4i+1..4i+4
+// (amount, receiver length, two receiver words), and a used withdrawal nonce
+// sets slot keccak256(nonce).
+func bridgeRuntime(validator common.Address) []byte {
+	selector := func(name string) []byte { return bridgeABI.Methods[name].ID }
+	prefix := common.RightPadBytes([]byte("\x19Ethereum Signed Message:\n32"), 32)
+
+	a := newAssembler()
+
+	// Dispatch on the function selector
+	a.push(0).op(vm.CALLDATALOAD).push(0xe0).op(vm.SHR)
+	a.op(vm.DUP1).push(selector("depositUnderlying")).op(vm.EQ).jumpi("deposit")
+	a.op(vm.DUP1).push(selector("deposits")).op(vm.EQ).jumpi("deposits")
+	a.op(vm.DUP1).push(selector("withdraw")).op(vm.EQ).jumpi("withdraw")
+	a.label("revert").push(0).op(vm.DUP1, vm.REVERT)
+
+	// depositUnderlying(amount @0x04, receiver length @0x44, receiver @0x64)
+	a.label("deposit")
+	a.push(0x44).op(vm.CALLDATALOAD)
+	a.op(vm.DUP1, vm.ISZERO).jumpi("revert")
+	a.op(vm.DUP1).push(MaxReceiverLength).op(vm.LT).jumpi("revert")
+	a.push(0).op(vm.SLOAD)                                 // len, index
+	a.op(vm.DUP1).push(1).op(vm.ADD).push(0).op(vm.SSTORE) // count = index + 1
+	a.op(vm.DUP1).push(4).op(vm.MUL).push(1).op(vm.ADD)    // len, index, slot
+	a.push(0x04).op(vm.CALLDATALOAD, vm.DUP2, vm.SSTORE)   // amount
+	a.op(vm.DUP3, vm.DUP2).push(1).op(vm.ADD, vm.SSTORE)   // receiver length
+	a.push(0x64).op(vm.CALLDATALOAD, vm.DUP2).push(2).op(vm.ADD, vm.SSTORE)
+	a.push(0x84).op(vm.CALLDATALOAD, vm.DUP2).push(3).op(vm.ADD, vm.SSTORE)
+	a.op(vm.POP)                                              // len, index
+	a.push(0x04).op(vm.CALLDATALOAD).push(0x00).op(vm.MSTORE) // data: amount
+	a.push(0x20).op(vm.MSTORE)                                // data: index
+	a.op(vm.DUP1).push(0x64).push(0x40).op(vm.CALLDATACOPY)   // receiver bytes
+	a.push(0x40).op(vm.KECCAK256)                             // keccak256(receiver)
+	a.push(bridgeABI.Events["Deposited"].ID.Bytes())
+	a.push(0x40).push(0).op(vm.LOG2, vm.STOP)
+
+	// deposits(index @0x04) returns (string account, uint256 amount)
+	a.label("deposits")
+	a.push(0x04).op(vm.CALLDATALOAD).push(4).op(vm.MUL).push(1).op(vm.ADD)
+	a.push(0x40).push(0x00).op(vm.MSTORE)
+	a.op(vm.DUP1, vm.SLOAD).push(0x20).op(vm.MSTORE)
+	for i := 1; i <= 3; i++ {
+		a.op(vm.DUP1).push(i).op(vm.ADD, vm.SLOAD).push(0x20 + 0x20*i).op(vm.MSTORE)
+	}
+	a.push(0xa0).push(0).op(vm.RETURN)
+
+	// withdraw(receiver @0x04, amount @0x24, nonce @0x44, signature length @0x84, r @0xa4, s @0xc4, v @0xe4)
+	a.label("withdraw")
+	a.push(0x44).op(vm.CALLDATALOAD).push(0).op(vm.MSTORE)
+	a.push(0x20).push(0).op(vm.KECCAK256) // nonce slot
+	a.op(vm.DUP1, vm.SLOAD).jumpi("revert")
+	a.push(0x84).op(vm.CALLDATALOAD).push(65).op(vm.EQ, vm.ISZERO).jumpi("revert")
+	a.push(0x04).op(vm.CALLDATALOAD).push(0x60).op(vm.SHL).push(0x00).op(vm.MSTORE)
+	a.push(0x24).op(vm.CALLDATALOAD).push(0x14).op(vm.MSTORE)
+	a.push(0x44).op(vm.CALLDATALOAD).push(0x34).op(vm.MSTORE)
+	a.push(0x54).push(0).op(vm.KECCAK256) // slot, message hash
+	a.push(prefix).push(0).op(vm.MSTORE)
+	a.push(0x1c).op(vm.MSTORE)
+	a.push(0x3c).push(0).op(vm.KECCAK256) // slot, signed message hash
+	a.push(0x80).op(vm.MSTORE)
+	a.push(0xe4).op(vm.CALLDATALOAD).push(0xf8).op(vm.SHR).push(0xa0).op(vm.MSTORE)
+	a.push(0xa4).op(vm.CALLDATALOAD).push(0xc0).op(vm.MSTORE)
+	a.push(0xc4).op(vm.CALLDATALOAD).push(0xe0).op(vm.MSTORE)
+	a.push(0).push(0).op(vm.MSTORE)
+	a.push(0x20).push(0).push(0x80).push(0x80).push(1).op(vm.GAS, vm.STATICCALL) // ecrecover
+	a.op(vm.ISZERO).jumpi("revert")
+	a.push(0).op(vm.MLOAD).push(validator.Bytes()).op(vm.EQ, vm.ISZERO).jumpi("revert")
+	a.push(1).op(vm.SWAP1, vm.SSTORE)                      // mark nonce used
+	a.push(0x24).op(vm.CALLDATALOAD).push(0).op(vm.MSTORE) // data: amount
+	a.push(0x44).op(vm.CALLDATALOAD)                       // topic: nonce
+	a.push(0x04).op(vm.CALLDATALOAD)                       // topic: receiver
+	a.push(bridgeABI.Events["Withdrawn"].ID.Bytes())
+	a.push(0x20).push(0).op(vm.LOG3, vm.STOP)
+
+	return a.bytecode()
+}
+
+// Bridge is a simulated chain with the stand-in bridge contract deployed
+type Bridge struct {
+	Backend *simulated.Backend
+	Client  simulated.Client
+	Address common.Address
+
+	key     *ecdsa.PrivateKey
+	chainID *big.Int
+}
+
+// NewBridge starts a simulated chain and deploys a bridge contract that
+// accepts withdrawals signed by validator
+func NewBridge(t testing.TB, validator common.Address) *Bridge {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	funds := new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(key.PublicKey): {Balance: funds},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+
+	client := backend.Client()
+	chainID, err := client.ChainID(context.Background())
+	require.NoError(t, err)
+
+	b := &Bridge{Backend: backend, Client: client, key: key, chainID: chainID}
+	receipt := b.send(t, nil, deployCode(bridgeRuntime(validator)))
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status, "bridge deployment failed")
+	b.Address = receipt.ContractAddress
+	return b
+}
+
+// Reader returns the simulated client as a keeper chain reader
+func (b *Bridge) Reader() keeper.EthChainReader {
+	return b.Client
+}
+
+// Deposit records a deposit for a Cosmos receiver and mines it
+func (b *Bridge) Deposit(t testing.TB, receiver string, amount uint64) *types.Receipt {
+	t.Helper()
+	data, err := bridgeABI.Pack("depositUnderlying", new(big.Int).SetUint64(amount), receiver)
+	require.NoError(t, err)
+	return b.send(t, &b.Address, data)
+}
+
+// WithdrawCall packs a withdraw call for a signed withdrawal request
+func WithdrawCall(receiver common.Address, amount uint64, nonce common.Hash, signature []byte) ([]byte, error) {
+	return bridgeABI.Pack("withdraw", receiver, new(big.Int).SetUint64(amount), nonce, signature)
+}
+
+// Withdraw claims a signed withdrawal and mines it. The receipt status is
+// failed if the contract rejected the signature or nonce.
+func (b *Bridge) Withdraw(t testing.TB, receiver common.Address, amount uint64, nonce common.Hash, signature []byte) *types.Receipt {
+	t.Helper()
+	data, err := WithdrawCall(receiver, amount, nonce, signature)
+	require.NoError(t, err)
+	return b.send(t, &b.Address, data)
+}
+
+// Mine commits n empty blocks
+func (b *Bridge) Mine(n int) {
+	for i := 0; i < n; i++ {
+		b.Backend.Commit()
+	}
+}
+
+// Finalize mines empty blocks up to the next 32-block epoch boundary, where
+// the simulated beacon marks the head finalized, and returns its number
+func (b *Bridge) Finalize(t testing.TB) uint64 {
+	t.Helper()
+	for {
+		head, err := b.Client.BlockNumber(context.Background())
+		require.NoError(t, err)
+		if head > 0 && head%32 == 0 {
+			return head
+		}
+		b.Backend.Commit()
+	}
+}
+
+// RawHeaders returns the RLP encoded headers from..to, as submitted in
+// MsgSubmitEthHeaders
+func (b *Bridge) RawHeaders(t testing.TB, from, to uint64) [][]byte {
+	t.Helper()
+	var headers [][]byte
+	for n := from; n <= to; n++ {
+		header, err := b.Client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(n))
+		require.NoError(t, err)
+		raw, err := rlp.EncodeToBytes(header)
+		require.NoError(t, err)
+		require.Equal(t, header.Hash(), crypto.Keccak256Hash(raw), "header %d does not re-encode to its hash", n)
+		headers = append(headers, raw)
+	}
+	return headers
+}
+
+// ReceiptProof returns the proof of a receipt against its block's receipts root
+func (b *Bridge) ReceiptProof(t testing.TB, receipt *types.Receipt) [][]byte {
+	t.Helper()
+	ctx := context.Background()
+	block, err := b.Client.BlockByNumber(ctx, receipt.BlockNumber)
+	require.NoError(t, err)
+
+	encoded := make([][]byte, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		r, err := b.Client.TransactionReceipt(ctx, tx.Hash())
+		require.NoError(t, err)
+		encoded[i], err = r.MarshalBinary()
+		require.NoError(t, err)
+	}
+
+	root, proof, err := keeper.BuildReceiptProof(encoded, uint64(receipt.TransactionIndex))
+	require.NoError(t, err)
+	require.Equal(t, block.ReceiptHash(), root)
+	return proof
+}
+
+// send signs, submits and mines a transaction from the funded account
+func (b *Bridge) send(t testing.TB, to *common.Address, data []byte) *types.Receipt {
+	t.Helper()
+	ctx := context.Background()
+	from := crypto.PubkeyToAddress(b.key.PublicKey)
+
+	nonce, err := b.Client.PendingNonceAt(ctx, from)
+	require.NoError(t, err)
+	head, err := b.Client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	tip := big.NewInt(params.GWei)
+
+	tx, err := types.SignNewTx(b.key, types.LatestSignerForChainID(b.chainID), &types.DynamicFeeTx{
+		ChainID:   b.chainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), tip),
+		Gas:       1_000_000,
+		To:        to,
+		Data:      data,
+	})
+	require.NoError(t, err)
+	require.NoError(t, b.Client.SendTransaction(ctx, tx))
+	b.Backend.Commit()
+
+	receipt, err := b.Client.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	return receipt
+}
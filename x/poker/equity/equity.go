@@ -165,6 +165,13 @@ func (c *Calculator) CalculateEquity(hands [][]string, board []string, dead []st
 		return nil, fmt.Errorf("invalid board size: %d (must be 0, 3, 4, or 5)", len(boardCards))
 	}
 
+	// Encode hands and board as card masks for the lookup-table evaluator
+	holeMasks := make([]CardMask, len(holeCards))
+	for i, cards := range holeCards {
+		holeMasks[i] = MaskFromCards(cards)
+	}
+	boardMask := MaskFromCards(boardCards)
+
 	// Build deck of remaining cards
 	remainingDeck := buildRemainingDeck(usedCards)
 	cardsNeeded := 5 - len(boardCards)
@@ -175,9 +182,9 @@ func (c *Calculator) CalculateEquity(hands [][]string, board []string, dead []st
 	var results []EquityResult
 	if cardsNeeded == 0 {
 		// River - just evaluate once
-		results = evaluateOnce(holeCards, boardCards)
+		results = evaluateOnce(holeMasks, boardMask)
 	} else {
-		results = c.runSimulations(holeCards, boardCards, remainingDeck, cardsNeeded)
+		results = c.runSimulations(holeMasks, boardMask, remainingDeck, cardsNeeded)
 	}
 
 	duration := time.Since(start)
@@ -195,8 +202,8 @@ func (c *Calculator) CalculateEquity(hands [][]string, board []string, dead []st
 }
 
 // runSimulations runs Monte Carlo simulations using multiple workers
-func (c *Calculator) runSimulations(holeCards [][]types.Card, board []types.Card, deck []types.Card, cardsNeeded int) []EquityResult {
-	numHands := len(holeCards)
+func (c *Calculator) runSimulations(holeMasks []CardMask, board CardMask, deck []CardMask, cardsNeeded int) []EquityResult {
+	numHands := len(holeMasks)
 
 	// Per-worker results
 	type workerResult struct {
@@ -223,36 +230,19 @@ func (c *Calculator) runSimulations(holeCards [][]types.Card, board []types.Card
 			// Each worker gets its own RNG
 			rng := rand.New(rand.NewSource(time.Now().UnixNano() + int64(workerID*1000)))
 
+			// Pre-allocate everything used by the hot loop
 			wins := make([]int, numHands)
 			ties := make([]int, numHands)
-			deckCopy := make([]types.Card, len(deck))
+			deckCopy := make([]CardMask, len(deck))
+			copy(deckCopy, deck)
 			scores := make([]uint32, numHands)
 
-			// Pre-allocate combined cards slice (reused each iteration)
-			combined := make([]types.Card, 7)
-			fullBoard := make([]types.Card, 5)
-
 			for i := 0; i < sims; i++ {
-				// Copy and shuffle deck
-				copy(deckCopy, deck)
-				shuffleDeck(deckCopy, rng)
-
-				// Complete the board
-				copy(fullBoard[:len(board)], board)
-				copy(fullBoard[len(board):], deckCopy[:cardsNeeded])
-
-				// Evaluate all hands using fast evaluator
-				for h := 0; h < numHands; h++ {
-					combined[0] = holeCards[h][0]
-					combined[1] = holeCards[h][1]
-					combined[2] = fullBoard[0]
-					combined[3] = fullBoard[1]
-					combined[4] = fullBoard[2]
-					combined[5] = fullBoard[3]
-					combined[6] = fullBoard[4]
-					result := EvaluateHandFast(combined)
-					scores[h] = result.Score
-				}
+				// Complete the board with randomly drawn cards
+				fullBoard := board | drawCards(deckCopy, cardsNeeded, rng)
+
+				// Evaluate all hands against the shared board in one batch
+				EvaluateBatch(fullBoard, holeMasks, scores)
 
 				// Find winners
 				maxScore := scores[0]
@@ -324,18 +314,10 @@ func (c *Calculator) runSimulations(holeCards [][]types.Card, board []types.Card
 }
 
 // evaluateOnce evaluates hands at the river (no simulation needed)
-func evaluateOnce(holeCards [][]types.Card, board []types.Card) []EquityResult {
-	numHands := len(holeCards)
+func evaluateOnce(holeMasks []CardMask, board CardMask) []EquityResult {
+	numHands := len(holeMasks)
 	scores := make([]uint32, numHands)
-	combined := make([]types.Card, 7)
-
-	for h := 0; h < numHands; h++ {
-		combined[0] = holeCards[h][0]
-		combined[1] = holeCards[h][1]
-		copy(combined[2:], board)
-		result := EvaluateHandFast(combined)
-		scores[h] = result.Score
-	}
+	EvaluateBatch(board, holeMasks, scores)
 
 	// Find winners
 	maxScore := scores[0]
@@ -382,30 +364,29 @@ func evaluateOnce(holeCards [][]types.Card, board []types.Card) []EquityResult {
 }
 
 // buildRemainingDeck builds a deck of cards not in the used set
-func buildRemainingDeck(used map[int]bool) []types.Card {
-	deck := make([]types.Card, 0, 52-len(used))
+func buildRemainingDeck(used map[int]bool) []CardMask {
+	deck := make([]CardMask, 0, 52-len(used))
 	for suit := types.SuitClubs; suit <= types.SuitSpades; suit++ {
 		for rank := 1; rank <= 13; rank++ {
 			value := 13*(int(suit)-1) + (rank - 1)
 			if !used[value] {
-				deck = append(deck, types.Card{
-					Suit:     suit,
-					Rank:     rank,
-					Value:    value,
-					Mnemonic: types.GetCardMnemonic(suit, rank),
-				})
+				deck = append(deck, CardToMask(types.Card{Suit: suit, Rank: rank}))
 			}
 		}
 	}
 	return deck
 }
 
-// shuffleDeck shuffles a deck using Fisher-Yates algorithm
-func shuffleDeck(deck []types.Card, rng *rand.Rand) {
-	for i := len(deck) - 1; i > 0; i-- {
-		j := rng.Intn(i + 1)
+// drawCards moves n random cards to the front of the deck using a partial
+// Fisher-Yates shuffle and returns them as a single mask
+func drawCards(deck []CardMask, n int, rng *rand.Rand) CardMask {
+	var drawn CardMask
+	for i := 0; i < n; i++ {
+		j := i + rng.Intn(len(deck)-i)
 		deck[i], deck[j] = deck[j], deck[i]
+		drawn |= deck[i]
 	}
+	return drawn
 }
 
 // QuickEquity is a convenience function for quick equity calculation
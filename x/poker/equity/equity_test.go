@@ -178,6 +178,33 @@ func TestCompareHands(t *testing.T) {
 	}
 }
 
+func TestDescribeScore(t *testing.T) {
+	tests := []struct {
+		hand     []string
+		expected string
+	}{
+		{[]string{"2H", "5D", "8C", "JS", "KH", "3C", "4D"}, "High Card, King"},
+		{[]string{"6S", "6H", "5D", "8C", "KH"}, "Pair of Sixes"},
+		{[]string{"KS", "KH", "7D", "7C", "2H"}, "Two Pair, Kings and Sevens"},
+		{[]string{"QS", "QH", "QD", "8C", "5H"}, "Three of a Kind, Queens"},
+		{[]string{"AH", "2D", "3C", "4S", "5H", "KD", "QC"}, "Straight, Five high"},
+		{[]string{"2H", "5H", "8H", "JH", "AH"}, "Flush, Ace high"},
+		{[]string{"QS", "QH", "QD", "4C", "4H"}, "Full House, Queens over Fours"},
+		{[]string{"7S", "7H", "7D", "7C", "KH"}, "Four of a Kind, Sevens"},
+		{[]string{"9S", "TS", "JS", "QS", "KS"}, "Straight Flush, King high"},
+		{[]string{"TS", "JS", "QS", "KS", "AS"}, "Royal Flush"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			cards, _ := CardsFromMnemonics(tt.hand)
+			if got := DescribeScore(EvaluateHand(cards).Score); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
 // =============================================================================
 // Equity Calculator Tests
 // =============================================================================
@@ -258,6 +258,54 @@ func makeScore(rank HandRank, k1, k2, k3, k4, k5 int) uint32 {
 	return uint32(rank)<<20 | uint32(k1)<<16 | uint32(k2)<<12 | uint32(k3)<<8 | uint32(k4)<<4 | uint32(k5)
 }
 
+// DescribeScore returns a human-readable description of a hand score,
+// e.g. "Two Pair, Kings and Sevens" or "Flush, Ace high"
+func DescribeScore(score uint32) string {
+	rank := HandRank(score >> 20)
+	k := func(i int) int { return int(score>>(16-4*i)) & 0xF }
+
+	switch rank {
+	case StraightFlush:
+		if k(0) == 14 {
+			return "Royal Flush"
+		}
+		return fmt.Sprintf("Straight Flush, %s high", rankName(k(0)))
+	case FourOfAKind:
+		return fmt.Sprintf("Four of a Kind, %s", rankPlural(k(0)))
+	case FullHouse:
+		return fmt.Sprintf("Full House, %s over %s", rankPlural(k(0)), rankPlural(k(1)))
+	case Flush:
+		return fmt.Sprintf("Flush, %s high", rankName(k(0)))
+	case Straight:
+		return fmt.Sprintf("Straight, %s high", rankName(k(0)))
+	case ThreeOfAKind:
+		return fmt.Sprintf("Three of a Kind, %s", rankPlural(k(0)))
+	case TwoPair:
+		return fmt.Sprintf("Two Pair, %s and %s", rankPlural(k(0)), rankPlural(k(1)))
+	case OnePair:
+		return fmt.Sprintf("Pair of %s", rankPlural(k(0)))
+	default:
+		return fmt.Sprintf("High Card, %s", rankName(k(0)))
+	}
+}
+
+// rankName returns the name of an ace-high rank value (2-14)
+func rankName(value int) string {
+	names := []string{"Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine", "Ten", "Jack", "Queen", "King", "Ace"}
+	if value < 2 || value > 14 {
+		return "Unknown"
+	}
+	return names[value-2]
+}
+
+// rankPlural returns the plural name of an ace-high rank value (2-14)
+func rankPlural(value int) string {
+	if value == 6 {
+		return "Sixes"
+	}
+	return rankName(value) + "s"
+}
+
 // generateCombinations generates all combinations of n items taken r at a time
 func generateCombinations(n, r int) [][]int {
 	if r > n {
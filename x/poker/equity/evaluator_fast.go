@@ -1,31 +1,60 @@
 package equity
 
 import (
+	"math/bits"
+
 	"github.com/block52/pokerchain/x/poker/types"
 )
 
-// Fast 7-card poker hand evaluator using bit manipulation and lookup tables.
-// This is ~100x faster than the combinatorial approach.
+// Fast 5-7 card poker hand evaluator using bitmask card encoding and perfect-hash
+// lookup tables. No combinations are enumerated: a hand is reduced to its four
+// per-suit rank masks plus a packed rank-count vector, and the score is read from
+// a precomputed table.
+//
+// Scores are identical to EvaluateHand (see makeScore), so results from both
+// evaluators can be compared directly.
+//
+// Card encoding:
+// Each suit occupies a 16-bit lane of a uint64 (clubs in the lowest lane).
+// Within a lane, bit 0 is a deuce and bit 12 is an ace.
+//
+// Rank counts:
+// The number of cards of each rank is packed into 3 bits per rank (39 bits total),
+// so adding a card to a hand is a single addition.
+//
+// Perfect hash:
+// Non-flush hands are identified by their rank-count vector (13 digits, 0-4 each,
+// summing to the number of cards). Such vectors are ranked lexicographically among
+// all vectors with the same digit sum, which gives a minimal perfect hash into a
+// dense table (49,205 entries for 7 cards).
+
+const (
+	numRanks      = 13
+	maxHandCards  = 7
+	minHandCards  = 5
+	rankLaneWidth = 16
+	rankLaneMask  = 0x1FFF
+	countBits     = 3
+)
 
-// Card bit representation:
-// Each card is represented by its rank (0-12 for 2-A) and suit (0-3)
-// We use separate 16-bit masks per suit for flush detection
+// CardMask is a set of cards with one bit per card (see encoding above).
+type CardMask uint64
 
 // Precomputed lookup tables
 var (
-	// straightTable[bitmask] = high card of straight (0 if no straight)
-	// bitmask is 13 bits representing ranks 2-A
-	straightTable [8192]uint8
+	// flushTable[suitMask] = score of the best flush or straight flush in the lane
+	// Only valid when 5+ bits are set
+	flushTable [1 << numRanks]uint32
 
-	// flushTable[bitmask] = hand rank value for flush hands
-	// Only valid when exactly 5+ bits are set from same suit
-	flushTable [8192]uint16
+	// spreadTable[laneMask] = packed rank counts for a single suit lane
+	spreadTable [1 << numRanks]uint64
 
-	// Rank lookup for non-flush hands based on rank counts
-	// Indexed by a hash of the rank distribution
-	uniqueRankTable [8192]uint16
+	// quinaryOffsets[rank][count][remaining] is the number of rank-count vectors
+	// that sort before a vector with the given count at this rank position
+	quinaryOffsets [numRanks][5][maxHandCards + 1]uint32
 
-	tablesInitialized bool
+	// rankTables[n][hash] = score of a non-flush n-card hand
+	rankTables [maxHandCards + 1][]uint32
 )
 
 func init() {
@@ -33,267 +62,267 @@ func init() {
 }
 
 func initLookupTables() {
-	if tablesInitialized {
-		return
-	}
+	// Initialize flush and lane spread tables
+	for mask := 0; mask < 1<<numRanks; mask++ {
+		var spread uint64
+		for r := 0; r < numRanks; r++ {
+			if mask&(1<<r) != 0 {
+				spread += 1 << (countBits * r)
+			}
+		}
+		spreadTable[mask] = spread
 
-	// Initialize straight table
-	// Straight patterns (13 bits, A=bit 12, K=11, ..., 2=bit 0)
-	straightPatterns := []struct {
-		pattern uint16
-		high    uint8
-	}{
-		{0x1F00, 14}, // A-K-Q-J-T (royal/broadway)
-		{0x0F80, 13}, // K-Q-J-T-9
-		{0x07C0, 12}, // Q-J-T-9-8
-		{0x03E0, 11}, // J-T-9-8-7
-		{0x01F0, 10}, // T-9-8-7-6
-		{0x00F8, 9},  // 9-8-7-6-5
-		{0x007C, 8},  // 8-7-6-5-4
-		{0x003E, 7},  // 7-6-5-4-3
-		{0x001F, 6},  // 6-5-4-3-2
-		{0x100F, 5},  // A-5-4-3-2 (wheel) - A is high bit + low 4
+		if bits.OnesCount16(uint16(mask)) >= minHandCards {
+			flushTable[mask] = flushScore(uint16(mask))
+		}
 	}
 
-	for i := 0; i < 8192; i++ {
-		mask := uint16(i)
-		for _, sp := range straightPatterns {
-			if mask&sp.pattern == sp.pattern {
-				straightTable[i] = sp.high
-				break
+	// vectors[n][s] = number of rank-count vectors of length n with digit sum s
+	var vectors [numRanks + 1][maxHandCards + 1]uint32
+	vectors[0][0] = 1
+	for n := 1; n <= numRanks; n++ {
+		for s := 0; s <= maxHandCards; s++ {
+			for d := 0; d <= 4 && d <= s; d++ {
+				vectors[n][s] += vectors[n-1][s-d]
 			}
 		}
 	}
 
-	// Initialize flush ranking table (for 5-card flush hands)
-	// This gives a relative ranking based on the high cards
-	for i := 0; i < 8192; i++ {
-		bits := popCount16(uint16(i))
-		if bits >= 5 {
-			// Calculate flush rank based on top 5 cards
-			flushTable[i] = calculateFlushRank(uint16(i))
+	for i := 0; i < numRanks; i++ {
+		remainingRanks := numRanks - i - 1
+		for k := 0; k <= maxHandCards; k++ {
+			var offset uint32
+			for d := 0; d <= 4; d++ {
+				quinaryOffsets[i][d][k] = offset
+				if d <= k {
+					offset += vectors[remainingRanks][k-d]
+				}
+			}
 		}
 	}
 
-	// Initialize unique rank table for high card hands
-	for i := 0; i < 8192; i++ {
-		if popCount16(uint16(i)) == 5 {
-			uniqueRankTable[i] = calculateHighCardRank(uint16(i))
-		}
+	// Enumerate every non-flush rank distribution and store its score
+	for n := minHandCards; n <= maxHandCards; n++ {
+		rankTables[n] = make([]uint32, vectors[numRanks][n])
+		var counts [numRanks]uint8
+		fillRankTable(n, counts[:], 0, n)
 	}
-
-	tablesInitialized = true
 }
 
-// calculateFlushRank calculates rank for a flush hand (top 5 cards matter)
-func calculateFlushRank(mask uint16) uint16 {
-	// Extract top 5 bits
-	var rank uint16
-	count := 0
-	for i := 12; i >= 0 && count < 5; i-- {
-		if mask&(1<<i) != 0 {
-			rank = rank<<4 | uint16(i+2) // +2 because rank 0 = 2
-			count++
+// fillRankTable recursively enumerates rank-count vectors summing to n
+func fillRankTable(n int, counts []uint8, rank int, remaining int) {
+	if rank == numRanks {
+		if remaining != 0 {
+			return
 		}
-	}
-	return rank
-}
-
-// calculateHighCardRank calculates rank for high card hands
-func calculateHighCardRank(mask uint16) uint16 {
-	var rank uint16
-	for i := 12; i >= 0; i-- {
-		if mask&(1<<i) != 0 {
-			rank = rank<<4 | uint16(i+2)
+		var packed uint64
+		for r, c := range counts {
+			packed += uint64(c) << (countBits * r)
 		}
+		rankTables[n][quinaryHash(packed, n)] = rankCountScore(counts)
+		return
 	}
-	return rank
-}
-
-// popCount16 counts set bits in a 16-bit value
-func popCount16(x uint16) int {
-	x = x - ((x >> 1) & 0x5555)
-	x = (x & 0x3333) + ((x >> 2) & 0x3333)
-	x = (x + (x >> 4)) & 0x0F0F
-	return int((x * 0x0101) >> 8)
-}
-
-// FastHandResult is a compact hand evaluation result
-type FastHandResult struct {
-	Category uint8  // 0=high card, 1=pair, ..., 8=straight flush
-	Score    uint32 // Full comparable score
+	for c := 0; c <= 4 && c <= remaining; c++ {
+		counts[rank] = uint8(c)
+		fillRankTable(n, counts, rank+1, remaining-c)
+	}
+	counts[rank] = 0
 }
 
-// EvaluateHandFast evaluates 5-7 cards using optimized lookup tables
-func EvaluateHandFast(cards []types.Card) FastHandResult {
-	if len(cards) < 5 || len(cards) > 7 {
-		return FastHandResult{}
+// quinaryHash returns the lexicographic rank of a packed rank-count vector among
+// all vectors with the same digit sum n
+func quinaryHash(counts uint64, n int) uint32 {
+	var hash uint32
+	k := n
+	for i := 0; i < numRanks && k > 0; i++ {
+		d := int(counts>>(countBits*i)) & 7
+		hash += quinaryOffsets[i][d][k]
+		k -= d
 	}
+	return hash
+}
 
-	// Build bitmasks per suit and overall rank mask
-	var suitMasks [4]uint16 // One mask per suit (bits 0-12 for ranks 2-A)
-	var rankCounts [13]uint8
-	var rankMask uint16
-
-	for _, c := range cards {
-		rank := c.Rank - 1 // Convert 1-13 to 0-12
-		if c.Rank == 1 {
-			rank = 12 // Ace is rank 12 (highest)
-		} else {
-			rank = c.Rank - 2 // 2=0, 3=1, ..., K=11
-		}
-		if c.Rank == 1 {
-			rank = 12 // Ace
-		}
-
-		suitIdx := int(c.Suit) - 1 // Suits are 1-4, convert to 0-3
-		bit := uint16(1) << rank
-		suitMasks[suitIdx] |= bit
-		rankMask |= bit
-		rankCounts[rank]++
+// flushScore scores the best five cards of a single suit lane
+func flushScore(mask uint16) uint32 {
+	if high := straightHigh(mask); high > 0 {
+		return makeScore(StraightFlush, high, 0, 0, 0, 0)
 	}
-
-	// Check for flush (5+ cards of same suit)
-	flushSuit := -1
-	for s := 0; s < 4; s++ {
-		if popCount16(suitMasks[s]) >= 5 {
-			flushSuit = s
-			break
+	var kickers [5]int
+	count := 0
+	for r := numRanks - 1; r >= 0 && count < 5; r-- {
+		if mask&(1<<r) != 0 {
+			kickers[count] = r + 2
+			count++
 		}
 	}
+	return makeScore(Flush, kickers[0], kickers[1], kickers[2], kickers[3], kickers[4])
+}
 
-	// Check for straight
-	straightHigh := straightTable[rankMask]
-
-	// If we have a flush, check for straight flush
-	if flushSuit >= 0 {
-		flushMask := suitMasks[flushSuit]
-		sfHigh := straightTable[flushMask]
-		if sfHigh > 0 {
-			// Straight flush!
-			return FastHandResult{
-				Category: 8,
-				Score:    uint32(8)<<24 | uint32(sfHigh)<<16,
-			}
+// straightHigh returns the ace-high value of the highest straight in a rank mask (0 if none)
+func straightHigh(mask uint16) int {
+	for high := numRanks - 1; high >= 3; high-- {
+		// A-5-4-3-2 (wheel) - ace plays low
+		run := uint16(0x100F)
+		if high > 3 {
+			run = uint16(0x1F) << (high - 4)
 		}
-		// Regular flush - rank by top 5 cards
-		flushRank := flushTable[flushMask]
-		return FastHandResult{
-			Category: 5,
-			Score:    uint32(5)<<24 | uint32(flushRank),
+		if mask&run == run {
+			return high + 2
 		}
 	}
+	return 0
+}
 
-	// Count rank frequencies
-	var quads, trips, pairs uint8
-	var quadRank, tripRank, highPairRank, lowPairRank uint8
-	var kickers [5]uint8
-	kickerCount := 0
-
-	// Scan from high to low
-	for r := 12; r >= 0; r-- {
-		cnt := rankCounts[r]
-		rankVal := uint8(r + 2) // Convert back to 2-14
-		switch cnt {
+// rankCountScore scores a hand with no flush from its rank distribution
+func rankCountScore(counts []uint8) uint32 {
+	var quads, trips, pairs, singles []int
+	var rankMask uint16
+	for r := numRanks - 1; r >= 0; r-- {
+		value := r + 2
+		switch counts[r] {
 		case 4:
-			quads++
-			quadRank = rankVal
+			quads = append(quads, value)
 		case 3:
-			if trips == 0 {
-				trips++
-				tripRank = rankVal
-			} else {
-				// Second trips acts as a pair for full house
-				pairs++
-				if highPairRank == 0 {
-					highPairRank = rankVal
-				} else if lowPairRank == 0 {
-					lowPairRank = rankVal
-				}
-			}
+			trips = append(trips, value)
 		case 2:
-			pairs++
-			if highPairRank == 0 {
-				highPairRank = rankVal
-			} else if lowPairRank == 0 {
-				lowPairRank = rankVal
-			}
+			pairs = append(pairs, value)
 		case 1:
-			if kickerCount < 5 {
-				kickers[kickerCount] = rankVal
-				kickerCount++
-			}
+			singles = append(singles, value)
+		}
+		if counts[r] > 0 {
+			rankMask |= 1 << r
 		}
 	}
 
-	// Determine hand category and score
-	if quads > 0 {
-		// Four of a kind
-		kicker := kickers[0]
-		if highPairRank > kicker {
-			kicker = highPairRank
-		}
-		if tripRank > kicker {
-			kicker = tripRank
+	// highestOf returns the best remaining rank from the given groups (0 if none)
+	highestOf := func(groups ...[]int) int {
+		best := 0
+		for _, g := range groups {
+			if len(g) > 0 && g[0] > best {
+				best = g[0]
+			}
 		}
-		return FastHandResult{
-			Category: 7,
-			Score:    uint32(7)<<24 | uint32(quadRank)<<16 | uint32(kicker)<<8,
+		return best
+	}
+	kickerAt := func(i int) int {
+		if i < len(singles) {
+			return singles[i]
 		}
+		return 0
 	}
 
-	if trips > 0 && pairs > 0 {
-		// Full house
-		pairRank := highPairRank
-		return FastHandResult{
-			Category: 6,
-			Score:    uint32(6)<<24 | uint32(tripRank)<<16 | uint32(pairRank)<<8,
-		}
+	switch {
+	case len(quads) > 0:
+		return makeScore(FourOfAKind, quads[0], highestOf(quads[1:], trips, pairs, singles), 0, 0, 0)
+	case len(trips) > 0 && (len(trips) > 1 || len(pairs) > 0):
+		return makeScore(FullHouse, trips[0], highestOf(trips[1:], pairs), 0, 0, 0)
 	}
 
-	if straightHigh > 0 {
-		// Straight
-		return FastHandResult{
-			Category: 4,
-			Score:    uint32(4)<<24 | uint32(straightHigh)<<16,
-		}
+	if high := straightHigh(rankMask); high > 0 {
+		return makeScore(Straight, high, 0, 0, 0, 0)
 	}
 
-	if trips > 0 {
-		// Three of a kind
-		return FastHandResult{
-			Category: 3,
-			Score:    uint32(3)<<24 | uint32(tripRank)<<16 | uint32(kickers[0])<<8 | uint32(kickers[1]),
-		}
+	switch {
+	case len(trips) > 0:
+		return makeScore(ThreeOfAKind, trips[0], kickerAt(0), kickerAt(1), 0, 0)
+	case len(pairs) >= 2:
+		return makeScore(TwoPair, pairs[0], pairs[1], highestOf(pairs[2:], singles), 0, 0)
+	case len(pairs) == 1:
+		return makeScore(OnePair, pairs[0], kickerAt(0), kickerAt(1), kickerAt(2), 0)
+	default:
+		return makeScore(HighCard, kickerAt(0), kickerAt(1), kickerAt(2), kickerAt(3), kickerAt(4))
 	}
+}
 
-	if pairs >= 2 {
-		// Two pair
-		kicker := kickers[0]
-		if lowPairRank > 0 && pairs > 2 {
-			// We have 3 pairs, use the third as potential kicker
-			if lowPairRank > kicker {
-				kicker = lowPairRank
-			}
-		}
-		return FastHandResult{
-			Category: 2,
-			Score:    uint32(2)<<24 | uint32(highPairRank)<<16 | uint32(lowPairRank)<<8 | uint32(kicker),
+// CardToMask returns the single-bit mask for a card (0 for an invalid card)
+func CardToMask(c types.Card) CardMask {
+	if c.Suit < types.SuitClubs || c.Suit > types.SuitSpades || c.Rank < 1 || c.Rank > 13 {
+		return 0
+	}
+	rank := c.Rank - 2 // 2=0, 3=1, ..., K=11
+	if c.Rank == 1 {
+		rank = 12 // Ace is rank 12 (highest)
+	}
+	suitIdx := int(c.Suit) - 1 // Suits are 1-4, convert to 0-3
+	return CardMask(1) << (suitIdx*rankLaneWidth + rank)
+}
+
+// MaskFromCards builds a card mask from a slice of cards
+func MaskFromCards(cards []types.Card) CardMask {
+	var m CardMask
+	for _, c := range cards {
+		m |= CardToMask(c)
+	}
+	return m
+}
+
+// Count returns the number of cards in the mask
+func (m CardMask) Count() int {
+	return bits.OnesCount64(uint64(m))
+}
+
+// rankCounts packs the rank counts of all four suit lanes
+func (m CardMask) rankCounts() uint64 {
+	return spreadTable[m&rankLaneMask] +
+		spreadTable[(m>>rankLaneWidth)&rankLaneMask] +
+		spreadTable[(m>>(2*rankLaneWidth))&rankLaneMask] +
+		spreadTable[(m>>(3*rankLaneWidth))&rankLaneMask]
+}
+
+// evaluate scores a hand given its mask, packed rank counts and size
+func evaluate(m CardMask, counts uint64, n int) uint32 {
+	if n < minHandCards || n > maxHandCards {
+		return 0
+	}
+	// With at most 7 cards only one suit can hold a flush. A flush doesn't
+	// beat quads or a full house, but 7 cards can't hold both: each needs at
+	// least three cards off the flush suit (a rank has one card per suit) on
+	// top of the five suited ones. So the flush lane scores the best hand.
+	for s := 0; s < 4; s++ {
+		lane := uint16(m>>(s*rankLaneWidth)) & rankLaneMask
+		if bits.OnesCount16(lane) >= minHandCards {
+			return flushTable[lane]
 		}
 	}
+	return rankTables[n][quinaryHash(counts, n)]
+}
+
+// EvaluateMask evaluates a 5-7 card mask and returns its comparable score
+// (0 for masks with fewer than 5 or more than 7 cards)
+func EvaluateMask(m CardMask) uint32 {
+	return evaluate(m, m.rankCounts(), m.Count())
+}
 
-	if pairs == 1 {
-		// One pair
-		return FastHandResult{
-			Category: 1,
-			Score:    uint32(1)<<24 | uint32(highPairRank)<<16 | uint32(kickers[0])<<8 | uint32(kickers[1])<<4 | uint32(kickers[2]),
+// EvaluateBatch scores several hands that share the same board, writing one score
+// per hole-card mask into scores. The board is decomposed once, and no memory is
+// allocated, so it can be called in Monte Carlo inner loops.
+func EvaluateBatch(board CardMask, holes []CardMask, scores []uint32) {
+	boardCounts := board.rankCounts()
+	boardSize := board.Count()
+	for i, hole := range holes {
+		counts := boardCounts
+		for h := uint64(hole); h != 0; h &= h - 1 {
+			counts += 1 << (countBits * (bits.TrailingZeros64(h) % rankLaneWidth))
 		}
+		scores[i] = evaluate(board|hole, counts, boardSize+hole.Count())
 	}
+}
+
+// FastHandResult is a compact hand evaluation result
+type FastHandResult struct {
+	Category uint8  // 0=high card, 1=pair, ..., 8=straight flush
+	Score    uint32 // Full comparable score (same encoding as HandResult.Score)
+}
 
-	// High card
+// EvaluateHandFast evaluates 5-7 cards using optimized lookup tables
+func EvaluateHandFast(cards []types.Card) FastHandResult {
+	if len(cards) < minHandCards || len(cards) > maxHandCards {
+		return FastHandResult{}
+	}
+	score := EvaluateMask(MaskFromCards(cards))
 	return FastHandResult{
-		Category: 0,
-		Score:    uint32(kickers[0])<<16 | uint32(kickers[1])<<12 | uint32(kickers[2])<<8 | uint32(kickers[3])<<4 | uint32(kickers[4]),
+		Category: uint8(score >> 20),
+		Score:    score,
 	}
 }
 
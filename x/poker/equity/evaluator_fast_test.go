@@ -0,0 +1,300 @@
+package equity
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// =============================================================================
+// Differential Tests (fast evaluator vs combinatorial EvaluateHand)
+// =============================================================================
+
+// fullDeck returns all 52 cards in deck order
+func fullDeck() []types.Card {
+	deck := make([]types.Card, 0, 52)
+	for suit := types.SuitClubs; suit <= types.SuitSpades; suit++ {
+		for rank := 1; rank <= 13; rank++ {
+			deck = append(deck, types.Card{
+				Suit:     suit,
+				Rank:     rank,
+				Value:    13*(int(suit)-1) + (rank - 1),
+				Mnemonic: types.GetCardMnemonic(suit, rank),
+			})
+		}
+	}
+	return deck
+}
+
+func TestEvaluateHandFast_MatchesEvaluateHandRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(52))
+	deck := fullDeck()
+
+	samples := 20000
+	if testing.Short() {
+		samples = 2000
+	}
+
+	for _, size := range []int{5, 6, 7} {
+		for i := 0; i < samples; i++ {
+			rng.Shuffle(len(deck), func(a, b int) { deck[a], deck[b] = deck[b], deck[a] })
+			hand := deck[:size]
+
+			want := EvaluateHand(hand)
+			got := EvaluateHandFast(hand)
+
+			if got.Score != want.Score || HandRank(got.Category) != want.Rank {
+				t.Fatalf("hand %v: EvaluateHand=%v (score %#x), EvaluateHandFast=%v (score %#x)",
+					mnemonicsOf(hand), want.Rank, want.Score, HandRank(got.Category), got.Score)
+			}
+		}
+	}
+}
+
+func TestEvaluateHandFast_MatchesEvaluateHandAllFiveCardHands(t *testing.T) {
+	if testing.Short() {
+		t.Skip("exhaustive 5-card comparison skipped in short mode")
+	}
+
+	deck := fullDeck()
+	hand := make([]types.Card, 5)
+	checked := 0
+	for a := 0; a < 48; a++ {
+		for b := a + 1; b < 49; b++ {
+			for c := b + 1; c < 50; c++ {
+				for d := c + 1; d < 51; d++ {
+					for e := d + 1; e < 52; e++ {
+						hand[0], hand[1], hand[2], hand[3], hand[4] = deck[a], deck[b], deck[c], deck[d], deck[e]
+						want := EvaluateHand(hand).Score
+						if got := EvaluateHandFast(hand).Score; got != want {
+							t.Fatalf("hand %v: EvaluateHand score %#x, EvaluateHandFast score %#x",
+								mnemonicsOf(hand), want, got)
+						}
+						checked++
+					}
+				}
+			}
+		}
+	}
+
+	if checked != 2598960 {
+		t.Fatalf("expected 2598960 hands, checked %d", checked)
+	}
+}
+
+func TestEvaluateHandFast_EdgeCases(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want int // 1 if a wins, -1 if b wins, 0 if tie
+	}{
+		{"three pairs uses best kicker", []string{"AS", "AH", "KD", "KC", "QH", "QD", "2C"}, []string{"AD", "AC", "KS", "KH", "JH", "JD", "3C"}, 1},
+		{"two trips make full house", []string{"9S", "9H", "9D", "4C", "4H", "4D", "2C"}, []string{"9C", "9H", "9D", "3C", "3H", "3D", "KC"}, 1},
+		{"wheel loses to six-high straight", []string{"AH", "2D", "3C", "4S", "5H", "KD", "QC"}, []string{"2H", "3D", "4C", "5S", "6H", "KC", "QD"}, -1},
+		{"quads kicker from board pair", []string{"7S", "7H", "7D", "7C", "KH", "KD", "2C"}, []string{"7S", "7H", "7D", "7C", "QH", "QD", "JC"}, 1},
+		{"board plays", []string{"AS", "KS", "QD", "JC", "TH", "2C", "3D"}, []string{"AS", "KS", "QD", "JC", "TH", "4C", "5D"}, 0},
+		{"flush beats straight", []string{"2H", "5H", "8H", "JH", "KH", "QD", "TC"}, []string{"9S", "TD", "JC", "QS", "KD", "2C", "3D"}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := EvaluateHandFastFromMnemonics(tt.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := EvaluateHandFastFromMnemonics(tt.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := CompareFastHands(a, b); got != tt.want {
+				t.Errorf("expected %d, got %d (a=%#x, b=%#x)", tt.want, got, a.Score, b.Score)
+			}
+		})
+	}
+}
+
+func TestEvaluateHandFast_InvalidSizes(t *testing.T) {
+	four, _ := CardsFromMnemonics([]string{"AS", "AH", "AD", "AC"})
+	if result := EvaluateHandFast(four); result.Score != 0 {
+		t.Errorf("expected zero score for 4 cards, got %#x", result.Score)
+	}
+
+	eight, _ := CardsFromMnemonics([]string{"AS", "AH", "AD", "AC", "KS", "KH", "KD", "KC"})
+	if result := EvaluateHandFast(eight); result.Score != 0 {
+		t.Errorf("expected zero score for 8 cards, got %#x", result.Score)
+	}
+}
+
+// =============================================================================
+// Perfect Hash and Batch Tests
+// =============================================================================
+
+func TestQuinaryHash_IsPerfect(t *testing.T) {
+	for n := minHandCards; n <= maxHandCards; n++ {
+		seen := make([]bool, len(rankTables[n]))
+		var counts [numRanks]uint8
+
+		var walk func(rank, remaining int)
+		walk = func(rank, remaining int) {
+			if rank == numRanks {
+				if remaining != 0 {
+					return
+				}
+				var packed uint64
+				for r, c := range counts {
+					packed += uint64(c) << (countBits * r)
+				}
+				h := quinaryHash(packed, n)
+				if int(h) >= len(seen) {
+					t.Fatalf("n=%d: hash %d out of range %d", n, h, len(seen))
+				}
+				if seen[h] {
+					t.Fatalf("n=%d: hash collision at %d", n, h)
+				}
+				seen[h] = true
+				return
+			}
+			for c := 0; c <= 4 && c <= remaining; c++ {
+				counts[rank] = uint8(c)
+				walk(rank+1, remaining-c)
+			}
+			counts[rank] = 0
+		}
+		walk(0, n)
+
+		for h, ok := range seen {
+			if !ok {
+				t.Fatalf("n=%d: hash %d never produced (table is not minimal)", n, h)
+			}
+		}
+	}
+
+	if len(rankTables[7]) != 49205 {
+		t.Errorf("expected 49205 7-card rank distributions, got %d", len(rankTables[7]))
+	}
+}
+
+func TestEvaluateBatch_MatchesEvaluateMask(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	deck := fullDeck()
+	masks := make([]CardMask, len(deck))
+	for i, c := range deck {
+		masks[i] = CardToMask(c)
+	}
+
+	holes := make([]CardMask, 9)
+	scores := make([]uint32, 9)
+	for i := 0; i < 2000; i++ {
+		rng.Shuffle(len(masks), func(a, b int) { masks[a], masks[b] = masks[b], masks[a] })
+
+		board := masks[0] | masks[1] | masks[2] | masks[3] | masks[4]
+		for h := range holes {
+			holes[h] = masks[5+2*h] | masks[6+2*h]
+		}
+
+		EvaluateBatch(board, holes, scores)
+		for h, hole := range holes {
+			if want := EvaluateMask(board | hole); scores[h] != want {
+				t.Fatalf("batch score %#x != mask score %#x", scores[h], want)
+			}
+		}
+	}
+}
+
+func TestEvaluateMask_DoesNotAllocate(t *testing.T) {
+	cards, _ := CardsFromMnemonics([]string{"AS", "KS", "QS", "JS", "9S", "2H", "3D"})
+	mask := MaskFromCards(cards)
+	board := MaskFromCards(cards[2:])
+	holes := []CardMask{MaskFromCards(cards[:2]), MaskFromCards([]types.Card{cards[0], cards[5]})}
+	scores := make([]uint32, len(holes))
+
+	if allocs := testing.AllocsPerRun(100, func() { EvaluateMask(mask) }); allocs != 0 {
+		t.Errorf("EvaluateMask allocated %.0f times", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { EvaluateBatch(board, holes, scores) }); allocs != 0 {
+		t.Errorf("EvaluateBatch allocated %.0f times", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { EvaluateHandFast(cards) }); allocs != 0 {
+		t.Errorf("EvaluateHandFast allocated %.0f times", allocs)
+	}
+}
+
+func TestCardToMask_InvalidCard(t *testing.T) {
+	if m := CardToMask(types.Card{Suit: types.SuitHearts, Rank: 14}); m != 0 {
+		t.Errorf("expected empty mask for invalid rank, got %#x", m)
+	}
+	if m := CardToMask(types.Card{Suit: 0, Rank: 5}); m != 0 {
+		t.Errorf("expected empty mask for invalid suit, got %#x", m)
+	}
+}
+
+func mnemonicsOf(cards []types.Card) []string {
+	out := make([]string, len(cards))
+	for i, c := range cards {
+		out[i] = c.Mnemonic
+	}
+	return out
+}
+
+// =============================================================================
+// Evaluator Benchmarks
+// =============================================================================
+
+// benchmarkHands returns a fixed set of random 7-card hands
+func benchmarkHands(n int) [][]types.Card {
+	rng := rand.New(rand.NewSource(1))
+	deck := fullDeck()
+	hands := make([][]types.Card, n)
+	for i := range hands {
+		rng.Shuffle(len(deck), func(a, b int) { deck[a], deck[b] = deck[b], deck[a] })
+		hands[i] = append([]types.Card(nil), deck[:7]...)
+	}
+	return hands
+}
+
+func BenchmarkEvaluators7Cards(b *testing.B) {
+	hands := benchmarkHands(1024)
+	masks := make([]CardMask, len(hands))
+	for i, h := range hands {
+		masks[i] = MaskFromCards(h)
+	}
+
+	b.Run("EvaluateHand", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			EvaluateHand(hands[i&1023])
+		}
+	})
+	b.Run("EvaluateHandFast", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			EvaluateHandFast(hands[i&1023])
+		}
+	})
+	b.Run("EvaluateMask", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			EvaluateMask(masks[i&1023])
+		}
+	})
+}
+
+func BenchmarkEvaluateBatch9Hands(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	deck := fullDeck()
+	rng.Shuffle(len(deck), func(i, j int) { deck[i], deck[j] = deck[j], deck[i] })
+
+	board := MaskFromCards(deck[:5])
+	holes := make([]CardMask, 9)
+	for h := range holes {
+		holes[h] = MaskFromCards(deck[5+2*h : 7+2*h])
+	}
+	scores := make([]uint32, len(holes))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EvaluateBatch(board, holes, scores)
+	}
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// autoTopUp carries out a player's standing top-up instruction between
+// hands. A player who cannot pay for it, or whose top-up the engine rejects,
+// has the instruction cancelled rather than holding up the table.
+func (k msgServer) autoTopUp(ctx sdk.Context, game types.Game, state types.TexasHoldemStateDTO, player string, amount uint64) error {
+	autoTopUp, _ := game.AutoTopUp(player)
+	p, ok := findPlayer(state, player)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidRequest, "player %s not found in game", player)
+	}
+	stack, err := strconv.ParseUint(p.Stack, 10, 64)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to parse player stack")
+	}
+	playerAddr, err := k.addressCodec.StringToBytes(player)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid player address")
+	}
+
+	balance := k.bankKeeper.SpendableCoins(ctx, playerAddr).AmountOf(game.TableDenom())
+	if balance.LT(math.NewIntFromUint64(amount)) {
+		return k.stopAutoTopUp(ctx, game, player, "insufficient_funds", amount, balance)
+	}
+
+	// Failed top-ups are refunded, but anything else they wrote is dropped
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.topUp(cacheCtx, game, player, uint64(p.Seat), amount, stack+amount); err != nil {
+		ctx.Logger().Error("❌ Auto top-up failed", "gameId", game.GameId, "player", player, "error", err)
+		return k.stopAutoTopUp(ctx, game, player, "top_up_failed", amount, balance)
+	}
+	write()
+
+	ctx.Logger().Info("💰 Performed auto top-up", "gameId", game.GameId, "player", player, "mode", autoTopUp.Mode, "amount", amount)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"auto_top_up_performed",
+			sdk.NewAttribute("game_id", game.GameId),
+			sdk.NewAttribute("player", player),
+			sdk.NewAttribute("mode", string(autoTopUp.Mode)),
+			sdk.NewAttribute("amount", strconv.FormatUint(amount, 10)),
+			sdk.NewAttribute("new_stack", strconv.FormatUint(stack+amount, 10)),
+		),
+	})
+	return nil
+}
+
+// stopAutoTopUp cancels a standing top-up instruction the chain could not
+// carry out
+func (k msgServer) stopAutoTopUp(ctx sdk.Context, game types.Game, player string, reason string, needed uint64, balance math.Int) error {
+	game.RemoveAutoTopUp(player)
+	if err := k.Games.Set(ctx, game.GameId, game); err != nil {
+		return errorsmod.Wrap(err, "failed to update game")
+	}
+	ctx.Logger().Info("💰 Stopped auto top-up", "gameId", game.GameId, "player", player, "reason", reason)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"auto_top_up_stopped",
+			sdk.NewAttribute("game_id", game.GameId),
+			sdk.NewAttribute("player", player),
+			sdk.NewAttribute("reason", reason),
+			sdk.NewAttribute("needed", strconv.FormatUint(needed, 10)),
+			sdk.NewAttribute("balance", balance.String()),
+		),
+	})
+	return nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestAutoTopUp(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.HandStartDelay = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	var players []string
+	for _, name := range []string{"alice_______________", "bob_________________", "carol_______________"} {
+		addr, err := f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		fundUSDC(t, f, bank, addr, 1_500)
+		players = append(players, addr)
+	}
+	alice, bob, carol := players[0], players[1], players[2]
+
+	_, err = ms.CreateGame(ctx, &types.MsgCreateGame{
+		Creator: alice, MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+		SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash",
+	})
+	require.NoError(t, err)
+	var gameId string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameId = id
+		return true, nil
+	}))
+	for i, player := range players {
+		_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: player, GameId: gameId, Seat: uint64(i + 1), BuyInAmount: 1000})
+		require.NoError(t, err)
+	}
+
+	getGame := func() types.Game {
+		game, err := f.keeper.Games.Get(ctx, gameId)
+		require.NoError(t, err)
+		return game
+	}
+	getState := func() types.TexasHoldemStateDTO {
+		state, err := f.keeper.GameStates.Get(ctx, gameId)
+		require.NoError(t, err)
+		return state
+	}
+	// chips is what a player has at the table, in their stack and in the pot
+	chips := func(player string) uint64 {
+		for _, p := range getState().Players {
+			if p.Address == player {
+				stack, err := strconv.ParseUint(p.Stack, 10, 64)
+				require.NoError(t, err)
+				bets, err := strconv.ParseUint(p.SumOfBets, 10, 64)
+				require.NoError(t, err)
+				return stack + bets
+			}
+		}
+		return 0
+	}
+	events := func(eventType string) []sdk.Event {
+		var found []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				found = append(found, event)
+			}
+		}
+		return found
+	}
+	finishHand := func() {
+		for state := getState(); state.Round != types.RoundShowdown; state = getState() {
+			for _, p := range state.Players {
+				if p.Seat == state.NextToAct {
+					_, err := ms.PerformAction(ctx, &types.MsgPerformAction{Player: p.Address, GameId: gameId, Action: "fold"})
+					require.NoError(t, err)
+				}
+			}
+		}
+	}
+
+	// The mode must be known and the target within the table's buy-in limits
+	for _, invalid := range []*types.MsgSetAutoTopUp{
+		{Player: alice, GameId: gameId, Mode: "double-up", TargetStack: 1000},
+		{Player: alice, GameId: gameId, Mode: "top-up", TargetStack: 199},
+		{Player: alice, GameId: gameId, Mode: "top-up", TargetStack: 2001},
+	} {
+		_, err = ms.SetAutoTopUp(ctx, invalid)
+		require.ErrorIs(t, err, types.ErrInvalidRequest)
+	}
+
+	// A top-up to the target is paid from the player's wallet before the
+	// next hand is dealt, while a rebuy waits for the player to bust
+	_, err = ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: alice, GameId: gameId, Mode: "top-up", TargetStack: 1200})
+	require.NoError(t, err)
+	_, err = ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: carol, GameId: gameId, Mode: "rebuy", TargetStack: 1200})
+	require.NoError(t, err)
+	require.Len(t, events("auto_top_up_set"), 2)
+	addr, err := f.addressCodec.StringToBytes(alice)
+	require.NoError(t, err)
+	before := bank.SpendableCoins(ctx, addr).AmountOf(getGame().TableDenom())
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.Len(t, events("auto_top_up_performed"), 1)
+	require.Equal(t, types.RoundPreflop, getState().Round)
+	require.Equal(t, uint64(1200), chips(alice))
+	require.Equal(t, uint64(1000), chips(carol))
+	require.Equal(t, before.SubRaw(200), bank.SpendableCoins(ctx, addr).AmountOf(getGame().TableDenom()))
+
+	// Cancelling forgets the instruction, and there is nothing to cancel twice
+	_, err = ms.CancelAutoTopUp(ctx, &types.MsgCancelAutoTopUp{Player: alice, GameId: gameId})
+	require.NoError(t, err)
+	require.Len(t, events("auto_top_up_cancelled"), 1)
+	_, ok := getGame().AutoTopUp(alice)
+	require.False(t, ok)
+	_, err = ms.CancelAutoTopUp(ctx, &types.MsgCancelAutoTopUp{Player: alice, GameId: gameId})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// A top-up the player can't pay for is stopped with an event
+	_, err = ms.SetAutoTopUp(ctx, &types.MsgSetAutoTopUp{Player: bob, GameId: gameId, Mode: "top-up", TargetStack: 2000})
+	require.NoError(t, err)
+	finishHand()
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	stopped := events("auto_top_up_stopped")
+	require.Len(t, stopped, 1)
+	reason, _ := stopped[0].GetAttribute("reason")
+	require.Equal(t, "insufficient_funds", reason.Value)
+	_, ok = getGame().AutoTopUp(bob)
+	require.False(t, ok)
+	require.Equal(t, 2, getState().HandNumber)
+}
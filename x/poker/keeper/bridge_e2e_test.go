@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/testutil/ethsim"
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// simBridgeFixture wires the keeper to a simulated chain running the bridge
+// contract, with the contract and validator key set in params and 2 required
+// confirmations
+func simBridgeFixture(t *testing.T) (*fixture, *mockBankKeeper, *ethsim.Bridge, string, keeper.WithdrawalSigner) {
+	t.Helper()
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+
+	validatorKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sim := ethsim.NewBridge(t, crypto.PubkeyToAddress(validatorKey.PublicKey))
+	f.keeper.SetBridgeConfig(sim.Reader(), sim.Address.Hex())
+
+	params := types.DefaultParams()
+	params.DepositContractAddress = sim.Address.Hex()
+	params.DepositConfirmations = 2
+	params.BridgeSigners = []string{crypto.PubkeyToAddress(validatorKey.PublicKey).Hex()}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	return f, bank, sim, authority, keeper.NewPrivateKeySigner(validatorKey)
+}
+
+// relayHeaders submits the simulated chain's headers from..tip
+func relayHeaders(t *testing.T, f *fixture, sim *ethsim.Bridge, relayer string, from uint64) {
+	t.Helper()
+	tip, err := sim.Client.BlockNumber(context.Background())
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(f.keeper).SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{
+		Relayer: relayer,
+		Headers: sim.RawHeaders(t, from, tip),
+	})
+	require.NoError(t, err)
+}
+
+func TestBridgeE2E_Deposit(t *testing.T) {
+	f, bank, sim, authority, _ := simBridgeFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	aliceAddr := sdk.AccAddress("alice_______________")
+	alice, err := f.addressCodec.BytesToString(aliceAddr)
+	require.NoError(t, err)
+
+	receipt := sim.Deposit(t, alice, 2_500_000)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+	height := receipt.BlockNumber.Uint64()
+
+	// The recipient is only indexed by hash, so read it back from the contract
+	verifier, err := f.keeper.GetBridgeVerifier(f.ctx)
+	require.NoError(t, err)
+	deposit, err := verifier.GetDepositByIndex(context.Background(), 0, height)
+	require.NoError(t, err)
+	require.Equal(t, alice, deposit.Account)
+	require.Equal(t, uint64(2_500_000), deposit.Amount.Uint64())
+	_, err = verifier.GetDepositByIndex(context.Background(), 1, height)
+	require.Error(t, err)
+
+	msg := &types.MsgProcessDeposit{
+		Creator:        authority,
+		DepositIndex:   0,
+		EthBlockHeight: height,
+		Recipient:      deposit.Account,
+		TxIndex:        uint64(receipt.TransactionIndex),
+		ReceiptProof:   sim.ReceiptProof(t, receipt),
+	}
+
+	// Not enough confirmations yet
+	relayHeaders(t, f, sim, authority, height)
+	_, err = ms.ProcessDeposit(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+
+	sim.Mine(2)
+	relayHeaders(t, f, sim, authority, height+1)
+	resp, err := ms.ProcessDeposit(f.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, alice, resp.Recipient)
+	require.Equal(t, int64(2_500_000), bank.SpendableCoins(f.ctx, aliceAddr).AmountOf(keeper.USDC_DENOM).Int64())
+
+	_, err = ms.ProcessDeposit(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrTxAlreadyProcessed)
+}
+
+func TestBridgeE2E_Withdrawal(t *testing.T) {
+	f, bank, sim, authority, validator := simBridgeFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := context.Background()
+
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, alice, 1_000_000)
+
+	resp, err := ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: alice, Amount: 750_000, BaseAddress: testBaseAddress})
+	require.NoError(t, err)
+
+	// The bridge signer sidecar signs off chain and submits the signature
+	request, err := f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+	signature, err := keeper.SignWithdrawalRequest(ctx, request, validator)
+	require.NoError(t, err)
+	_, err = ms.SignWithdrawal(f.ctx, types.NewMsgSignWithdrawal(alice, resp.Nonce, signature))
+	require.NoError(t, err)
+
+	request, err = f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusSigned, request.Status)
+	receiver := common.HexToAddress(request.BaseAddress)
+	nonce := common.HexToHash(request.Nonce)
+
+	call := func(amount uint64, signature []byte) error {
+		data, err := ethsim.WithdrawCall(receiver, amount, nonce, signature)
+		require.NoError(t, err)
+		_, err = sim.Client.CallContract(ctx, ethereum.CallMsg{To: &sim.Address, Data: data}, nil)
+		return err
+	}
+
+	// The contract accepts the validator signature, and only for the signed amount
+	require.NoError(t, call(request.Amount, request.Signature))
+	require.Error(t, call(request.Amount+1, request.Signature))
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, signWithdrawal(t, f, resp.Nonce, otherKey)) // already signed, no-op
+	forged, err := crypto.Sign(crypto.Keccak256([]byte("forged")), otherKey)
+	require.NoError(t, err)
+	require.Error(t, call(request.Amount, forged))
+
+	// Claim on Base, then a replay of the same nonce is rejected
+	receipt := sim.Withdraw(t, receiver, request.Amount, nonce, request.Signature)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+	replay := sim.Withdraw(t, receiver, request.Amount, nonce, request.Signature)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, replay.Status)
+
+	// Prove the Withdrawn event to complete the request
+	height := receipt.BlockNumber.Uint64()
+	sim.Mine(2)
+	relayHeaders(t, f, sim, authority, height)
+	_, err = ms.CompleteWithdrawal(f.ctx, &types.MsgCompleteWithdrawal{
+		Creator:        alice,
+		Nonce:          request.Nonce,
+		EthBlockHeight: height,
+		TxIndex:        uint64(receipt.TransactionIndex),
+		ReceiptProof:   sim.ReceiptProof(t, receipt),
+	})
+	require.NoError(t, err)
+
+	request, err = f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusCompleted, request.Status)
+}
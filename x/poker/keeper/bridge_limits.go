@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// GetBridgePause returns the bridge pause state (unpaused if never set)
+func (k Keeper) GetBridgePause(ctx context.Context) (types.BridgePauseState, error) {
+	state, err := k.BridgePause.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.BridgePauseState{}, nil
+	}
+	return state, err
+}
+
+// checkBridgeNotPaused returns ErrBridgePaused while the circuit breaker is on
+func (k Keeper) checkBridgeNotPaused(ctx context.Context) error {
+	state, err := k.GetBridgePause(ctx)
+	if err != nil {
+		return err
+	}
+	if state.Paused {
+		return errorsmod.Wrapf(types.ErrBridgePaused, "paused by %s: %s", state.UpdatedBy, state.Reason)
+	}
+	return nil
+}
+
+// SetBridgePaused turns the bridge circuit breaker on or off. Guardians may
+// only pause; unpausing requires the module authority.
+func (k Keeper) SetBridgePaused(ctx context.Context, signer string, paused bool, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	signerAddr, err := k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	isAuthority := sdk.AccAddress(signerAddr).Equals(sdk.AccAddress(k.GetAuthority()))
+	switch {
+	case isAuthority:
+	case paused && params.IsBridgeGuardian(signer):
+	case paused:
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a bridge guardian", signer)
+	default:
+		return errorsmod.Wrap(types.ErrUnauthorized, "only the module authority can unpause the bridge")
+	}
+
+	state := types.BridgePauseState{
+		Paused:    paused,
+		UpdatedBy: signer,
+		UpdatedAt: sdkCtx.BlockTime().Unix(),
+		Reason:    reason,
+	}
+	if err := k.BridgePause.Set(ctx, state); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"bridge_pause_updated",
+			sdk.NewAttribute("paused", fmt.Sprintf("%t", paused)),
+			sdk.NewAttribute("updated_by", signer),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return nil
+}
+
+// windowStart returns the first block time inside the current withdrawal window
+func windowStart(sdkCtx sdk.Context, params types.Params) int64 {
+	return sdkCtx.BlockTime().Unix() - int64(params.WithdrawalWindow) + 1
+}
+
+// WithdrawnInWindow returns the amount withdrawn in the current window by all
+// addresses and, if address is set, by that address
+func (k Keeper) WithdrawnInWindow(ctx context.Context, address string) (global uint64, byAddress uint64, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	if params.WithdrawalWindow == 0 {
+		return 0, 0, nil
+	}
+	start := windowStart(sdkCtx, params)
+
+	globalRange := new(collections.Range[collections.Pair[int64, string]]).StartInclusive(collections.Join(start, ""))
+	err = k.WithdrawalOutflows.Walk(ctx, globalRange, func(_ collections.Pair[int64, string], amount uint64) (bool, error) {
+		global += amount
+		return false, nil
+	})
+	if err != nil || address == "" {
+		return global, 0, err
+	}
+
+	err = k.AddressWithdrawalOutflows.Walk(ctx, collections.NewPrefixedTripleRange[string, int64, string](address),
+		func(key collections.Triple[string, int64, string], amount uint64) (bool, error) {
+			if key.K2() >= start {
+				byAddress += amount
+			}
+			return false, nil
+		})
+	return global, byAddress, err
+}
+
+// checkWithdrawalLimits prunes outflows that left the window and checks the
+// withdrawal fits within the per-address and global limits
+func (k Keeper) checkWithdrawalLimits(ctx context.Context, params types.Params, address string, amount uint64) error {
+	if params.WithdrawalWindow == 0 {
+		return nil
+	}
+	if err := k.pruneWithdrawalOutflows(ctx, params, address); err != nil {
+		return err
+	}
+
+	global, byAddress, err := k.WithdrawnInWindow(ctx, address)
+	if err != nil {
+		return err
+	}
+	if exceedsLimit(byAddress, amount, params.AddressWithdrawalLimit) {
+		return errorsmod.Wrapf(types.ErrWithdrawalLimit, "address limit is %d per %ds, %d already withdrawn",
+			params.AddressWithdrawalLimit, params.WithdrawalWindow, byAddress)
+	}
+	if exceedsLimit(global, amount, params.GlobalWithdrawalLimit) {
+		return errorsmod.Wrapf(types.ErrWithdrawalLimit, "global limit is %d per %ds, %d already withdrawn",
+			params.GlobalWithdrawalLimit, params.WithdrawalWindow, global)
+	}
+	return nil
+}
+
+// exceedsLimit reports whether used+amount is over a non-zero limit
+func exceedsLimit(used, amount, limit uint64) bool {
+	return limit > 0 && (amount > limit || used > limit-amount)
+}
+
+// remainingUnderLimit returns the headroom left under a limit (0 if unlimited)
+func remainingUnderLimit(used, limit uint64) uint64 {
+	if limit == 0 || used >= limit {
+		return 0
+	}
+	return limit - used
+}
+
+// pruneWithdrawalOutflows removes the global outflows and the address's
+// outflows that are older than the window
+func (k Keeper) pruneWithdrawalOutflows(ctx context.Context, params types.Params, address string) error {
+	start := windowStart(sdk.UnwrapSDKContext(ctx), params)
+
+	var expired []collections.Pair[int64, string]
+	globalRange := new(collections.Range[collections.Pair[int64, string]]).EndExclusive(collections.Join(start, ""))
+	err := k.WithdrawalOutflows.Walk(ctx, globalRange, func(key collections.Pair[int64, string], _ uint64) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range expired {
+		if err := k.WithdrawalOutflows.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	var expiredByAddress []collections.Triple[string, int64, string]
+	err = k.AddressWithdrawalOutflows.Walk(ctx, collections.NewPrefixedTripleRange[string, int64, string](address),
+		func(key collections.Triple[string, int64, string], _ uint64) (bool, error) {
+			if key.K2() >= start {
+				return true, nil
+			}
+			expiredByAddress = append(expiredByAddress, key)
+			return false, nil
+		})
+	if err != nil {
+		return err
+	}
+	for _, key := range expiredByAddress {
+		if err := k.AddressWithdrawalOutflows.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordWithdrawalOutflow counts a withdrawal towards the rolling limits
+func (k Keeper) recordWithdrawalOutflow(ctx context.Context, request types.WithdrawalRequest) error {
+	return k.recordOutflow(ctx, request.CosmosAddress, request.CreatedAt, request.Nonce, request.Amount)
+}
+
+// recordOutflow counts amount leaving the chain from address at createdAt
+// towards the rolling limits; id keeps outflows in the same second apart
+func (k Keeper) recordOutflow(ctx context.Context, address string, createdAt int64, id string, amount uint64) error {
+	if err := k.WithdrawalOutflows.Set(ctx, collections.Join(createdAt, id), amount); err != nil {
+		return err
+	}
+	return k.AddressWithdrawalOutflows.Set(ctx, collections.Join3(address, createdAt, id), amount)
+}
+
+// ReleaseQueuedWithdrawals moves queued large withdrawals whose delay has
+// passed to pending, so they get signed. Nothing is released while the bridge
+// is paused, which gives guardians the delay to react to a suspicious withdrawal.
+func (k Keeper) ReleaseQueuedWithdrawals(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pause, err := k.GetBridgePause(ctx)
+	if err != nil || pause.Paused {
+		return err
+	}
+
+	now := sdkCtx.BlockTime().Unix()
+	var due []collections.Pair[int64, string]
+	dueRange := new(collections.Range[collections.Pair[int64, string]]).EndExclusive(collections.Join(now+1, ""))
+	err = k.WithdrawalQueue.Walk(ctx, dueRange, func(key collections.Pair[int64, string]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.WithdrawalQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		request, err := k.WithdrawalRequests.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if request.Status != WithdrawalStatusQueued {
+			continue
+		}
+		request.Status = WithdrawalStatusPending
+		if err := k.WithdrawalRequests.Set(ctx, request.Nonce, request); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"withdrawal_released",
+				sdk.NewAttribute("nonce", request.Nonce),
+				sdk.NewAttribute("amount", fmt.Sprintf("%d", request.Amount)),
+			),
+		)
+	}
+	return nil
+}
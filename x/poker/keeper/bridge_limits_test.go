@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+const testBaseAddress = "0x1111111111111111111111111111111111111111"
+
+// fundUSDC mints USDC to an address through the mock bank
+func fundUSDC(t *testing.T, f *fixture, bank *mockBankKeeper, addr string, amount uint64) {
+	t.Helper()
+	accAddr, err := f.addressCodec.StringToBytes(addr)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewCoin(keeper.USDC_DENOM, math.NewIntFromUint64(amount)))
+	require.NoError(t, bank.MintCoins(f.ctx, types.ModuleName, coins))
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(f.ctx, types.ModuleName, accAddr, coins))
+}
+
+func TestSetBridgePaused(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	guardian, err := f.addressCodec.BytesToString(sdk.AccAddress("guardian____________"))
+	require.NoError(t, err)
+	stranger, err := f.addressCodec.BytesToString(sdk.AccAddress("stranger____________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.BridgeGuardians = []string{guardian}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, err = ms.SetBridgePaused(f.ctx, &types.MsgSetBridgePaused{Signer: stranger, Paused: true})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.SetBridgePaused(f.ctx, &types.MsgSetBridgePaused{Signer: guardian, Paused: true, Reason: "suspicious outflow"})
+	require.NoError(t, err)
+
+	status, err := keeper.NewQueryServerImpl(f.keeper).BridgeStatus(f.ctx, &types.QueryBridgeStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, status.Pause.Paused)
+	require.Equal(t, guardian, status.Pause.UpdatedBy)
+	require.Equal(t, "suspicious outflow", status.Pause.Reason)
+
+	// Deposits and withdrawals are rejected while paused
+	_, err = ms.ProcessDeposit(f.ctx, &types.MsgProcessDeposit{Creator: stranger, ReceiptProof: [][]byte{{0x01}}})
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+	_, err = ms.Mint(f.ctx, types.NewMsgMint(stranger, stranger, 1, "0xabc", 1, 100, 1, 0, [][]byte{{0x01}}))
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+	_, err = ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: stranger, Amount: 1, BaseAddress: testBaseAddress})
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+
+	// Oracle deposits are deferred rather than skipped
+	require.NoError(t, f.keeper.ApplyOracleResult(f.ctx, types.OracleResult{
+		Deposits: []types.OracleDeposit{{Index: 0, Recipient: stranger, Amount: 1}},
+	}))
+	next, err := f.keeper.NextDepositIndex(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), next)
+
+	// Only the authority can unpause
+	_, err = ms.SetBridgePaused(f.ctx, &types.MsgSetBridgePaused{Signer: guardian, Paused: false})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetBridgePaused(f.ctx, &types.MsgSetBridgePaused{Signer: authority, Paused: false})
+	require.NoError(t, err)
+
+	pause, err := f.keeper.GetBridgePause(f.ctx)
+	require.NoError(t, err)
+	require.False(t, pause.Paused)
+}
+
+func TestInitiateWithdrawal_Limits(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, alice, 1_000)
+	fundUSDC(t, f, bank, bob, 1_000)
+
+	params := types.DefaultParams()
+	params.WithdrawalWindow = 3600
+	params.AddressWithdrawalLimit = 300
+	params.GlobalWithdrawalLimit = 500
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	now := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	withdraw := func(creator string, amount uint64) error {
+		_, err := ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: creator, Amount: amount, BaseAddress: testBaseAddress})
+		return err
+	}
+
+	require.NoError(t, withdraw(alice, 200))
+	require.ErrorIs(t, withdraw(alice, 101), types.ErrWithdrawalLimit, "address limit")
+	require.NoError(t, withdraw(alice, 100))
+	require.ErrorIs(t, withdraw(bob, 201), types.ErrWithdrawalLimit, "global limit")
+	require.NoError(t, withdraw(bob, 200))
+
+	status, err := qs.BridgeStatus(f.ctx, &types.QueryBridgeStatusRequest{Address: bob})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), status.GlobalWithdrawn)
+	require.Equal(t, uint64(0), status.GlobalRemaining)
+	require.Equal(t, uint64(200), status.AddressWithdrawn)
+	require.Equal(t, uint64(100), status.AddressRemaining)
+
+	// Outflows leave the rolling window and are pruned
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now.Add(time.Hour))
+	require.NoError(t, withdraw(alice, 300))
+
+	status, err = qs.BridgeStatus(f.ctx, &types.QueryBridgeStatusRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), status.GlobalWithdrawn)
+	require.Equal(t, uint64(300), status.AddressWithdrawn)
+
+	count := 0
+	require.NoError(t, f.keeper.WithdrawalOutflows.Walk(f.ctx, nil, func(_ collections.Pair[int64, string], _ uint64) (bool, error) {
+		count++
+		return false, nil
+	}))
+	require.Equal(t, 1, count)
+}
+
+func TestInitiateWithdrawal_LargeWithdrawalQueue(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, alice, 1_000)
+
+	params := types.DefaultParams()
+	params.LargeWithdrawalThreshold = 500
+	params.LargeWithdrawalDelay = 600
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	now := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	small, err := ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: alice, Amount: 499, BaseAddress: testBaseAddress})
+	require.NoError(t, err)
+	large, err := ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: alice, Amount: 500, BaseAddress: testBaseAddress})
+	require.NoError(t, err)
+
+	requireStatus := func(nonce, want string) types.WithdrawalRequest {
+		t.Helper()
+		request, err := f.keeper.WithdrawalRequests.Get(f.ctx, nonce)
+		require.NoError(t, err)
+		require.Equal(t, want, request.Status)
+		return request
+	}
+	requireStatus(small.Nonce, keeper.WithdrawalStatusPending)
+	request := requireStatus(large.Nonce, keeper.WithdrawalStatusQueued)
+	require.Equal(t, now.Unix()+600, request.ReleaseAt)
+	require.Equal(t, request.ReleaseAt+int64(types.DefaultWithdrawalExpiry), request.ExpiresAt)
+
+	// Not released before the delay
+	require.NoError(t, f.keeper.ReleaseQueuedWithdrawals(f.ctx))
+	requireStatus(large.Nonce, keeper.WithdrawalStatusQueued)
+
+	// Not released while paused
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now.Add(10 * time.Minute))
+	require.NoError(t, f.keeper.SetBridgePaused(f.ctx, authority, true, "investigating"))
+	require.NoError(t, f.keeper.ReleaseQueuedWithdrawals(f.ctx))
+	requireStatus(large.Nonce, keeper.WithdrawalStatusQueued)
+
+	require.NoError(t, f.keeper.SetBridgePaused(f.ctx, authority, false, ""))
+	require.NoError(t, f.keeper.ReleaseQueuedWithdrawals(f.ctx))
+	requireStatus(large.Nonce, keeper.WithdrawalStatusPending)
+	has, err := f.keeper.WithdrawalQueue.Has(f.ctx, collections.Join(request.ReleaseAt, large.Nonce))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -11,13 +11,12 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 // BridgeService handles monitoring Ethereum L1 deposits and bridging USDC to Cosmos
 type BridgeService struct {
 	keeper             *Keeper
-	ethClient          *ethclient.Client
+	ethClient          EthChainReader
 	depositContract    common.Address
 	usdcContract       common.Address
 	logger             log.Logger
@@ -44,20 +43,30 @@ func NewBridgeService(
 	usdcContractAddr string,
 	logger log.Logger,
 ) (*BridgeService, error) {
-	client, err := ethclient.Dial(ethRPCURL)
+	client, err := DialEthChainReader(ethRPCURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to Ethereum client: %w", err)
+		return nil, err
 	}
+	return NewBridgeServiceWithReader(keeper, client, depositContractAddr, usdcContractAddr, logger), nil
+}
 
+// NewBridgeServiceWithReader creates a bridge service on an existing chain reader
+func NewBridgeServiceWithReader(
+	keeper *Keeper,
+	reader EthChainReader,
+	depositContractAddr string,
+	usdcContractAddr string,
+	logger log.Logger,
+) *BridgeService {
 	return &BridgeService{
 		keeper:             keeper,
-		ethClient:          client,
+		ethClient:          reader,
 		depositContract:    common.HexToAddress(depositContractAddr),
 		usdcContract:       common.HexToAddress(usdcContractAddr),
 		logger:             logger,
 		pollingInterval:    15 * time.Second, // Poll every 15 seconds
 		lastProcessedBlock: 0,                // Should be loaded from state
-	}, nil
+	}
 }
 
 // Start begins monitoring Ethereum deposits
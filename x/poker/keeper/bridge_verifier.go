@@ -10,12 +10,12 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // BridgeVerifier handles verification of Ethereum deposits
 type BridgeVerifier struct {
-	ethClient       *ethclient.Client
+	ethClient       EthChainReader
 	depositContract common.Address
 }
 
@@ -27,17 +27,23 @@ type DepositVerification struct {
 	Verified  bool
 }
 
-// NewBridgeVerifier creates a new bridge verifier
+// NewBridgeVerifier creates a new bridge verifier with its own connection to
+// the given RPC endpoint. Close releases the connection.
 func NewBridgeVerifier(ethRPCURL string, depositContractAddr string) (*BridgeVerifier, error) {
-	client, err := ethclient.Dial(ethRPCURL)
+	client, err := DialEthChainReader(ethRPCURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to Ethereum client: %w", err)
+		return nil, err
 	}
+	return NewBridgeVerifierWithReader(client, depositContractAddr), nil
+}
 
+// NewBridgeVerifierWithReader creates a bridge verifier on an existing chain
+// reader. The reader stays owned by the caller.
+func NewBridgeVerifierWithReader(reader EthChainReader, depositContractAddr string) *BridgeVerifier {
 	return &BridgeVerifier{
-		ethClient:       client,
+		ethClient:       reader,
 		depositContract: common.HexToAddress(depositContractAddr),
-	}, nil
+	}
 }
 
 // VerifyDeposit verifies an Ethereum deposit transaction
@@ -259,9 +265,18 @@ func (bv *BridgeVerifier) GetDepositByIndex(
 	}, nil
 }
 
+// FinalizedBlockNumber returns the latest finalized block number of the source chain
+func (bv *BridgeVerifier) FinalizedBlockNumber(ctx context.Context) (uint64, error) {
+	header, err := bv.ethClient.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get finalized block: %w", err)
+	}
+	return header.Number.Uint64(), nil
+}
+
 // Close closes the Ethereum client connection
 func (bv *BridgeVerifier) Close() {
 	if bv.ethClient != nil {
-		bv.ethClient.Close()
+		closeEthChainReader(bv.ethClient)
 	}
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 
 	"github.com/block52/pokerchain/x/poker/types"
@@ -9,8 +10,10 @@ import (
 )
 
 // GenerateShuffleSeed creates a deterministic 52-number seed array from the block hash
-// This provides verifiable randomness for deck shuffling based on blockchain state
-func (k Keeper) GenerateShuffleSeed(ctx context.Context) []int {
+// This provides verifiable randomness for deck shuffling based on blockchain state.
+// The game ID and hand number are mixed in so that tables shuffling in the same
+// block, and consecutive hands at a table, get unrelated decks.
+func (k Keeper) GenerateShuffleSeed(ctx context.Context, gameId string, handNumber int) []int {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// Use block hash for deterministic randomness
@@ -28,21 +31,24 @@ func (k Keeper) GenerateShuffleSeed(ctx context.Context) []int {
 		blockHash = []byte(fallbackStr)
 	}
 
-	// Generate 52 seed values from the block hash
+	// Generate 52 seed values by hashing the block hash with the game and
+	// hand, plus a counter for every 32 values
 	seed := make([]int, 52)
+	var digest [sha256.Size]byte
 	for i := 0; i < 52; i++ {
+		if i%sha256.Size == 0 {
+			digest = sha256.Sum256(fmt.Appendf(nil, "%x/%s/%d/%d", blockHash, gameId, handNumber, i/sha256.Size))
+		}
 		// Use modulo on hash bytes to create values in range 0-51
-		// Cycle through hash bytes if we need more than hash length
-		hashIndex := i % len(blockHash)
-		seed[i] = int(blockHash[hashIndex]) % 52
+		seed[i] = int(digest[i%sha256.Size]) % 52
 	}
 
 	return seed
 }
 
 // InitializeAndShuffleDeck creates a new standard 52-card deck and shuffles it
-// using a seed generated from the current block hash
-func (k Keeper) InitializeAndShuffleDeck(ctx context.Context) (*types.Deck, error) {
+// using a seed generated from the current block hash, the game and the hand
+func (k Keeper) InitializeAndShuffleDeck(ctx context.Context, gameId string, handNumber int) (*types.Deck, error) {
 	// Create a new standard deck
 	deck, err := types.NewDeck("")
 	if err != nil {
@@ -50,7 +56,7 @@ func (k Keeper) InitializeAndShuffleDeck(ctx context.Context) (*types.Deck, erro
 	}
 
 	// Generate shuffle seed from block state
-	seed := k.GenerateShuffleSeed(ctx)
+	seed := k.GenerateShuffleSeed(ctx, gameId, handNumber)
 
 	// Shuffle the deck with the deterministic seed
 	deck.Shuffle(seed)
@@ -13,7 +13,7 @@ func TestGenerateShuffleSeed(t *testing.T) {
 	ctx := f.ctx
 
 	// Generate seed
-	seed := k.GenerateShuffleSeed(ctx)
+	seed := k.GenerateShuffleSeed(ctx, "0xgame", 1)
 
 	// Verify seed has exactly 52 values
 	require.Equal(t, 52, len(seed), "Seed should have exactly 52 values")
@@ -25,8 +25,12 @@ func TestGenerateShuffleSeed(t *testing.T) {
 	}
 
 	// Generate seed again - should be same in same block context
-	seed2 := k.GenerateShuffleSeed(ctx)
+	seed2 := k.GenerateShuffleSeed(ctx, "0xgame", 1)
 	require.Equal(t, seed, seed2, "Same context should produce same seed (deterministic)")
+
+	// Other tables and hands in the same block get different seeds
+	require.NotEqual(t, seed, k.GenerateShuffleSeed(ctx, "0xother", 1), "Different games should produce different seeds")
+	require.NotEqual(t, seed, k.GenerateShuffleSeed(ctx, "0xgame", 2), "Different hands should produce different seeds")
 }
 
 func TestInitializeAndShuffleDeck(t *testing.T) {
@@ -35,7 +39,7 @@ func TestInitializeAndShuffleDeck(t *testing.T) {
 	ctx := f.ctx
 
 	// Initialize and shuffle deck
-	deck, err := k.InitializeAndShuffleDeck(ctx)
+	deck, err := k.InitializeAndShuffleDeck(ctx, "0xgame", 1)
 	require.NoError(t, err, "Should successfully initialize deck")
 	require.NotNil(t, deck, "Deck should not be nil")
 
@@ -51,7 +55,7 @@ func TestInitializeAndShuffleDeck(t *testing.T) {
 	require.Contains(t, deckStr, "-", "Deck string should contain card separators")
 
 	// Initialize another deck - should be different due to shuffle
-	deck2, err := k.InitializeAndShuffleDeck(ctx)
+	deck2, err := k.InitializeAndShuffleDeck(ctx, "0xgame", 1)
 	require.NoError(t, err)
 
 	// In same context, should produce same shuffled deck (deterministic)
@@ -64,7 +68,7 @@ func TestDeckRoundTripSerialization(t *testing.T) {
 	ctx := f.ctx
 
 	// Create and shuffle a deck
-	originalDeck, err := k.InitializeAndShuffleDeck(ctx)
+	originalDeck, err := k.InitializeAndShuffleDeck(ctx, "0xgame", 1)
 	require.NoError(t, err)
 
 	// Serialize to string
@@ -94,7 +98,7 @@ func TestDeckStateWithTopPointer(t *testing.T) {
 	ctx := f.ctx
 
 	// Create and shuffle deck
-	deck, err := k.InitializeAndShuffleDeck(ctx)
+	deck, err := k.InitializeAndShuffleDeck(ctx, "0xgame", 1)
 	require.NoError(t, err)
 
 	// Deal some cards to advance top pointer
@@ -146,7 +150,7 @@ func TestDeckIntegrationWithGameState(t *testing.T) {
 	ctx := f.ctx
 
 	// Initialize deck
-	deck, err := k.InitializeAndShuffleDeck(ctx)
+	deck, err := k.InitializeAndShuffleDeck(ctx, "0xgame", 1)
 	require.NoError(t, err)
 
 	// Simulate storing in game state
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// EthChainReader is the read access to the source chain that the bridge needs.
+// *ethclient.Client implements it for a live RPC endpoint, and the go-ethereum
+// simulated backend client implements it for tests.
+//
+// Nothing read through it may be used during block execution; consensus code
+// only trusts tracked headers and receipt proofs.
+type EthChainReader interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *ethtypes.Transaction, isPending bool, err error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*ethtypes.Receipt, error)
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]ethtypes.Log, error)
+}
+
+var _ EthChainReader = (*ethclient.Client)(nil)
+
+// DialEthChainReader connects to a source chain JSON-RPC endpoint
+func DialEthChainReader(rpcURL string) (*ethclient.Client, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Ethereum client: %w", err)
+	}
+	return client, nil
+}
+
+// closeEthChainReader closes readers that hold a connection
+func closeEthChainReader(reader EthChainReader) {
+	if closer, ok := reader.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
@@ -0,0 +1,427 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// depositedEventTopic is keccak256("Deposited(string,uint256,uint256)")
+var depositedEventTopic = common.HexToHash("0x46008385c8bcecb546cb0a96e5b409f34ac1a8ece8f3ea98488282519372bdf2")
+
+// withdrawnEventTopic is keccak256("Withdrawn(address,uint256,bytes32)")
+var withdrawnEventTopic = common.HexToHash("0x510b30a02fc9b7506980950b9c615af8c13e88658555c0ea22acffd73d9ea222")
+
+// withdrawalCancelledEventTopic is keccak256("WithdrawalCancelled(bytes32)")
+var withdrawalCancelledEventTopic = common.HexToHash("0x2c3f6464ee7dbe9f7d807f41160b09edb0dfc340865796a3b9d03c9fe1b1b028")
+
+// VerifiedDeposit is a Deposited event proven against a tracked header
+type VerifiedDeposit struct {
+	Recipient      string
+	Amount         uint64
+	Index          uint64
+	EthBlockHeight uint64
+}
+
+// DecodeEthHeader decodes an RLP-encoded source chain header. The block hash is
+// the keccak256 of the raw encoding, so it commits to every header field even
+// though only the ones needed for proof verification are kept.
+func DecodeEthHeader(raw []byte) (types.EthHeader, error) {
+	var header ethtypes.Header
+	if err := rlp.DecodeBytes(raw, &header); err != nil {
+		return types.EthHeader{}, errorsmod.Wrapf(types.ErrInvalidEthHeader, "failed to decode header: %v", err)
+	}
+	if header.Number == nil || !header.Number.IsUint64() {
+		return types.EthHeader{}, errorsmod.Wrap(types.ErrInvalidEthHeader, "header number out of range")
+	}
+	return types.EthHeader{
+		Number:       header.Number.Uint64(),
+		Hash:         crypto.Keccak256(raw),
+		ParentHash:   header.ParentHash.Bytes(),
+		ReceiptsRoot: header.ReceiptHash.Bytes(),
+		StateRoot:    header.Root.Bytes(),
+		Timestamp:    header.Time,
+	}, nil
+}
+
+// GetEthHeaderTip returns the highest tracked header. The boolean is false when
+// no header has been submitted yet.
+func (k Keeper) GetEthHeaderTip(ctx context.Context) (types.EthHeader, bool, error) {
+	iter, err := k.EthHeaders.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return types.EthHeader{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.EthHeader{}, false, nil
+	}
+	header, err := iter.Value()
+	if err != nil {
+		return types.EthHeader{}, false, err
+	}
+	return header, true, nil
+}
+
+// AppendEthHeader extends the tracked header chain with the child of the
+// current tip. When reanchor is set and the header does not extend the tip,
+// the tracked chain is discarded and restarted from the header instead.
+func (k Keeper) AppendEthHeader(ctx context.Context, header types.EthHeader, reanchor bool) error {
+	if err := header.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidEthHeader, err.Error())
+	}
+
+	tip, found, err := k.GetEthHeaderTip(ctx)
+	if err != nil {
+		return err
+	}
+
+	extendsTip := found && header.Number == tip.Number+1 && bytes.Equal(header.ParentHash, tip.Hash)
+	switch {
+	case !found || extendsTip:
+		// First header becomes the checkpoint, later ones must be children of the tip
+	case reanchor:
+		if err := k.EthHeaders.Clear(ctx, nil); err != nil {
+			return err
+		}
+		// Pending headers were submitted against the discarded chain
+		if err := k.PendingEthHeaders.Clear(ctx, nil); err != nil {
+			return err
+		}
+		if err := k.EthHeaderVotes.Clear(ctx, nil); err != nil {
+			return err
+		}
+	case header.Number != tip.Number+1:
+		return errorsmod.Wrapf(types.ErrInvalidEthHeader, "header %d does not follow tip %d", header.Number, tip.Number)
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidEthHeader,
+			"header %d parent hash 0x%x does not match tip hash 0x%x", header.Number, header.ParentHash, tip.Hash)
+	}
+
+	if err := k.EthHeaders.Set(ctx, header.Number, header); err != nil {
+		return err
+	}
+	if err := k.clearPendingEthHeaders(ctx, header.Number); err != nil {
+		return err
+	}
+
+	// Prune the header that fell out of the retention window
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.HeaderRetention > 0 && header.Number >= params.HeaderRetention {
+		if err := k.EthHeaders.Remove(ctx, header.Number-params.HeaderRetention); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AttestEthHeader records that a relayer submitted a header, and appends it to
+// the tracked chain once quorum distinct relayers have submitted the same
+// header. A header that is already tracked is accepted without a new vote. The
+// boolean reports whether the header is tracked.
+func (k Keeper) AttestEthHeader(ctx context.Context, header types.EthHeader, relayer string, quorum uint64) (bool, error) {
+	if err := header.Validate(); err != nil {
+		return false, errorsmod.Wrap(types.ErrInvalidEthHeader, err.Error())
+	}
+
+	tracked, err := k.EthHeaders.Get(ctx, header.Number)
+	if err == nil && bytes.Equal(tracked.Hash, header.Hash) {
+		return true, nil
+	} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+	tip, found, err := k.GetEthHeaderTip(ctx)
+	if err != nil {
+		return false, err
+	}
+	if found && header.Number <= tip.Number {
+		return false, errorsmod.Wrapf(types.ErrInvalidEthHeader, "header %d does not follow tip %d", header.Number, tip.Number)
+	}
+
+	if err := k.PendingEthHeaders.Set(ctx, collections.Join(header.Number, header.Hash), header); err != nil {
+		return false, err
+	}
+	if err := k.EthHeaderVotes.Set(ctx, collections.Join3(header.Number, header.Hash, relayer)); err != nil {
+		return false, err
+	}
+
+	votes, err := k.countEthHeaderVotes(ctx, header.Number, header.Hash)
+	if err != nil {
+		return false, err
+	}
+	if votes < quorum {
+		return false, nil
+	}
+	if err := k.AppendEthHeader(ctx, header, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// countEthHeaderVotes returns the number of relayers that submitted a pending header
+func (k Keeper) countEthHeaderVotes(ctx context.Context, number uint64, hash []byte) (uint64, error) {
+	iter, err := k.EthHeaderVotes.Iterate(ctx, collections.NewSuperPrefixedTripleRange[uint64, []byte, string](number, hash))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var votes uint64
+	for ; iter.Valid(); iter.Next() {
+		votes++
+	}
+	return votes, nil
+}
+
+// clearPendingEthHeaders removes the pending headers and votes up to and
+// including a tracked block number, which can no longer be appended
+func (k Keeper) clearPendingEthHeaders(ctx context.Context, number uint64) error {
+	pending := new(collections.Range[collections.Pair[uint64, []byte]]).
+		EndExclusive(collections.Join(number+1, []byte{}))
+	if err := k.PendingEthHeaders.Clear(ctx, pending); err != nil {
+		return err
+	}
+	votes := new(collections.Range[collections.Triple[uint64, []byte, string]]).
+		EndExclusive(collections.Join3(number+1, []byte{}, ""))
+	return k.EthHeaderVotes.Clear(ctx, votes)
+}
+
+// VerifyDepositProof proves that the receipt at txIndex in a tracked block
+// contains a Deposited event from the bridge contract at logIndex, and returns
+// the decoded deposit. No RPC calls are made, so this is safe during block
+// execution.
+func (k Keeper) VerifyDepositProof(ctx context.Context, ethBlockHeight, txIndex, logIndex uint64, proof [][]byte, recipient string) (*VerifiedDeposit, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.DepositContractAddress == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "deposits are disabled: no deposit contract configured")
+	}
+
+	log, err := k.verifyBridgeLog(ctx, params, ethBlockHeight, txIndex, logIndex, proof)
+	if err != nil {
+		return nil, err
+	}
+	deposit, err := parseDepositedLog(log, common.HexToAddress(params.DepositContractAddress), recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidProof, err.Error())
+	}
+	deposit.EthBlockHeight = ethBlockHeight
+	return deposit, nil
+}
+
+// VerifyWithdrawnProof proves that the receipt at txIndex in a tracked block
+// contains the Withdrawn event for the withdrawal request at logIndex, i.e.
+// that the withdrawal was claimed on the bridge contract.
+func (k Keeper) VerifyWithdrawnProof(ctx context.Context, request types.WithdrawalRequest, ethBlockHeight, txIndex, logIndex uint64, proof [][]byte) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.DepositContractAddress == "" {
+		return errorsmod.Wrap(types.ErrInvalidRequest, "no bridge contract configured")
+	}
+
+	log, err := k.verifyBridgeLog(ctx, params, ethBlockHeight, txIndex, logIndex, proof)
+	if err != nil {
+		return err
+	}
+	if err := checkWithdrawnLog(log, common.HexToAddress(params.DepositContractAddress), request); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidProof, err.Error())
+	}
+	return nil
+}
+
+// VerifyWithdrawalCancelledProof proves that the receipt at txIndex in a
+// tracked block contains the WithdrawalCancelled event for the nonce at
+// logIndex, i.e. that the nonce can no longer be claimed on the bridge contract.
+func (k Keeper) VerifyWithdrawalCancelledProof(ctx context.Context, nonce string, ethBlockHeight, txIndex, logIndex uint64, proof [][]byte) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.DepositContractAddress == "" {
+		return errorsmod.Wrap(types.ErrInvalidRequest, "no bridge contract configured")
+	}
+
+	log, err := k.verifyBridgeLog(ctx, params, ethBlockHeight, txIndex, logIndex, proof)
+	if err != nil {
+		return err
+	}
+	if log.Address != common.HexToAddress(params.DepositContractAddress) {
+		return errorsmod.Wrapf(types.ErrInvalidProof, "log emitted by %s, not the bridge contract", log.Address.Hex())
+	}
+	if len(log.Topics) != 2 || log.Topics[0] != withdrawalCancelledEventTopic {
+		return errorsmod.Wrap(types.ErrInvalidProof, "log is not a WithdrawalCancelled event")
+	}
+	if log.Topics[1] != common.HexToHash(nonce) {
+		return errorsmod.Wrapf(types.ErrInvalidProof, "cancelled nonce %s does not match %s", log.Topics[1].Hex(), nonce)
+	}
+	return nil
+}
+
+// verifyBridgeLog proves the receipt at txIndex in a tracked block with enough
+// confirmations, and returns its log at logIndex. The transaction must have
+// succeeded; the caller checks the log itself.
+func (k Keeper) verifyBridgeLog(ctx context.Context, params types.Params, ethBlockHeight, txIndex, logIndex uint64, proof [][]byte) (*ethtypes.Log, error) {
+	header, err := k.EthHeaders.Get(ctx, ethBlockHeight)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(types.ErrEthHeaderNotFound, "block %d is not tracked", ethBlockHeight)
+	} else if err != nil {
+		return nil, err
+	}
+
+	tip, _, err := k.GetEthHeaderTip(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tip.Number-header.Number < params.DepositConfirmations {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProof, "block %d has %d confirmations, need %d",
+			ethBlockHeight, tip.Number-header.Number, params.DepositConfirmations)
+	}
+
+	receipt, err := verifyReceiptProof(common.BytesToHash(header.ReceiptsRoot), txIndex, proof)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidProof, err.Error())
+	}
+	if !bytes.Equal(receipt.PostStateOrStatus, []byte{byte(ethtypes.ReceiptStatusSuccessful)}) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProof, "transaction %d in block %d failed", txIndex, ethBlockHeight)
+	}
+	if logIndex >= uint64(len(receipt.Logs)) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProof, "log index %d out of range (%d logs)", logIndex, len(receipt.Logs))
+	}
+	return receipt.Logs[logIndex], nil
+}
+
+// DepositKey returns the processed-deposit key for a bridge contract deposit
+// index: 0x + sha256("<contract>-<index>"), with the contract address in its
+// EIP-55 checksum form so that the same contract written in another case has
+// the same keys
+func DepositKey(contractAddress string, index uint64) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s-%d", normalizeEthAddress(contractAddress), index)))
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+// normalizeEthAddress returns an Ethereum address in its EIP-55 checksum
+// form, leaving an empty address empty
+func normalizeEthAddress(address string) string {
+	if address == "" {
+		return ""
+	}
+	return common.HexToAddress(address).Hex()
+}
+
+// receiptFields is the consensus encoding of a receipt. Extra trailing fields
+// (e.g. the deposit nonce of OP Stack deposit receipts) are ignored.
+type receiptFields struct {
+	PostStateOrStatus []byte
+	CumulativeGasUsed uint64
+	Bloom             ethtypes.Bloom
+	Logs              []*ethtypes.Log
+	Rest              []rlp.RawValue `rlp:"tail"`
+}
+
+// verifyReceiptProof checks a Merkle-Patricia proof for the receipt at txIndex
+// against the receipts root and decodes the proven receipt
+func verifyReceiptProof(root common.Hash, txIndex uint64, proof [][]byte) (*receiptFields, error) {
+	nodes := memorydb.New()
+	for _, node := range proof {
+		if err := nodes.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := rlp.EncodeToBytes(txIndex)
+	if err != nil {
+		return nil, err
+	}
+	value, err := trie.VerifyProof(root, key, nodes)
+	if err != nil {
+		return nil, fmt.Errorf("receipt proof verification failed: %w", err)
+	}
+	if len(value) == 0 {
+		return nil, fmt.Errorf("no receipt at index %d", txIndex)
+	}
+
+	// Typed receipts are prefixed with their type byte
+	if value[0] < 0x7f {
+		value = value[1:]
+	}
+	var receipt receiptFields
+	if err := rlp.DecodeBytes(value, &receipt); err != nil {
+		return nil, fmt.Errorf("failed to decode receipt: %w", err)
+	}
+	return &receipt, nil
+}
+
+// parseDepositedLog decodes a Deposited(string indexed account, uint256 amount, uint256 index)
+// log and checks it was emitted by the bridge contract for the given recipient
+func parseDepositedLog(log *ethtypes.Log, contract common.Address, recipient string) (*VerifiedDeposit, error) {
+	if log.Address != contract {
+		return nil, fmt.Errorf("log emitted by %s, not the deposit contract %s", log.Address.Hex(), contract.Hex())
+	}
+	if len(log.Topics) != 2 || log.Topics[0] != depositedEventTopic {
+		return nil, fmt.Errorf("log is not a Deposited event")
+	}
+	if log.Topics[1] != crypto.Keccak256Hash([]byte(recipient)) {
+		return nil, fmt.Errorf("deposit recipient does not match %s", recipient)
+	}
+	if len(log.Data) != 64 {
+		return nil, fmt.Errorf("invalid Deposited event data length %d", len(log.Data))
+	}
+
+	amount := new(big.Int).SetBytes(log.Data[:32])
+	index := new(big.Int).SetBytes(log.Data[32:])
+	if !amount.IsUint64() || !index.IsUint64() {
+		return nil, fmt.Errorf("deposit amount or index out of range")
+	}
+	return &VerifiedDeposit{
+		Recipient: recipient,
+		Amount:    amount.Uint64(),
+		Index:     index.Uint64(),
+	}, nil
+}
+
+// checkWithdrawnLog checks a Withdrawn(address indexed receiver, uint256 amount, bytes32 indexed nonce)
+// log was emitted by the bridge contract for exactly the given withdrawal request
+func checkWithdrawnLog(log *ethtypes.Log, contract common.Address, request types.WithdrawalRequest) error {
+	if log.Address != contract {
+		return fmt.Errorf("log emitted by %s, not the bridge contract %s", log.Address.Hex(), contract.Hex())
+	}
+	if len(log.Topics) != 3 || log.Topics[0] != withdrawnEventTopic {
+		return fmt.Errorf("log is not a Withdrawn event")
+	}
+	if log.Topics[2] != common.HexToHash(request.Nonce) {
+		return fmt.Errorf("withdrawn nonce %s does not match %s", log.Topics[2].Hex(), request.Nonce)
+	}
+	if common.BytesToAddress(log.Topics[1].Bytes()) != common.HexToAddress(request.BaseAddress) {
+		return fmt.Errorf("withdrawal receiver does not match %s", request.BaseAddress)
+	}
+	if len(log.Data) != 32 {
+		return fmt.Errorf("invalid Withdrawn event data length %d", len(log.Data))
+	}
+	amount := new(big.Int).SetBytes(log.Data)
+	if !amount.IsUint64() || amount.Uint64() != request.Amount {
+		return fmt.Errorf("withdrawn amount %s does not match %d", amount, request.Amount)
+	}
+	return nil
+}
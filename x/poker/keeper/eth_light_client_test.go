@@ -0,0 +1,424 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+var testDepositContract = common.HexToAddress(types.DefaultDepositContractAddress)
+
+// rawHeader RLP-encodes a minimal header and returns it with its hash
+func rawHeader(t *testing.T, number uint64, parent common.Hash, receiptsRoot common.Hash) ([]byte, common.Hash) {
+	t.Helper()
+	header := &ethtypes.Header{
+		ParentHash:  parent,
+		Root:        common.HexToHash("0x01"),
+		TxHash:      ethtypes.EmptyTxsHash,
+		ReceiptHash: receiptsRoot,
+		Difficulty:  big.NewInt(0),
+		Number:      new(big.Int).SetUint64(number),
+		GasLimit:    30_000_000,
+		Time:        1_700_000_000 + number*2,
+	}
+	raw, err := rlp.EncodeToBytes(header)
+	require.NoError(t, err)
+	return raw, header.Hash()
+}
+
+// headerChain builds count contiguous headers starting at number. The first
+// header carries receiptsRoot, the rest have empty receipts.
+func headerChain(t *testing.T, number uint64, count int, parent common.Hash, receiptsRoot common.Hash) [][]byte {
+	t.Helper()
+	var headers [][]byte
+	for i := 0; i < count; i++ {
+		root := ethtypes.EmptyReceiptsHash
+		if i == 0 {
+			root = receiptsRoot
+		}
+		raw, hash := rawHeader(t, number+uint64(i), parent, root)
+		headers = append(headers, raw)
+		parent = hash
+	}
+	return headers
+}
+
+// depositLog builds a Deposited(string indexed account, uint256 amount, uint256 index) log
+func depositLog(contract common.Address, recipient string, amount, index uint64) *ethtypes.Log {
+	data := make([]byte, 64)
+	new(big.Int).SetUint64(amount).FillBytes(data[:32])
+	new(big.Int).SetUint64(index).FillBytes(data[32:])
+	return &ethtypes.Log{
+		Address: contract,
+		Topics: []common.Hash{
+			common.HexToHash("0x46008385c8bcecb546cb0a96e5b409f34ac1a8ece8f3ea98488282519372bdf2"),
+			crypto.Keccak256Hash([]byte(recipient)),
+		},
+		Data: data,
+	}
+}
+
+// blockReceipts returns the consensus encoding of a block's receipts where
+// the deposit is in the second transaction
+func blockReceipts(t *testing.T, status uint64, logs ...*ethtypes.Log) ethtypes.Receipts {
+	t.Helper()
+	return ethtypes.Receipts{
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21000},
+		{Type: ethtypes.DynamicFeeTxType, Status: status, CumulativeGasUsed: 90000, Logs: logs},
+	}
+}
+
+func encodeReceipts(t *testing.T, receipts ethtypes.Receipts) [][]byte {
+	t.Helper()
+	encoded := make([][]byte, len(receipts))
+	for i, r := range receipts {
+		raw, err := r.MarshalBinary()
+		require.NoError(t, err)
+		encoded[i] = raw
+	}
+	return encoded
+}
+
+func TestBuildReceiptProof_MatchesDeriveSha(t *testing.T) {
+	receipts := blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, "alice", 1, 1))
+	root, proof, err := keeper.BuildReceiptProof(encodeReceipts(t, receipts), 1)
+	require.NoError(t, err)
+	require.NotEmpty(t, proof)
+	require.Equal(t, ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)), root)
+}
+
+func TestSubmitEthHeaders(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	relayer, err := f.addressCodec.BytesToString(sdk.AccAddress("relayer_____________"))
+	require.NoError(t, err)
+	stranger, err := f.addressCodec.BytesToString(sdk.AccAddress("stranger____________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.HeaderRelayers = []string{relayer}
+	params.HeaderRetention = 5
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	chain := headerChain(t, 100, 8, common.Hash{}, ethtypes.EmptyReceiptsHash)
+
+	// Only relayers and the authority may submit headers
+	_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: stranger, Headers: chain[:1]})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// First header becomes the checkpoint, then the chain is extended
+	resp, err := ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayer, Headers: chain[:3]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(102), resp.Tip)
+
+	// Gaps and forks are rejected
+	_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayer, Headers: chain[4:5]})
+	require.ErrorIs(t, err, types.ErrInvalidEthHeader)
+	fork, _ := rawHeader(t, 103, common.HexToHash("0xdead"), ethtypes.EmptyReceiptsHash)
+	_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayer, Headers: [][]byte{fork}})
+	require.ErrorIs(t, err, types.ErrInvalidEthHeader)
+	_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayer, Headers: [][]byte{{0x01, 0x02}}})
+	require.ErrorIs(t, err, types.ErrInvalidEthHeader)
+
+	// Old headers are pruned beyond the retention window
+	resp, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayer, Headers: chain[3:]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(107), resp.Tip)
+	_, err = f.keeper.EthHeaders.Get(f.ctx, 102)
+	require.Error(t, err)
+	header, err := f.keeper.EthHeaders.Get(f.ctx, 103)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256(chain[3]), header.Hash)
+
+	// The authority can re-anchor the chain at an unrelated header
+	_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayer, Headers: [][]byte{fork}})
+	require.ErrorIs(t, err, types.ErrInvalidEthHeader)
+	resp, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: authority, Headers: [][]byte{fork}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(103), resp.Tip)
+	_, err = f.keeper.EthHeaders.Get(f.ctx, 107)
+	require.Error(t, err)
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	tip, err := qs.EthHeaderTip(f.ctx, &types.QueryEthHeaderTipRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(103), tip.Header.Number)
+	require.Equal(t, crypto.Keccak256(fork), tip.Header.Hash)
+}
+
+func TestSubmitEthHeaders_RelayerQuorum(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	var relayers []string
+	for _, name := range []string{"relayer_one_________", "relayer_two_________", "relayer_three_______"} {
+		relayer, err := f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		relayers = append(relayers, relayer)
+	}
+
+	params := types.DefaultParams()
+	params.HeaderRelayers = relayers
+	params.HeaderRelayerQuorum = 4
+	require.Error(t, params.Validate())
+	params.HeaderRelayerQuorum = 2
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	chain := headerChain(t, 100, 3, common.Hash{}, ethtypes.EmptyReceiptsHash)
+	fork, forkHash := rawHeader(t, 100, common.HexToHash("0xdead"), ethtypes.EmptyReceiptsHash)
+
+	// A single relayer cannot get a header tracked, even by submitting it twice
+	for i := 0; i < 2; i++ {
+		_, err := ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayers[0], Headers: chain[:2]})
+		require.NoError(t, err)
+	}
+	_, found, err := f.keeper.GetEthHeaderTip(f.ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// Submissions of different headers at the same height do not add up
+	_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayers[1], Headers: [][]byte{fork}})
+	require.NoError(t, err)
+	_, found, err = f.keeper.GetEthHeaderTip(f.ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// A second relayer submitting the same headers reaches the quorum, and the
+	// competing header is dropped
+	resp, err := ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayers[1], Headers: chain[:2]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), resp.Tip)
+	header, err := f.keeper.EthHeaders.Get(f.ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256(chain[0]), header.Hash)
+	pending, err := f.keeper.PendingEthHeaders.Has(f.ctx, collections.Join(uint64(100), forkHash.Bytes()))
+	require.NoError(t, err)
+	require.False(t, pending)
+
+	// Tracked headers are accepted again without a vote, new ones wait for the quorum
+	resp, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayers[2], Headers: chain})
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), resp.Tip)
+	resp, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayers[0], Headers: chain[2:]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(102), resp.Tip)
+
+	// A different header at a tracked height is rejected
+	_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{Relayer: relayers[1], Headers: [][]byte{fork}})
+	require.ErrorIs(t, err, types.ErrInvalidEthHeader)
+}
+
+func TestVerifyDepositProof(t *testing.T) {
+	recipient := "b521qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	otherContract := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	tests := []struct {
+		name          string
+		receipts      ethtypes.Receipts
+		recipient     string
+		txIndex       uint64
+		logIndex      uint64
+		height        uint64
+		confirmations uint64
+		tamper        bool
+		wantErr       error
+		wantAmount    uint64
+	}{
+		{
+			name:       "valid deposit",
+			receipts:   blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, recipient, 2_500_000, 7)),
+			wantAmount: 2_500_000,
+		},
+		{
+			name: "second log in receipt",
+			receipts: blockReceipts(t, ethtypes.ReceiptStatusSuccessful,
+				depositLog(otherContract, recipient, 1, 7), depositLog(testDepositContract, recipient, 42, 7)),
+			logIndex:   1,
+			wantAmount: 42,
+		},
+		{
+			name:      "recipient mismatch",
+			receipts:  blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, recipient, 1, 7)),
+			recipient: "b521someoneelse",
+			wantErr:   types.ErrInvalidProof,
+		},
+		{
+			name:     "log from another contract",
+			receipts: blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(otherContract, recipient, 1, 7)),
+			wantErr:  types.ErrInvalidProof,
+		},
+		{
+			name:     "failed transaction",
+			receipts: blockReceipts(t, ethtypes.ReceiptStatusFailed, depositLog(testDepositContract, recipient, 1, 7)),
+			wantErr:  types.ErrInvalidProof,
+		},
+		{
+			name:     "log index out of range",
+			receipts: blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, recipient, 1, 7)),
+			logIndex: 1,
+			wantErr:  types.ErrInvalidProof,
+		},
+		{
+			name:     "proof for another receipt",
+			receipts: blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, recipient, 1, 7)),
+			txIndex:  0,
+			wantErr:  types.ErrInvalidProof,
+		},
+		{
+			name:     "tampered proof",
+			receipts: blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, recipient, 1, 7)),
+			tamper:   true,
+			wantErr:  types.ErrInvalidProof,
+		},
+		{
+			name:     "untracked block",
+			receipts: blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, recipient, 1, 7)),
+			height:   99,
+			wantErr:  types.ErrEthHeaderNotFound,
+		},
+		{
+			name:          "not enough confirmations",
+			receipts:      blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, recipient, 1, 7)),
+			confirmations: 3,
+			wantErr:       types.ErrInvalidProof,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := initFixture(t)
+			ms := keeper.NewMsgServerImpl(f.keeper)
+			authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+			require.NoError(t, err)
+
+			params := types.DefaultParams()
+			params.DepositConfirmations = tt.confirmations
+			require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+			// The deposit is in block 100 with two confirming headers on top
+			root, proof, err := keeper.BuildReceiptProof(encodeReceipts(t, tt.receipts), 1)
+			require.NoError(t, err)
+			_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{
+				Relayer: authority,
+				Headers: headerChain(t, 100, 3, common.Hash{}, root),
+			})
+			require.NoError(t, err)
+
+			if tt.tamper {
+				proof[len(proof)-1] = append([]byte{}, proof[len(proof)-1]...)
+				proof[len(proof)-1][len(proof[len(proof)-1])-1] ^= 0xff
+			}
+			if tt.recipient == "" {
+				tt.recipient = recipient
+			}
+			if tt.height == 0 {
+				tt.height = 100
+			}
+			if tt.txIndex == 0 && tt.name != "proof for another receipt" {
+				tt.txIndex = 1
+			}
+
+			deposit, err := f.keeper.VerifyDepositProof(f.ctx, tt.height, tt.txIndex, tt.logIndex, proof, tt.recipient)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAmount, deposit.Amount)
+			require.Equal(t, uint64(7), deposit.Index)
+			require.Equal(t, recipient, deposit.Recipient)
+			require.Equal(t, uint64(100), deposit.EthBlockHeight)
+		})
+	}
+}
+
+func TestProcessDeposit_RejectsMismatchAndReplay(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	recipient := "b521qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	receipts := blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, recipient, 1_000_000, 7))
+	root, proof, err := keeper.BuildReceiptProof(encodeReceipts(t, receipts), 1)
+	require.NoError(t, err)
+	_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{
+		Relayer: authority,
+		Headers: headerChain(t, 100, 1, common.Hash{}, root),
+	})
+	require.NoError(t, err)
+
+	msg := &types.MsgProcessDeposit{
+		Creator:        authority,
+		DepositIndex:   8,
+		EthBlockHeight: 100,
+		Recipient:      recipient,
+		TxIndex:        1,
+		ReceiptProof:   proof,
+	}
+
+	// Proven index must match the claimed deposit index
+	_, err = ms.ProcessDeposit(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+
+	// A deposit already minted (e.g. through MsgMint) cannot be processed again
+	msg.DepositIndex = 7
+	require.NoError(t, f.keeper.ProcessedEthTxs.Set(f.ctx, keeper.DepositKey(types.DefaultDepositContractAddress, 7)))
+	_, err = ms.ProcessDeposit(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrTxAlreadyProcessed)
+}
+
+func TestProcessDeposit_MarkersOnlyMoveForward(t *testing.T) {
+	f := initFixtureWithBank(t, newMockBankKeeper())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	recipient, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	receipts := blockReceipts(t, ethtypes.ReceiptStatusSuccessful, depositLog(testDepositContract, recipient, 1_000_000, 7))
+	root, proof, err := keeper.BuildReceiptProof(encodeReceipts(t, receipts), 1)
+	require.NoError(t, err)
+	_, err = ms.SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{
+		Relayer: authority,
+		Headers: headerChain(t, 100, 1, common.Hash{}, root),
+	})
+	require.NoError(t, err)
+
+	// The oracle has already synced past the deposit
+	require.NoError(t, f.keeper.SetLastEthBlockHeight(f.ctx, 500))
+	require.NoError(t, f.keeper.SetLastProcessedDepositIndex(f.ctx, 20))
+
+	_, err = ms.ProcessDeposit(f.ctx, &types.MsgProcessDeposit{
+		Creator:        authority,
+		DepositIndex:   7,
+		EthBlockHeight: 100,
+		Recipient:      recipient,
+		TxIndex:        1,
+		ReceiptProof:   proof,
+	})
+	require.NoError(t, err)
+
+	// Proving an older deposit leaves the markers where they were
+	height, err := f.keeper.GetLastEthBlockHeight(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(501), height)
+	next, err := f.keeper.NextDepositIndex(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21), next)
+}
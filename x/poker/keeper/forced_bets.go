@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// validateForcedBets checks the antes, straddle and bomb pots of a table
+// being created, returning the ante type and bomb pot ante with their
+// defaults filled in
+func validateForcedBets(msg *types.MsgCreateGame) (types.AnteType, uint64, error) {
+	anteType := types.AnteType(msg.AnteType)
+	switch {
+	case msg.Ante == 0 && anteType != "":
+		return "", 0, errorsmod.Wrap(types.ErrInvalidRequest, "ante type needs an ante")
+	case msg.Ante > 0 && anteType == "":
+		anteType = types.AnteTypePerPlayer
+	}
+	if anteType != "" && !anteType.IsValid() {
+		return "", 0, errorsmod.Wrapf(types.ErrInvalidRequest, "unknown ante type %q", msg.AnteType)
+	}
+	if msg.Ante > msg.MinBuyIn {
+		return "", 0, errorsmod.Wrap(types.ErrInvalidRequest, "ante cannot exceed the minimum buy-in")
+	}
+
+	if !types.StraddleType(msg.Straddle).IsValid() {
+		return "", 0, errorsmod.Wrapf(types.ErrInvalidRequest, "unknown straddle %q", msg.Straddle)
+	}
+
+	bombPotAnte := msg.BombPotAnte
+	switch {
+	case msg.BombPotFrequency == 0 && bombPotAnte > 0:
+		return "", 0, errorsmod.Wrap(types.ErrInvalidRequest, "bomb pot ante needs a bomb pot frequency")
+	case msg.BombPotFrequency > 0 && bombPotAnte == 0:
+		bombPotAnte = msg.BigBlind
+	}
+	if bombPotAnte > msg.MinBuyIn {
+		return "", 0, errorsmod.Wrap(types.ErrInvalidRequest, "bomb pot ante cannot exceed the minimum buy-in")
+	}
+	return anteType, bombPotAnte, nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestForcedBets(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.HandStartDelay = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	var players []string
+	for _, name := range []string{"alice_______________", "bob_________________", "carol_______________"} {
+		addr, err := f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		fundUSDC(t, f, bank, addr, 10_000)
+		players = append(players, addr)
+	}
+	table := func() *types.MsgCreateGame {
+		return &types.MsgCreateGame{
+			Creator: players[0], MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+			SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash",
+		}
+	}
+
+	// Forced bets are validated
+	for name, invalid := range map[string]func(*types.MsgCreateGame){
+		"ante type without ante":    func(m *types.MsgCreateGame) { m.AnteType = string(types.AnteTypeButton) },
+		"unknown ante type":         func(m *types.MsgCreateGame) { m.Ante, m.AnteType = 2, "everyone" },
+		"unknown straddle":          func(m *types.MsgCreateGame) { m.Straddle = "mississippi" },
+		"bomb pot ante without pot": func(m *types.MsgCreateGame) { m.BombPotAnte = 10 },
+		"ante above min buy-in":     func(m *types.MsgCreateGame) { m.Ante = 201 },
+	} {
+		msg := table()
+		invalid(msg)
+		_, err := ms.CreateGame(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidRequest, name)
+	}
+
+	msg := table()
+	msg.Ante = 2
+	msg.Straddle = string(types.StraddleUTG)
+	msg.BombPotFrequency = 2
+	_, err = ms.CreateGame(ctx, msg)
+	require.NoError(t, err)
+	var game types.Game
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(_ string, g types.Game) (bool, error) {
+		game = g
+		return true, nil
+	}))
+	require.Equal(t, types.AnteTypePerPlayer, game.AnteType)
+	require.Equal(t, uint64(10), game.BombPotAnte)
+	require.Equal(t, uint64(20), game.StraddleAmount())
+	for i, player := range players {
+		_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: player, GameId: game.GameId, Seat: uint64(i + 1), BuyInAmount: 1000})
+		require.NoError(t, err)
+	}
+	getState := func() types.TexasHoldemStateDTO {
+		state, err := f.keeper.GameStates.Get(ctx, game.GameId)
+		require.NoError(t, err)
+		return state
+	}
+
+	// The first hand takes antes, blinds and the UTG straddle, and action
+	// starts after the straddler
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	state := getState()
+	require.Equal(t, types.RoundPreflop, state.Round)
+	require.NotNil(t, state.GameOptions.Ante)
+	require.Equal(t, "2", *state.GameOptions.Ante)
+	bets := map[int]string{}
+	for _, p := range state.Players {
+		bets[p.Seat] = p.SumOfBets
+	}
+	require.Equal(t, "7", bets[state.SmallBlindPosition])
+	require.Equal(t, "12", bets[state.BigBlindPosition])
+	require.Equal(t, "22", bets[state.Dealer]) // three-handed, UTG is the button
+	require.Equal(t, state.SmallBlindPosition, state.NextToAct)
+	for _, p := range state.Players {
+		if p.Seat != state.NextToAct {
+			continue
+		}
+		call, ok := findLegal(p, "call")
+		require.True(t, ok)
+		require.Equal(t, "15", *call.Min) // the straddle less the small blind
+	}
+
+	// Both blinds fold to the straddle
+	for state.Round != types.RoundShowdown {
+		for _, p := range state.Players {
+			if p.Seat == state.NextToAct {
+				_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: p.Address, GameId: game.GameId, Action: "fold"})
+				require.NoError(t, err)
+			}
+		}
+		state = getState()
+	}
+
+	// The second hand is a bomb pot: everyone antes and it starts on the flop
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	state = getState()
+	require.Equal(t, 2, state.HandNumber)
+	require.Equal(t, types.RoundFlop, state.Round)
+	require.Len(t, state.CommunityCards, 3)
+	for _, p := range state.Players {
+		require.Equal(t, "10", p.SumOfBets, p.Address)
+	}
+	require.Equal(t, []string{"30"}, state.Pots)
+}
+
+// findLegal returns the player's legal action with the given name
+func findLegal(p types.PlayerDTO, action string) (types.LegalActionDTO, bool) {
+	for _, legal := range p.LegalActions {
+		if legal.Action == action {
+			return legal, true
+		}
+	}
+	return types.LegalActionDTO{}, false
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// GameEngineRequest is a single action for the game engine to apply to a
+// table's state
+type GameEngineRequest struct {
+	PlayerId  string
+	GameId    string
+	Action    string
+	Amount    uint64
+	Index     int // expected action index, already checked against the player's legal actions
+	State     types.TexasHoldemStateDTO
+	Options   types.GameOptionsDTO
+	Data      string // "seat=N", or "deck=..." for new-hand
+	Timestamp int64  // block time in milliseconds
+}
+
+// GameEngine applies poker actions and returns the updated game state. The
+// PVM is the production engine; simulations inject a deterministic
+// in-process one.
+type GameEngine interface {
+	PerformAction(ctx context.Context, req GameEngineRequest) (types.TexasHoldemStateDTO, error)
+}
+
+// SetGameEngine replaces the PVM with another game engine. Passing nil
+// restores the PVM.
+func (k *Keeper) SetGameEngine(engine GameEngine) {
+	k.gameEngine = engine
+}
+
+// getGameEngine returns the injected game engine, or the PVM at the
+// configured URL
+func (k *Keeper) getGameEngine() GameEngine {
+	if k.gameEngine != nil {
+		return k.gameEngine
+	}
+	return pvmEngine{url: k.GetPVMURL()}
+}
+
+// pvmEngine calls the Poker Virtual Machine's perform_action JSON-RPC method
+type pvmEngine struct {
+	url string
+}
+
+func (e pvmEngine) PerformAction(_ context.Context, req GameEngineRequest) (types.TexasHoldemStateDTO, error) {
+	var state types.TexasHoldemStateDTO
+
+	gameStateJson, err := json.Marshal(req.State)
+	if err != nil {
+		return state, fmt.Errorf("failed to marshal game state: %w", err)
+	}
+	gameOptionsJson, err := json.Marshal(req.Options)
+	if err != nil {
+		return state, fmt.Errorf("failed to marshal game options: %w", err)
+	}
+
+	// Params: [from, to, action, value, index, gameStateJson, gameOptionsJson, data, timestamp]
+	request := JSONRPCRequest{
+		Method: "perform_action",
+		Params: []interface{}{
+			req.PlayerId,                       // from
+			req.GameId,                         // to (game address)
+			req.Action,                         // action
+			strconv.FormatUint(req.Amount, 10), // value
+			req.Index,                          // index (current action count)
+			string(gameStateJson),              // gameStateJson
+			string(gameOptionsJson),            // gameOptionsJson
+			req.Data,                           // data with seat parameter (empty = auto-assign)
+			req.Timestamp,                      // timestamp (Cosmos block time for deterministic gameplay)
+		},
+		ID:      1,
+		JSONRPC: "2.0",
+	}
+
+	requestBody, err := json.Marshal(request)
+	if err != nil {
+		return state, fmt.Errorf("failed to marshal JSON-RPC request: %w", err)
+	}
+
+	resp, err := http.Post(e.url, "application/json", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return state, fmt.Errorf("failed to make HTTP request to game engine at %s: %w", e.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(resp.Body)
+		return state, fmt.Errorf("game engine returned non-OK status %d: %s (body: %s)", resp.StatusCode, resp.Status, string(responseBody))
+	}
+
+	var response JSONRPCResponse
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return state, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return state, fmt.Errorf("failed to decode JSON-RPC response (body: %s): %w", string(responseBody), err)
+	}
+
+	if response.Error != nil {
+		switch err := response.Error.(type) {
+		case string:
+			return state, fmt.Errorf("game engine error: %s", err)
+		case map[string]interface{}:
+			if code, ok := err["code"].(float64); ok {
+				if message, ok := err["message"].(string); ok {
+					return state, fmt.Errorf("game engine error: %s (code %.0f)", message, code)
+				}
+			}
+			return state, fmt.Errorf("game engine error: %v", err)
+		default:
+			return state, fmt.Errorf("game engine error: %v", response.Error)
+		}
+	}
+	if response.Result == nil {
+		return state, fmt.Errorf("no result returned from poker engine")
+	}
+
+	// The engine response has a "data" field containing the actual game state
+	resultBytes, err := json.Marshal(response.Result)
+	if err != nil {
+		return state, fmt.Errorf("failed to marshal response result: %w", err)
+	}
+	var engineResponse struct {
+		Data      map[string]interface{} `json:"data"`
+		Signature interface{}            `json:"signature"`
+	}
+	if err := json.Unmarshal(resultBytes, &engineResponse); err != nil {
+		return state, fmt.Errorf("failed to unmarshal engine response wrapper: %v", err)
+	}
+	dataBytes, err := json.Marshal(engineResponse.Data)
+	if err != nil {
+		return state, fmt.Errorf("failed to marshal engine data: %w", err)
+	}
+	if err := json.Unmarshal(dataBytes, &state); err != nil {
+		return state, fmt.Errorf("failed to unmarshal updated game state: %v", err)
+	}
+	return state, nil
+}
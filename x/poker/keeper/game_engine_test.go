@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// gameStack returns a player's stack, or -1 if they are not seated
+func gameStack(t *testing.T, state types.TexasHoldemStateDTO, address string) int64 {
+	t.Helper()
+	for _, p := range state.Players {
+		if p.Address == address {
+			stack, err := strconv.ParseInt(p.Stack, 10, 64)
+			require.NoError(t, err)
+			return stack
+		}
+	}
+	return -1
+}
+
+func TestGameEngine_PlaysHandThroughKeeper(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	aliceAddr, bobAddr := sdk.AccAddress("alice_______________"), sdk.AccAddress("bob_________________")
+	alice, err := f.addressCodec.BytesToString(aliceAddr)
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(bobAddr)
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, alice, 10_000)
+	fundUSDC(t, f, bank, bob, 10_000)
+
+	_, err = ms.CreateGame(ctx, &types.MsgCreateGame{
+		Creator: alice, MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+		SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash",
+	})
+	require.NoError(t, err)
+	var gameId string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameId = id
+		return true, nil
+	}))
+
+	_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: alice, GameId: gameId, Seat: 1, BuyInAmount: 1000})
+	require.NoError(t, err)
+	_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: bob, GameId: gameId, Seat: 2, BuyInAmount: 500})
+	require.NoError(t, err)
+	_, err = ms.TopUp(ctx, &types.MsgTopUp{Player: bob, GameId: gameId, Amount: 500})
+	require.NoError(t, err)
+
+	// Actions are only accepted at the index the engine's legal actions expect
+	_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: alice, GameId: gameId, Action: "new-hand"})
+	require.NoError(t, err)
+
+	// Call or check down to a contested showdown
+	for step := 0; ; step++ {
+		require.Less(t, step, 20, "hand did not finish")
+		state, err := f.keeper.GameStates.Get(ctx, gameId)
+		require.NoError(t, err)
+		if state.Round == types.RoundShowdown {
+			break
+		}
+		for _, p := range state.Players {
+			if p.Seat != state.NextToAct {
+				continue
+			}
+			action := "check"
+			for _, legal := range p.LegalActions {
+				if legal.Action == "call" {
+					action = "call"
+				}
+			}
+			_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: p.Address, GameId: gameId, Action: action})
+			require.NoError(t, err)
+		}
+	}
+
+	var verified bool
+	for _, event := range ctx.EventManager().Events() {
+		verified = verified || event.Type == "showdown_verified"
+	}
+	require.True(t, verified, "the keeper verified the engine's showdown")
+
+	state, err := f.keeper.GameStates.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Len(t, state.CommunityCards, 5)
+	require.Equal(t, int64(2000), gameStack(t, state, alice)+gameStack(t, state, bob))
+	_, broken := keeper.AllInvariants(f.keeper)(ctx)
+	require.False(t, broken)
+
+	// Leaving between hands cashes out the stack
+	bobStack := gameStack(t, state, bob)
+	_, err = ms.LeaveGame(ctx, &types.MsgLeaveGame{Creator: bob, GameId: gameId})
+	require.NoError(t, err)
+	require.Equal(t, 10_000-1000+bobStack, bank.SpendableCoins(ctx, bobAddr).AmountOf(keeper.USDC_DENOM).Int64())
+	_, broken = keeper.AllInvariants(f.keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestGameEngine_RejectsIllegalActions(t *testing.T) {
+	engine := simulation.NewEngine()
+	bigBlind, maxPlayers := "10", 6
+	options := types.GameOptionsDTO{SmallBlind: &[]string{"5"}[0], BigBlind: &bigBlind, MaxPlayers: &maxPlayers}
+	state := types.TexasHoldemStateDTO{Round: types.RoundAnte, HandNumber: 1}
+
+	join := func(player, seat string) (types.TexasHoldemStateDTO, error) {
+		return engine.PerformAction(context.Background(), keeper.GameEngineRequest{
+			PlayerId: player, Action: "join", Amount: 100, Data: "seat=" + seat, State: state, Options: options,
+			Index: state.ActionCount + len(state.PreviousActions) + 1,
+		})
+	}
+	var err error
+	state, err = join("alice", "1")
+	require.NoError(t, err)
+	_, err = join("bob", "1")
+	require.ErrorContains(t, err, "seat 1 is taken")
+	_, err = join("bob", "7")
+	require.ErrorContains(t, err, "invalid seat")
+
+	// One player cannot start a hand
+	_, err = engine.PerformAction(context.Background(), keeper.GameEngineRequest{PlayerId: "alice", Action: "new-hand", State: state, Options: options})
+	require.Error(t, err)
+	_, err = engine.PerformAction(context.Background(), keeper.GameEngineRequest{PlayerId: "alice", Action: "call", State: state, Options: options})
+	require.ErrorContains(t, err, "no hand in progress")
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/block52/pokerchain/x/poker/types"
@@ -24,11 +25,29 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 
-	// Import withdrawal requests
+	// Import withdrawal requests, rebuilding the withdrawal queue and the
+	// outflows that count towards the current limit window
 	for _, wr := range genState.WithdrawalRequests {
 		if err := k.WithdrawalRequests.Set(sdkCtx, wr.Nonce, *wr); err != nil {
 			return err
 		}
+		if wr.Status == WithdrawalStatusQueued {
+			if err := k.WithdrawalQueue.Set(sdkCtx, collections.Join(wr.ReleaseAt, wr.Nonce)); err != nil {
+				return err
+			}
+		}
+		if genState.Params.WithdrawalWindow > 0 && wr.CreatedAt >= windowStart(sdkCtx, genState.Params) {
+			if err := k.recordWithdrawalOutflow(sdkCtx, *wr); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Import bridge pause state
+	if genState.BridgePause != nil {
+		if err := k.BridgePause.Set(sdkCtx, *genState.BridgePause); err != nil {
+			return err
+		}
 	}
 
 	// Set withdrawal nonce sequence
@@ -38,6 +57,13 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 
+	// Import tracked source chain headers
+	for _, header := range genState.EthHeaders {
+		if err := k.EthHeaders.Set(sdkCtx, header.Number, *header); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -79,5 +105,21 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		genesis.WithdrawalNonce = 0
 	}
 
+	// Export tracked source chain headers
+	err = k.EthHeaders.Walk(sdkCtx, nil, func(number uint64, header types.EthHeader) (bool, error) {
+		genesis.EthHeaders = append(genesis.EthHeaders, &header)
+		return false, nil // Continue iteration
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Export bridge pause state
+	pause, err := k.GetBridgePause(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.BridgePause = &pause
+
 	return genesis, nil
 }
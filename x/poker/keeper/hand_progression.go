@@ -0,0 +1,318 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// maxAutoActionsPerTable bounds the actions AdvanceHands performs at one
+// table in a block: new-hand, both blinds and the deal, with room for the
+// folds and departures of players leaving after the hand, the removal of
+// players who sat out too long and standing top-ups
+const maxAutoActionsPerTable = 8
+
+// MaxAutoActionsPerBlock bounds the actions AdvanceHands performs across all
+// tables in a block. Each action is a call to the game engine made from
+// EndBlock, so the bound keeps block time flat however many tables are open.
+const MaxAutoActionsPerBlock = 64
+
+// autoAction is an action the module performs on a player's behalf
+type autoAction struct {
+	player   string
+	action   PlayerActionType
+	amount   uint64
+	timedOut bool // the player ran out of time to act
+	leaving  bool // the player is cashed out after asking to leave mid-hand
+	removed  bool // the player is cashed out for sitting out too long
+	topUp    bool // the player's stack is refilled by their auto top-up
+}
+
+// AdvanceHands keeps active tables moving without waiting for players to send
+// the bookkeeping actions between hands. Once a hand has finished, enough
+// players can play and the params' hand start delay has passed, it starts
+// the next hand with a fresh deck; it then posts the blinds of players who
+// opted in with MsgSetAutoPostBlinds, and deals once the blinds are in. A
+// player who runs out of time to act, time bank included, checks or folds.
+// Players who asked to leave during a hand fold when it is their turn and
+// are cashed out once the hand is over, players who sit out longer than the
+// table allows are removed between hands, and standing rebuys and top-ups
+// set with MsgSetAutoTopUp are carried out before the next hand starts.
+//
+// Tables are visited in game ID order, starting from a table that rotates
+// with the block height, until MaxAutoActionsPerBlock actions have been
+// performed; tables left over are advanced in the following blocks. Each
+// table is advanced in its own cache context so that an engine error leaves
+// that table as it was and never halts the chain.
+func (k *Keeper) AdvanceHands(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var gameIds []string
+	err = k.Games.Walk(ctx, nil, func(gameId string, game types.Game) (bool, error) {
+		if game.Status == types.TableStatusActive || len(game.SitOuts) > 0 {
+			gameIds = append(gameIds, gameId)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(gameIds) == 0 {
+		return nil
+	}
+	budget := MaxAutoActionsPerBlock
+	first := int(sdkCtx.BlockHeight() % int64(len(gameIds)))
+	for i := range gameIds {
+		if budget == 0 {
+			sdkCtx.Logger().Info("⏳ Auto action budget spent", "tablesLeft", len(gameIds)-i)
+			break
+		}
+		gameId := gameIds[(first+i)%len(gameIds)]
+		cacheCtx, write := sdkCtx.CacheContext()
+		advanced, err := k.advanceHand(cacheCtx, gameId, params, &budget)
+		if err != nil {
+			sdkCtx.Logger().Error("❌ Failed to advance hand", "gameId", gameId, "error", err)
+			continue
+		}
+		if advanced {
+			write()
+		}
+	}
+	return nil
+}
+
+// advanceHand performs the automatic actions due at a table, reporting
+// whether it performed any. Each action attempted is taken from budget.
+func (k *Keeper) advanceHand(ctx sdk.Context, gameId string, params types.Params, budget *int) (bool, error) {
+	ms := msgServer{Keeper: k}
+	advanced := false
+	for range maxAutoActionsPerTable {
+		game, err := k.Games.Get(ctx, gameId)
+		if err != nil {
+			return advanced, err
+		}
+		state, err := k.GameStates.Get(ctx, gameId)
+		if err != nil {
+			return advanced, err
+		}
+
+		next, ok := nextAutoAction(ctx.BlockTime(), game, state, params)
+		if !ok || *budget == 0 {
+			return advanced, nil
+		}
+		*budget--
+		if next.leaving {
+			refund, err := ms.leaveTable(ctx, gameId, next.player)
+			if err != nil {
+				return advanced, err
+			}
+			advanced = true
+			if next.removed {
+				ctx.Logger().Info("🪑 Removed player who sat out too long", "gameId", gameId, "player", next.player, "refund", refund)
+				ctx.EventManager().EmitEvents(sdk.Events{
+					sdk.NewEvent(
+						"player_removed",
+						sdk.NewAttribute("game_id", gameId),
+						sdk.NewAttribute("player", next.player),
+						sdk.NewAttribute("reason", "sit_out_limit"),
+						sdk.NewAttribute("refund_amount", strconv.FormatUint(refund, 10)),
+					),
+				})
+			}
+			continue
+		}
+		if next.topUp {
+			if err := ms.autoTopUp(ctx, game, state, next.player, next.amount); err != nil {
+				return advanced, err
+			}
+			advanced = true
+			continue
+		}
+		if err := ms.callGameEngine(ctx, next.player, gameId, string(next.action), next.amount, 0); err != nil {
+			return advanced, err
+		}
+		advanced = true
+
+		ctx.Logger().Info("🤖 Performed automatic action",
+			"gameId", gameId,
+			"player", next.player,
+			"action", next.action,
+			"amount", next.amount)
+		if next.timedOut {
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					"player_timed_out",
+					sdk.NewAttribute("game_id", gameId),
+					sdk.NewAttribute("player", next.player),
+					sdk.NewAttribute("action", string(next.action)),
+				),
+			})
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"auto_action_performed",
+				sdk.NewAttribute("game_id", gameId),
+				sdk.NewAttribute("player", next.player),
+				sdk.NewAttribute("action", string(next.action)),
+				sdk.NewAttribute("amount", strconv.FormatUint(next.amount, 10)),
+			),
+		})
+	}
+	return advanced, nil
+}
+
+// nextAutoAction returns the action the module should perform next at a
+// table, if any. It only performs actions the game engine lists as legal, and
+// picks between players in seat order.
+func nextAutoAction(now time.Time, game types.Game, state types.TexasHoldemStateDTO, params types.Params) (autoAction, bool) {
+	players := slices.Clone(state.Players)
+	slices.SortFunc(players, func(a, b types.PlayerDTO) int { return a.Seat - b.Seat })
+
+	// A player leaving after the hand folds as soon as it is their turn
+	for _, p := range players {
+		if p.Seat != state.NextToAct || !game.IsLeaving(p.Address) {
+			continue
+		}
+		if _, ok := findLegalAction(p, Fold); ok {
+			return autoAction{player: p.Address, action: Fold}, true
+		}
+	}
+
+	// A player who has used up the action timeout and their time bank checks
+	// or folds
+	if p, ok := timedOut(now, game, state, params); ok {
+		return autoAction{player: p.Address, action: timeoutAction(p), timedOut: true}, true
+	}
+
+	// Blinds are posted by the player next to act, if they opted in
+	for _, p := range players {
+		if p.Seat != state.NextToAct || !game.AutoPostsBlinds(p.Address) {
+			continue
+		}
+		for _, blind := range []PlayerActionType{SmallBlind, BigBlind} {
+			if legal, ok := findLegalAction(p, blind); ok {
+				return autoAction{player: p.Address, action: blind, amount: blindAmount(game, legal, blind)}, true
+			}
+		}
+	}
+
+	// With the blinds in, the hole cards are dealt
+	for _, p := range players {
+		if _, ok := findLegalAction(p, Deal); ok {
+			return autoAction{player: p.Address, action: Deal}, true
+		}
+	}
+
+	// Once their hand is over, leaving players are cashed out before the next
+	// hand starts
+	for _, p := range players {
+		if !game.IsLeaving(p.Address) || inLiveHand(state, p) {
+			continue
+		}
+		if _, ok := findLegalAction(p, Leave); ok {
+			return autoAction{player: p.Address, action: Leave, leaving: true}, true
+		}
+	}
+
+	// Players who have sat out longer than the table allows lose their seat
+	for _, p := range players {
+		sitOut, ok := game.SitOut(p.Address)
+		if !ok || p.Status != types.StatusSittingOut || !game.SitOutExpired(sitOut, now) {
+			continue
+		}
+		if _, ok := findLegalAction(p, Leave); ok {
+			return autoAction{player: p.Address, action: Leave, leaving: true, removed: true}, true
+		}
+	}
+
+	// Standing rebuys and top-ups are carried out between hands
+	for _, p := range players {
+		autoTopUp, ok := game.AutoTopUp(p.Address)
+		if !ok || game.IsLeaving(p.Address) || inLiveHand(state, p) {
+			continue
+		}
+		if _, ok := findLegalAction(p, Leave); !ok {
+			continue
+		}
+		stack, err := strconv.ParseUint(p.Stack, 10, 64)
+		if err != nil {
+			continue
+		}
+		if due := autoTopUp.Due(stack, game.MaxBuyIn); due > 0 {
+			return autoAction{player: p.Address, amount: due, topUp: true}, true
+		}
+	}
+
+	// Between hands, the next hand starts once enough players can play it
+	// and the table has paused for the hand start delay
+	if !handStartDue(now, state, params.HandStartDelay) || readyPlayers(players) < game.MinPlayersToDeal() {
+		return autoAction{}, false
+	}
+	for _, p := range players {
+		if _, ok := findLegalAction(p, NewHand); ok {
+			return autoAction{player: p.Address, action: NewHand}, true
+		}
+	}
+	return autoAction{}, false
+}
+
+// findLegalAction returns the player's legal action of the given type
+func findLegalAction(p types.PlayerDTO, action PlayerActionType) (types.LegalActionDTO, bool) {
+	for _, legal := range p.LegalActions {
+		if legal.Action == string(action) {
+			return legal, true
+		}
+	}
+	return types.LegalActionDTO{}, false
+}
+
+// blindAmount is the amount the engine asks for a blind, or the table's
+// blind if it doesn't say
+func blindAmount(game types.Game, legal types.LegalActionDTO, blind PlayerActionType) uint64 {
+	if legal.Min != nil {
+		if amount, err := strconv.ParseUint(*legal.Min, 10, 64); err == nil && amount > 0 {
+			return amount
+		}
+	}
+	if blind == SmallBlind {
+		return game.SmallBlind
+	}
+	return game.BigBlind
+}
+
+// handStartDue reports whether the hand start delay has passed since the
+// table's last action, which gives players a pause after a hand ends. A table
+// with no actions yet starts straight away.
+func handStartDue(now time.Time, state types.TexasHoldemStateDTO, delay uint64) bool {
+	if len(state.PreviousActions) == 0 {
+		return true
+	}
+	last := state.PreviousActions[len(state.PreviousActions)-1].Timestamp
+	return !now.Before(time.UnixMilli(last).Add(time.Duration(delay) * time.Second))
+}
+
+// readyPlayers counts the seated players who can be dealt into a hand
+func readyPlayers(players []types.PlayerDTO) int64 {
+	var ready int64
+	for _, p := range players {
+		if p.Status == types.StatusSittingOut || p.Status == types.StatusBusted {
+			continue
+		}
+		if stack, err := strconv.ParseUint(p.Stack, 10, 64); err != nil || stack == 0 {
+			continue
+		}
+		ready++
+	}
+	return ready
+}
@@ -0,0 +1,293 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestAdvanceHands_StartsNextHandAfterDelay(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	start := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.HandStartDelay = 5
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, alice, 10_000)
+	fundUSDC(t, f, bank, bob, 10_000)
+
+	_, err = ms.CreateGame(ctx, &types.MsgCreateGame{
+		Creator: alice, MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+		SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash",
+	})
+	require.NoError(t, err)
+	var gameId string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameId = id
+		return true, nil
+	}))
+
+	// One player is not enough to deal
+	_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: alice, GameId: gameId, Seat: 1, BuyInAmount: 1000})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	state, err := f.keeper.GameStates.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Equal(t, types.RoundAnte, state.Round)
+
+	// With two, the table still pauses for the delay after the last action
+	_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: bob, GameId: gameId, Seat: 2, BuyInAmount: 1000})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.AdvanceHands(ctx.WithBlockTime(start.Add(4*time.Second))))
+	state, err = f.keeper.GameStates.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Equal(t, types.RoundAnte, state.Round)
+
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Second))
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	state, err = f.keeper.GameStates.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Equal(t, types.RoundPreflop, state.Round)
+	require.NotEmpty(t, state.Deck)
+	firstHand, firstDeck := state.HandNumber, state.Deck
+
+	// Nothing happens mid-hand; play it to showdown
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	for step := 0; state.Round != types.RoundShowdown; step++ {
+		require.Less(t, step, 20, "hand did not finish")
+		for _, p := range state.Players {
+			if p.Seat != state.NextToAct {
+				continue
+			}
+			action := "check"
+			for _, legal := range p.LegalActions {
+				if legal.Action == "call" {
+					action = "call"
+				}
+			}
+			_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: p.Address, GameId: gameId, Action: action})
+			require.NoError(t, err)
+		}
+		state, err = f.keeper.GameStates.Get(ctx, gameId)
+		require.NoError(t, err)
+	}
+
+	// The next hand starts with a fresh deck once the delay has passed
+	require.NoError(t, f.keeper.AdvanceHands(ctx.WithBlockTime(start.Add(9*time.Second))))
+	state, err = f.keeper.GameStates.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Equal(t, types.RoundShowdown, state.Round)
+
+	ctx = ctx.WithBlockTime(start.Add(10 * time.Second))
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	state, err = f.keeper.GameStates.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Equal(t, types.RoundPreflop, state.Round)
+	require.Greater(t, state.HandNumber, firstHand)
+	require.NotEqual(t, firstDeck, state.Deck)
+
+	var started int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "auto_action_performed" {
+			started++
+		}
+	}
+	require.Equal(t, 2, started)
+}
+
+// blindsEngine is a scripted game engine for a heads-up hand in which the
+// blinds are posted and the cards dealt as separate actions
+type blindsEngine struct {
+	actions []string
+}
+
+func (e *blindsEngine) PerformAction(_ context.Context, req keeper.GameEngineRequest) (types.TexasHoldemStateDTO, error) {
+	e.actions = append(e.actions, req.Action)
+	state := req.State
+	state.Players = append([]types.PlayerDTO(nil), req.State.Players...)
+	state.PreviousActions = append(state.PreviousActions, types.ActionDTO{
+		PlayerId: req.PlayerId, Action: req.Action, Index: req.Index, Timestamp: req.Timestamp,
+	})
+	index := req.Index + 1
+
+	next := map[string]struct {
+		seat   int
+		action string
+	}{
+		"new-hand":         {1, "post-small-blind"},
+		"post-small-blind": {2, "post-big-blind"},
+		"post-big-blind":   {1, "deal"},
+		"deal":             {1, "fold"},
+	}[req.Action]
+	state.NextToAct = next.seat
+	for i := range state.Players {
+		state.Players[i].LegalActions = nil
+		if state.Players[i].Seat == next.seat {
+			state.Players[i].LegalActions = []types.LegalActionDTO{{Action: next.action, Index: index}}
+		}
+	}
+	if req.Action == "deal" {
+		state.Round = types.RoundPreflop
+	}
+	return state, nil
+}
+
+func TestAdvanceHands_PostsBlindsOfOptedInPlayers(t *testing.T) {
+	f := initFixture(t)
+	engine := &blindsEngine{}
+	f.keeper.SetGameEngine(engine)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+
+	gameId := "0xauto"
+	require.NoError(t, f.keeper.Games.Set(ctx, gameId, types.Game{
+		GameId: gameId, Creator: alice, MinPlayers: 2, MaxPlayers: 2, SmallBlind: 5, BigBlind: 10,
+		GameType: "cash", Players: []string{alice, bob}, Status: types.TableStatusActive,
+	}))
+	newHand := []types.LegalActionDTO{{Action: "new-hand", Index: 1}}
+	require.NoError(t, f.keeper.GameStates.Set(ctx, gameId, types.TexasHoldemStateDTO{
+		Round: types.RoundAnte,
+		Players: []types.PlayerDTO{
+			{Address: bob, Seat: 2, Stack: "1000", Status: types.StatusActive, LegalActions: newHand},
+			{Address: alice, Seat: 1, Stack: "1000", Status: types.StatusActive, LegalActions: newHand},
+		},
+	}))
+
+	// Only players at the table can opt in
+	_, err = ms.SetAutoPostBlinds(ctx, &types.MsgSetAutoPostBlinds{Player: alice, GameId: gameId, Enabled: true})
+	require.NoError(t, err)
+	carol, err := f.addressCodec.BytesToString(sdk.AccAddress("carol_______________"))
+	require.NoError(t, err)
+	_, err = ms.SetAutoPostBlinds(ctx, &types.MsgSetAutoPostBlinds{Player: carol, GameId: gameId, Enabled: true})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// The hand starts from the lowest seat, and alice's small blind is posted,
+	// but the table waits for bob to post his big blind
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.Equal(t, []string{"new-hand", "post-small-blind"}, engine.actions)
+	state, err := f.keeper.GameStates.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Equal(t, alice, state.PreviousActions[0].PlayerId)
+	require.Equal(t, 2, state.NextToAct)
+
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.Len(t, engine.actions, 2)
+
+	// Once bob opts in, his blind is posted and the cards are dealt
+	_, err = ms.SetAutoPostBlinds(ctx, &types.MsgSetAutoPostBlinds{Player: bob, GameId: gameId, Enabled: true})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.Equal(t, []string{"new-hand", "post-small-blind", "post-big-blind", "deal"}, engine.actions)
+	state, err = f.keeper.GameStates.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Equal(t, types.RoundPreflop, state.Round)
+
+	// Opting out removes the player from the list
+	_, err = ms.SetAutoPostBlinds(ctx, &types.MsgSetAutoPostBlinds{Player: alice, GameId: gameId, Enabled: false})
+	require.NoError(t, err)
+	game, err := f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Equal(t, []string{bob}, game.AutoPostBlinds)
+}
+
+// countingEngine counts the game engine calls made through it
+type countingEngine struct {
+	keeper.GameEngine
+	calls int
+}
+
+func (e *countingEngine) PerformAction(ctx context.Context, req keeper.GameEngineRequest) (types.TexasHoldemStateDTO, error) {
+	e.calls++
+	return e.GameEngine.PerformAction(ctx, req)
+}
+
+func TestAdvanceHands_PerBlockBudget(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	engine := &countingEngine{GameEngine: simulation.NewEngine()}
+	f.keeper.SetGameEngine(engine)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	start := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+
+	// More tables waiting on a new hand than the block budget covers
+	tables := keeper.MaxAutoActionsPerBlock + 16
+	fundUSDC(t, f, bank, alice, uint64(int64(tables)*(types.GameCreationCost+1000)))
+	fundUSDC(t, f, bank, bob, uint64(tables)*1000)
+	for i := range tables {
+		createCtx := ctx.WithBlockTime(start.Add(time.Duration(i) * time.Second))
+		_, err = ms.CreateGame(createCtx, &types.MsgCreateGame{
+			Creator: alice, MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+			SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash",
+		})
+		require.NoError(t, err)
+	}
+	var gameIds []string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameIds = append(gameIds, id)
+		return false, nil
+	}))
+	require.Len(t, gameIds, tables)
+	ctx = ctx.WithBlockTime(start.Add(time.Duration(tables) * time.Second))
+	for _, gameId := range gameIds {
+		_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: alice, GameId: gameId, Seat: 1, BuyInAmount: 1000})
+		require.NoError(t, err)
+		_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: bob, GameId: gameId, Seat: 2, BuyInAmount: 1000})
+		require.NoError(t, err)
+	}
+
+	preflop := func() int {
+		n := 0
+		for _, gameId := range gameIds {
+			state, err := f.keeper.GameStates.Get(ctx, gameId)
+			require.NoError(t, err)
+			if state.Round == types.RoundPreflop {
+				n++
+			}
+		}
+		return n
+	}
+
+	// Each block spends at most the budget, and the tables left over are
+	// advanced in the following blocks
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	blocks := 0
+	for preflop() < tables {
+		require.Less(t, blocks, 10, "tables did not all start a hand")
+		engine.calls = 0
+		require.NoError(t, f.keeper.AdvanceHands(ctx.WithBlockHeight(int64(10+blocks))))
+		require.LessOrEqual(t, engine.calls, keeper.MaxAutoActionsPerBlock)
+		if blocks == 0 {
+			require.Equal(t, keeper.MaxAutoActionsPerBlock, engine.calls)
+		}
+		blocks++
+	}
+	require.Greater(t, blocks, 1)
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// IBCWithdrawalTimeout is how long an IBC withdrawal packet may take to be
+// received before it times out and is refunded
+const IBCWithdrawalTimeout = 10 * time.Minute
+
+// OnRecvIBCUSDC converts USDC received over an allowlisted route into usdc
+// chips. It runs after the transfer module has credited the IBC denom to the
+// receiver; other transfers are left untouched. Returning an error makes the
+// middleware fail the packet, which reverts the transfer and refunds the sender.
+func (k Keeper) OnRecvIBCUSDC(ctx context.Context, packet channeltypes.Packet, data ibctransfertypes.InternalTransferRepresentation) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	route, ok := params.IBCUSDCRoute(packet.DestinationChannel)
+	if !ok {
+		return nil
+	}
+	// Only USDC issued on the counterparty arrives without a denom trace
+	if !data.Token.Denom.IsNative() || data.Token.Denom.Base != route.BaseDenom {
+		return nil
+	}
+
+	receiver, err := k.addressCodec.StringToBytes(data.Receiver)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidRecipient, "invalid IBC receiver %s: %s", data.Receiver, err)
+	}
+	return k.convertIBCUSDC(ctx, receiver, route, data.Token.Amount)
+}
+
+// OnIBCUSDCRefund converts USDC refunded by a failed or timed out IBC
+// withdrawal back into usdc chips for the sender
+func (k Keeper) OnIBCUSDCRefund(ctx context.Context, packet channeltypes.Packet, data ibctransfertypes.InternalTransferRepresentation) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	route, ok := params.IBCUSDCRoute(packet.SourceChannel)
+	if !ok || data.Token.Denom.IBCDenom() != route.IBCDenom() {
+		return nil
+	}
+
+	sender, err := k.addressCodec.StringToBytes(data.Sender)
+	if err != nil {
+		return fmt.Errorf("invalid IBC sender %s: %w", data.Sender, err)
+	}
+	return k.convertIBCUSDC(ctx, sender, route, data.Token.Amount)
+}
+
+// convertIBCUSDC escrows IBC USDC in the module account and mints the same
+// amount of usdc chips to its owner
+func (k Keeper) convertIBCUSDC(ctx context.Context, owner sdk.AccAddress, route types.IBCUSDCRoute, amountStr string) error {
+	amount, ok := math.NewIntFromString(amountStr)
+	if !ok || !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "invalid IBC USDC amount %q", amountStr)
+	}
+	ibcCoins := sdk.NewCoins(sdk.NewCoin(route.IBCDenom(), amount))
+	chips := sdk.NewCoins(sdk.NewCoin(USDC_DENOM, amount))
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, ibcCoins); err != nil {
+		return fmt.Errorf("failed to escrow IBC USDC: %w", err)
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, chips); err != nil {
+		return fmt.Errorf("failed to mint USDC: %w", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, chips); err != nil {
+		return fmt.Errorf("failed to send USDC: %w", err)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ibc_usdc_converted",
+			sdk.NewAttribute("address", owner.String()),
+			sdk.NewAttribute("channel_id", route.ChannelId),
+			sdk.NewAttribute("ibc_denom", route.IBCDenom()),
+			sdk.NewAttribute("amount", amount.String()),
+		),
+	)
+	return nil
+}
+
+// InitiateIBCWithdrawal burns usdc chips, releases the same amount of
+// escrowed IBC USDC to the creator and sends it back over the route's channel.
+// Like Base withdrawals it is blocked while the bridge is paused, pays the
+// withdrawal fee and counts towards the rolling withdrawal limits.
+// If the packet fails or times out the refund is converted back into chips.
+// It returns the packet sequence and the fee charged.
+func (k Keeper) InitiateIBCWithdrawal(ctx context.Context, creator, channelID, receiver string, amount uint64) (uint64, uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.checkBridgeNotPaused(ctx); err != nil {
+		return 0, 0, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	route, ok := params.IBCUSDCRoute(channelID)
+	if !ok {
+		return 0, 0, errorsmod.Wrapf(types.ErrUnknownIBCRoute, "channel %s", channelID)
+	}
+	if k.ibcTransferKeeper == nil {
+		return 0, 0, fmt.Errorf("IBC transfer keeper not configured")
+	}
+
+	creatorAddr, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	fee, netAmount, err := WithdrawalFee(params, amount)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := k.checkWithdrawalLimits(ctx, params, creator, netAmount); err != nil {
+		return 0, 0, err
+	}
+
+	chips := sdk.NewCoins(sdk.NewCoin(USDC_DENOM, math.NewIntFromUint64(netAmount)))
+	ibcCoin := sdk.NewCoin(route.IBCDenom(), math.NewIntFromUint64(netAmount))
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, chips); err != nil {
+		return 0, 0, fmt.Errorf("failed to transfer USDC: %w", err)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, chips); err != nil {
+		return 0, 0, fmt.Errorf("failed to burn USDC: %w", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.NewCoins(ibcCoin)); err != nil {
+		return 0, 0, fmt.Errorf("failed to release escrowed IBC USDC: %w", err)
+	}
+
+	timeout := sdkCtx.BlockTime().Add(IBCWithdrawalTimeout).UnixNano()
+	resp, err := k.ibcTransferKeeper.Transfer(ctx, ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		route.ChannelId,
+		ibcCoin,
+		creator,
+		receiver,
+		clienttypes.ZeroHeight(),
+		uint64(timeout),
+		"",
+	))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to send IBC transfer: %w", err)
+	}
+
+	// The packet sequence identifies the withdrawal for the fee and outflow
+	id := fmt.Sprintf("ibc/%s/%d", route.ChannelId, resp.Sequence)
+	if err := k.collectWithdrawalFee(ctx, params, creatorAddr, id, fee); err != nil {
+		return 0, 0, err
+	}
+	if params.WithdrawalWindow > 0 {
+		if err := k.recordOutflow(ctx, creator, sdkCtx.BlockTime().Unix(), id, netAmount); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ibc_withdrawal_initiated",
+			sdk.NewAttribute("creator", creator),
+			sdk.NewAttribute("channel_id", route.ChannelId),
+			sdk.NewAttribute("receiver", receiver),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", netAmount)),
+			sdk.NewAttribute("fee", fmt.Sprintf("%d", fee)),
+			sdk.NewAttribute("sequence", fmt.Sprintf("%d", resp.Sequence)),
+		),
+	)
+	return resp.Sequence, fee, nil
+}
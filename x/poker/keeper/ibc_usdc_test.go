@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+var testIBCRoute = types.IBCUSDCRoute{ChannelId: "channel-0", BaseDenom: "uusdc"}
+
+// mockTransferKeeper records IBC transfers and moves the tokens out of the
+// sender's account like the transfer module's escrow does
+type mockTransferKeeper struct {
+	bank      *mockBankKeeper
+	transfers []*ibctransfertypes.MsgTransfer
+}
+
+func (m *mockTransferKeeper) Transfer(ctx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.bank.SendCoins(ctx, sender, authtypes.NewModuleAddress(ibctransfertypes.ModuleName), sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+	m.transfers = append(m.transfers, msg)
+	return &ibctransfertypes.MsgTransferResponse{Sequence: uint64(len(m.transfers))}, nil
+}
+
+func ibcUSDCFixture(t *testing.T) (*fixture, *mockBankKeeper, *mockTransferKeeper) {
+	t.Helper()
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	transfer := &mockTransferKeeper{bank: bank}
+	f.keeper.SetIBCTransferKeeper(transfer)
+
+	params := types.DefaultParams()
+	params.IbcUsdcRoutes = []types.IBCUSDCRoute{testIBCRoute}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	return f, bank, transfer
+}
+
+// recvData is an ICS-20 packet of amount denom to receiver
+func recvData(denom, receiver, amount string) ibctransfertypes.InternalTransferRepresentation {
+	return ibctransfertypes.InternalTransferRepresentation{
+		Token:    ibctransfertypes.Token{Denom: ibctransfertypes.ExtractDenomFromPath(denom), Amount: amount},
+		Sender:   "noble1sender",
+		Receiver: receiver,
+	}
+}
+
+func balance(f *fixture, bank *mockBankKeeper, addr sdk.AccAddress, denom string) int64 {
+	return bank.SpendableCoins(f.ctx, addr).AmountOf(denom).Int64()
+}
+
+func TestOnRecvIBCUSDC(t *testing.T) {
+	ibcDenom := testIBCRoute.IBCDenom()
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+
+	tests := []struct {
+		name      string
+		channel   string
+		denom     string
+		converted bool
+	}{
+		{name: "allowlisted route", channel: "channel-0", denom: "uusdc", converted: true},
+		{name: "other channel", channel: "channel-1", denom: "uusdc"},
+		{name: "other denom", channel: "channel-0", denom: "uatom"},
+		{name: "multi-hop USDC", channel: "channel-0", denom: "transfer/channel-9/uusdc"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f, bank, _ := ibcUSDCFixture(t)
+			aliceAddr := sdk.AccAddress("alice_______________")
+			alice, err := f.addressCodec.BytesToString(aliceAddr)
+			require.NoError(t, err)
+
+			// The transfer module credits the voucher before the middleware runs
+			voucher := ibctransfertypes.ExtractDenomFromPath(tc.denom)
+			voucher.Trace = append([]ibctransfertypes.Hop{ibctransfertypes.NewHop(ibctransfertypes.PortID, tc.channel)}, voucher.Trace...)
+			require.NoError(t, bank.MintCoins(f.ctx, ibctransfertypes.ModuleName, sdk.NewCoins(sdk.NewCoin(voucher.IBCDenom(), math.NewInt(5_000_000)))))
+			require.NoError(t, bank.SendCoinsFromModuleToAccount(f.ctx, ibctransfertypes.ModuleName, aliceAddr, sdk.NewCoins(sdk.NewCoin(voucher.IBCDenom(), math.NewInt(5_000_000)))))
+
+			packet := channeltypes.Packet{DestinationPort: ibctransfertypes.PortID, DestinationChannel: tc.channel}
+			require.NoError(t, f.keeper.OnRecvIBCUSDC(f.ctx, packet, recvData(tc.denom, alice, "5000000")))
+
+			if tc.converted {
+				require.Equal(t, int64(5_000_000), balance(f, bank, aliceAddr, keeper.USDC_DENOM))
+				require.Zero(t, balance(f, bank, aliceAddr, ibcDenom))
+				require.Equal(t, int64(5_000_000), balance(f, bank, escrow, ibcDenom))
+			} else {
+				require.Zero(t, balance(f, bank, aliceAddr, keeper.USDC_DENOM))
+				require.Equal(t, int64(5_000_000), balance(f, bank, aliceAddr, voucher.IBCDenom()))
+			}
+		})
+	}
+}
+
+func TestOnRecvIBCUSDC_Errors(t *testing.T) {
+	f, _, _ := ibcUSDCFixture(t)
+	packet := channeltypes.Packet{DestinationPort: ibctransfertypes.PortID, DestinationChannel: "channel-0"}
+
+	// A bad receiver fails the packet so the transfer is refunded
+	err := f.keeper.OnRecvIBCUSDC(f.ctx, packet, recvData("uusdc", "not-an-address", "100"))
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// So does a receiver that was never credited the voucher
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	require.Error(t, f.keeper.OnRecvIBCUSDC(f.ctx, packet, recvData("uusdc", alice, "100")))
+}
+
+func TestInitiateIBCWithdrawal(t *testing.T) {
+	f, bank, transfer := ibcUSDCFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ibcDenom := testIBCRoute.IBCDenom()
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+
+	aliceAddr := sdk.AccAddress("alice_______________")
+	alice, err := f.addressCodec.BytesToString(aliceAddr)
+	require.NoError(t, err)
+
+	// Deposit 3 USDC over IBC
+	require.NoError(t, bank.MintCoins(f.ctx, ibctransfertypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 3_000_000))))
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(f.ctx, ibctransfertypes.ModuleName, aliceAddr, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 3_000_000))))
+	packet := channeltypes.Packet{DestinationPort: ibctransfertypes.PortID, DestinationChannel: "channel-0"}
+	require.NoError(t, f.keeper.OnRecvIBCUSDC(f.ctx, packet, recvData("uusdc", alice, "3000000")))
+
+	_, err = ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: alice, Amount: 1_000_000, IbcChannelId: "channel-7", IbcReceiver: "noble1alice"})
+	require.ErrorIs(t, err, types.ErrUnknownIBCRoute)
+
+	resp, err := ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: alice, Amount: 1_000_000, IbcChannelId: "channel-0", IbcReceiver: "noble1alice"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.IbcSequence)
+	require.Equal(t, uint64(1_000_000), resp.NetAmount)
+	require.Empty(t, resp.Nonce)
+
+	require.Len(t, transfer.transfers, 1)
+	sent := transfer.transfers[0]
+	require.Equal(t, "channel-0", sent.SourceChannel)
+	require.Equal(t, alice, sent.Sender)
+	require.Equal(t, "noble1alice", sent.Receiver)
+	require.Equal(t, sdk.NewInt64Coin(ibcDenom, 1_000_000), sent.Token)
+	require.NotZero(t, sent.TimeoutTimestamp)
+
+	require.Equal(t, int64(2_000_000), balance(f, bank, aliceAddr, keeper.USDC_DENOM))
+	require.Zero(t, balance(f, bank, aliceAddr, ibcDenom))
+	require.Equal(t, int64(2_000_000), balance(f, bank, escrow, ibcDenom))
+
+	// Withdrawals are limited to the chips held
+	_, err = ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: alice, Amount: 2_000_001, IbcChannelId: "channel-0", IbcReceiver: "noble1alice"})
+	require.Error(t, err)
+
+	// A timed out withdrawal is refunded to alice and converted back into chips
+	require.NoError(t, bank.SendCoins(f.ctx, authtypes.NewModuleAddress(ibctransfertypes.ModuleName), aliceAddr, sdk.NewCoins(sent.Token)))
+	data, err := ibctransfertypes.PacketDataV1ToV2(ibctransfertypes.NewFungibleTokenPacketData(
+		testIBCRoute.Denom().Path(), "1000000", alice, "noble1alice", ""))
+	require.NoError(t, err)
+	refunded := channeltypes.Packet{SourcePort: ibctransfertypes.PortID, SourceChannel: "channel-0"}
+	require.NoError(t, f.keeper.OnIBCUSDCRefund(f.ctx, refunded, data))
+
+	require.Equal(t, int64(3_000_000), balance(f, bank, aliceAddr, keeper.USDC_DENOM))
+	require.Zero(t, balance(f, bank, aliceAddr, ibcDenom))
+	require.Equal(t, int64(3_000_000), balance(f, bank, escrow, ibcDenom))
+}
+
+// ibcDepositor credits addr with amount chips deposited over testIBCRoute
+func ibcDepositor(t *testing.T, f *fixture, bank *mockBankKeeper, name string, amount int64) (sdk.AccAddress, string) {
+	t.Helper()
+	ibcDenom := testIBCRoute.IBCDenom()
+	addr := sdk.AccAddress(name)
+	addrStr, err := f.addressCodec.BytesToString(addr)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, amount))
+	require.NoError(t, bank.MintCoins(f.ctx, ibctransfertypes.ModuleName, coins))
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(f.ctx, ibctransfertypes.ModuleName, addr, coins))
+	packet := channeltypes.Packet{DestinationPort: ibctransfertypes.PortID, DestinationChannel: testIBCRoute.ChannelId}
+	require.NoError(t, f.keeper.OnRecvIBCUSDC(f.ctx, packet, recvData(testIBCRoute.BaseDenom, addrStr, math.NewInt(amount).String())))
+	return addr, addrStr
+}
+
+func TestInitiateIBCWithdrawal_BridgeChecks(t *testing.T) {
+	f, bank, transfer := ibcUSDCFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ibcDenom := testIBCRoute.IBCDenom()
+
+	treasuryAddr := sdk.AccAddress("treasury____________")
+	treasury, err := f.addressCodec.BytesToString(treasuryAddr)
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.WithdrawalFeeFlat = 100
+	params.FeeTreasury = treasury
+	params.AddressWithdrawalLimit = 1_000
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	aliceAddr, alice := ibcDepositor(t, f, bank, "alice_______________", 5_000)
+	withdraw := func(amount uint64) (*types.MsgInitiateWithdrawalResponse, error) {
+		return ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: alice, Amount: amount, IbcChannelId: testIBCRoute.ChannelId, IbcReceiver: "noble1alice"})
+	}
+
+	// Paused bridge
+	require.NoError(t, f.keeper.BridgePause.Set(f.ctx, types.BridgePauseState{Paused: true}))
+	_, err = withdraw(500)
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+	require.Empty(t, transfer.transfers)
+	require.NoError(t, f.keeper.BridgePause.Set(f.ctx, types.BridgePauseState{}))
+
+	// The fee goes to the treasury and the net amount is sent and counted
+	resp, err := withdraw(700)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), resp.Fee)
+	require.Equal(t, uint64(600), resp.NetAmount)
+	require.Equal(t, sdk.NewInt64Coin(ibcDenom, 600), transfer.transfers[0].Token)
+	require.Equal(t, int64(100), balance(f, bank, treasuryAddr, keeper.USDC_DENOM))
+	require.Equal(t, int64(4_300), balance(f, bank, aliceAddr, keeper.USDC_DENOM))
+
+	_, byAddress, err := f.keeper.WithdrawnInWindow(f.ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, uint64(600), byAddress)
+
+	// 600 + 401 net is over the 1000 address limit
+	_, err = withdraw(501)
+	require.ErrorIs(t, err, types.ErrWithdrawalLimit)
+	require.Len(t, transfer.transfers, 1)
+
+	_, err = withdraw(500)
+	require.NoError(t, err)
+	require.Len(t, transfer.transfers, 2)
+}
+
+func TestMsgInitiateWithdrawal_ValidateBasicIBC(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	require.NoError(t, (&types.MsgInitiateWithdrawal{Creator: creator, Amount: 1, IbcChannelId: "channel-0", IbcReceiver: "noble1alice"}).ValidateBasic())
+	require.Error(t, (&types.MsgInitiateWithdrawal{Creator: creator, Amount: 1, IbcChannelId: "channel-0"}).ValidateBasic())
+	require.Error(t, (&types.MsgInitiateWithdrawal{Creator: creator, Amount: 1, IbcChannelId: "bad channel", IbcReceiver: "noble1alice"}).ValidateBasic())
+	require.Error(t, (&types.MsgInitiateWithdrawal{Creator: creator, Amount: 1, IbcChannelId: "channel-0", IbcReceiver: "noble1alice", BaseAddress: testBaseAddress}).ValidateBasic())
+	require.Error(t, (&types.MsgInitiateWithdrawal{Creator: creator, Amount: 1, IbcReceiver: "noble1alice", BaseAddress: testBaseAddress}).ValidateBasic())
+}
+
+func TestParams_IBCUSDCRoutes(t *testing.T) {
+	params := types.DefaultParams()
+	params.IbcUsdcRoutes = []types.IBCUSDCRoute{testIBCRoute}
+	require.NoError(t, params.Validate())
+
+	params.IbcUsdcRoutes = []types.IBCUSDCRoute{testIBCRoute, testIBCRoute}
+	require.Error(t, params.Validate())
+	params.IbcUsdcRoutes = []types.IBCUSDCRoute{{ChannelId: "channel 0", BaseDenom: "uusdc"}}
+	require.Error(t, params.Validate())
+	params.IbcUsdcRoutes = []types.IBCUSDCRoute{{ChannelId: "channel-0", BaseDenom: ""}}
+	require.Error(t, params.Validate())
+}
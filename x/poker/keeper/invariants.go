@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// RegisterInvariants registers the poker module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "table-funds", TableFundsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "game-states", GameStatesInvariant(k))
+}
+
+// AllInvariants runs all poker module invariants
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if msg, broken := TableFundsInvariant(k)(ctx); broken {
+			return msg, broken
+		}
+		return GameStatesInvariant(k)(ctx)
+	}
+}
+
+// TableFundsInvariant checks that the module account holds enough of every
+// table currency to pay out all chips at the tables: every player's stack,
+// plus the chips committed to a hand that has not been settled yet.
+func TableFundsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		atTables := sdk.NewCoins()
+		var msg string
+		broken := false
+
+		err := k.Games.Walk(ctx, nil, func(gameId string, game types.Game) (bool, error) {
+			state, err := k.GameStates.Get(ctx, gameId)
+			if err != nil {
+				return true, err
+			}
+			var chips uint64
+			for _, p := range state.Players {
+				stack, err := parseInvariantChips(p.Stack)
+				if err != nil {
+					return true, fmt.Errorf("game %s: invalid stack for %s: %w", gameId, p.Address, err)
+				}
+				chips += stack
+				// Settled hands have already paid the pot into the winners' stacks
+				if !handSettled(state) {
+					committed, err := parseInvariantChips(p.SumOfBets)
+					if err != nil {
+						return true, fmt.Errorf("game %s: invalid sumOfBets for %s: %w", gameId, p.Address, err)
+					}
+					chips += committed
+				}
+			}
+			atTables = atTables.Add(sdk.NewInt64Coin(game.TableDenom(), int64(chips)))
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "table-funds", err.Error()), true
+		}
+
+		escrow := k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		for _, coin := range atTables {
+			if held := escrow.AmountOf(coin.Denom); held.LT(coin.Amount) {
+				broken = true
+				msg += fmt.Sprintf("\t%s at tables but the module holds %s%s\n", coin, held, coin.Denom)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "table-funds", msg), broken
+	}
+}
+
+// GameStatesInvariant checks that every game has a stored state seating
+// exactly the players in the game's player list
+func GameStatesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		err := k.Games.Walk(ctx, nil, func(gameId string, game types.Game) (bool, error) {
+			state, err := k.GameStates.Get(ctx, gameId)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tgame %s has no state\n", gameId)
+				return false, nil
+			}
+			seated := make(map[string]bool, len(state.Players))
+			for _, p := range state.Players {
+				seated[p.Address] = true
+			}
+			listed := make(map[string]bool, len(game.Players))
+			for _, player := range game.Players {
+				listed[player] = true
+				if !seated[player] {
+					broken = true
+					msg += fmt.Sprintf("\tgame %s lists %s who is not seated\n", gameId, player)
+				}
+			}
+			for _, p := range state.Players {
+				if !listed[p.Address] {
+					broken = true
+					msg += fmt.Sprintf("\tgame %s seats %s who is not in its player list\n", gameId, p.Address)
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "game-states", err.Error()), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, "game-states", msg), broken
+	}
+}
+
+func parseInvariantChips(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
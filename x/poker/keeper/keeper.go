@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -35,19 +36,39 @@ type Keeper struct {
 	LastProcessedDepositIndex collections.Sequence
 	// LastEthBlockHeight tracks the Ethereum block height used for deterministic queries
 	LastEthBlockHeight collections.Sequence
+	// EthHeaders stores verified source chain headers by block number for deposit proofs
+	EthHeaders collections.Map[uint64, types.EthHeader]
+	// PendingEthHeaders stores submitted headers awaiting a relayer quorum by (number, hash)
+	PendingEthHeaders collections.Map[collections.Pair[uint64, []byte], types.EthHeader]
+	// EthHeaderVotes records which relayers submitted each pending header by (number, hash, relayer)
+	EthHeaderVotes collections.KeySet[collections.Triple[uint64, []byte, string]]
+	// BridgePause is the bridge circuit breaker state
+	BridgePause collections.Item[types.BridgePauseState]
+	// WithdrawalOutflows stores recent withdrawal amounts by (created_at, nonce) for the global limit
+	WithdrawalOutflows collections.Map[collections.Pair[int64, string], uint64]
+	// AddressWithdrawalOutflows stores recent withdrawal amounts by (address, created_at, nonce) for the per-address limit
+	AddressWithdrawalOutflows collections.Map[collections.Triple[string, int64, string], uint64]
+	// WithdrawalQueue indexes queued large withdrawals by (release_at, nonce)
+	WithdrawalQueue collections.KeySet[collections.Pair[int64, string]]
 
 	authKeeper    types.AuthKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
 	bridgeService *BridgeService
 
+	// IBC transfer keeper for IBC USDC withdrawals, set after the IBC keepers are built
+	ibcTransferKeeper types.IBCTransferKeeper
+
 	// Bridge configuration for Ethereum verification
 	ethRPCURL              string
+	ethReader              EthChainReader
 	depositContractAddr    string
-	validatorEthPrivateKey string // Hex-encoded Ethereum private key for withdrawal signing (without 0x prefix)
 
 	// PVM configuration
 	pvmURL string // URL of the Poker Virtual Machine RPC endpoint
+	// gameEngine replaces the PVM when set, e.g. by simulations (nil = PVM)
+	gameEngine GameEngine
 }
 
 func NewKeeper(
@@ -59,6 +80,7 @@ func NewKeeper(
 	authKeeper types.AuthKeeper,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper,
 
 	ethRPCURL string,
 	depositContractAddr string,
@@ -78,6 +100,7 @@ func NewKeeper(
 		authKeeper:          authKeeper,
 		bankKeeper:          bankKeeper,
 		stakingKeeper:       stakingKeeper,
+		distrKeeper:         distrKeeper,
 		ethRPCURL:           ethRPCURL,
 		depositContractAddr: depositContractAddr,
 		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
@@ -88,6 +111,13 @@ func NewKeeper(
 		WithdrawalNonce:           collections.NewSequence(sb, types.WithdrawalNonceKey, "withdrawal_nonce"),
 		LastProcessedDepositIndex: collections.NewSequence(sb, types.LastProcessedDepositIndexKey, "last_processed_deposit_index"),
 		LastEthBlockHeight:        collections.NewSequence(sb, types.LastEthBlockHeightKey, "last_eth_block_height"),
+		EthHeaders:                collections.NewMap(sb, types.EthHeadersKey, "eth_headers", collections.Uint64Key, codec.CollValue[types.EthHeader](cdc)),
+		PendingEthHeaders:         collections.NewMap(sb, types.PendingEthHeadersKey, "pending_eth_headers", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), codec.CollValue[types.EthHeader](cdc)),
+		EthHeaderVotes:            collections.NewKeySet(sb, types.EthHeaderVotesKey, "eth_header_votes", collections.TripleKeyCodec(collections.Uint64Key, collections.BytesKey, collections.StringKey)),
+		BridgePause:               collections.NewItem(sb, types.BridgePauseKey, "bridge_pause", codec.CollValue[types.BridgePauseState](cdc)),
+		WithdrawalOutflows:        collections.NewMap(sb, types.WithdrawalOutflowsKey, "withdrawal_outflows", collections.PairKeyCodec(collections.Int64Key, collections.StringKey), collections.Uint64Value),
+		AddressWithdrawalOutflows: collections.NewMap(sb, types.AddressWithdrawalOutflowsKey, "address_withdrawal_outflows", collections.TripleKeyCodec(collections.StringKey, collections.Int64Key, collections.StringKey), collections.Uint64Value),
+		WithdrawalQueue:           collections.NewKeySet(sb, types.WithdrawalQueueKey, "withdrawal_queue", collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
 	}
 
 	schema, err := sb.Build()
@@ -114,16 +144,31 @@ func (k *Keeper) GetBridgeService() *BridgeService {
 	return k.bridgeService
 }
 
-// SetBridgeConfig updates the bridge configuration for Ethereum verification
-func (k *Keeper) SetBridgeConfig(ethRPCURL string, depositContractAddr string, validatorEthPrivateKey string) {
-	k.ethRPCURL = ethRPCURL
+// SetBridgeConfig updates the bridge configuration for Ethereum verification.
+// ethReader may be nil when the node has no source chain RPC endpoint.
+func (k *Keeper) SetBridgeConfig(ethReader EthChainReader, depositContractAddr string) {
+	k.ethReader = ethReader
 	k.depositContractAddr = depositContractAddr
-	k.validatorEthPrivateKey = validatorEthPrivateKey
 }
 
-// GetValidatorEthPrivateKey returns the configured validator Ethereum private key
-func (k *Keeper) GetValidatorEthPrivateKey() string {
-	return k.validatorEthPrivateKey
+// GetBridgeVerifier returns a verifier for the params' bridge contract that
+// reads through the configured chain reader. It must only be used off the
+// consensus path (vote extensions, CLI, relayers), never in block execution.
+func (k *Keeper) GetBridgeVerifier(ctx context.Context) (*BridgeVerifier, error) {
+	if k.ethReader == nil {
+		return nil, fmt.Errorf("no Ethereum chain reader configured")
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewBridgeVerifierWithReader(k.ethReader, params.DepositContractAddress), nil
+}
+
+// SetIBCTransferKeeper sets the IBC transfer keeper. The IBC keepers are not
+// wired through depinject, so the app sets it once they are created.
+func (k *Keeper) SetIBCTransferKeeper(transferKeeper types.IBCTransferKeeper) {
+	k.ibcTransferKeeper = transferKeeper
 }
 
 // SetPVMConfig updates the PVM configuration
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
@@ -26,6 +27,12 @@ type fixture struct {
 
 func initFixture(t *testing.T) *fixture {
 	t.Helper()
+	return initFixtureWithBank(t, nil)
+}
+
+// initFixtureWithBank creates a fixture whose keeper uses the given bank keeper
+func initFixtureWithBank(t *testing.T, bankKeeper types.BankKeeper) *fixture {
+	t.Helper()
 
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
 	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
@@ -40,12 +47,13 @@ func initFixture(t *testing.T) *fixture {
 		storeService,
 		encCfg.Codec,
 		addressCodec,
-		authority.Bytes(), // authority as []byte
-		nil,               // authKeeper (not needed for basic tests)
-		nil,               // bankKeeper (not needed for basic tests)
-		nil,               // stakingKeeper (not needed for basic tests)
-		"",                // ethRPCURL (empty for tests)
-		"",                // depositContractAddr (empty for tests)
+		authority.Bytes(),               // authority as []byte
+		newMockAuthKeeper(addressCodec), // authKeeper (in-memory accounts)
+		bankKeeper,                      // bankKeeper (nil for basic tests)
+		nil,                             // stakingKeeper (not needed for basic tests)
+		nil,                             // distrKeeper (not needed for basic tests)
+		"",                              // ethRPCURL (empty for tests)
+		"",                              // depositContractAddr (empty for tests)
 	)
 
 	// Initialize params
@@ -59,3 +67,83 @@ func initFixture(t *testing.T) *fixture {
 		addressCodec: addressCodec,
 	}
 }
+
+// mockAuthKeeper is an in-memory account keeper for bridge tests
+type mockAuthKeeper struct {
+	addressCodec address.Codec
+	accounts     map[string]sdk.AccountI
+}
+
+func newMockAuthKeeper(addressCodec address.Codec) *mockAuthKeeper {
+	return &mockAuthKeeper{addressCodec: addressCodec, accounts: make(map[string]sdk.AccountI)}
+}
+
+func (a *mockAuthKeeper) AddressCodec() address.Codec {
+	return a.addressCodec
+}
+
+func (a *mockAuthKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return a.accounts[string(addr)]
+}
+
+func (a *mockAuthKeeper) SetAccount(_ context.Context, account sdk.AccountI) {
+	a.accounts[string(account.GetAddress())] = account
+}
+
+func (a *mockAuthKeeper) NewAccountWithAddress(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+// mockBankKeeper is an in-memory bank keeper for bridge tests. Module
+// accounts hold balances like any other account.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[string(addr)]
+}
+
+func (b *mockBankKeeper) MintCoins(_ context.Context, module string, amt sdk.Coins) error {
+	b.supply = b.supply.Add(amt...)
+	return b.credit(authtypes.NewModuleAddress(module), amt)
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, module string, amt sdk.Coins) error {
+	b.supply = b.supply.Sub(amt...)
+	return b.debit(authtypes.NewModuleAddress(module), amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, module string, addr sdk.AccAddress, amt sdk.Coins) error {
+	return b.SendCoins(ctx, authtypes.NewModuleAddress(module), addr, amt)
+}
+
+func (b *mockBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if err := b.debit(from, amt); err != nil {
+		return err
+	}
+	return b.credit(to, amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, addr sdk.AccAddress, module string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, addr, authtypes.NewModuleAddress(module), amt)
+}
+
+func (b *mockBankKeeper) credit(addr sdk.AccAddress, amt sdk.Coins) error {
+	b.balances[string(addr)] = b.balances[string(addr)].Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) debit(addr sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := b.balances[string(addr)].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds")
+	}
+	b.balances[string(addr)] = balance
+	return nil
+}
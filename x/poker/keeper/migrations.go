@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/block52/pokerchain/x/poker/migrations/v2"
+)
+
+// Migrator runs the poker module's in-place store migrations. Each
+// MigrateNtoM is registered in the module's RegisterServices and runs during
+// the upgrade that bumps ConsensusVersion from N to M.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper's store
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 backfills the params, table denominations and statuses on
+// games, and the fields older game states are missing
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.Params, m.keeper.Games, m.keeper.GameStates)
+}
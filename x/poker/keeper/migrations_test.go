@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// loadV1Store writes the games and game states in testdata/v1_games.json as a
+// node at consensus version 1 stored them
+func loadV1Store(t *testing.T, f *fixture) {
+	t.Helper()
+	bz, err := os.ReadFile("testdata/v1_games.json")
+	require.NoError(t, err)
+
+	var fixtureStore struct {
+		Games      []json.RawMessage          `json:"games"`
+		GameStates map[string]json.RawMessage `json:"gameStates"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &fixtureStore))
+
+	for _, raw := range fixtureStore.Games {
+		var game types.Game
+		require.NoError(t, game.Unmarshal(raw))
+		require.NoError(t, f.keeper.Games.Set(f.ctx, game.GameId, game))
+	}
+	for gameId, raw := range fixtureStore.GameStates {
+		var state types.TexasHoldemStateDTO
+		require.NoError(t, json.Unmarshal(raw, &state))
+		require.NoError(t, f.keeper.GameStates.Set(f.ctx, gameId, state))
+	}
+}
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	loadV1Store(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	// A game from before table denominations gets the default currency, a
+	// status from its seated players, and the creator as rake owner
+	legacy, err := f.keeper.Games.Get(ctx, "0xlegacy")
+	require.NoError(t, err)
+	require.Equal(t, types.TokenDenom, legacy.Denom)
+	require.Equal(t, types.TableStatusActive, legacy.Status)
+	require.Equal(t, "b521legacycreator", legacy.RakeOwner)
+	require.Equal(t, time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC), legacy.UpdatedAt.UTC())
+	require.Equal(t, []string{"b521alice", "b521bob"}, legacy.Players)
+
+	state, err := f.keeper.GameStates.Get(ctx, "0xlegacy")
+	require.NoError(t, err)
+	require.Equal(t, types.GameTypeTexasHoldem, state.Type)
+	require.Equal(t, "0xlegacy", state.Address)
+	require.Equal(t, types.RoundAnte, state.Round)
+	require.Equal(t, 1, state.HandNumber)
+	require.Equal(t, 4, state.ActionCount)
+	require.Equal(t, "100", *state.GameOptions.MinBuyIn)
+	require.Equal(t, "1000", *state.GameOptions.MaxBuyIn)
+	require.Equal(t, "2", *state.GameOptions.BigBlind)
+	require.Equal(t, 6, *state.GameOptions.MaxPlayers)
+	require.Equal(t, types.GameTypeSitAndGo, *state.GameOptions.Type)
+	require.Equal(t, "b521legacycreator", *state.GameOptions.Owner)
+	require.NotNil(t, state.CommunityCards)
+	require.NotNil(t, state.Pots)
+	require.NotNil(t, state.PreviousActions)
+	require.NotNil(t, state.Winners)
+	require.NotNil(t, state.Results)
+	require.Len(t, state.Players, 2)
+	require.Equal(t, "700", state.Players[1].Stack)
+
+	// Fields that were already set are kept
+	empty, err := f.keeper.Games.Get(ctx, "0xempty")
+	require.NoError(t, err)
+	require.Equal(t, "uatom", empty.Denom)
+	require.Equal(t, "b521rakeowner", empty.RakeOwner)
+	require.Equal(t, types.TableStatusWaiting, empty.Status)
+	require.Equal(t, []string{}, empty.Players)
+	require.Equal(t, time.Date(2025, 7, 2, 12, 0, 0, 0, time.UTC), empty.UpdatedAt.UTC())
+
+	emptyState, err := f.keeper.GameStates.Get(ctx, "0xempty")
+	require.NoError(t, err)
+	require.Equal(t, 3, emptyState.HandNumber)
+
+	// Running the migration again changes nothing
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+	again, err := f.keeper.GameStates.Get(ctx, "0xlegacy")
+	require.NoError(t, err)
+	require.Equal(t, state, again)
+}
+
+func TestMigrate1to2_Params(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// Version 1 had no params
+	require.NoError(t, f.keeper.Params.Remove(ctx))
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+
+	// Params stored empty get the defaults; fields already set are kept
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{
+		TimeBank:               45,
+		AddressWithdrawalLimit: 1_000,
+		AllowedGameDenoms:      []string{"uatom"},
+	}))
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+	params, err = f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	want := types.DefaultParams()
+	want.TimeBank = 45
+	want.AddressWithdrawalLimit = 1_000
+	want.AllowedGameDenoms = []string{"uatom"}
+	require.Equal(t, want, params)
+	require.Equal(t, types.DefaultWithdrawalWindow, params.WithdrawalWindow)
+	require.Equal(t, types.DefaultSeatReservationWindow, params.SeatReservationWindow)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CancelAutoTopUp cancels a player's standing top-up instruction at a table
+func (k msgServer) CancelAutoTopUp(ctx context.Context, msg *types.MsgCancelAutoTopUp) (*types.MsgCancelAutoTopUpResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Player); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid player address")
+	}
+
+	game, err := k.Games.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrGameNotFound, "game not found: %s", msg.GameId)
+	}
+	if !game.RemoveAutoTopUp(msg.Player) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "player %s has no auto top-up in game %s", msg.Player, msg.GameId)
+	}
+	if err := k.Games.Set(ctx, msg.GameId, game); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update game")
+	}
+	sdkCtx.Logger().Info("💰 Auto top-up cancelled", "gameId", msg.GameId, "player", msg.Player)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"auto_top_up_cancelled",
+			sdk.NewAttribute("game_id", msg.GameId),
+			sdk.NewAttribute("player", msg.Player),
+		),
+	})
+
+	return &types.MsgCancelAutoTopUpResponse{}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CancelWithdrawal handles MsgCancelWithdrawal transactions.
+// The owner of an expired withdrawal can cancel it to get the burned USDC back.
+func (k msgServer) CancelWithdrawal(ctx context.Context, msg *types.MsgCancelWithdrawal) (*types.MsgCancelWithdrawalResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := sdkCtx.Logger().With("module", "poker/withdrawal")
+
+	status, err := k.Keeper.CancelWithdrawal(ctx, msg.Creator, msg.Nonce)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	logger.Info("✅ Withdrawal cancelled",
+		"nonce", msg.Nonce,
+		"creator", msg.Creator,
+		"status", status,
+	)
+
+	return &types.MsgCancelWithdrawalResponse{Status: status}, nil
+}
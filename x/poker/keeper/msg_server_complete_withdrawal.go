@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CompleteWithdrawal handles MsgCompleteWithdrawal transactions.
+// Anyone may prove that a signed withdrawal was claimed on the Base CosmosBridge
+// contract; the proof is checked against the tracked header chain.
+func (k msgServer) CompleteWithdrawal(ctx context.Context, msg *types.MsgCompleteWithdrawal) (*types.MsgCompleteWithdrawalResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := sdkCtx.Logger().With("module", "poker/withdrawal")
+
+	request, err := k.getWithdrawalRequest(ctx, msg.Nonce)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.VerifyWithdrawnProof(ctx, *request, msg.EthBlockHeight, msg.TxIndex, msg.LogIndex, msg.ReceiptProof); err != nil {
+		logger.Error("❌ Withdrawal proof verification failed", "nonce", msg.Nonce, "error", err)
+		return nil, err
+	}
+
+	if err := k.MarkWithdrawalCompleted(ctx, msg.Nonce, msg.EthBlockHeight); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	logger.Info("✅ Withdrawal completed on Base",
+		"nonce", msg.Nonce,
+		"amount", request.Amount,
+		"eth_block_height", msg.EthBlockHeight,
+	)
+
+	return &types.MsgCompleteWithdrawalResponse{}, nil
+}
@@ -21,15 +21,46 @@ func (k msgServer) CreateGame(ctx context.Context, msg *types.MsgCreateGame) (*t
 		return nil, errorsmod.Wrap(err, "invalid creator address")
 	}
 
+	// Tables are denominated in a governance-approved currency
+	denom := msg.Denom
+	if denom == "" {
+		denom = types.TokenDenom
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get params")
+	}
+	if !params.IsAllowedGameDenom(denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s", denom)
+	}
+
+	// Private, unlisted and invite-only tables
+	invitePublicKey, err := k.validateTableAccess(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Antes, straddle and bomb pots
+	anteType, bombPotAnte, err := validateForcedBets(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Who may watch the table
+	spectators, err := validateSpectators(msg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if creator has enough tokens
 	creatorBalance := k.bankKeeper.SpendableCoins(ctx, creatorAddr)
-	tokenCoin := sdk.NewCoin(types.TokenDenom, math.NewInt(types.GameCreationCost))
+	tokenCoin := sdk.NewCoin(denom, math.NewInt(types.GameCreationCost))
 
 	if !creatorBalance.IsAllGTE(sdk.NewCoins(tokenCoin)) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
 			"creator needs %s to create a game, but only has %s",
 			tokenCoin.String(),
-			creatorBalance.AmountOf(types.TokenDenom).String())
+			creatorBalance.AmountOf(denom).String())
 	}
 
 	// Deduct tokens from creator account and send to module account
@@ -69,24 +100,42 @@ func (k msgServer) CreateGame(ctx context.Context, msg *types.MsgCreateGame) (*t
 	// Use block time for deterministic timestamps across all validators
 	now := sdkCtx.BlockTime()
 	game := types.Game{
-		GameId:            gameId,
-		Creator:           msg.Creator,
-		MinBuyIn:          msg.MinBuyIn,
-		MaxBuyIn:          msg.MaxBuyIn,
-		MinPlayers:        msg.MinPlayers,
-		MaxPlayers:        msg.MaxPlayers,
-		SmallBlind:        msg.SmallBlind,
-		BigBlind:          msg.BigBlind,
-		Timeout:           msg.Timeout,
-		GameType:          msg.GameType,
-		Players:           []string{}, // Empty initially, players join separately
-		CreatedAt:         now,
-		UpdatedAt:         now,
-		RakeFreeThreshold: msg.RakeFreeThreshold,
-		RakePercentage:    msg.RakePercentage,
-		RakeCap:           msg.RakeCap,
-		RakeOwner:         rakeOwner,
+		GameId:                gameId,
+		Creator:               msg.Creator,
+		MinBuyIn:              msg.MinBuyIn,
+		MaxBuyIn:              msg.MaxBuyIn,
+		MinPlayers:            msg.MinPlayers,
+		MaxPlayers:            msg.MaxPlayers,
+		SmallBlind:            msg.SmallBlind,
+		BigBlind:              msg.BigBlind,
+		Timeout:               msg.Timeout,
+		GameType:              msg.GameType,
+		Players:               []string{}, // Empty initially, players join separately
+		CreatedAt:             now,
+		UpdatedAt:             now,
+		RakeFreeThreshold:     msg.RakeFreeThreshold,
+		RakePercentage:        msg.RakePercentage,
+		RakeCap:               msg.RakeCap,
+		RakeOwner:             rakeOwner,
+		Denom:                 denom,
+		Visibility:            types.TableVisibility(msg.Visibility),
+		InvitePublicKey:       invitePublicKey,
+		RequiredDenom:         msg.RequiredDenom,
+		RequiredAmount:        msg.RequiredAmount,
+		Ante:                  msg.Ante,
+		AnteType:              anteType,
+		Straddle:              types.StraddleType(msg.Straddle),
+		BombPotFrequency:      msg.BombPotFrequency,
+		BombPotAnte:           bombPotAnte,
+		MaxSitOutHands:        msg.MaxSitOutHands,
+		MaxSitOutMinutes:      msg.MaxSitOutMinutes,
+		PostMissedBlinds:      msg.PostMissedBlinds,
+		Spectators:            spectators,
+		SpectatorDelayActions: msg.SpectatorDelayActions,
+		SpectatorDelaySeconds: msg.SpectatorDelaySeconds,
 	}
+	game.UpdateAllowlist(msg.Allowlist, nil)
+	game.Status = game.SeatedStatus()
 
 	// Store game in keeper
 	if err := k.Games.Set(ctx, gameId, game); err != nil {
@@ -115,7 +164,7 @@ func (k msgServer) CreateGame(ctx context.Context, msg *types.MsgCreateGame) (*t
 	}
 
 	// Initialize and shuffle deck for the new game
-	deck, err := k.InitializeAndShuffleDeck(ctx)
+	deck, err := k.InitializeAndShuffleDeck(ctx, gameId, 1)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to initialize deck")
 	}
@@ -140,7 +189,7 @@ func (k msgServer) CreateGame(ctx context.Context, msg *types.MsgCreateGame) (*t
 		HandNumber:  1,
 		Round:       types.RoundAnte,
 		ActionCount: 0,
-		GameOptions: types.GameOptionsDTO{
+		GameOptions: game.ForcedBetOptions(types.GameOptionsDTO{
 			MinBuyIn:   &minBuyInStr,
 			MaxBuyIn:   &maxBuyInStr,
 			SmallBlind: &smallBlindStr,
@@ -150,7 +199,7 @@ func (k msgServer) CreateGame(ctx context.Context, msg *types.MsgCreateGame) (*t
 			Type:       &gameType,
 			Rake:       rakeConfig,
 			Owner:      &rakeOwner,
-		},
+		}),
 		Players:         []types.PlayerDTO{},
 		CommunityCards:  []string{},
 		Deck:            deck.ToString(), // Shuffled deck serialized to string
@@ -177,6 +226,8 @@ func (k msgServer) CreateGame(ctx context.Context, msg *types.MsgCreateGame) (*t
 			sdk.NewAttribute("max_players", fmt.Sprintf("%d", msg.MaxPlayers)),
 			sdk.NewAttribute("min_buy_in", fmt.Sprintf("%d", msg.MinBuyIn)),
 			sdk.NewAttribute("max_buy_in", fmt.Sprintf("%d", msg.MaxBuyIn)),
+			sdk.NewAttribute("denom", denom),
+			sdk.NewAttribute("visibility", string(game.Visibility)),
 		),
 	})
 
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/block52/pokerchain/x/poker/keeper"
@@ -108,3 +111,64 @@ func TestGameStateStorage(t *testing.T) {
 	require.Equal(t, gameState.GameOptions.MinPlayers, retrievedState.GameOptions.MinPlayers)
 	require.Equal(t, gameState.GameOptions.MaxPlayers, retrievedState.GameOptions.MaxPlayers)
 }
+
+func TestMsgCreateGame_Denom(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.AllowedGameDenoms = []string{"usdc", "b52"}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creatorAddr := sdk.AccAddress("creator_____________")
+	creator, err := f.addressCodec.BytesToString(creatorAddr)
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, creator, 10)
+	require.NoError(t, bank.MintCoins(f.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("b52", 10))))
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(f.ctx, types.ModuleName, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("b52", 10))))
+
+	create := func(denom string, at time.Time) error {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(at)
+		_, err := ms.CreateGame(ctx, &types.MsgCreateGame{
+			Creator: creator, MinBuyIn: 100, MaxBuyIn: 1000, MinPlayers: 2, MaxPlayers: 6,
+			SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash", Denom: denom,
+		})
+		return err
+	}
+	now := time.Unix(1_700_000_000, 0)
+	require.NoError(t, create("", now))
+	require.NoError(t, create("b52", now.Add(time.Second)))
+	require.ErrorIs(t, create("uatom", now.Add(2*time.Second)), types.ErrDenomNotAllowed)
+
+	// The creation cost is paid in the table currency
+	require.Equal(t, int64(10-types.GameCreationCost), bank.SpendableCoins(f.ctx, creatorAddr).AmountOf("b52").Int64())
+
+	listGames := func(denom string) []types.Game {
+		resp, err := qs.ListGames(f.ctx, &types.QueryListGamesRequest{Denom: denom})
+		require.NoError(t, err)
+		var games []types.Game
+		require.NoError(t, json.Unmarshal([]byte(resp.Games), &games))
+		return games
+	}
+	require.Len(t, listGames(""), 2)
+	b52Games := listGames("b52")
+	require.Len(t, b52Games, 1)
+	require.Equal(t, "b52", b52Games[0].TableDenom())
+	usdcGames := listGames("usdc")
+	require.Len(t, usdcGames, 1)
+	require.Equal(t, "usdc", usdcGames[0].Denom)
+	require.Empty(t, listGames("uatom"))
+}
+
+func TestGame_TableDenom(t *testing.T) {
+	require.Equal(t, types.TokenDenom, types.Game{}.TableDenom())
+	require.Equal(t, "b52", types.Game{Denom: "b52"}.TableDenom())
+
+	// Params stored before allowed_game_denoms existed still allow usdc tables
+	params := types.DefaultParams()
+	params.AllowedGameDenoms = nil
+	require.True(t, params.IsAllowedGameDenom(types.TokenDenom))
+	require.False(t, params.IsAllowedGameDenom("b52"))
+}
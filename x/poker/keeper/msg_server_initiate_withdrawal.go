@@ -8,20 +8,34 @@ import (
 
 // InitiateWithdrawal handles MsgInitiateWithdrawal transactions.
 // This is the message server entry point for users initiating USDC withdrawals
-// from Cosmos chain to Base chain.
+// from Cosmos chain to Base chain, or over an IBC channel when one is set.
 func (ms msgServer) InitiateWithdrawal(ctx context.Context, msg *types.MsgInitiateWithdrawal) (*types.MsgInitiateWithdrawalResponse, error) {
 	// Validate message (basic validation is done in ValidateBasic, but we can add more here)
 	if msg.Amount == 0 {
 		return nil, types.ErrInvalidAmount
 	}
 
+	if msg.IbcChannelId != "" {
+		sequence, fee, err := ms.Keeper.InitiateIBCWithdrawal(ctx, msg.Creator, msg.IbcChannelId, msg.IbcReceiver, msg.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgInitiateWithdrawalResponse{
+			Fee:         fee,
+			NetAmount:   msg.Amount - fee,
+			IbcSequence: sequence,
+		}, nil
+	}
+
 	// Call keeper to initiate withdrawal
-	nonce, err := ms.Keeper.InitiateWithdrawal(ctx, msg.Creator, msg.BaseAddress, msg.Amount)
+	nonce, fee, err := ms.Keeper.InitiateWithdrawal(ctx, msg.Creator, msg.BaseAddress, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.MsgInitiateWithdrawalResponse{
-		Nonce: nonce,
+		Nonce:     nonce,
+		Fee:       fee,
+		NetAmount: msg.Amount - fee,
 	}, nil
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -35,6 +36,12 @@ func (k msgServer) JoinGame(ctx context.Context, msg *types.MsgJoinGame) (*types
 	}
 	sdkCtx.Logger().Info("✅ Game found", "gameId", msg.GameId, "creator", game.Creator)
 
+	// Private and invite-only tables only seat the players they admit
+	if err := k.checkTableAccess(ctx, game, msg.Player, playerAddr, msg.InviteSignature); err != nil {
+		sdkCtx.Logger().Error("❌ Table access denied", "gameId", msg.GameId, "player", msg.Player)
+		return nil, err
+	}
+
 	// Verify buy-in amount is within game limits
 	if msg.BuyInAmount < game.MinBuyIn || msg.BuyInAmount > game.MaxBuyIn {
 		return nil, errorsmod.Wrapf(types.ErrInvalidRequest,
@@ -42,23 +49,51 @@ func (k msgServer) JoinGame(ctx context.Context, msg *types.MsgJoinGame) (*types
 			msg.BuyInAmount, game.MinBuyIn, game.MaxBuyIn)
 	}
 
+	// Determine seat number
+	// If seat=0, we need to find the next available seat
+	// The PVM requires a specific seat number, it doesn't support auto-assignment
+	// Seats held for waitlisted players are only open to them
+	gameState, err := k.GameStates.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get game state for seat selection")
+	}
+	openSeats := game.OpenSeats(gameState, msg.Player)
+	seatNumber := msg.Seat
+	if seatNumber == 0 {
+		if len(openSeats) == 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "no available seats in game; join the waitlist to be offered the next one")
+		}
+		seatNumber = openSeats[0]
+		sdkCtx.Logger().Info("🎲 Auto-assigned seat", "seat", seatNumber, "gameId", msg.GameId)
+	} else if !slices.Contains(openSeats, seatNumber) {
+		// Occupied seats are left to the game engine to reject
+		for _, r := range game.Reservations {
+			if r.Seat == seatNumber {
+				return nil, errorsmod.Wrapf(types.ErrSeatReserved, "seat %d is held for %s", seatNumber, r.Player)
+			}
+		}
+		if slices.Contains(game.FreeSeats(gameState), seatNumber) {
+			return nil, errorsmod.Wrapf(types.ErrSeatReserved, "seat %d is set aside for the %d players on the waitlist", seatNumber, len(game.Waitlist))
+		}
+	}
+
 	// Check if player has enough balance for buy-in
 	playerBalance := k.bankKeeper.SpendableCoins(ctx, playerAddr)
-	buyInCoin := sdk.NewCoin(types.TokenDenom, math.NewInt(int64(msg.BuyInAmount)))
+	buyInCoin := sdk.NewCoin(game.TableDenom(), math.NewInt(int64(msg.BuyInAmount)))
 
 	sdkCtx.Logger().Info("💰 Checking player balance",
 		"playerBalance", playerBalance.String(),
 		"requiredBuyIn", buyInCoin.String(),
-		"playerUSDC", playerBalance.AmountOf(types.TokenDenom).String())
+		"playerTableBalance", playerBalance.AmountOf(game.TableDenom()).String())
 
 	if !playerBalance.IsAllGTE(sdk.NewCoins(buyInCoin)) {
 		sdkCtx.Logger().Error("❌ Insufficient funds",
 			"required", buyInCoin.String(),
-			"available", playerBalance.AmountOf(types.TokenDenom).String())
+			"available", playerBalance.AmountOf(game.TableDenom()).String())
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
 			"player needs %s to join, but only has %s",
 			buyInCoin.String(),
-			playerBalance.AmountOf(types.TokenDenom).String())
+			playerBalance.AmountOf(game.TableDenom()).String())
 	}
 	sdkCtx.Logger().Info("✅ Player has sufficient balance")
 
@@ -72,44 +107,10 @@ func (k msgServer) JoinGame(ctx context.Context, msg *types.MsgJoinGame) (*types
 		return nil, errorsmod.Wrap(err, "failed to transfer buy-in amount")
 	}
 
-	// Determine seat number
-	// If seat=0, we need to find the next available seat
-	// The PVM requires a specific seat number, it doesn't support auto-assignment
-	seatNumber := msg.Seat
-	if seatNumber == 0 {
-		// Get current game state to find occupied seats
-		gameState, err := k.GameStates.Get(ctx, msg.GameId)
-		if err != nil {
-			return nil, errorsmod.Wrap(err, "failed to get game state for seat selection")
-		}
-
-		// Find next available seat (1 to maxPlayers)
-		occupiedSeats := make(map[int]bool)
-		for _, player := range gameState.Players {
-			occupiedSeats[player.Seat] = true
-		}
-
-		// Find first available seat
-		found := false
-		for seat := int(1); int64(seat) <= game.MaxPlayers; seat++ {
-			if !occupiedSeats[seat] {
-				seatNumber = uint64(seat)
-				found = true
-				break
-			}
-		}
-
-		if !found {
-			return nil, errorsmod.Wrap(types.ErrInvalidRequest, "no available seats in game")
-		}
-
-		sdkCtx.Logger().Info("🎲 Auto-assigned seat", "seat", seatNumber, "gameId", msg.GameId)
-	}
-
 	// Call PVM to add player to game
 	// Use "join" action to add player to the game state
 	// Pass specific seat number (PVM requires explicit seat, doesn't support auto-assignment)
-	err = k.callGameEngine(ctx, msg.Player, msg.GameId, "join", msg.BuyInAmount, seatNumber)
+	err = k.callGameEngine(ctx, msg.Player, msg.GameId, string(Join), msg.BuyInAmount, seatNumber)
 	if err != nil {
 		// Refund buy-in if game engine call fails
 		if refundErr := k.bankKeeper.SendCoinsFromModuleToAccount(
@@ -132,8 +133,14 @@ func (k msgServer) JoinGame(ctx context.Context, msg *types.MsgJoinGame) (*types
 		}
 	}
 
-	if !playerAlreadyInGame {
-		game.Players = append(game.Players, msg.Player)
+	// A seated player no longer waits for a seat
+	leftWaitlist := game.RemoveFromWaitlist(msg.Player)
+
+	if !playerAlreadyInGame || leftWaitlist {
+		if !playerAlreadyInGame {
+			game.Players = append(game.Players, msg.Player)
+			game.Status = game.SeatedStatus()
+		}
 		if err := k.Games.Set(ctx, msg.GameId, game); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to update game player list")
 		}
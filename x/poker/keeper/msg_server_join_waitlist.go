@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// JoinWaitlist queues a player for the next free seat at a full table. Seats
+// are offered in EndBlock (see AdvanceWaitlists).
+func (k msgServer) JoinWaitlist(ctx context.Context, msg *types.MsgJoinWaitlist) (*types.MsgJoinWaitlistResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	playerAddr, err := k.addressCodec.StringToBytes(msg.Player)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid player address")
+	}
+
+	game, err := k.Games.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrGameNotFound, "game not found: %s", msg.GameId)
+	}
+	if err := k.checkTableAccess(ctx, game, msg.Player, playerAddr, msg.InviteSignature); err != nil {
+		return nil, err
+	}
+	gameState, err := k.GameStates.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get game state")
+	}
+
+	for _, p := range gameState.Players {
+		if p.Address == msg.Player {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "player %s is already seated in game %s", msg.Player, msg.GameId)
+		}
+	}
+	if _, ok := game.Reservation(msg.Player); ok || game.WaitlistPosition(msg.Player) > 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "player %s is already on the waitlist of game %s", msg.Player, msg.GameId)
+	}
+	if len(game.OpenSeats(gameState, msg.Player)) > 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "game %s has a free seat; join the game instead", msg.GameId)
+	}
+	if game.WaitlistFull() {
+		return nil, errorsmod.Wrapf(types.ErrWaitlistFull, "game %s already has %d players waiting", msg.GameId, len(game.Waitlist))
+	}
+
+	game.Waitlist = append(game.Waitlist, msg.Player)
+	if err := k.Games.Set(ctx, msg.GameId, game); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update game")
+	}
+	position := game.WaitlistPosition(msg.Player)
+	sdkCtx.Logger().Info("🕒 Player joined waitlist", "gameId", msg.GameId, "player", msg.Player, "position", position)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"waitlist_joined",
+			sdk.NewAttribute("game_id", msg.GameId),
+			sdk.NewAttribute("player", msg.Player),
+			sdk.NewAttribute("position", strconv.Itoa(position)),
+		),
+	})
+
+	return &types.MsgJoinWaitlistResponse{Position: uint64(position)}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LeaveWaitlist takes a player off a table's waitlist. A seat held for them
+// is offered to the next player in EndBlock.
+func (k msgServer) LeaveWaitlist(ctx context.Context, msg *types.MsgLeaveWaitlist) (*types.MsgLeaveWaitlistResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Player); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid player address")
+	}
+
+	game, err := k.Games.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrGameNotFound, "game not found: %s", msg.GameId)
+	}
+	if !game.RemoveFromWaitlist(msg.Player) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "player %s is not on the waitlist of game %s", msg.Player, msg.GameId)
+	}
+	if err := k.Games.Set(ctx, msg.GameId, game); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update game")
+	}
+	sdkCtx.Logger().Info("🕒 Player left waitlist", "gameId", msg.GameId, "player", msg.Player)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"waitlist_left",
+			sdk.NewAttribute("game_id", msg.GameId),
+			sdk.NewAttribute("player", msg.Player),
+		),
+	})
+
+	return &types.MsgLeaveWaitlistResponse{}, nil
+}
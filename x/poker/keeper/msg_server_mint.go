@@ -22,6 +22,10 @@ func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMint
 		return nil, errorsmod.Wrap(err, "invalid mint message")
 	}
 
+	if err := k.checkBridgeNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
 	// Check if the Ethereum transaction has already been processed
 	if exists, err := k.ProcessedEthTxs.Has(sdkCtx, msg.EthTxHash); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to check processed transactions")
@@ -36,44 +40,42 @@ func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMint
 	}
 
 	// VERIFY THE DEPOSIT ON ETHEREUM
-	// This ensures the deposit actually happened and matches the claimed parameters
-	logger.Info("🔍 Verifying Ethereum deposit",
+	// The receipt proof ties the claimed parameters to a Deposited event in a
+	// tracked header, so no RPC call is needed during block execution
+	logger.Info("🔍 Verifying Ethereum deposit proof",
 		"eth_tx_hash", msg.EthTxHash,
 		"recipient", msg.Recipient,
 		"amount", msg.Amount,
 		"nonce", msg.Nonce,
+		"eth_block_height", msg.EthBlockHeight,
 	)
 
-	// Create bridge verifier
-	verifier, err := NewBridgeVerifier(k.ethRPCURL, k.depositContractAddr)
-	if err != nil {
-		logger.Error("❌ Failed to create bridge verifier", "error", err)
-		return nil, errorsmod.Wrap(err, "failed to create bridge verifier")
-	}
-	defer verifier.Close()
-
-	// Verify the deposit on Ethereum
-	verification, err := verifier.VerifyDeposit(
-		ctx,
-		msg.EthTxHash,
-		msg.Recipient,
-		msg.Amount,
-		msg.Nonce,
-	)
+	deposit, err := k.VerifyDepositProof(ctx, msg.EthBlockHeight, msg.TxIndex, msg.LogIndex, msg.ReceiptProof, msg.Recipient)
 	if err != nil {
 		logger.Error("❌ Ethereum deposit verification failed", "error", err)
-		return nil, errorsmod.Wrap(err, "ethereum deposit verification failed")
+		return nil, err
+	}
+	if deposit.Amount != msg.Amount || deposit.Index != msg.Nonce {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProof, "proven deposit (amount %d, index %d) does not match claimed (amount %d, nonce %d)",
+			deposit.Amount, deposit.Index, msg.Amount, msg.Nonce)
 	}
 
-	if !verification.Verified {
-		logger.Error("❌ Deposit not verified")
-		return nil, errorsmod.Wrap(fmt.Errorf("deposit not verified"), "invalid deposit")
+	// The same deposit may also be claimed through MsgProcessDeposit
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	depositKey := DepositKey(params.DepositContractAddress, deposit.Index)
+	if exists, err := k.ProcessedEthTxs.Has(sdkCtx, depositKey); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to check processed deposits")
+	} else if exists {
+		return nil, errorsmod.Wrapf(types.ErrTxAlreadyProcessed, "deposit index %d already processed", deposit.Index)
 	}
 
 	logger.Info("✅ Ethereum deposit verified successfully",
-		"recipient", verification.Recipient,
-		"amount", verification.Amount,
-		"nonce", verification.Nonce,
+		"recipient", deposit.Recipient,
+		"amount", deposit.Amount,
+		"nonce", deposit.Index,
 	)
 
 	// Create coins to mint (assuming USDC with 6 decimals, so amount is in micro-USDC)
@@ -93,6 +95,9 @@ func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMint
 	if err := k.ProcessedEthTxs.Set(sdkCtx, msg.EthTxHash); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to mark transaction as processed")
 	}
+	if err := k.ProcessedEthTxs.Set(sdkCtx, depositKey); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to mark deposit as processed")
+	}
 
 	// Emit an event for the successful mint
 	sdkCtx.EventManager().EmitEvent(
@@ -1,16 +1,12 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/block52/pokerchain/x/poker/types"
 )
@@ -67,10 +63,7 @@ type JSONRPCResponse struct {
 }
 
 func (k msgServer) PerformAction(ctx context.Context, msg *types.MsgPerformAction) (*types.MsgPerformActionResponse, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	playerAddr, err := k.addressCodec.StringToBytes(msg.Player)
-	if err != nil {
+	if _, err := k.addressCodec.StringToBytes(msg.Player); err != nil {
 		return nil, errorsmod.Wrap(err, "invalid player address")
 	}
 
@@ -79,33 +72,30 @@ func (k msgServer) PerformAction(ctx context.Context, msg *types.MsgPerformActio
 		return nil, errorsmod.Wrapf(types.ErrInvalidAction, "invalid action: %s", msg.Action)
 	}
 
+	// Joining takes the buy-in and checks the table's access policy, so
+	// players only sit through MsgJoinGame
+	if msg.Action == string(Join) {
+		return nil, errorsmod.Wrap(types.ErrInvalidAction, "join a table with MsgJoinGame")
+	}
+
 	// Check if the game exists
 	game, err := k.Games.Get(ctx, msg.GameId)
 	if err != nil {
 		return nil, errorsmod.Wrapf(types.ErrGameNotFound, "game not found: %s", msg.GameId)
 	}
 
-	// For "leave" action, get player's stack BEFORE calling game engine
-	var playerStack uint64 = 0
+	// Leaving cashes the player out, or queues their departure until the
+	// end of the hand they are playing
 	if msg.Action == string(Leave) {
-		gameState, err := k.GameStates.Get(ctx, msg.GameId)
-		if err != nil {
-			return nil, errorsmod.Wrap(err, "failed to get game state for leave action")
-		}
-		for _, player := range gameState.Players {
-			if player.Address == msg.Player {
-				stack, parseErr := strconv.ParseUint(player.Stack, 10, 64)
-				if parseErr != nil {
-					sdkCtx.Logger().Error("❌ Failed to parse player stack", "error", parseErr, "stack", player.Stack)
-					return nil, errorsmod.Wrap(parseErr, "failed to parse player stack")
-				}
-				playerStack = stack
-				sdkCtx.Logger().Info("💰 Player stack for leave",
-					"player", msg.Player,
-					"stack", playerStack)
-				break
-			}
+		if err := k.leave(ctx, msg.GameId, msg.Player); err != nil {
+			return nil, err
 		}
+		return &types.MsgPerformActionResponse{}, nil
+	}
+
+	// A player leaving after the hand can only fold
+	if game.IsLeaving(msg.Player) && msg.Action != string(Fold) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "player %s is leaving after this hand and can only fold", msg.Player)
 	}
 
 	// Make JSON-RPC call to game engine with game state
@@ -115,53 +105,6 @@ func (k msgServer) PerformAction(ctx context.Context, msg *types.MsgPerformActio
 		return nil, errorsmod.Wrap(err, "failed to call game engine")
 	}
 
-	// Handle leave action: refund chips and update game player list
-	if msg.Action == string(Leave) {
-		// Credit the USDC balance back to the player
-		if playerStack > 0 {
-			refundCoin := sdk.NewCoin(types.TokenDenom, math.NewInt(int64(playerStack)))
-			sdkCtx.Logger().Info("💸 Refunding chips to player",
-				"player", msg.Player,
-				"amount", refundCoin.String())
-
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx,
-				types.ModuleName,
-				playerAddr,
-				sdk.NewCoins(refundCoin),
-			); err != nil {
-				sdkCtx.Logger().Error("❌ Failed to refund chips", "error", err)
-				return nil, errorsmod.Wrap(err, "failed to refund chips to player")
-			}
-			sdkCtx.Logger().Info("✅ Chips refunded successfully")
-		}
-
-		// Remove player from game's player list
-		updatedPlayers := make([]string, 0, len(game.Players)-1)
-		for _, p := range game.Players {
-			if p != msg.Player {
-				updatedPlayers = append(updatedPlayers, p)
-			}
-		}
-		game.Players = updatedPlayers
-
-		if err := k.Games.Set(ctx, msg.GameId, game); err != nil {
-			sdkCtx.Logger().Error("❌ Failed to update game player list", "error", err)
-			return nil, errorsmod.Wrap(err, "failed to update game player list")
-		}
-		sdkCtx.Logger().Info("✅ Player removed from game", "remainingPlayers", len(game.Players))
-
-		// Emit player_left_game event
-		sdkCtx.EventManager().EmitEvents(sdk.Events{
-			sdk.NewEvent(
-				"player_left_game",
-				sdk.NewAttribute("game_id", msg.GameId),
-				sdk.NewAttribute("player", msg.Player),
-				sdk.NewAttribute("refund_amount", fmt.Sprintf("%d", playerStack)),
-			),
-		})
-	}
-
 	return &types.MsgPerformActionResponse{}, nil
 }
 
@@ -190,12 +133,6 @@ func (k msgServer) callGameEngine(ctx context.Context, playerId, gameId, action
 	}
 	sdkCtx.Logger().Info("✅ Game retrieved", "gameId", gameId, "creator", game.Creator)
 
-	// Convert game state to JSON
-	gameStateJson, err := json.Marshal(gameState)
-	if err != nil {
-		return fmt.Errorf("failed to marshal game state: %w", err)
-	}
-
 	// Convert string game type to GameType enum
 	var gameType types.GameType
 	switch game.GameType {
@@ -210,7 +147,7 @@ func (k msgServer) callGameEngine(ctx context.Context, playerId, gameId, action
 	}
 
 	// Create GameOptionsDTO from the game object
-	gameOptions := types.GameOptionsDTO{
+	gameOptions := game.ForcedBetOptions(types.GameOptionsDTO{
 		MinBuyIn:   &[]string{strconv.FormatUint(game.MinBuyIn, 10)}[0],
 		MaxBuyIn:   &[]string{strconv.FormatUint(game.MaxBuyIn, 10)}[0],
 		MinPlayers: &[]int{int(game.MinPlayers)}[0],
@@ -219,16 +156,7 @@ func (k msgServer) callGameEngine(ctx context.Context, playerId, gameId, action
 		BigBlind:   &[]string{strconv.FormatUint(game.BigBlind, 10)}[0],
 		Timeout:    &[]int{int(game.Timeout)}[0],
 		Type:       &gameType,
-	}
-
-	// Convert game options to JSON
-	gameOptionsJson, err := json.Marshal(gameOptions)
-	if err != nil {
-		return fmt.Errorf("failed to marshal game options: %w", err)
-	}
-
-	// Create JSON-RPC request with new params format:
-	// [from, to, action, value, index, gameStateJson, gameOptionsJson, data]
+	})
 
 	// Step 1: Calculate expected action index to match PVM's getActionIndex()
 	// PVM calculates: this._actionCount + this.getPreviousActions().length + 1
@@ -283,11 +211,21 @@ func (k msgServer) callGameEngine(ctx context.Context, playerId, gameId, action
 	// Step 3: Use validated index
 	actionIndex := expectedActionIndex
 
+	// Charge the acting player's time bank for time taken beyond the action timeout
+	gameChanged := false
+	if isBettingAction(action) {
+		gameChanged, err = k.chargeTimeBank(sdkCtx, &game, gameState, playerId, action)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Format data parameter based on action type
 	var seatData string
 	if action == "new-hand" {
-		// For new-hand action, generate a deterministic shuffled deck from block hash
-		deck, err := k.Keeper.InitializeAndShuffleDeck(ctx)
+		// For new-hand action, generate a deterministic shuffled deck from
+		// the block hash, the game and the number of the hand being started
+		deck, err := k.Keeper.InitializeAndShuffleDeck(ctx, gameId, gameState.HandNumber+1)
 		if err != nil {
 			return fmt.Errorf("failed to initialize and shuffle deck: %w", err)
 		}
@@ -304,209 +242,181 @@ func (k msgServer) callGameEngine(ctx context.Context, playerId, gameId, action
 	// This ensures all validators get the same timestamp for consensus
 	blockTimestamp := sdkCtx.BlockTime().UnixMilli() // Milliseconds since epoch
 
-	request := JSONRPCRequest{
-		Method: "perform_action",
-		Params: []interface{}{
-			playerId,                       // from
-			gameId,                         // to (game address)
-			action,                         // action
-			strconv.FormatUint(amount, 10), // value
-			actionIndex,                    // index (current action count)
-			string(gameStateJson),          // gameStateJson
-			string(gameOptionsJson),        // gameOptionsJson
-			seatData,                       // data with seat parameter (empty = auto-assign)
-			blockTimestamp,                 // timestamp (Cosmos block time for deterministic gameplay)
-		},
-		ID:      1,
-		JSONRPC: "2.0",
-	}
-
-	// Marshal request to JSON
-	requestBody, err := json.Marshal(request)
+	updatedGameState, err := k.Keeper.getGameEngine().PerformAction(ctx, GameEngineRequest{
+		PlayerId:  playerId,
+		GameId:    gameId,
+		Action:    action,
+		Amount:    amount,
+		Index:     actionIndex,
+		State:     gameState,
+		Options:   gameOptions,
+		Data:      seatData,
+		Timestamp: blockTimestamp,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to marshal JSON-RPC request: %w", err)
-	}
-
-	// Make HTTP POST request to game engine
-	node := k.Keeper.GetPVMURL()
-	resp, err := http.Post(node, "application/json", bytes.NewBuffer(requestBody))
-	if err != nil {
-		return fmt.Errorf("failed to make HTTP request to game engine at %s: %w", node, err)
-	}
-	defer resp.Body.Close()
-
-	// Check HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		responseBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("game engine returned non-OK status %d: %s (body: %s)", resp.StatusCode, resp.Status, string(responseBody))
+		return err
 	}
 
-	// Parse JSON-RPC response
-	var response JSONRPCResponse
-	responseBody, readErr := io.ReadAll(resp.Body)
-	if readErr != nil {
-		return fmt.Errorf("failed to read response body: %w", readErr)
-	}
+	// Additional validation: Validate that PVM incremented the action index correctly
+	if len(updatedGameState.PreviousActions) > 0 {
+		lastIndex := updatedGameState.PreviousActions[len(updatedGameState.PreviousActions)-1].Index
 
-	if err := json.Unmarshal(responseBody, &response); err != nil {
-		return fmt.Errorf("failed to decode JSON-RPC response (body: %s): %w", string(responseBody), err)
+		// Should match what we sent
+		if lastIndex != actionIndex {
+			sdkCtx.Logger().Warn("⚠️ PVM returned different action index than expected",
+				"expected", actionIndex,
+				"received", lastIndex,
+				"gameId", gameId,
+				"action", action,
+				"player", playerId)
+		}
 	}
 
-	// Check for JSON-RPC error
-	if response.Error != nil {
-		switch err := response.Error.(type) {
-		case string:
-			return fmt.Errorf("game engine error: %s", err)
-		case map[string]interface{}:
-			if code, ok := err["code"].(float64); ok {
-				if message, ok := err["message"].(string); ok {
-					return fmt.Errorf("game engine error: %s (code %.0f)", message, code)
-				}
-			}
-			return fmt.Errorf("game engine error: %v", err)
-		default:
-			return fmt.Errorf("game engine error: %v", response.Error)
+	// Additional validation: Check for duplicate indices in previous actions
+	indexMap := make(map[int]bool)
+	for i, prevAction := range updatedGameState.PreviousActions {
+		if indexMap[prevAction.Index] {
+			sdkCtx.Logger().Error("🚨 Duplicate action index detected",
+				"gameId", gameId,
+				"index", prevAction.Index,
+				"position", i,
+				"action", prevAction.Action,
+				"playerId", prevAction.PlayerId,
+				"timestamp", prevAction.Timestamp)
+			// Don't fail the transaction, but log it for monitoring
 		}
+		indexMap[prevAction.Index] = true
 	}
 
-	// Parse the result to get updated game state
-	if response.Result != nil {
-		// The result should contain the updated game state
-		// Parse the result as a TexasHoldemStateDTO and update our stored game state
-		resultBytes, err := json.Marshal(response.Result)
+	// Independently evaluate the showdown and reject results that don't match
+	var showdown *ShowdownResult
+	if updatedGameState.Round == types.RoundShowdown && len(updatedGameState.Winners) > 0 {
+		showdown, err = VerifyShowdown(updatedGameState)
 		if err != nil {
-			return fmt.Errorf("failed to marshal response result: %w", err)
-		}
-
-		// The engine response has a "data" field containing the actual game state
-		// First extract the wrapper structure
-		var engineResponse struct {
-			Data      map[string]interface{} `json:"data"`
-			Signature interface{}            `json:"signature"`
+			sdkCtx.Logger().Error("🚨 Showdown verification failed",
+				"gameId", gameId,
+				"handNumber", updatedGameState.HandNumber,
+				"error", err)
+			return err
 		}
+		sdkCtx.Logger().Info("✅ Showdown verified",
+			"gameId", gameId,
+			"handNumber", updatedGameState.HandNumber,
+			"pots", len(showdown.Pots))
+	}
 
-		if err := json.Unmarshal(resultBytes, &engineResponse); err != nil {
-			return fmt.Errorf("failed to unmarshal engine response wrapper: %v", err)
-		}
+	// Store the updated game state
+	if err := k.GameStates.Set(ctx, gameId, updatedGameState); err != nil {
+		return fmt.Errorf("failed to store updated game state: %w", err)
+	}
 
-		// Now marshal just the data portion and unmarshal into our game state structure
-		dataBytes, err := json.Marshal(engineResponse.Data)
+	// Every new hand counts towards the dealt-in players' time bank top-ups
+	if action == string(NewHand) {
+		params, err := k.Params.Get(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to marshal engine data: %w", err)
+			return fmt.Errorf("failed to get params: %w", err)
+		}
+		replenishTimeBanks(&game, updatedGameState, params)
+		gameChanged = true
+	}
+	// Sitting out counts towards the table's sit-out rules
+	if trackSitOuts(sdkCtx, &game, gameState, updatedGameState, action == string(NewHand)) {
+		gameChanged = true
+	}
+	if gameChanged {
+		if err := k.Games.Set(ctx, gameId, game); err != nil {
+			return fmt.Errorf("failed to store game: %w", err)
 		}
+	}
 
-		var updatedGameState types.TexasHoldemStateDTO
-		if err := json.Unmarshal(dataBytes, &updatedGameState); err != nil {
-			return fmt.Errorf("failed to unmarshal updated game state: %v", err)
+	// Emit event for WebSocket subscribers (Tendermint event system)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"action_performed",
+			sdk.NewAttribute("game_id", gameId),
+			sdk.NewAttribute("player", playerId),
+			sdk.NewAttribute("action", action),
+			sdk.NewAttribute("amount", strconv.FormatUint(amount, 10)),
+		),
+	})
+
+	// Emit hand distribution events for indexer tracking
+	if action == "new-hand" {
+		// Emit hand_started event with deck seed for randomness verification
+		blockHash := sdkCtx.BlockHeader().AppHash
+		if len(blockHash) == 0 {
+			blockHash = sdkCtx.BlockHeader().LastCommitHash
 		}
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"hand_started",
+				sdk.NewAttribute("game_id", gameId),
+				sdk.NewAttribute("hand_number", strconv.Itoa(updatedGameState.HandNumber)),
+				sdk.NewAttribute("block_height", strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+				sdk.NewAttribute("deck_seed", fmt.Sprintf("%x", blockHash)),
+				sdk.NewAttribute("deck", updatedGameState.Deck),
+			),
+		})
+	}
 
-		// Additional validation: Validate that PVM incremented the action index correctly
-		if len(updatedGameState.PreviousActions) > 0 {
-			lastIndex := updatedGameState.PreviousActions[len(updatedGameState.PreviousActions)-1].Index
-
-			// Should match what we sent
-			if lastIndex != actionIndex {
-				sdkCtx.Logger().Warn("⚠️ PVM returned different action index than expected",
-					"expected", actionIndex,
-					"received", lastIndex,
-					"gameId", gameId,
-					"action", action,
-					"player", playerId)
+	// Emit hand_completed event at showdown with revealed cards
+	if updatedGameState.Round == "showdown" && len(updatedGameState.Winners) > 0 {
+		// Collect all revealed hole cards from players who showed
+		var revealedCards []string
+		for _, player := range updatedGameState.Players {
+			if player.HoleCards != nil && len(*player.HoleCards) > 0 {
+				for _, card := range *player.HoleCards {
+					revealedCards = append(revealedCards, card)
+				}
 			}
 		}
-
-		// Additional validation: Check for duplicate indices in previous actions
-		indexMap := make(map[int]bool)
-		for i, prevAction := range updatedGameState.PreviousActions {
-			if indexMap[prevAction.Index] {
-				sdkCtx.Logger().Error("🚨 Duplicate action index detected",
-					"gameId", gameId,
-					"index", prevAction.Index,
-					"position", i,
-					"action", prevAction.Action,
-					"playerId", prevAction.PlayerId,
-					"timestamp", prevAction.Timestamp)
-				// Don't fail the transaction, but log it for monitoring
+		// Serialize community cards
+		communityCardsStr := ""
+		for i, card := range updatedGameState.CommunityCards {
+			if i > 0 {
+				communityCardsStr += ","
 			}
-			indexMap[prevAction.Index] = true
+			communityCardsStr += card
 		}
-
-		// Store the updated game state
-		if err := k.GameStates.Set(ctx, gameId, updatedGameState); err != nil {
-			return fmt.Errorf("failed to store updated game state: %w", err)
+		// Serialize revealed hole cards
+		revealedCardsStr := ""
+		for i, card := range revealedCards {
+			if i > 0 {
+				revealedCardsStr += ","
+			}
+			revealedCardsStr += card
 		}
-
-		// Emit event for WebSocket subscribers (Tendermint event system)
 		sdkCtx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
-				"action_performed",
+				"hand_completed",
 				sdk.NewAttribute("game_id", gameId),
-				sdk.NewAttribute("player", playerId),
-				sdk.NewAttribute("action", action),
-				sdk.NewAttribute("amount", strconv.FormatUint(amount, 10)),
+				sdk.NewAttribute("hand_number", strconv.Itoa(updatedGameState.HandNumber)),
+				sdk.NewAttribute("block_height", strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+				sdk.NewAttribute("community_cards", communityCardsStr),
+				sdk.NewAttribute("revealed_hole_cards", revealedCardsStr),
+				sdk.NewAttribute("winner_count", strconv.Itoa(len(updatedGameState.Winners))),
 			),
 		})
 
-		// Emit hand distribution events for indexer tracking
-		if action == "new-hand" {
-			// Emit hand_started event with deck seed for randomness verification
-			blockHash := sdkCtx.BlockHeader().AppHash
-			if len(blockHash) == 0 {
-				blockHash = sdkCtx.BlockHeader().LastCommitHash
-			}
-			sdkCtx.EventManager().EmitEvents(sdk.Events{
-				sdk.NewEvent(
-					"hand_started",
-					sdk.NewAttribute("game_id", gameId),
-					sdk.NewAttribute("hand_number", strconv.Itoa(updatedGameState.HandNumber)),
-					sdk.NewAttribute("block_height", strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
-					sdk.NewAttribute("deck_seed", fmt.Sprintf("%x", blockHash)),
-					sdk.NewAttribute("deck", updatedGameState.Deck),
-				),
-			})
+		handsJson, err := json.Marshal(showdown.Hands)
+		if err != nil {
+			return fmt.Errorf("failed to marshal showdown hands: %w", err)
 		}
-
-		// Emit hand_completed event at showdown with revealed cards
-		if updatedGameState.Round == "showdown" && len(updatedGameState.Winners) > 0 {
-			// Collect all revealed hole cards from players who showed
-			var revealedCards []string
-			for _, player := range updatedGameState.Players {
-				if player.HoleCards != nil && len(*player.HoleCards) > 0 {
-					for _, card := range *player.HoleCards {
-						revealedCards = append(revealedCards, card)
-					}
-				}
-			}
-			// Serialize community cards
-			communityCardsStr := ""
-			for i, card := range updatedGameState.CommunityCards {
-				if i > 0 {
-					communityCardsStr += ","
-				}
-				communityCardsStr += card
-			}
-			// Serialize revealed hole cards
-			revealedCardsStr := ""
-			for i, card := range revealedCards {
-				if i > 0 {
-					revealedCardsStr += ","
-				}
-				revealedCardsStr += card
-			}
-			sdkCtx.EventManager().EmitEvents(sdk.Events{
-				sdk.NewEvent(
-					"hand_completed",
-					sdk.NewAttribute("game_id", gameId),
-					sdk.NewAttribute("hand_number", strconv.Itoa(updatedGameState.HandNumber)),
-					sdk.NewAttribute("block_height", strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
-					sdk.NewAttribute("community_cards", communityCardsStr),
-					sdk.NewAttribute("revealed_hole_cards", revealedCardsStr),
-					sdk.NewAttribute("winner_count", strconv.Itoa(len(updatedGameState.Winners))),
-				),
-			})
+		potsJson, err := json.Marshal(showdown.Pots)
+		if err != nil {
+			return fmt.Errorf("failed to marshal showdown pots: %w", err)
 		}
-	} else {
-		return fmt.Errorf("no result returned from poker engine")
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"showdown_verified",
+				sdk.NewAttribute("game_id", gameId),
+				sdk.NewAttribute("hand_number", strconv.Itoa(updatedGameState.HandNumber)),
+				sdk.NewAttribute("community_cards", communityCardsStr),
+				sdk.NewAttribute("pot_count", strconv.Itoa(len(showdown.Pots))),
+				sdk.NewAttribute("hands", string(handsJson)),
+				sdk.NewAttribute("pots", string(potsJson)),
+			),
+		})
 	}
 
 	return nil
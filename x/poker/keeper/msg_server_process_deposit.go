@@ -2,8 +2,6 @@ package keeper
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -19,60 +17,43 @@ func (k msgServer) ProcessDeposit(ctx context.Context, msg *types.MsgProcessDepo
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	logger := sdkCtx.Logger().With("module", "poker/process_deposit")
 
+	if err := k.checkBridgeNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
 	logger.Info("🔷 Processing deposit by index",
 		"deposit_index", msg.DepositIndex,
 		"creator", msg.Creator,
 		"eth_block_height", msg.EthBlockHeight,
+		"tx_index", msg.TxIndex,
+		"log_index", msg.LogIndex,
 	)
 
-	// CONSENSUS CRITICAL: eth_block_height MUST be specified for deterministic replay
-	// If eth_block_height is 0, different validators would query Ethereum at different
-	// block heights, resulting in different responses and consensus failure.
-	// The deposit relayer must always specify the Ethereum block height.
-	if msg.EthBlockHeight == 0 {
-		logger.Error("❌ eth_block_height is required for deterministic consensus")
-		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "eth_block_height must be specified (got 0) - relayer must provide the Ethereum block height for deterministic replay")
-	}
-
-	// Create bridge verifier to query Ethereum
-	verifier, err := NewBridgeVerifier(k.ethRPCURL, k.depositContractAddr)
+	// CONSENSUS CRITICAL: the deposit is proven against a tracked header's receipts
+	// root rather than fetched over RPC, so every validator reaches the same result
+	// from the transaction bytes alone.
+	deposit, err := k.VerifyDepositProof(ctx, msg.EthBlockHeight, msg.TxIndex, msg.LogIndex, msg.ReceiptProof, msg.Recipient)
 	if err != nil {
-		logger.Error("❌ Failed to create bridge verifier", "error", err)
-		return nil, errorsmod.Wrap(err, "failed to create bridge verifier")
+		logger.Error("❌ Deposit proof verification failed", "error", err)
+		return nil, err
+	}
+	if deposit.Index != msg.DepositIndex {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProof, "proven deposit index %d does not match %d", deposit.Index, msg.DepositIndex)
 	}
-	defer verifier.Close()
 
-	// Query Ethereum contract for deposit data by index at the specified block height
-	// This ensures all validators query at the same Ethereum block for consensus
-	logger.Info("🔍 Querying Ethereum contract for deposit",
-		"contract", k.depositContractAddr,
-		"deposit_index", msg.DepositIndex,
-		"eth_block_height", msg.EthBlockHeight,
+	logger.Info("✅ Deposit proven against tracked header",
+		"account", deposit.Recipient,
+		"amount", deposit.Amount,
+		"index", deposit.Index,
+		"eth_block_height", deposit.EthBlockHeight,
 	)
 
-	depositData, err := verifier.GetDepositByIndex(ctx, msg.DepositIndex, msg.EthBlockHeight)
+	// Deterministic txHash from contract address + deposit index, shared with MsgMint
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		logger.Error("❌ Failed to query deposit from Ethereum", "error", err)
-		return nil, errorsmod.Wrap(err, "failed to query deposit from ethereum")
+		return nil, err
 	}
-
-	logger.Info("✅ Deposit data retrieved from Ethereum",
-		"account", depositData.Account,
-		"amount", depositData.Amount.String(),
-		"index", depositData.Index,
-		"eth_block_height", depositData.EthBlockHeight,
-	)
-
-	// Generate deterministic txHash from contract address + deposit index
-	// This ensures we have a unique identifier for this deposit even without the actual Ethereum tx hash
-	txHashInput := fmt.Sprintf("%s-%d", k.depositContractAddr, msg.DepositIndex)
-	hash := sha256.Sum256([]byte(txHashInput))
-	ethTxHash := "0x" + hex.EncodeToString(hash[:])
-
-	logger.Info("📝 Generated deterministic txHash",
-		"txHash", ethTxHash,
-		"input", txHashInput,
-	)
+	ethTxHash := DepositKey(params.DepositContractAddress, msg.DepositIndex)
 
 	// Check if already processed - return error to prevent double-spend
 	if exists, err := k.ProcessedEthTxs.Has(sdkCtx, ethTxHash); err != nil {
@@ -83,56 +64,36 @@ func (k msgServer) ProcessDeposit(ctx context.Context, msg *types.MsgProcessDepo
 			"deposit_index", msg.DepositIndex,
 			"txHash", ethTxHash,
 		)
-		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "deposit index %d already processed (txHash: %s)", msg.DepositIndex, ethTxHash)
+		return nil, errorsmod.Wrapf(types.ErrTxAlreadyProcessed, "deposit index %d already processed (txHash: %s)", msg.DepositIndex, ethTxHash)
 	}
 
-	// Convert amount from *big.Int to uint64
-	// Note: This assumes the amount fits in uint64. For USDC with 6 decimals, max is ~18 trillion USDC
-	amount := depositData.Amount.Uint64()
-
-	// Process the deposit using the existing ProcessBridgeDeposit logic
-	logger.Info("🪙 Processing bridge deposit",
-		"recipient", depositData.Account,
-		"amount", amount,
-		"nonce", depositData.Index,
-		"txHash", ethTxHash,
-	)
-
-	err = k.ProcessBridgeDeposit(ctx, ethTxHash, depositData.Account, amount, depositData.Index)
-	if err != nil {
+	if err := k.ProcessBridgeDeposit(ctx, ethTxHash, deposit.Recipient, deposit.Amount, deposit.Index); err != nil {
 		logger.Error("❌ Failed to process bridge deposit", "error", err)
 		return nil, errorsmod.Wrap(err, "failed to process bridge deposit")
 	}
 
 	logger.Info("✅ Deposit processed successfully",
 		"deposit_index", msg.DepositIndex,
-		"recipient", depositData.Account,
-		"amount", fmt.Sprintf("%d usdc", amount),
-		"eth_block_height", depositData.EthBlockHeight,
+		"recipient", deposit.Recipient,
+		"amount", fmt.Sprintf("%d usdc", deposit.Amount),
+		"eth_block_height", msg.EthBlockHeight,
 	)
 
-	// CRITICAL: Store the eth_block_height and last processed deposit index
-	// This allows EndBlock auto-sync to use the same height for deterministic queries
-	if err := k.SetLastEthBlockHeight(ctx, msg.EthBlockHeight); err != nil {
+	// Track relayer progress for the deposit sync queries. Proving an older
+	// deposit must not move the markers back.
+	if err := k.advanceLastEthBlockHeight(ctx, msg.EthBlockHeight); err != nil {
 		logger.Error("❌ Failed to store eth_block_height", "error", err)
 		// Don't fail the tx, deposit was processed successfully
 	}
-	if err := k.SetLastProcessedDepositIndex(ctx, msg.DepositIndex); err != nil {
+	if err := k.advanceLastProcessedDepositIndex(ctx, msg.DepositIndex); err != nil {
 		logger.Error("❌ Failed to store last processed deposit index", "error", err)
 		// Don't fail the tx, deposit was processed successfully
 	}
 
-	logger.Info("📝 Stored eth_block_height and deposit index for auto-sync",
-		"eth_block_height", msg.EthBlockHeight,
-		"last_processed_index", msg.DepositIndex,
-	)
-
-	// Return success response with the Ethereum block height used
-	// This block height should be included in the transaction for deterministic replay
 	return &types.MsgProcessDepositResponse{
-		Recipient:      depositData.Account,
-		Amount:         fmt.Sprintf("%d usdc", amount),
+		Recipient:      deposit.Recipient,
+		Amount:         fmt.Sprintf("%d usdc", deposit.Amount),
 		DepositIndex:   msg.DepositIndex,
-		EthBlockHeight: depositData.EthBlockHeight,
+		EthBlockHeight: msg.EthBlockHeight,
 	}, nil
 }
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RefundWithdrawal handles MsgRefundWithdrawal transactions.
+// Anyone may prove that a cancelling withdrawal's nonce was invalidated on the
+// Base CosmosBridge contract, which re-mints the withdrawal to its owner.
+func (k msgServer) RefundWithdrawal(ctx context.Context, msg *types.MsgRefundWithdrawal) (*types.MsgRefundWithdrawalResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := sdkCtx.Logger().With("module", "poker/withdrawal")
+
+	// CONSENSUS CRITICAL: the refund is only safe once the nonce can no longer
+	// be claimed on Base, otherwise the withdrawal could be paid out twice.
+	if err := k.VerifyWithdrawalCancelledProof(ctx, msg.Nonce, msg.EthBlockHeight, msg.TxIndex, msg.LogIndex, msg.ReceiptProof); err != nil {
+		logger.Error("❌ Withdrawal cancellation proof verification failed", "nonce", msg.Nonce, "error", err)
+		return nil, err
+	}
+
+	amount, err := k.Keeper.RefundWithdrawal(ctx, msg.Nonce)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	logger.Info("✅ Withdrawal refunded",
+		"nonce", msg.Nonce,
+		"amount", amount,
+		"eth_block_height", msg.EthBlockHeight,
+	)
+
+	return &types.MsgRefundWithdrawalResponse{Amount: amount}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoPostBlinds opts a seated player in or out of having the module post
+// their blinds in EndBlock (see AdvanceHands).
+func (k msgServer) SetAutoPostBlinds(ctx context.Context, msg *types.MsgSetAutoPostBlinds) (*types.MsgSetAutoPostBlindsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Player); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid player address")
+	}
+
+	game, err := k.Games.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrGameNotFound, "game not found: %s", msg.GameId)
+	}
+
+	playerInGame := false
+	for _, p := range game.Players {
+		if p == msg.Player {
+			playerInGame = true
+			break
+		}
+	}
+	if !playerInGame {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "player %s is not in game %s", msg.Player, msg.GameId)
+	}
+
+	game.SetAutoPostBlinds(msg.Player, msg.Enabled)
+	if err := k.Games.Set(ctx, msg.GameId, game); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update game")
+	}
+	sdkCtx.Logger().Info("🤖 Auto-post blinds updated", "gameId", msg.GameId, "player", msg.Player, "enabled", msg.Enabled)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"auto_post_blinds_set",
+			sdk.NewAttribute("game_id", msg.GameId),
+			sdk.NewAttribute("player", msg.Player),
+			sdk.NewAttribute("enabled", strconv.FormatBool(msg.Enabled)),
+		),
+	})
+
+	return &types.MsgSetAutoPostBlindsResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoTopUp gives a seated player a standing instruction to refill their
+// stack from their wallet between hands: rebuying for the target once they
+// are busted, or topping back up to it. EndBlock carries it out and cancels
+// it when the player cannot pay.
+func (k msgServer) SetAutoTopUp(ctx context.Context, msg *types.MsgSetAutoTopUp) (*types.MsgSetAutoTopUpResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Player); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid player address")
+	}
+
+	game, err := k.Games.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrGameNotFound, "game not found: %s", msg.GameId)
+	}
+	if !slices.Contains(game.Players, msg.Player) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "player %s is not seated in game %s", msg.Player, msg.GameId)
+	}
+
+	mode := types.AutoTopUpMode(msg.Mode)
+	if !mode.IsValid() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "unknown auto top-up mode %q", msg.Mode)
+	}
+	if msg.TargetStack < game.MinBuyIn || msg.TargetStack > game.MaxBuyIn {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRequest,
+			"target stack %d must be between the table's buy-in limits of %d and %d",
+			msg.TargetStack, game.MinBuyIn, game.MaxBuyIn)
+	}
+
+	game.SetAutoTopUp(types.AutoTopUp{Player: msg.Player, Mode: mode, Target: msg.TargetStack})
+	if err := k.Games.Set(ctx, msg.GameId, game); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update game")
+	}
+	sdkCtx.Logger().Info("💰 Auto top-up set", "gameId", msg.GameId, "player", msg.Player, "mode", mode, "target", msg.TargetStack)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"auto_top_up_set",
+			sdk.NewAttribute("game_id", msg.GameId),
+			sdk.NewAttribute("player", msg.Player),
+			sdk.NewAttribute("mode", string(mode)),
+			sdk.NewAttribute("target_stack", strconv.FormatUint(msg.TargetStack, 10)),
+		),
+	})
+
+	return &types.MsgSetAutoTopUpResponse{}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetBridgePaused handles MsgSetBridgePaused transactions.
+// Bridge guardians and the module authority can pause the bridge; only the
+// authority can unpause it.
+func (k msgServer) SetBridgePaused(ctx context.Context, msg *types.MsgSetBridgePaused) (*types.MsgSetBridgePausedResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Signer); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := sdkCtx.Logger().With("module", "poker/bridge")
+
+	if err := k.Keeper.SetBridgePaused(ctx, msg.Signer, msg.Paused, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	if msg.Paused {
+		logger.Warn("⛔ Bridge paused", "by", msg.Signer, "reason", msg.Reason)
+	} else {
+		logger.Info("✅ Bridge unpaused", "by", msg.Signer, "reason", msg.Reason)
+	}
+
+	return &types.MsgSetBridgePausedResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SubmitEthHeaders(ctx context.Context, msg *types.MsgSubmitEthHeaders) (*types.MsgSubmitEthHeadersResponse, error) {
+	relayer, err := k.addressCodec.StringToBytes(msg.Relayer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid relayer address")
+	}
+	if len(msg.Headers) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "no headers submitted")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := sdkCtx.Logger().With("module", "poker/eth_headers")
+
+	// Only configured relayers and the module authority may submit headers
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAuthority := bytes.Equal(relayer, k.GetAuthority())
+	if !isAuthority && !params.IsHeaderRelayer(msg.Relayer) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a header relayer", msg.Relayer)
+	}
+
+	// The authority's headers are tracked directly; relayer headers are only
+	// tracked once a quorum of relayers has submitted the same header
+	quorum := params.HeaderQuorum()
+	var tracked int
+	for i, raw := range msg.Headers {
+		header, err := DecodeEthHeader(raw)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "header %d", i)
+		}
+		if isAuthority {
+			// The authority may re-anchor the chain with the first header of a batch
+			err = k.AppendEthHeader(ctx, header, i == 0)
+			tracked++
+		} else {
+			var ok bool
+			ok, err = k.AttestEthHeader(ctx, header, msg.Relayer, quorum)
+			if ok {
+				tracked++
+			}
+		}
+		if err != nil {
+			logger.Error("❌ Rejected eth header", "number", header.Number, "error", err)
+			return nil, err
+		}
+	}
+
+	tip, _, err := k.GetEthHeaderTip(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("✅ Eth headers accepted",
+		"relayer", msg.Relayer,
+		"count", len(msg.Headers),
+		"tracked", tracked,
+		"tip", tip.Number,
+	)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"eth_headers_submitted",
+			sdk.NewAttribute("relayer", msg.Relayer),
+			sdk.NewAttribute("count", fmt.Sprintf("%d", len(msg.Headers))),
+			sdk.NewAttribute("tracked", fmt.Sprintf("%d", tracked)),
+			sdk.NewAttribute("tip", fmt.Sprintf("%d", tip.Number)),
+			sdk.NewAttribute("tip_hash", fmt.Sprintf("0x%x", tip.Hash)),
+		),
+	)
+
+	return &types.MsgSubmitEthHeadersResponse{Tip: tip.Number}, nil
+}
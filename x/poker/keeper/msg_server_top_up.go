@@ -23,8 +23,7 @@ func (k msgServer) TopUp(ctx context.Context, msg *types.MsgTopUp) (*types.MsgTo
 		"amount", msg.Amount)
 
 	// Validate player address
-	playerAddr, err := k.addressCodec.StringToBytes(msg.Player)
-	if err != nil {
+	if _, err := k.addressCodec.StringToBytes(msg.Player); err != nil {
 		sdkCtx.Logger().Error("❌ Invalid player address", "error", err, "player", msg.Player)
 		return nil, errorsmod.Wrap(err, "invalid player address")
 	}
@@ -83,20 +82,39 @@ func (k msgServer) TopUp(ctx context.Context, msg *types.MsgTopUp) (*types.MsgTo
 			currentStack, msg.Amount, maxAllowed)
 	}
 
+	if err := k.topUp(ctx, game, msg.Player, uint64(playerSeat), msg.Amount, newStack); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTopUpResponse{
+		NewStack: newStack,
+	}, nil
+}
+
+// topUp moves chips from a seated player's wallet onto their stack, refunding
+// them if the game engine rejects the top-up
+func (k msgServer) topUp(ctx context.Context, game types.Game, player string, seat uint64, amount uint64, newStack uint64) error {
+	playerAddr, err := k.addressCodec.StringToBytes(player)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid player address")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
 	// Check if player has enough balance for top-up
 	playerBalance := k.bankKeeper.SpendableCoins(ctx, playerAddr)
-	topUpCoin := sdk.NewCoin(types.TokenDenom, math.NewInt(int64(msg.Amount)))
+	topUpCoin := sdk.NewCoin(game.TableDenom(), math.NewInt(int64(amount)))
 
 	sdkCtx.Logger().Info("💰 Checking player balance for top-up",
 		"playerBalance", playerBalance.String(),
 		"requiredTopUp", topUpCoin.String(),
-		"playerUSDC", playerBalance.AmountOf(types.TokenDenom).String())
+		"playerTableBalance", playerBalance.AmountOf(game.TableDenom()).String())
 
 	if !playerBalance.IsAllGTE(sdk.NewCoins(topUpCoin)) {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
 			"player needs %s to top up, but only has %s",
 			topUpCoin.String(),
-			playerBalance.AmountOf(types.TokenDenom).String())
+			playerBalance.AmountOf(game.TableDenom()).String())
 	}
 
 	// Transfer top-up amount from player to module account
@@ -106,13 +124,12 @@ func (k msgServer) TopUp(ctx context.Context, msg *types.MsgTopUp) (*types.MsgTo
 		types.ModuleName,
 		sdk.NewCoins(topUpCoin),
 	); err != nil {
-		return nil, errorsmod.Wrap(err, "failed to transfer top-up amount")
+		return errorsmod.Wrap(err, "failed to transfer top-up amount")
 	}
 
 	// Call PVM to execute top-up action
 	// Use "top-up" action which is NonPlayerActionType.TOP_UP in the PVM
-	err = k.callGameEngine(ctx, msg.Player, msg.GameId, "top-up", msg.Amount, uint64(playerSeat))
-	if err != nil {
+	if err := k.callGameEngine(ctx, player, game.GameId, "top-up", amount, seat); err != nil {
 		// Refund top-up if game engine call fails
 		if refundErr := k.bankKeeper.SendCoinsFromModuleToAccount(
 			ctx,
@@ -123,28 +140,26 @@ func (k msgServer) TopUp(ctx context.Context, msg *types.MsgTopUp) (*types.MsgTo
 			sdkCtx.Logger().Error("❌ Failed to refund after game engine error",
 				"originalError", err,
 				"refundError", refundErr)
-			return nil, errorsmod.Wrapf(err, "failed to call game engine AND failed to refund: %v", refundErr)
+			return errorsmod.Wrapf(err, "failed to call game engine AND failed to refund: %v", refundErr)
 		}
-		return nil, errorsmod.Wrap(err, "failed to execute top-up in game engine")
+		return errorsmod.Wrap(err, "failed to execute top-up in game engine")
 	}
 
 	sdkCtx.Logger().Info("✅ TopUp successful",
-		"player", msg.Player,
-		"amount", msg.Amount,
+		"player", player,
+		"amount", amount,
 		"newStack", newStack)
 
 	// Emit event
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			"player_top_up",
-			sdk.NewAttribute("game_id", msg.GameId),
-			sdk.NewAttribute("player", msg.Player),
-			sdk.NewAttribute("amount", fmt.Sprintf("%d", msg.Amount)),
+			sdk.NewAttribute("game_id", game.GameId),
+			sdk.NewAttribute("player", player),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
 			sdk.NewAttribute("new_stack", fmt.Sprintf("%d", newStack)),
 		),
 	})
 
-	return &types.MsgTopUpResponse{
-		NewStack: newStack,
-	}, nil
+	return nil
 }
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/block52/pokerchain/x/poker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateAllowlist adds and removes the players allowed to sit at a table.
+// Removed players who are already seated keep their seats.
+func (k msgServer) UpdateAllowlist(ctx context.Context, msg *types.MsgUpdateAllowlist) (*types.MsgUpdateAllowlistResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "nothing to add or remove")
+	}
+	if err := k.validateAddresses(msg.Add); err != nil {
+		return nil, err
+	}
+
+	game, err := k.Games.Get(ctx, msg.GameId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrGameNotFound, "game not found: %s", msg.GameId)
+	}
+	if game.Creator != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "only the creator of game %s can update its allowlist", msg.GameId)
+	}
+
+	game.UpdateAllowlist(msg.Add, msg.Remove)
+	if err := k.Games.Set(ctx, msg.GameId, game); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update game")
+	}
+	sdkCtx.Logger().Info("🔐 Allowlist updated", "gameId", msg.GameId, "added", len(msg.Add), "removed", len(msg.Remove), "size", len(game.Allowlist))
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"allowlist_updated",
+			sdk.NewAttribute("game_id", msg.GameId),
+			sdk.NewAttribute("creator", msg.Creator),
+			sdk.NewAttribute("size", strconv.Itoa(len(game.Allowlist))),
+		),
+	})
+
+	return &types.MsgUpdateAllowlistResponse{}, nil
+}
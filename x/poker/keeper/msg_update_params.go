@@ -24,6 +24,25 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	// Processed deposits are keyed by the contract address (see DepositKey),
+	// so switching contracts would let deposit indexes be minted again
+	current, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if normalizeEthAddress(current.DepositContractAddress) != normalizeEthAddress(req.Params.DepositContractAddress) {
+		processed := false
+		if err := k.ProcessedEthTxs.Walk(ctx, nil, func(string) (bool, error) {
+			processed = true
+			return true, nil
+		}); err != nil {
+			return nil, err
+		}
+		if processed {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRequest, "deposit contract address cannot change from %s once deposits have been processed", current.DepositContractAddress)
+		}
+	}
+
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -66,3 +67,33 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParams_DepositContract(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	update := func(contract string) error {
+		p := params
+		p.DepositContractAddress = contract
+		_, err := ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: p})
+		return err
+	}
+	other := "0x2222222222222222222222222222222222222222"
+
+	// The contract can change until a deposit is processed
+	require.NoError(t, update(other))
+	require.NoError(t, update(params.DepositContractAddress))
+	require.NoError(t, f.keeper.ProcessedEthTxs.Set(f.ctx, keeper.DepositKey(params.DepositContractAddress, 0)))
+
+	// Writing the same contract in another case keeps its deposit keys
+	lower := strings.ToLower(params.DepositContractAddress)
+	require.Equal(t, keeper.DepositKey(params.DepositContractAddress, 0), keeper.DepositKey(lower, 0))
+	require.NoError(t, update(lower))
+
+	require.ErrorIs(t, update(other), types.ErrInvalidRequest)
+	require.ErrorIs(t, update(""), types.ErrInvalidRequest)
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// NextDepositIndex returns the deposit index the oracle should look for next
+// (one past the highest index processed in order, 0 on a fresh chain)
+func (k Keeper) NextDepositIndex(ctx context.Context) (uint64, error) {
+	return k.LastProcessedDepositIndex.Peek(ctx)
+}
+
+// ApplyOracleResult consumes the aggregated vote extensions of the previous
+// block. The source chain height only moves forward, and every deposit that
+// reached quorum is minted once; deposits that cannot be minted (e.g. an
+// invalid recipient) are marked processed and skipped so they are never
+// retried. While the bridge is paused deposits are left for later.
+//
+// DETERMINISM: the result is derived purely from the extended commit included
+// in the block, so every node applies the same result.
+func (k Keeper) ApplyOracleResult(ctx context.Context, result types.OracleResult) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := sdkCtx.Logger().With("module", "poker/oracle")
+
+	if result.EthBlockHeight > 0 {
+		if err := k.advanceLastEthBlockHeight(ctx, result.EthBlockHeight); err != nil {
+			return err
+		}
+	}
+
+	if len(result.Deposits) == 0 {
+		return nil
+	}
+	// Deposits stay unprocessed while the bridge is paused and are reported
+	// again once it is unpaused
+	pause, err := k.GetBridgePause(ctx)
+	if err != nil {
+		return err
+	}
+	if pause.Paused {
+		logger.Info("Bridge paused, deferring oracle deposits", "count", len(result.Deposits))
+		return nil
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.DepositContractAddress == "" {
+		logger.Debug("Deposits disabled, ignoring oracle deposits", "count", len(result.Deposits))
+		return nil
+	}
+
+	deposits := make([]types.OracleDeposit, len(result.Deposits))
+	copy(deposits, result.Deposits)
+	sort.Slice(deposits, func(i, j int) bool { return deposits[i].Index < deposits[j].Index })
+
+	for _, deposit := range deposits {
+		ethTxHash := DepositKey(params.DepositContractAddress, deposit.Index)
+		processed, err := k.ProcessedEthTxs.Has(ctx, ethTxHash)
+		if err != nil {
+			return err
+		}
+		if !processed {
+			if err := k.applyOracleDeposit(sdkCtx, ethTxHash, deposit, result.EthBlockHeight); err != nil {
+				return err
+			}
+		}
+
+		if err := k.advanceLastProcessedDepositIndex(ctx, deposit.Index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyOracleDeposit mints a single quorum deposit, or marks it processed and
+// skipped if it cannot be minted
+func (k Keeper) applyOracleDeposit(sdkCtx sdk.Context, ethTxHash string, deposit types.OracleDeposit, ethBlockHeight uint64) error {
+	logger := sdkCtx.Logger().With("module", "poker/oracle")
+
+	// Mint in a cached context so a failed deposit leaves no partial state
+	cacheCtx, write := sdkCtx.CacheContext()
+	if err := k.ProcessBridgeDeposit(cacheCtx, ethTxHash, deposit.Recipient, deposit.Amount, deposit.Index); err != nil {
+		// CONSENSUS CRITICAL: skip the deposit deterministically to avoid retrying forever
+		logger.Error("❌ Failed to process oracle deposit, marking as skipped",
+			"index", deposit.Index,
+			"recipient", deposit.Recipient,
+			"error", err,
+		)
+		if err := k.ProcessedEthTxs.Set(sdkCtx, ethTxHash); err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"deposit_skipped",
+				sdk.NewAttribute("deposit_index", fmt.Sprintf("%d", deposit.Index)),
+				sdk.NewAttribute("recipient", deposit.Recipient),
+				sdk.NewAttribute("amount", fmt.Sprintf("%d", deposit.Amount)),
+				sdk.NewAttribute("reason", err.Error()),
+			),
+		)
+		return nil
+	}
+
+	write()
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"deposit_synced",
+			sdk.NewAttribute("deposit_index", fmt.Sprintf("%d", deposit.Index)),
+			sdk.NewAttribute("recipient", deposit.Recipient),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", deposit.Amount)),
+			sdk.NewAttribute("eth_block_height", fmt.Sprintf("%d", ethBlockHeight)),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestApplyOracleResult(t *testing.T) {
+	f := initFixture(t)
+
+	// Height only moves forward
+	require.NoError(t, f.keeper.ApplyOracleResult(f.ctx, types.OracleResult{EthBlockHeight: 500}))
+	height, err := f.keeper.GetLastEthBlockHeight(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(501), height) // stored as height+1
+
+	require.NoError(t, f.keeper.ApplyOracleResult(f.ctx, types.OracleResult{EthBlockHeight: 400}))
+	height, err = f.keeper.GetLastEthBlockHeight(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(501), height)
+
+	// Deposits already minted through a proof are not minted again, but still
+	// advance the deposit index
+	key := keeper.DepositKey(types.DefaultDepositContractAddress, 0)
+	require.NoError(t, f.keeper.ProcessedEthTxs.Set(f.ctx, key))
+	require.NoError(t, f.keeper.ApplyOracleResult(f.ctx, types.OracleResult{
+		EthBlockHeight: 600,
+		Deposits:       []types.OracleDeposit{{Index: 0, Recipient: "alice", Amount: 10}},
+	}))
+	next, err := f.keeper.NextDepositIndex(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), next)
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// handSettled reports whether the hand has reached showdown and its pots
+// have been paid into the winners' stacks
+func handSettled(state types.TexasHoldemStateDTO) bool {
+	return state.Round == types.RoundShowdown && len(state.Winners) > 0
+}
+
+// inLiveHand reports whether the player has a stake in a hand that is still
+// being played: they were dealt in, or have chips committed this hand, and
+// the pot has not been resolved yet
+func inLiveHand(state types.TexasHoldemStateDTO, p types.PlayerDTO) bool {
+	if handSettled(state) {
+		return false
+	}
+	if state.Round != types.RoundAnte {
+		switch p.Status {
+		case types.StatusActive, types.StatusAllIn, types.StatusFolded:
+			return true
+		}
+	}
+	committed, err := strconv.ParseUint(p.SumOfBets, 10, 64)
+	return err == nil && committed > 0
+}
+
+// leave takes a player off a table. Between hands they are cashed out
+// straight away. During a live hand their departure is queued instead: the
+// module folds them when it is their turn and cashes them out once the pot
+// has been resolved, so their refund reflects the hand's result.
+func (k msgServer) leave(ctx context.Context, gameId, player string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	game, err := k.Games.Get(ctx, gameId)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrGameNotFound, "game not found: %s", gameId)
+	}
+	state, err := k.GameStates.Get(ctx, gameId)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get game state")
+	}
+	p, ok := findPlayer(state, player)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidRequest, "player %s not found in game state", player)
+	}
+	if !inLiveHand(state, p) {
+		_, err := k.leaveTable(ctx, gameId, player)
+		return err
+	}
+
+	if game.IsLeaving(player) {
+		return errorsmod.Wrapf(types.ErrInvalidRequest, "player %s is already leaving game %s after this hand", player, gameId)
+	}
+	game.SetLeaving(player, true)
+	if err := k.Games.Set(ctx, gameId, game); err != nil {
+		return errorsmod.Wrap(err, "failed to queue leave")
+	}
+
+	sdkCtx.Logger().Info("🚪 Leave queued until the end of the hand", "gameId", gameId, "player", player)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"leave_requested",
+			sdk.NewAttribute("game_id", gameId),
+			sdk.NewAttribute("player", player),
+		),
+	})
+	return nil
+}
+
+// leaveTable removes a player from a table through the game engine and
+// refunds their stack, returning the amount refunded
+func (k msgServer) leaveTable(ctx context.Context, gameId, player string) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	playerAddr, err := k.addressCodec.StringToBytes(player)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "invalid player address")
+	}
+	state, err := k.GameStates.Get(ctx, gameId)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to get game state")
+	}
+	p, ok := findPlayer(state, player)
+	if !ok {
+		return 0, errorsmod.Wrapf(types.ErrInvalidRequest, "player %s not found in game state", player)
+	}
+	stack, err := strconv.ParseUint(p.Stack, 10, 64)
+	if err != nil {
+		sdkCtx.Logger().Error("❌ Failed to parse player stack", "error", err, "stack", p.Stack)
+		return 0, errorsmod.Wrap(err, "failed to parse player stack")
+	}
+
+	// The game engine validates the leave and removes the player from the
+	// game state
+	if err := k.callGameEngine(ctx, player, gameId, string(Leave), 0, uint64(p.Seat)); err != nil {
+		sdkCtx.Logger().Error("❌ Failed to call game engine for leave", "error", err)
+		return 0, errorsmod.Wrap(err, "failed to process leave action in game engine")
+	}
+
+	game, err := k.Games.Get(ctx, gameId)
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrGameNotFound, "game not found: %s", gameId)
+	}
+
+	// Credit the table currency back to the player
+	if stack > 0 {
+		refundCoin := sdk.NewCoin(game.TableDenom(), math.NewIntFromUint64(stack))
+		sdkCtx.Logger().Info("💸 Refunding chips to player", "player", player, "amount", refundCoin.String())
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, playerAddr, sdk.NewCoins(refundCoin)); err != nil {
+			sdkCtx.Logger().Error("❌ Failed to refund chips", "error", err)
+			return 0, errorsmod.Wrap(err, "failed to refund chips to player")
+		}
+	}
+
+	players := make([]string, 0, len(game.Players))
+	for _, seated := range game.Players {
+		if seated != player {
+			players = append(players, seated)
+		}
+	}
+	game.Players = players
+	game.Status = game.SeatedStatus()
+	game.SetAutoPostBlinds(player, false)
+	game.RemoveTimeBank(player)
+	game.SetLeaving(player, false)
+	game.RemoveAutoTopUp(player)
+	if err := k.Games.Set(ctx, gameId, game); err != nil {
+		sdkCtx.Logger().Error("❌ Failed to update game player list", "error", err)
+		return 0, errorsmod.Wrap(err, "failed to update game player list")
+	}
+	sdkCtx.Logger().Info("✅ Player removed from game", "gameId", gameId, "player", player, "refundAmount", stack, "remainingPlayers", len(game.Players))
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"player_left_game",
+			sdk.NewAttribute("game_id", gameId),
+			sdk.NewAttribute("player", player),
+			sdk.NewAttribute("refund_amount", strconv.FormatUint(stack, 10)),
+		),
+	})
+	return stack, nil
+}
+
+// findPlayer returns the player's seat in the game state
+func findPlayer(state types.TexasHoldemStateDTO, player string) (types.PlayerDTO, bool) {
+	for _, p := range state.Players {
+		if p.Address == player {
+			return p, true
+		}
+	}
+	return types.PlayerDTO{}, false
+}
+
+// withPlayerDetails returns the game state with the table's bookkeeping on
+// each player filled in: their remaining time bank, as of the current block
+// time, and whether they are leaving after the hand
+func (k *Keeper) withPlayerDetails(ctx context.Context, gameId string, state types.TexasHoldemStateDTO) (types.TexasHoldemStateDTO, error) {
+	game, err := k.Games.Get(ctx, gameId)
+	if err != nil {
+		return state, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return state, err
+	}
+	state = fillTimeBanks(state, game, params, sdk.UnwrapSDKContext(ctx).BlockTime())
+	for i, p := range state.Players {
+		state.Players[i].LeavingAfterHand = game.IsLeaving(p.Address)
+	}
+	return state, nil
+}
@@ -0,0 +1,288 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestLeaveDuringHand(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.HandStartDelay = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	var players []string
+	for _, name := range []string{"alice_______________", "bob_________________", "carol_______________"} {
+		addr, err := f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		fundUSDC(t, f, bank, addr, 10_000)
+		players = append(players, addr)
+	}
+
+	_, err = ms.CreateGame(ctx, &types.MsgCreateGame{
+		Creator: players[0], MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+		SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash",
+	})
+	require.NoError(t, err)
+	var gameId string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameId = id
+		return true, nil
+	}))
+	for i, player := range players {
+		_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: player, GameId: gameId, Seat: uint64(i + 1), BuyInAmount: 1000})
+		require.NoError(t, err)
+	}
+	game, err := f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	denom := game.TableDenom()
+
+	balance := func(player string) int64 {
+		addr, err := f.addressCodec.StringToBytes(player)
+		require.NoError(t, err)
+		return bank.SpendableCoins(ctx, addr).AmountOf(denom).Int64()
+	}
+	getState := func() types.TexasHoldemStateDTO {
+		state, err := f.keeper.GameStates.Get(ctx, gameId)
+		require.NoError(t, err)
+		return state
+	}
+	emitted := func(eventType string) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				return true
+			}
+		}
+		return false
+	}
+
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	state := getState()
+	require.Equal(t, types.RoundPreflop, state.Round)
+
+	// A player who is not next to act asks to leave mid-hand
+	var leaver types.PlayerDTO
+	for _, p := range state.Players {
+		if p.Seat != state.NextToAct {
+			leaver = p
+			break
+		}
+	}
+	before := balance(leaver.Address)
+	_, err = ms.LeaveGame(ctx, &types.MsgLeaveGame{Creator: leaver.Address, GameId: gameId})
+	require.NoError(t, err)
+	require.True(t, emitted("leave_requested"))
+	require.Equal(t, before, balance(leaver.Address))
+
+	// Their departure is queued rather than settled, and they can only fold
+	game, err = f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.True(t, game.IsLeaving(leaver.Address))
+	require.Contains(t, game.Players, leaver.Address)
+	_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: leaver.Address, GameId: gameId, Action: "leave"})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: leaver.Address, GameId: gameId, Action: "call", Amount: 10})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// Queries show who is leaving
+	res, err := qs.GameStatePublic(ctx, &types.QueryGameStatePublicRequest{GameId: gameId})
+	require.NoError(t, err)
+	var public types.TexasHoldemStateDTO
+	require.NoError(t, json.Unmarshal([]byte(res.GameState), &public))
+	for _, p := range public.Players {
+		require.Equal(t, p.Address == leaver.Address, p.LeavingAfterHand, p.Address)
+	}
+
+	// The module folds them on their turn; the others play to showdown
+	for step := 0; state.Round != types.RoundShowdown; step++ {
+		require.Less(t, step, 30, "hand did not finish")
+		if state.NextToAct == leaver.Seat {
+			require.NoError(t, f.keeper.AdvanceHands(ctx))
+			state = getState()
+			continue
+		}
+		for _, p := range state.Players {
+			if p.Seat != state.NextToAct {
+				continue
+			}
+			action := "check"
+			var amount uint64
+			for _, legal := range p.LegalActions {
+				if legal.Action == "call" {
+					action = "call"
+					amount, err = strconv.ParseUint(*legal.Min, 10, 64)
+					require.NoError(t, err)
+				}
+			}
+			_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: p.Address, GameId: gameId, Action: action, Amount: amount})
+			require.NoError(t, err)
+		}
+		state = getState()
+	}
+	var stack uint64
+	for _, p := range state.Players {
+		if p.Address == leaver.Address {
+			require.Equal(t, types.StatusFolded, p.Status)
+			stack, err = strconv.ParseUint(p.Stack, 10, 64)
+			require.NoError(t, err)
+		}
+	}
+	// Chips they had committed to the hand stay in the pot
+	committed, err := strconv.ParseUint(leaver.SumOfBets, 10, 64)
+	require.NoError(t, err)
+	require.Equal(t, 1000-committed, stack)
+
+	// Once the pot is resolved, they are cashed out for what is left
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.True(t, emitted("player_left_game"))
+	require.Equal(t, before+int64(stack), balance(leaver.Address))
+	game, err = f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.NotContains(t, game.Players, leaver.Address)
+	require.Empty(t, game.PendingLeaves)
+	for _, p := range getState().Players {
+		require.NotEqual(t, leaver.Address, p.Address)
+	}
+}
+
+func TestLeaveDuringUnresolvedShowdown(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.HandStartDelay = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	var players []string
+	for _, name := range []string{"alice_______________", "bob_________________", "carol_______________"} {
+		addr, err := f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		fundUSDC(t, f, bank, addr, 10_000)
+		players = append(players, addr)
+	}
+
+	_, err = ms.CreateGame(ctx, &types.MsgCreateGame{
+		Creator: players[0], MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+		SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash",
+	})
+	require.NoError(t, err)
+	var gameId string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameId = id
+		return true, nil
+	}))
+	for i, player := range players {
+		_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: player, GameId: gameId, Seat: uint64(i + 1), BuyInAmount: 1000})
+		require.NoError(t, err)
+	}
+	game, err := f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	denom := game.TableDenom()
+
+	balance := func(player string) int64 {
+		addr, err := f.addressCodec.StringToBytes(player)
+		require.NoError(t, err)
+		return bank.SpendableCoins(ctx, addr).AmountOf(denom).Int64()
+	}
+	getState := func() types.TexasHoldemStateDTO {
+		state, err := f.keeper.GameStates.Get(ctx, gameId)
+		require.NoError(t, err)
+		return state
+	}
+	emitted := func(eventType string) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Everyone checks or calls down to a settled showdown
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	state := getState()
+	for step := 0; state.Round != types.RoundShowdown; step++ {
+		require.Less(t, step, 30, "hand did not finish")
+		for _, p := range state.Players {
+			if p.Seat != state.NextToAct {
+				continue
+			}
+			action := "check"
+			var amount uint64
+			for _, legal := range p.LegalActions {
+				if legal.Action == "call" {
+					action = "call"
+					amount, err = strconv.ParseUint(*legal.Min, 10, 64)
+					require.NoError(t, err)
+				}
+			}
+			_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: p.Address, GameId: gameId, Action: action, Amount: amount})
+			require.NoError(t, err)
+		}
+		state = getState()
+	}
+	settled := state
+	require.NotEmpty(t, settled.Winners)
+	winner := settled.Winners[0]
+	won, err := strconv.ParseUint(winner.Amount, 10, 64)
+	require.NoError(t, err)
+
+	// Roll the table back to the showdown before the engine paid the pot out
+	var unresolved types.TexasHoldemStateDTO
+	bz, err := json.Marshal(settled)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, &unresolved))
+	unresolved.Winners = nil
+	var stack uint64
+	for i, p := range unresolved.Players {
+		unresolved.Players[i].LegalActions = nil
+		if p.Address == winner.Address {
+			stack, err = strconv.ParseUint(p.Stack, 10, 64)
+			require.NoError(t, err)
+			unresolved.Players[i].Stack = strconv.FormatUint(stack-won, 10)
+		}
+	}
+	require.NoError(t, f.keeper.GameStates.Set(ctx, gameId, unresolved))
+
+	// The winner asks to leave before the pot is paid out: their departure is
+	// queued rather than cashing out the stack without their winnings
+	before := balance(winner.Address)
+	_, err = ms.LeaveGame(ctx, &types.MsgLeaveGame{Creator: winner.Address, GameId: gameId})
+	require.NoError(t, err)
+	require.True(t, emitted("leave_requested"))
+	require.False(t, emitted("player_left_game"))
+	require.Equal(t, before, balance(winner.Address))
+	game, err = f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.True(t, game.IsLeaving(winner.Address))
+	require.Contains(t, game.Players, winner.Address)
+
+	// Once the showdown is settled, they are cashed out with their winnings
+	require.NoError(t, f.keeper.GameStates.Set(ctx, gameId, settled))
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.True(t, emitted("player_left_game"))
+	require.Equal(t, before+int64(stack), balance(winner.Address))
+	game, err = f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.NotContains(t, game.Players, winner.Address)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// BridgeStatus returns the bridge pause state and the withdrawal headroom left
+// in the current window, globally and optionally for one address
+func (q queryServer) BridgeStatus(ctx context.Context, req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Address != "" {
+		if _, err := q.k.addressCodec.StringToBytes(req.Address); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid address")
+		}
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	pause, err := q.k.GetBridgePause(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	global, byAddress, err := q.k.WithdrawnInWindow(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryBridgeStatusResponse{
+		Pause:                    pause,
+		WithdrawalWindow:         params.WithdrawalWindow,
+		GlobalWithdrawalLimit:    params.GlobalWithdrawalLimit,
+		GlobalWithdrawn:          global,
+		GlobalRemaining:          remainingUnderLimit(global, params.GlobalWithdrawalLimit),
+		AddressWithdrawalLimit:   params.AddressWithdrawalLimit,
+		AddressWithdrawn:         byAddress,
+		AddressRemaining:         remainingUnderLimit(byAddress, params.AddressWithdrawalLimit),
+		LargeWithdrawalThreshold: params.LargeWithdrawalThreshold,
+		LargeWithdrawalDelay:     params.LargeWithdrawalDelay,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// EstimateWithdrawalFee returns the fee and net payout for a withdrawal amount
+// under the current params
+func (q queryServer) EstimateWithdrawalFee(ctx context.Context, req *types.QueryEstimateWithdrawalFeeRequest) (*types.QueryEstimateWithdrawalFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	fee, net, err := WithdrawalFee(params, req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateWithdrawalFeeResponse{
+		Fee:         fee,
+		NetAmount:   net,
+		FeeTreasury: params.FeeTreasury,
+	}, nil
+}
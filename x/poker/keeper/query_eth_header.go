@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// EthHeaderTip returns the latest tracked source chain header
+func (q queryServer) EthHeaderTip(ctx context.Context, req *types.QueryEthHeaderTipRequest) (*types.QueryEthHeaderTipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tip, found, err := q.k.GetEthHeaderTip(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "no eth headers tracked")
+	}
+
+	return &types.QueryEthHeaderTipResponse{Header: &tip}, nil
+}
+
+// EthHeader returns a tracked source chain header by block number
+func (q queryServer) EthHeader(ctx context.Context, req *types.QueryEthHeaderRequest) (*types.QueryEthHeaderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	header, err := q.k.EthHeaders.Get(ctx, req.Number)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "eth header %d not tracked", req.Number)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryEthHeaderResponse{Header: &header}, nil
+}
@@ -12,17 +12,21 @@ import (
 // CombinedGameResponse combines game metadata with public game state
 type CombinedGameResponse struct {
 	// Game metadata
-	GameId     string   `json:"gameId"`
-	Creator    string   `json:"creator"`
-	MinBuyIn   uint64   `json:"minBuyIn"`
-	MaxBuyIn   uint64   `json:"maxBuyIn"`
-	MinPlayers int64    `json:"minPlayers"`
-	MaxPlayers int64    `json:"maxPlayers"`
-	SmallBlind uint64   `json:"smallBlind"`
-	BigBlind   uint64   `json:"bigBlind"`
-	Timeout    int64    `json:"timeout"`
-	GameType   string   `json:"gameType"`
-	Players    []string `json:"players"`
+	GameId     string            `json:"gameId"`
+	Creator    string            `json:"creator"`
+	MinBuyIn   uint64            `json:"minBuyIn"`
+	MaxBuyIn   uint64            `json:"maxBuyIn"`
+	MinPlayers int64             `json:"minPlayers"`
+	MaxPlayers int64             `json:"maxPlayers"`
+	SmallBlind uint64            `json:"smallBlind"`
+	BigBlind   uint64            `json:"bigBlind"`
+	Timeout    int64             `json:"timeout"`
+	GameType   string            `json:"gameType"`
+	Players    []string          `json:"players"`
+	Denom      string            `json:"denom"`
+	Status     types.TableStatus `json:"status"`
+	// Spectators is who may watch the table, and how (see GameStateSpectator)
+	Spectators types.SpectatorMode `json:"spectators"`
 
 	// Game state (public view with masked cards)
 	GameState *types.TexasHoldemStateDTO `json:"gameState,omitempty"`
@@ -56,6 +60,9 @@ func (q queryServer) Game(ctx context.Context, req *types.QueryGameRequest) (*ty
 		Timeout:    game.Timeout,
 		GameType:   game.GameType,
 		Players:    game.Players,
+		Denom:      game.TableDenom(),
+		Status:     game.Status,
+		Spectators: game.SpectatorMode(),
 	}
 
 	// Try to get game state (may not exist for new games)
@@ -63,6 +70,9 @@ func (q queryServer) Game(ctx context.Context, req *types.QueryGameRequest) (*ty
 	if err == nil {
 		// Mask all cards for public view
 		maskedState := maskAllCards(gameState)
+		if maskedState, err = q.k.withPlayerDetails(ctx, req.GameId, maskedState); err != nil {
+			return nil, status.Error(codes.Internal, "failed to load player details")
+		}
 		combined.GameState = &maskedState
 	}
 
@@ -69,6 +69,9 @@ func (q queryServer) GameState(ctx context.Context, req *types.QueryGameStateReq
 
 	// Mask cards that don't belong to the requesting player
 	maskedGameState := maskOtherPlayersCards(gameState, req.PlayerAddress)
+	if maskedGameState, err = q.k.withPlayerDetails(ctx, req.GameId, maskedGameState); err != nil {
+		return nil, status.Error(codes.Internal, "failed to load player details")
+	}
 
 	// Convert game state to JSON string for response
 	gameStateBytes, err := json.Marshal(maskedGameState)
@@ -27,6 +27,9 @@ func (q queryServer) GameStatePublic(ctx context.Context, req *types.QueryGameSt
 
 	// Mask ALL hole cards for public view (passing empty address means no player match)
 	maskedGameState := maskAllHoleCards(gameState)
+	if maskedGameState, err = q.k.withPlayerDetails(ctx, req.GameId, maskedGameState); err != nil {
+		return nil, status.Error(codes.Internal, "failed to load player details")
+	}
 
 	// Convert game state to JSON string for response
 	gameStateBytes, err := json.Marshal(maskedGameState)
@@ -72,3 +75,15 @@ func maskAllHoleCards(gameState types.TexasHoldemStateDTO) types.TexasHoldemStat
 
 	return maskedState
 }
+
+// spectatorView builds what spectators of a table see. During a hand it is
+// the public view; once the hand is over, tables that hold spectators back
+// reveal every hole card dealt, since the hand can no longer be affected.
+func spectatorView(game types.Game, gameState types.TexasHoldemStateDTO) types.TexasHoldemStateDTO {
+	if game.SpectatorMode() != types.SpectatorsDelayed || gameState.Round != types.RoundShowdown {
+		return maskAllHoleCards(gameState)
+	}
+	view := gameState
+	view.Deck = "X"
+	return view
+}
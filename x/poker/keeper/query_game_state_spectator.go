@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/block52/pokerchain/x/poker/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GameStateSpectator returns the spectator view of a table's current state
+// with the table's spectator policy. Spectator channels hold the view back
+// by the policy's delay; tables that are off to spectators are refused. The
+// query itself is live: the delay is applied by the channel, not the chain.
+func (q queryServer) GameStateSpectator(ctx context.Context, req *types.QueryGameStateSpectatorRequest) (*types.QueryGameStateSpectatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GameId == "" {
+		return nil, status.Error(codes.InvalidArgument, "game ID cannot be empty")
+	}
+
+	game, err := q.k.Games.Get(ctx, req.GameId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "game with ID %s not found", req.GameId)
+	}
+	if game.SpectatorMode() == types.SpectatorsOff {
+		return nil, status.Errorf(codes.PermissionDenied, "game %s does not allow spectators", req.GameId)
+	}
+
+	gameState, err := q.k.GameStates.Get(ctx, req.GameId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "game state with ID %s not found", req.GameId)
+	}
+	view, err := q.k.withPlayerDetails(ctx, req.GameId, spectatorView(game, gameState))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load player details")
+	}
+
+	gameStateBytes, err := json.Marshal(view)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to serialize game state data")
+	}
+
+	return &types.QueryGameStateSpectatorResponse{
+		GameState:    string(gameStateBytes),
+		Spectators:   string(game.SpectatorMode()),
+		DelayActions: game.SpectatorDelayActions,
+		DelaySeconds: game.SpectatorDelaySeconds,
+	}, nil
+}
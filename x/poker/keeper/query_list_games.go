@@ -17,9 +17,13 @@ func (q queryServer) ListGames(ctx context.Context, req *types.QueryListGamesReq
 	// Collect all games from the Games collection
 	var games []types.Game
 
-	// Iterate over all games in the collection
+	// Iterate over the lobby's games, optionally filtered by currency.
+	// Unlisted and private tables are left out.
 	err := q.k.Games.Walk(ctx, nil, func(gameId string, game types.Game) (bool, error) {
-		games = append(games, game)
+		if !game.IsListed() || (req.Denom != "" && game.TableDenom() != req.Denom) {
+			return false, nil
+		}
+		games = append(games, game.Public())
 		return false, nil // false means continue iterating
 	})
 
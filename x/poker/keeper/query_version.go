@@ -14,7 +14,7 @@ func (q queryServer) Version(ctx context.Context, req *types.QueryVersionRequest
 	chainVersion := &types.ChainVersion{
 		Name:             "pokerchain",
 		Version:          version.Version,
-		ConsensusVersion: 1,
+		ConsensusVersion: types.ConsensusVersion,
 	}
 
 	// Check PVM health
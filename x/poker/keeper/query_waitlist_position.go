@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/block52/pokerchain/x/poker/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) WaitlistPosition(ctx context.Context, req *types.QueryWaitlistPositionRequest) (*types.QueryWaitlistPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	game, err := q.k.Games.Get(ctx, req.GameId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "game with ID %s not found", req.GameId)
+	}
+
+	res := &types.QueryWaitlistPositionResponse{
+		Position:     uint64(game.WaitlistPosition(req.Player)),
+		WaitlistSize: uint64(len(game.Waitlist)),
+	}
+	if r, ok := game.Reservation(req.Player); ok {
+		res.ReservedSeat = r.Seat
+		res.ReservedUntil = r.ExpiresAt.Unix()
+	}
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+)
+
+// proofList collects trie proof nodes in the order they are written
+type proofList [][]byte
+
+func (p *proofList) Put(key []byte, value []byte) error {
+	*p = append(*p, value)
+	return nil
+}
+
+func (p *proofList) Delete(key []byte) error {
+	return fmt.Errorf("proof list does not support deletes")
+}
+
+// BuildReceiptProof builds the receipt trie of a block from its consensus
+// encoded receipts (as returned by debug_getRawReceipts) and returns the
+// receipts root together with the proof for the receipt at txIndex.
+//
+// This is used off-chain by relayers; on-chain verification only needs the
+// proof nodes and the tracked header's receipts root.
+func BuildReceiptProof(receipts [][]byte, txIndex uint64) (common.Hash, [][]byte, error) {
+	if txIndex >= uint64(len(receipts)) {
+		return common.Hash{}, nil, fmt.Errorf("tx index %d out of range (%d receipts)", txIndex, len(receipts))
+	}
+
+	tr := trie.NewEmpty(triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+	for i, receipt := range receipts {
+		key, err := rlp.EncodeToBytes(uint64(i))
+		if err != nil {
+			return common.Hash{}, nil, err
+		}
+		if err := tr.Update(key, receipt); err != nil {
+			return common.Hash{}, nil, err
+		}
+	}
+
+	key, err := rlp.EncodeToBytes(txIndex)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	var proof proofList
+	if err := tr.Prove(key, &proof); err != nil {
+		return common.Hash{}, nil, err
+	}
+	return tr.Hash(), proof, nil
+}
@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/block52/pokerchain/x/poker/equity"
+	"github.com/block52/pokerchain/x/poker/pots"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// ShowdownHand is the on-chain evaluation of one live hand at showdown
+type ShowdownHand struct {
+	Address     string   `json:"address"`
+	Seat        int      `json:"seat"`
+	HoleCards   []string `json:"holeCards"`
+	Rank        string   `json:"rank"`
+	Description string   `json:"description"`
+	Score       uint32   `json:"score"`
+	Payout      uint64   `json:"payout"`
+}
+
+// ShowdownPot is a main or side pot together with the seats that won it
+type ShowdownPot struct {
+	Amount  uint64   `json:"amount"`
+	Winners []string `json:"winners"`
+}
+
+// ShowdownResult is the independently computed outcome of a hand
+type ShowdownResult struct {
+	Hands       []ShowdownHand `json:"hands"`
+	Pots        []ShowdownPot  `json:"pots"`
+	UncalledBet *ShowdownPot   `json:"uncalledBet,omitempty"`
+	// Rake is the table's rake on the hand, paid to the rake owner
+	Rake    *ShowdownPot      `json:"rake,omitempty"`
+	Payouts map[string]uint64 `json:"payouts"`
+}
+
+// showdownPlayer is a player who committed chips to the hand
+type showdownPlayer struct {
+	pots.Contribution
+	holeCards []string        // only set for live players once the board is dealt
+	hole      equity.CardMask // hole cards as a mask
+}
+
+// VerifyShowdown evaluates every live hand in a showdown state, splits the
+// chips committed to the hand into a main pot and side pots, and checks that
+// the engine's winners and amounts match exactly.
+//
+// Each player's SumOfBets is their total contribution to the hand. Uncalled
+// bets are returned to the bettor and count towards their winnings. At a
+// raked table the rake is taken from the pots first, as the engine does, so
+// the winners are paid net of it. Odd chips from a split pot go to the
+// winners closest to the left of the dealer.
+func VerifyShowdown(state types.TexasHoldemStateDTO) (*ShowdownResult, error) {
+	players, board, err := collectShowdownPlayers(state)
+	if err != nil {
+		return nil, err
+	}
+	rake, rakeOwner, err := tableRake(state.GameOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	contributions := make([]pots.Contribution, len(players))
+	holes := make(map[string]equity.CardMask)
+	for i, p := range players {
+		contributions[i] = p.Contribution
+		if p.hole != 0 {
+			holes[p.Address] = p.hole
+		}
+	}
+
+	built, refund, err := pots.Build(contributions)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidShowdown, err.Error())
+	}
+	for _, pot := range built {
+		if len(pot.Eligible) > 1 && board.Count() != 5 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidShowdown,
+				"%d community cards dealt but %d players contest a pot", board.Count(), len(pot.Eligible))
+		}
+	}
+
+	scores := pots.ScoreHands(board, holes)
+	awards := pots.DistributeRaked(built, scores, state.Dealer, rake)
+	result := &ShowdownResult{Payouts: pots.Totals(awards, refund)}
+	if amount := pots.TotalRake(awards); amount > 0 {
+		result.Rake = &ShowdownPot{Amount: amount, Winners: []string{rakeOwner}}
+	}
+
+	for _, award := range awards {
+		pot := ShowdownPot{Amount: award.Amount}
+		for _, w := range award.Winners {
+			pot.Winners = append(pot.Winners, w.Address)
+		}
+		result.Pots = append(result.Pots, pot)
+	}
+	if refund != nil {
+		result.UncalledBet = &ShowdownPot{Amount: refund.Amount, Winners: []string{refund.Address}}
+	}
+
+	// Report every evaluated hand in table order
+	for _, p := range players {
+		if p.hole == 0 {
+			continue
+		}
+		score := scores[p.Address]
+		result.Hands = append(result.Hands, ShowdownHand{
+			Address:     p.Address,
+			Seat:        p.Seat,
+			HoleCards:   p.holeCards,
+			Rank:        equity.HandRank(score >> 20).String(),
+			Description: equity.DescribeScore(score),
+			Score:       score,
+			Payout:      result.Payouts[p.Address],
+		})
+	}
+
+	if err := compareWinners(state.Winners, result.Payouts, result.Rake); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// tableRake returns the rake the engine takes at a table and its owner
+func tableRake(options types.GameOptionsDTO) (pots.Rake, string, error) {
+	config := options.Rake
+	if config == nil || config.RakePercentage == 0 {
+		return pots.Rake{}, "", nil
+	}
+	if config.RakePercentage < 0 || config.RakePercentage > pots.MaxRakePercent {
+		return pots.Rake{}, "", errorsmod.Wrapf(types.ErrInvalidShowdown, "invalid rake percentage %d", config.RakePercentage)
+	}
+	rake := pots.Rake{Percent: uint32(config.RakePercentage)}
+	for _, field := range []struct {
+		name  string
+		value string
+		dest  *uint64
+	}{
+		{"rakeFreeThreshold", config.RakeFreeThreshold, &rake.Threshold},
+		{"rakeCap", config.RakeCap, &rake.Cap},
+	} {
+		if field.value == "" {
+			continue
+		}
+		amount, err := strconv.ParseUint(field.value, 10, 64)
+		if err != nil {
+			return pots.Rake{}, "", errorsmod.Wrapf(types.ErrInvalidShowdown, "invalid %s %q", field.name, field.value)
+		}
+		*field.dest = amount
+	}
+
+	owner := config.Owner
+	if owner == "" && options.Owner != nil {
+		owner = *options.Owner
+	}
+	return rake, owner, nil
+}
+
+// collectShowdownPlayers parses contributions and cards for every player in
+// the hand and returns the board as a card mask
+func collectShowdownPlayers(state types.TexasHoldemStateDTO) ([]showdownPlayer, equity.CardMask, error) {
+	board, err := cardMask(state.CommunityCards)
+	if err != nil {
+		return nil, 0, errorsmod.Wrapf(types.ErrInvalidShowdown, "community cards: %v", err)
+	}
+	used := board
+
+	players := make([]showdownPlayer, 0, len(state.Players))
+	for _, player := range state.Players {
+		var contribution uint64
+		if player.SumOfBets != "" {
+			contribution, err = strconv.ParseUint(player.SumOfBets, 10, 64)
+			if err != nil {
+				return nil, 0, errorsmod.Wrapf(types.ErrInvalidShowdown,
+					"invalid sumOfBets %q for %s", player.SumOfBets, player.Address)
+			}
+		}
+
+		hasCards := player.HoleCards != nil && len(*player.HoleCards) > 0
+		live := isLiveAtShowdown(player) && (hasCards || contribution > 0)
+		if !live && contribution == 0 {
+			continue
+		}
+
+		p := showdownPlayer{
+			Contribution: pots.Contribution{
+				Player: pots.Player{Address: player.Address, Seat: player.Seat},
+				Amount: contribution,
+				Folded: !live,
+				AllIn:  player.Status == types.StatusAllIn,
+			},
+		}
+		if live && board != 0 {
+			if !hasCards {
+				return nil, 0, errorsmod.Wrapf(types.ErrInvalidShowdown, "live player %s has no hole cards", player.Address)
+			}
+			hole, err := cardMask(*player.HoleCards)
+			if err != nil || hole.Count() != 2 {
+				return nil, 0, errorsmod.Wrapf(types.ErrInvalidShowdown,
+					"invalid hole cards %v for %s", *player.HoleCards, player.Address)
+			}
+			if used&hole != 0 {
+				return nil, 0, errorsmod.Wrapf(types.ErrInvalidShowdown, "duplicate card in hand of %s", player.Address)
+			}
+			used |= hole
+			p.holeCards = *player.HoleCards
+			p.hole = hole
+		}
+		players = append(players, p)
+	}
+	return players, board, nil
+}
+
+// isLiveAtShowdown reports whether a player is still contesting the pot
+func isLiveAtShowdown(player types.PlayerDTO) bool {
+	if player.LastAction != nil && player.LastAction.Action == string(Muck) {
+		return false
+	}
+	switch player.Status {
+	case types.StatusActive, types.StatusAllIn, types.StatusShowing:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareWinners checks the engine's winners against the computed payouts.
+// The engine may list the rake owner among the winners with the rake, which
+// isn't part of the payouts.
+func compareWinners(winners []types.WinnerDTO, payouts map[string]uint64, rake *ShowdownPot) error {
+	reported := make(map[string]uint64)
+	for _, w := range winners {
+		amount, err := strconv.ParseUint(w.Amount, 10, 64)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidShowdown, "invalid winner amount %q for %s", w.Amount, w.Address)
+		}
+		if amount > 0 {
+			reported[w.Address] += amount
+		}
+	}
+	if rake != nil {
+		owner := rake.Winners[0]
+		if reported[owner] == payouts[owner]+rake.Amount {
+			reported[owner] -= rake.Amount
+			if reported[owner] == 0 {
+				delete(reported, owner)
+			}
+		}
+	}
+
+	// Check addresses in sorted order so the reported mismatch is deterministic
+	addresses := make([]string, 0, len(payouts)+len(reported))
+	for address := range payouts {
+		addresses = append(addresses, address)
+	}
+	for address := range reported {
+		if _, ok := payouts[address]; !ok {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		expected, got := payouts[address], reported[address]
+		switch {
+		case expected == got:
+			continue
+		case expected == 0:
+			return errorsmod.Wrapf(types.ErrShowdownMismatch,
+				"engine reported %s winning %d but on-chain evaluation awards nothing", address, got)
+		default:
+			return errorsmod.Wrapf(types.ErrShowdownMismatch,
+				"%s should win %d but engine reported %d", address, expected, got)
+		}
+	}
+	return nil
+}
+
+// cardMask parses card mnemonics into a mask, rejecting invalid and duplicate cards
+func cardMask(mnemonics []string) (equity.CardMask, error) {
+	cards, err := equity.CardsFromMnemonics(mnemonics)
+	if err != nil {
+		return 0, err
+	}
+	mask := equity.MaskFromCards(cards)
+	if mask.Count() != len(cards) {
+		return 0, fmt.Errorf("invalid or duplicate card in %v", mnemonics)
+	}
+	return mask, nil
+}
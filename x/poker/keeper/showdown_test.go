@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// showdownPlayer builds a player DTO for showdown tests
+func showdownPlayer(address string, seat int, status types.PlayerStatus, sumOfBets string, cards ...string) types.PlayerDTO {
+	player := types.PlayerDTO{
+		Address:   address,
+		Seat:      seat,
+		Status:    status,
+		SumOfBets: sumOfBets,
+	}
+	if len(cards) > 0 {
+		player.HoleCards = &cards
+	}
+	return player
+}
+
+func showdownState(dealer int, board []string, winners []types.WinnerDTO, players ...types.PlayerDTO) types.TexasHoldemStateDTO {
+	return types.TexasHoldemStateDTO{
+		Dealer:         dealer,
+		Round:          types.RoundShowdown,
+		CommunityCards: board,
+		Players:        players,
+		Winners:        winners,
+	}
+}
+
+func TestVerifyShowdown(t *testing.T) {
+	board := []string{"2C", "7D", "9H", "JS", "KD"}
+
+	tests := []struct {
+		name        string
+		state       types.TexasHoldemStateDTO
+		wantErr     error
+		wantPayouts map[string]uint64
+		wantPots    int
+	}{
+		{
+			name: "best hand wins whole pot",
+			state: showdownState(1, board,
+				[]types.WinnerDTO{{Address: "alice", Amount: "200"}},
+				showdownPlayer("alice", 1, types.StatusActive, "100", "AS", "AH"),
+				showdownPlayer("bob", 2, types.StatusActive, "100", "KS", "QH"),
+			),
+			wantPayouts: map[string]uint64{"alice": 200},
+			wantPots:    1,
+		},
+		{
+			name: "folded contributions go to the winner",
+			state: showdownState(1, board,
+				[]types.WinnerDTO{{Address: "bob", Amount: "250"}},
+				showdownPlayer("alice", 1, types.StatusFolded, "50", "AS", "AH"),
+				showdownPlayer("bob", 2, types.StatusActive, "100", "KS", "QH"),
+				showdownPlayer("carol", 3, types.StatusActive, "100", "3C", "4C"),
+			),
+			wantPayouts: map[string]uint64{"bob": 250},
+			wantPots:    1,
+		},
+		{
+			name: "short all-in wins main pot only",
+			state: showdownState(1, board,
+				[]types.WinnerDTO{{Address: "alice", Amount: "150"}, {Address: "bob", Amount: "200"}},
+				showdownPlayer("alice", 1, types.StatusAllIn, "50", "AS", "AH"),
+				showdownPlayer("bob", 2, types.StatusActive, "150", "KS", "QH"),
+				showdownPlayer("carol", 3, types.StatusActive, "150", "3C", "4C"),
+			),
+			wantPayouts: map[string]uint64{"alice": 150, "bob": 200},
+			wantPots:    2,
+		},
+		{
+			name: "uncalled bet returns to bettor",
+			state: showdownState(1, board,
+				[]types.WinnerDTO{{Address: "alice", Amount: "100"}, {Address: "bob", Amount: "100"}},
+				showdownPlayer("alice", 1, types.StatusAllIn, "50", "AS", "AH"),
+				showdownPlayer("bob", 2, types.StatusActive, "150", "KS", "QH"),
+			),
+			wantPayouts: map[string]uint64{"alice": 100, "bob": 100},
+			wantPots:    1,
+		},
+		{
+			name: "odd chip goes left of dealer",
+			state: showdownState(2, []string{"TS", "JS", "QD", "KC", "AH"},
+				[]types.WinnerDTO{{Address: "alice", Amount: "51"}, {Address: "bob", Amount: "50"}},
+				showdownPlayer("alice", 1, types.StatusActive, "50", "2C", "3D"),
+				showdownPlayer("bob", 2, types.StatusActive, "50", "4C", "5D"),
+				showdownPlayer("carol", 3, types.StatusFolded, "1"),
+			),
+			wantPayouts: map[string]uint64{"alice": 51, "bob": 50},
+			wantPots:    1,
+		},
+		{
+			name: "last live player wins without cards",
+			state: showdownState(1, nil,
+				[]types.WinnerDTO{{Address: "bob", Amount: "30"}},
+				showdownPlayer("alice", 1, types.StatusFolded, "10"),
+				showdownPlayer("bob", 2, types.StatusActive, "20"),
+			),
+			wantPayouts: map[string]uint64{"bob": 30},
+			wantPots:    1,
+		},
+		{
+			name: "wrong winner rejected",
+			state: showdownState(1, board,
+				[]types.WinnerDTO{{Address: "bob", Amount: "200"}},
+				showdownPlayer("alice", 1, types.StatusActive, "100", "AS", "AH"),
+				showdownPlayer("bob", 2, types.StatusActive, "100", "KS", "QH"),
+			),
+			wantErr: types.ErrShowdownMismatch,
+		},
+		{
+			name: "wrong amount rejected",
+			state: showdownState(1, board,
+				[]types.WinnerDTO{{Address: "alice", Amount: "199"}},
+				showdownPlayer("alice", 1, types.StatusActive, "100", "AS", "AH"),
+				showdownPlayer("bob", 2, types.StatusActive, "100", "KS", "QH"),
+			),
+			wantErr: types.ErrShowdownMismatch,
+		},
+		{
+			name: "missing hole cards rejected",
+			state: showdownState(1, board,
+				[]types.WinnerDTO{{Address: "alice", Amount: "200"}},
+				showdownPlayer("alice", 1, types.StatusActive, "100", "AS", "AH"),
+				showdownPlayer("bob", 2, types.StatusActive, "100"),
+			),
+			wantErr: types.ErrInvalidShowdown,
+		},
+		{
+			name: "duplicate card rejected",
+			state: showdownState(1, board,
+				[]types.WinnerDTO{{Address: "alice", Amount: "200"}},
+				showdownPlayer("alice", 1, types.StatusActive, "100", "AS", "AH"),
+				showdownPlayer("bob", 2, types.StatusActive, "100", "AS", "QH"),
+			),
+			wantErr: types.ErrInvalidShowdown,
+		},
+		{
+			name: "incomplete board rejected when pot is contested",
+			state: showdownState(1, board[:3],
+				[]types.WinnerDTO{{Address: "alice", Amount: "200"}},
+				showdownPlayer("alice", 1, types.StatusActive, "100", "AS", "AH"),
+				showdownPlayer("bob", 2, types.StatusActive, "100", "KS", "QH"),
+			),
+			wantErr: types.ErrInvalidShowdown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := keeper.VerifyShowdown(tt.state)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, result.Pots, tt.wantPots)
+			for address, amount := range tt.wantPayouts {
+				require.Equal(t, amount, result.Payouts[address], "payout for %s", address)
+			}
+		})
+	}
+}
+
+func TestVerifyShowdown_Rake(t *testing.T) {
+	board := []string{"2C", "7D", "9H", "JS", "KD"}
+	raked := func(winners ...types.WinnerDTO) types.TexasHoldemStateDTO {
+		state := showdownState(1, board, winners,
+			showdownPlayer("alice", 1, types.StatusActive, "1000", "AS", "AH"),
+			showdownPlayer("bob", 2, types.StatusActive, "1000", "KS", "QH"),
+		)
+		// 5% of the 2000 pot is 100, capped at 60
+		state.GameOptions.Rake = &types.RakeConfigDTO{
+			RakeFreeThreshold: "500",
+			RakePercentage:    5,
+			RakeCap:           "60",
+			Owner:             "house",
+		}
+		return state
+	}
+
+	result, err := keeper.VerifyShowdown(raked(types.WinnerDTO{Address: "alice", Amount: "1940"}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1940), result.Payouts["alice"])
+	require.Equal(t, &keeper.ShowdownPot{Amount: 60, Winners: []string{"house"}}, result.Rake)
+
+	// The engine may also list the rake owner with the rake
+	_, err = keeper.VerifyShowdown(raked(
+		types.WinnerDTO{Address: "alice", Amount: "1940"},
+		types.WinnerDTO{Address: "house", Amount: "60"},
+	))
+	require.NoError(t, err)
+
+	// Paying the gross pot doesn't match a raked table
+	_, err = keeper.VerifyShowdown(raked(types.WinnerDTO{Address: "alice", Amount: "2000"}))
+	require.ErrorIs(t, err, types.ErrShowdownMismatch)
+}
+
+func TestVerifyShowdown_HandDescriptions(t *testing.T) {
+	state := showdownState(1, []string{"2C", "7D", "9H", "JS", "KD"},
+		[]types.WinnerDTO{{Address: "alice", Amount: "200"}},
+		showdownPlayer("alice", 1, types.StatusShowing, "100", "AS", "AH"),
+		showdownPlayer("bob", 2, types.StatusShowing, "100", "KS", "QH"),
+	)
+
+	result, err := keeper.VerifyShowdown(state)
+	require.NoError(t, err)
+	require.Len(t, result.Hands, 2)
+
+	require.Equal(t, "alice", result.Hands[0].Address)
+	require.Equal(t, "One Pair", result.Hands[0].Rank)
+	require.Equal(t, "Pair of Aces", result.Hands[0].Description)
+	require.Equal(t, uint64(200), result.Hands[0].Payout)
+
+	require.Equal(t, "Pair of Kings", result.Hands[1].Description)
+	require.Equal(t, uint64(0), result.Hands[1].Payout)
+}
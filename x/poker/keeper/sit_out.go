@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// trackSitOuts brings a table's sit-out records up to date with a game state
+// the engine has just returned, reporting whether the game changed.
+//
+// A player who starts sitting out is recorded from the block time. Each new
+// hand dealt without them counts towards the table's sit-out limit, and
+// marks the blinds the button moved past them as missed. A player who sits
+// back in owes their missed blinds as dead money if the table says so; the
+// engine collects them the next time the player is dealt in, and they are
+// settled once that hand is over.
+func trackSitOuts(ctx sdk.Context, game *types.Game, before, after types.TexasHoldemStateDTO, newHand bool) bool {
+	changed := false
+	seated := make(map[string]types.PlayerDTO, len(after.Players))
+	for _, p := range after.Players {
+		seated[p.Address] = p
+	}
+
+	for _, sitOut := range game.SitOuts {
+		p, ok := seated[sitOut.Player]
+		switch {
+		case !ok:
+			// Left the table
+			game.RemoveSitOut(sitOut.Player)
+			game.SetDeadBlind(sitOut.Player, 0)
+			changed = true
+		case p.Status != types.StatusSittingOut:
+			game.RemoveSitOut(sitOut.Player)
+			owed := uint64(0)
+			if game.PostMissedBlinds {
+				owed = game.MissedBlinds(sitOut)
+				game.SetDeadBlind(sitOut.Player, owed)
+			}
+			changed = true
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					"player_sat_in",
+					sdk.NewAttribute("game_id", game.GameId),
+					sdk.NewAttribute("player", sitOut.Player),
+					sdk.NewAttribute("hands_missed", strconv.FormatUint(sitOut.Hands, 10)),
+					sdk.NewAttribute("dead_blinds", strconv.FormatUint(owed, 10)),
+				),
+			})
+		case newHand:
+			sitOut.Hands++
+			sitOut.MissedSmallBlind = sitOut.MissedSmallBlind || passedOver(before.SmallBlindPosition, after.SmallBlindPosition, p.Seat)
+			sitOut.MissedBigBlind = sitOut.MissedBigBlind || passedOver(before.BigBlindPosition, after.BigBlindPosition, p.Seat)
+			game.SetSitOut(sitOut)
+			changed = true
+		}
+	}
+
+	for _, p := range after.Players {
+		if _, ok := game.SitOut(p.Address); ok || p.Status != types.StatusSittingOut {
+			continue
+		}
+		game.SetSitOut(types.SitOut{Player: p.Address, Since: ctx.BlockTime()})
+		changed = true
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"player_sat_out",
+				sdk.NewAttribute("game_id", game.GameId),
+				sdk.NewAttribute("player", p.Address),
+			),
+		})
+	}
+
+	// Dead blinds are posted as the player is dealt in, and the engine keeps
+	// them apart from the player's bets until the hand is over
+	if after.Round == types.RoundShowdown && before.Round != types.RoundShowdown {
+		for _, d := range game.DeadBlinds {
+			switch seated[d.Player].Status {
+			case types.StatusActive, types.StatusAllIn, types.StatusFolded:
+				game.SetDeadBlind(d.Player, 0)
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// passedOver reports whether a blind moving clockwise from one seat to
+// another skipped the given seat. A blind that has not been posted before
+// skips nobody.
+func passedOver(from, to, seat int) bool {
+	switch {
+	case from == 0:
+		return false
+	case from < to:
+		return from < seat && seat < to
+	case from > to:
+		return seat > from || seat < to
+	default:
+		return seat != from
+	}
+}
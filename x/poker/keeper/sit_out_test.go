@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestSitOutRules(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	start := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.HandStartDelay = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	var players []string
+	for _, name := range []string{"alice_______________", "bob_________________", "carol_______________"} {
+		addr, err := f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		fundUSDC(t, f, bank, addr, 10_000)
+		players = append(players, addr)
+	}
+	carol := players[2]
+
+	_, err = ms.CreateGame(ctx, &types.MsgCreateGame{
+		Creator: players[0], MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+		SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash",
+		MaxSitOutHands: 5, MaxSitOutMinutes: 10, PostMissedBlinds: true,
+	})
+	require.NoError(t, err)
+	var gameId string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameId = id
+		return true, nil
+	}))
+	for i, player := range players {
+		_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: player, GameId: gameId, Seat: uint64(i + 1), BuyInAmount: 1000})
+		require.NoError(t, err)
+	}
+
+	getGame := func() types.Game {
+		game, err := f.keeper.Games.Get(ctx, gameId)
+		require.NoError(t, err)
+		return game
+	}
+	getState := func() types.TexasHoldemStateDTO {
+		state, err := f.keeper.GameStates.Get(ctx, gameId)
+		require.NoError(t, err)
+		return state
+	}
+	emitted := func(eventType string) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				return true
+			}
+		}
+		return false
+	}
+	// playHand deals a hand and has everyone fold to the last player in it
+	playHand := func() {
+		require.NoError(t, f.keeper.AdvanceHands(ctx))
+		state := getState()
+		require.Equal(t, types.RoundPreflop, state.Round)
+		for state.Round != types.RoundShowdown {
+			for _, p := range state.Players {
+				if p.Seat == state.NextToAct {
+					_, err := ms.PerformAction(ctx, &types.MsgPerformAction{Player: p.Address, GameId: gameId, Action: "fold"})
+					require.NoError(t, err)
+				}
+			}
+			state = getState()
+		}
+	}
+
+	// Sitting out is recorded from the block time
+	_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: carol, GameId: gameId, Action: "sit-out"})
+	require.NoError(t, err)
+	require.True(t, emitted("player_sat_out"))
+	sitOut, ok := getGame().SitOut(carol)
+	require.True(t, ok)
+	require.True(t, sitOut.Since.Equal(start))
+
+	// Hands dealt without them count, and the small blind passes them by in
+	// the second
+	playHand()
+	playHand()
+	sitOut, _ = getGame().SitOut(carol)
+	require.Equal(t, uint64(2), sitOut.Hands)
+	require.True(t, sitOut.MissedSmallBlind)
+	require.False(t, sitOut.MissedBigBlind)
+
+	// Sitting back in, they owe the small blind as dead money
+	_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: carol, GameId: gameId, Action: "sit-in"})
+	require.NoError(t, err)
+	require.True(t, emitted("player_sat_in"))
+	game := getGame()
+	require.Empty(t, game.SitOuts)
+	require.Equal(t, uint64(5), game.DeadBlind(carol))
+
+	// which they post when next dealt in, on the button
+	playHand()
+	state := getState()
+	var carolBets string
+	for _, p := range state.Players {
+		if p.Address == carol {
+			carolBets = p.SumOfBets
+		}
+	}
+	require.Equal(t, "5", carolBets)
+	require.Zero(t, getGame().DeadBlind(carol))
+
+	// Sitting out past the table's time limit loses them their seat
+	_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: carol, GameId: gameId, Action: "sit-out"})
+	require.NoError(t, err)
+	addr, err := f.addressCodec.StringToBytes(carol)
+	require.NoError(t, err)
+	before := bank.SpendableCoins(ctx, addr).AmountOf(getGame().TableDenom())
+
+	ctx = ctx.WithBlockTime(start.Add(9 * time.Minute))
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.Contains(t, getGame().Players, carol)
+
+	ctx = ctx.WithBlockTime(start.Add(10 * time.Minute))
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.True(t, emitted("player_removed"))
+	game = getGame()
+	require.NotContains(t, game.Players, carol)
+	require.Empty(t, game.SitOuts)
+	require.True(t, bank.SpendableCoins(ctx, addr).AmountOf(game.TableDenom()).GT(before))
+}
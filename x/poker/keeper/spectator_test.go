@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestSpectatorView(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.HandStartDelay = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	var players []string
+	for _, name := range []string{"alice_______________", "bob_________________"} {
+		addr, err := f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		fundUSDC(t, f, bank, addr, 10_000)
+		players = append(players, addr)
+	}
+	table := func(spectators string, delayActions, delaySeconds uint64) *types.MsgCreateGame {
+		return &types.MsgCreateGame{
+			Creator: players[0], MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+			SmallBlind: 5, BigBlind: 10, Timeout: 60, GameType: "cash",
+			Spectators: spectators, SpectatorDelayActions: delayActions, SpectatorDelaySeconds: delaySeconds,
+		}
+	}
+
+	// The spectator policy is validated
+	for name, invalid := range map[string]*types.MsgCreateGame{
+		"unknown mode":          table("everyone", 0, 0),
+		"delay without delay":   table("delayed", 0, 0),
+		"delay on a live table": table("live", 5, 0),
+		"delay too long":        table("delayed", 0, types.MaxSpectatorDelaySeconds+1),
+	} {
+		_, err := ms.CreateGame(ctx, invalid)
+		require.ErrorIs(t, err, types.ErrInvalidRequest, name)
+	}
+
+	// createTable seats both players at a new table, a second after the last
+	// one was created
+	createTable := func(msg *types.MsgCreateGame) string {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+		var existing []string
+		require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+			existing = append(existing, id)
+			return false, nil
+		}))
+		_, err := ms.CreateGame(ctx, msg)
+		require.NoError(t, err)
+		var gameId string
+		require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+			if !slices.Contains(existing, id) {
+				gameId = id
+			}
+			return false, nil
+		}))
+		for i, player := range players {
+			_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: player, GameId: gameId, Seat: uint64(i + 1), BuyInAmount: 1000})
+			require.NoError(t, err)
+		}
+		return gameId
+	}
+	spectate := func(gameId string) (*types.QueryGameStateSpectatorResponse, types.TexasHoldemStateDTO) {
+		res, err := qs.GameStateSpectator(ctx, &types.QueryGameStateSpectatorRequest{GameId: gameId})
+		require.NoError(t, err)
+		var state types.TexasHoldemStateDTO
+		require.NoError(t, json.Unmarshal([]byte(res.GameState), &state))
+		require.Equal(t, "X", state.Deck)
+		return res, state
+	}
+	// shown counts the hole cards a view shows
+	shown := func(state types.TexasHoldemStateDTO) int {
+		n := 0
+		for _, p := range state.Players {
+			if p.HoleCards == nil {
+				continue
+			}
+			for _, card := range *p.HoleCards {
+				if card != "X" {
+					n++
+				}
+			}
+		}
+		return n
+	}
+	fold := func(gameId string) {
+		state, err := f.keeper.GameStates.Get(ctx, gameId)
+		require.NoError(t, err)
+		for _, p := range state.Players {
+			if p.Seat == state.NextToAct {
+				_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: p.Address, GameId: gameId, Action: "fold"})
+				require.NoError(t, err)
+			}
+		}
+		state, err = f.keeper.GameStates.Get(ctx, gameId)
+		require.NoError(t, err)
+		require.Equal(t, types.RoundShowdown, state.Round)
+	}
+
+	// Tables closed to spectators refuse them
+	closed := createTable(table("off", 0, 0))
+	_, err = qs.GameStateSpectator(ctx, &types.QueryGameStateSpectatorRequest{GameId: closed})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	live := createTable(table("", 0, 0))
+	delayed := createTable(table("delayed", 3, 30))
+	res, _ := spectate(live)
+	require.Equal(t, string(types.SpectatorsLive), res.Spectators)
+	res, _ = spectate(delayed)
+	require.Equal(t, string(types.SpectatorsDelayed), res.Spectators)
+	require.Equal(t, uint64(3), res.DelayActions)
+	require.Equal(t, uint64(30), res.DelaySeconds)
+
+	// Nobody's hole cards are shown during a hand
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	for _, gameId := range []string{live, delayed} {
+		_, state := spectate(gameId)
+		require.Equal(t, types.RoundPreflop, state.Round)
+		require.Zero(t, shown(state))
+	}
+
+	// Once the hand is over, delayed spectators see every hole card dealt,
+	// while live spectators only see the cards players showed
+	fold(live)
+	fold(delayed)
+	_, state := spectate(live)
+	require.Zero(t, shown(state))
+	_, state = spectate(delayed)
+	require.Equal(t, 4, shown(state))
+}
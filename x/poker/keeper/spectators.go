@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// validateSpectators checks the spectator policy of a table being created,
+// returning the mode with its default filled in. Only delayed tables take a
+// delay, and they need one.
+func validateSpectators(msg *types.MsgCreateGame) (types.SpectatorMode, error) {
+	mode := types.SpectatorMode(msg.Spectators)
+	if mode == "" {
+		mode = types.SpectatorsLive
+	}
+	if !mode.IsValid() {
+		return "", errorsmod.Wrapf(types.ErrInvalidRequest, "unknown spectator mode %q", msg.Spectators)
+	}
+
+	delayed := msg.SpectatorDelayActions > 0 || msg.SpectatorDelaySeconds > 0
+	switch {
+	case mode == types.SpectatorsDelayed && !delayed:
+		return "", errorsmod.Wrap(types.ErrInvalidRequest, "delayed spectators need a delay in actions or seconds")
+	case mode != types.SpectatorsDelayed && delayed:
+		return "", errorsmod.Wrap(types.ErrInvalidRequest, "only delayed spectators take a delay")
+	case msg.SpectatorDelayActions > types.MaxSpectatorDelayActions:
+		return "", errorsmod.Wrapf(types.ErrInvalidRequest, "spectator delay cannot exceed %d actions", types.MaxSpectatorDelayActions)
+	case msg.SpectatorDelaySeconds > types.MaxSpectatorDelaySeconds:
+		return "", errorsmod.Wrapf(types.ErrInvalidRequest, "spectator delay cannot exceed %d seconds", types.MaxSpectatorDelaySeconds)
+	}
+	return mode, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// validateTableAccess checks the visibility and access policy of a table
+// being created, returning the invite public key in lower case
+func (k msgServer) validateTableAccess(msg *types.MsgCreateGame) (string, error) {
+	if !types.TableVisibility(msg.Visibility).IsValid() {
+		return "", errorsmod.Wrapf(types.ErrInvalidRequest, "unknown table visibility %q", msg.Visibility)
+	}
+	if err := k.validateAddresses(msg.Allowlist); err != nil {
+		return "", err
+	}
+
+	invitePublicKey := strings.ToLower(msg.InvitePublicKey)
+	if invitePublicKey != "" {
+		if err := types.ValidateInvitePublicKey(invitePublicKey); err != nil {
+			return "", errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	if msg.RequiredDenom != "" {
+		if err := sdk.ValidateDenom(msg.RequiredDenom); err != nil {
+			return "", errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+		}
+		if msg.RequiredAmount == 0 {
+			return "", errorsmod.Wrap(types.ErrInvalidRequest, "required amount must be positive")
+		}
+	} else if msg.RequiredAmount > 0 {
+		return "", errorsmod.Wrap(types.ErrInvalidRequest, "required amount needs a required denom")
+	}
+	return invitePublicKey, nil
+}
+
+// validateAddresses checks that every allowlist entry is an account address
+func (k msgServer) validateAddresses(addresses []string) error {
+	for _, address := range addresses {
+		if _, err := k.addressCodec.StringToBytes(address); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidRequest, "invalid allowlist address %s: %v", address, err)
+		}
+	}
+	return nil
+}
+
+// checkTableAccess returns ErrTableAccessDenied unless the player may sit at
+// the table: the creator always may, and otherwise the player must be on the
+// allowlist, prove they know the invite code (see types.SignInvite) or hold
+// the required tokens
+func (k msgServer) checkTableAccess(ctx context.Context, game types.Game, player string, playerAddr sdk.AccAddress, inviteSignature []byte) error {
+	if !game.HasAccessPolicy() || player == game.Creator || game.IsAllowlisted(player) {
+		return nil
+	}
+	if game.VerifyInvite(player, inviteSignature) {
+		return nil
+	}
+	if game.RequiredDenom != "" {
+		held := k.bankKeeper.SpendableCoins(ctx, playerAddr).AmountOf(game.RequiredDenom)
+		if held.GTE(math.NewIntFromUint64(game.RequiredAmount)) {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(types.ErrTableAccessDenied, "player %s may not sit at game %s", player, game.GameId)
+}
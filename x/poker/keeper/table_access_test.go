@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestTableAccess(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	start := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	var players []string
+	for _, name := range []string{"alice_______________", "bob_________________", "carol_______________", "dave________________"} {
+		addr, err := f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		fundUSDC(t, f, bank, addr, 10_000)
+		players = append(players, addr)
+	}
+	alice, bob, carol, dave := players[0], players[1], players[2], players[3]
+
+	// Each table is created in its own block, as game IDs hash the block time
+	createTable := func(msg types.MsgCreateGame) (string, error) {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+		msg.Creator, msg.MinBuyIn, msg.MaxBuyIn, msg.MinPlayers, msg.MaxPlayers = alice, 100, 1000, 2, 6
+		msg.SmallBlind, msg.BigBlind, msg.Timeout, msg.GameType = 5, 10, 30, "cash"
+		if _, err := ms.CreateGame(ctx, &msg); err != nil {
+			return "", err
+		}
+		events := ctx.EventManager().Events()
+		for i := len(events) - 1; i >= 0; i-- {
+			if events[i].Type == "game_created" {
+				gameId, _ := events[i].GetAttribute("game_id")
+				return gameId.Value, nil
+			}
+		}
+		t.Fatal("no game_created event")
+		return "", nil
+	}
+	invite := func(gameId, code, player string) []byte {
+		signature, err := types.SignInvite(gameId, alice, code, player)
+		require.NoError(t, err)
+		return signature
+	}
+	joinSigned := func(player, gameId string, inviteSignature []byte) error {
+		_, err := ms.JoinGame(ctx, &types.MsgJoinGame{Player: player, GameId: gameId, BuyInAmount: 100, InviteSignature: inviteSignature})
+		return err
+	}
+	join := func(player, gameId, inviteCode string) error {
+		var signature []byte
+		if inviteCode != "" {
+			signature = invite(gameId, inviteCode, player)
+		}
+		return joinSigned(player, gameId, signature)
+	}
+
+	// Invalid policies are rejected
+	_, err := createTable(types.MsgCreateGame{Visibility: "secret"})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = createTable(types.MsgCreateGame{InvitePublicKey: "not-a-key"})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = createTable(types.MsgCreateGame{InvitePublicKey: "02" + strings.Repeat("ff", 32)})
+	require.ErrorIs(t, err, types.ErrInvalidRequest, "not a curve point")
+	_, err = createTable(types.MsgCreateGame{RequiredDenom: "member"})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = createTable(types.MsgCreateGame{Allowlist: []string{"not-an-address"}})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	public, err := createTable(types.MsgCreateGame{})
+	require.NoError(t, err)
+	unlisted, err := createTable(types.MsgCreateGame{Visibility: string(types.TableVisibilityUnlisted)})
+	require.NoError(t, err)
+	private, err := createTable(types.MsgCreateGame{
+		Visibility:      string(types.TableVisibilityPrivate),
+		Allowlist:       []string{bob},
+		InvitePublicKey: types.InvitePublicKey(alice, "letmein"),
+	})
+	require.NoError(t, err)
+	members, err := createTable(types.MsgCreateGame{RequiredDenom: "member", RequiredAmount: 2})
+	require.NoError(t, err)
+	invited, err := createTable(types.MsgCreateGame{InvitePublicKey: types.InvitePublicKey(alice, "letmein")})
+	require.NoError(t, err)
+
+	// Only public tables are in the lobby
+	res, err := qs.ListGames(ctx, &types.QueryListGamesRequest{})
+	require.NoError(t, err)
+	var games []types.Game
+	require.NoError(t, json.Unmarshal([]byte(res.Games), &games))
+	var listed []string
+	for _, game := range games {
+		listed = append(listed, game.GameId)
+		// The invite public key is never shown
+		require.Empty(t, game.InvitePublicKey)
+		require.Equal(t, game.GameId == invited, game.HasInviteCode)
+	}
+	require.ElementsMatch(t, []string{public, members, invited}, listed)
+
+	// Only the code's public key is stored, and a join proves the player
+	// knows the code without sending it
+	privateGame, err := f.keeper.Games.Get(ctx, private)
+	require.NoError(t, err)
+	require.Equal(t, types.InvitePublicKey(alice, "letmein"), privateGame.InvitePublicKey)
+	require.ErrorIs(t, join(dave, invited, "wrong"), types.ErrTableAccessDenied)
+	require.ErrorIs(t, join(dave, invited, ""), types.ErrTableAccessDenied)
+	carolInvite := invite(invited, "letmein", carol)
+	require.NoError(t, joinSigned(carol, invited, carolInvite))
+
+	// A signature read from the mempool only admits the player and table it
+	// was made for
+	require.ErrorIs(t, joinSigned(dave, invited, carolInvite), types.ErrTableAccessDenied)
+	require.ErrorIs(t, joinSigned(carol, private, carolInvite), types.ErrTableAccessDenied)
+
+	// Anyone with the ID can sit at an unlisted table
+	require.NoError(t, join(dave, unlisted, ""))
+
+	// A private table seats its creator, allowlisted players and players
+	// with the invite code
+	require.NoError(t, join(alice, private, ""))
+	require.NoError(t, join(bob, private, ""))
+	require.ErrorIs(t, join(dave, private, ""), types.ErrTableAccessDenied)
+	require.ErrorIs(t, join(dave, private, "wrong"), types.ErrTableAccessDenied)
+	_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: dave, GameId: private, Action: "join", Amount: 100})
+	require.ErrorIs(t, err, types.ErrInvalidAction, "joins only go through JoinGame")
+	require.NoError(t, join(carol, private, "letmein"))
+
+	// Players must hold enough of the required token
+	member := sdk.NewCoins(sdk.NewCoin("member", math.NewInt(1)))
+	daveAddr, err := f.addressCodec.StringToBytes(dave)
+	require.NoError(t, err)
+	require.NoError(t, bank.MintCoins(ctx, types.ModuleName, member))
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, daveAddr, member))
+	require.ErrorIs(t, join(dave, members, ""), types.ErrTableAccessDenied)
+	require.NoError(t, bank.MintCoins(ctx, types.ModuleName, member))
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, daveAddr, member))
+	require.NoError(t, join(dave, members, ""))
+
+	// Only the creator can change the allowlist
+	_, err = ms.UpdateAllowlist(ctx, &types.MsgUpdateAllowlist{Creator: bob, GameId: private, Add: []string{dave}})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.UpdateAllowlist(ctx, &types.MsgUpdateAllowlist{Creator: alice, GameId: private, Add: []string{dave, bob}, Remove: []string{bob}})
+	require.NoError(t, err)
+	game, err := f.keeper.Games.Get(ctx, private)
+	require.NoError(t, err)
+	require.Equal(t, []string{dave}, game.Allowlist)
+	require.NoError(t, join(dave, private, ""))
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// isBettingAction reports whether an action is a player's decision in a
+// betting round, which the action clock applies to
+func isBettingAction(action string) bool {
+	switch PlayerActionType(action) {
+	case Fold, Check, Call, Bet, Raise, AllIn:
+		return true
+	default:
+		return false
+	}
+}
+
+// currentTurn returns the player whose betting decision the table is waiting
+// on, and the block time their turn began (the time of the last action)
+func currentTurn(state types.TexasHoldemStateDTO) (types.PlayerDTO, time.Time, bool) {
+	if len(state.PreviousActions) == 0 {
+		return types.PlayerDTO{}, time.Time{}, false
+	}
+	started := time.UnixMilli(state.PreviousActions[len(state.PreviousActions)-1].Timestamp)
+	for _, p := range state.Players {
+		if p.Seat != state.NextToAct {
+			continue
+		}
+		if _, ok := findLegalAction(p, Fold); ok {
+			return p, started, true
+		}
+	}
+	return types.PlayerDTO{}, time.Time{}, false
+}
+
+// timeBankUsed returns the seconds of time bank a turn that began at started
+// has used by now, rounded up. Tables without an action timeout never use
+// the time bank.
+func timeBankUsed(now time.Time, game types.Game, started time.Time) uint64 {
+	if game.Timeout <= 0 {
+		return 0
+	}
+	over := now.Sub(started) - time.Duration(game.Timeout)*time.Second
+	if over <= 0 {
+		return 0
+	}
+	return uint64((over + time.Second - 1) / time.Second)
+}
+
+// timedOut reports whether the player to act has run through both the action
+// timeout and their time bank
+func timedOut(now time.Time, game types.Game, state types.TexasHoldemStateDTO, params types.Params) (types.PlayerDTO, bool) {
+	p, started, ok := currentTurn(state)
+	if !ok {
+		return types.PlayerDTO{}, false
+	}
+	return p, timeBankUsed(now, game, started) > game.TimeBank(p.Address, params.TimeBank).Seconds
+}
+
+// timeoutAction is what the module does for a player who runs out of time:
+// check if they can, otherwise fold
+func timeoutAction(p types.PlayerDTO) PlayerActionType {
+	if _, ok := findLegalAction(p, Check); ok {
+		return Check
+	}
+	return Fold
+}
+
+// chargeTimeBank draws the time a betting action took beyond the action
+// timeout from the acting player's time bank, reporting whether the bank
+// changed. A player who is out of time may only fold or take their timeout
+// action, as the module would at the end of the block.
+func (k *Keeper) chargeTimeBank(ctx sdk.Context, game *types.Game, state types.TexasHoldemStateDTO, player, action string) (bool, error) {
+	p, started, ok := currentTurn(state)
+	if !ok || p.Address != player {
+		return false, nil
+	}
+	used := timeBankUsed(ctx.BlockTime(), *game, started)
+	if used == 0 {
+		return false, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	bank := game.TimeBank(player, params.TimeBank)
+	if used > bank.Seconds {
+		if action != string(Fold) && action != string(timeoutAction(p)) {
+			return false, errorsmod.Wrapf(types.ErrActionTimedOut, "player %s has used their %ds time bank", player, bank.Seconds)
+		}
+		used = bank.Seconds
+	}
+	bank.Seconds -= used
+	game.SetTimeBank(bank)
+
+	ctx.Logger().Info("⏳ Time bank used", "gameId", game.GameId, "player", player, "seconds", used, "remaining", bank.Seconds)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"time_bank_used",
+			sdk.NewAttribute("game_id", game.GameId),
+			sdk.NewAttribute("player", player),
+			sdk.NewAttribute("seconds", strconv.FormatUint(used, 10)),
+			sdk.NewAttribute("remaining", strconv.FormatUint(bank.Seconds, 10)),
+		),
+	})
+	return true, nil
+}
+
+// replenishTimeBanks counts a new hand towards every dealt-in player's next
+// time bank top-up
+func replenishTimeBanks(game *types.Game, state types.TexasHoldemStateDTO, params types.Params) {
+	for _, p := range state.Players {
+		if p.Status != types.StatusActive && p.Status != types.StatusAllIn {
+			continue
+		}
+		bank := game.TimeBank(p.Address, params.TimeBank)
+		bank.Hands++
+		if params.TimeBankReplenishHands > 0 && bank.Hands >= params.TimeBankReplenishHands {
+			bank.Seconds = min(bank.Seconds+params.TimeBankIncrement, params.TimeBankMax)
+			bank.Hands = 0
+		}
+		game.SetTimeBank(bank)
+	}
+}
+
+// fillTimeBanks sets every player's remaining time bank, net of what the
+// player to act has used so far in their turn
+func fillTimeBanks(state types.TexasHoldemStateDTO, game types.Game, params types.Params, now time.Time) types.TexasHoldemStateDTO {
+	acting, started, acts := currentTurn(state)
+
+	players := make([]types.PlayerDTO, len(state.Players))
+	for i, p := range state.Players {
+		seconds := game.TimeBank(p.Address, params.TimeBank).Seconds
+		if acts && p.Address == acting.Address {
+			seconds -= min(seconds, timeBankUsed(now, game, started))
+		}
+		remaining := int(seconds)
+		p.TimeBank = &remaining
+		players[i] = p
+	}
+	state.Players = players
+	return state
+}
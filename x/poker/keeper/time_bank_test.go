@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestTimeBank(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	start := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.HandStartDelay = 0
+	params.TimeBank = 20
+	params.TimeBankIncrement = 5
+	params.TimeBankReplenishHands = 1
+	params.TimeBankMax = 30
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("alice_______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bob_________________"))
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, alice, 10_000)
+	fundUSDC(t, f, bank, bob, 10_000)
+
+	_, err = ms.CreateGame(ctx, &types.MsgCreateGame{
+		Creator: alice, MinBuyIn: 200, MaxBuyIn: 2000, MinPlayers: 2, MaxPlayers: 6,
+		SmallBlind: 5, BigBlind: 10, Timeout: 30, GameType: "cash",
+	})
+	require.NoError(t, err)
+	var gameId string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameId = id
+		return true, nil
+	}))
+	_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: alice, GameId: gameId, Seat: 1, BuyInAmount: 1000})
+	require.NoError(t, err)
+	_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: bob, GameId: gameId, Seat: 2, BuyInAmount: 1000})
+	require.NoError(t, err)
+
+	timeBank := func(player string) uint64 {
+		game, err := f.keeper.Games.Get(ctx, gameId)
+		require.NoError(t, err)
+		return game.TimeBank(player, params.TimeBank).Seconds
+	}
+	toAct := func() types.PlayerDTO {
+		state, err := f.keeper.GameStates.Get(ctx, gameId)
+		require.NoError(t, err)
+		for _, p := range state.Players {
+			if p.Seat == state.NextToAct {
+				return p
+			}
+		}
+		t.Fatal("nobody to act")
+		return types.PlayerDTO{}
+	}
+	passive := func(p types.PlayerDTO) string {
+		for _, legal := range p.LegalActions {
+			if legal.Action == "call" {
+				return "call"
+			}
+		}
+		return "check"
+	}
+
+	// Dealing a hand replenishes the time banks of everyone dealt in
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.Equal(t, uint64(25), timeBank(alice))
+	require.Equal(t, uint64(25), timeBank(bob))
+
+	// Acting 10s after the 30s action timeout draws 10s from the time bank
+	first := toAct()
+	ctx = ctx.WithBlockTime(start.Add(40 * time.Second))
+	_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: first.Address, GameId: gameId, Action: passive(first)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), timeBank(first.Address))
+
+	// Queries show the time bank the player to act has left
+	second := toAct()
+	ctx = ctx.WithBlockTime(start.Add(80 * time.Second))
+	res, err := qs.GameStatePublic(ctx, &types.QueryGameStatePublicRequest{GameId: gameId})
+	require.NoError(t, err)
+	var state types.TexasHoldemStateDTO
+	require.NoError(t, json.Unmarshal([]byte(res.GameState), &state))
+	for _, p := range state.Players {
+		require.NotNil(t, p.TimeBank)
+		want := map[string]int{first.Address: 15, second.Address: 15}[p.Address]
+		require.Equal(t, want, *p.TimeBank, p.Address)
+	}
+
+	// Out of time, a player can no longer raise
+	ctx = ctx.WithBlockTime(start.Add(96 * time.Second))
+	for _, legal := range second.LegalActions {
+		if legal.Action != "raise" && legal.Action != "bet" {
+			continue
+		}
+		amount, err := strconv.ParseUint(*legal.Min, 10, 64)
+		require.NoError(t, err)
+		_, err = ms.PerformAction(ctx, &types.MsgPerformAction{Player: second.Address, GameId: gameId, Action: legal.Action, Amount: amount})
+		require.ErrorIs(t, err, types.ErrActionTimedOut)
+	}
+
+	// and the module takes their timeout action at the end of the block
+	require.NoError(t, f.keeper.AdvanceHands(ctx))
+	require.Zero(t, timeBank(second.Address))
+	var timedOut bool
+	for _, event := range ctx.EventManager().Events() {
+		timedOut = timedOut || event.Type == "player_timed_out"
+	}
+	require.True(t, timedOut)
+	require.NotEqual(t, second.Seat, toAct().Seat)
+
+	// Leaving the table forgets the time bank
+	game, err := f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	game.RemoveTimeBank(second.Address)
+	require.Len(t, game.TimeBanks, 1)
+}
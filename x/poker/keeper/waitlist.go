@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// AdvanceWaitlists releases seat reservations whose window has passed and
+// offers the free seats of every table to the players at the head of its
+// waitlist, lowest seat first. A player whose reservation lapses loses their
+// place and must join the waitlist again.
+func (k *Keeper) AdvanceWaitlists(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var gameIds []string
+	err = k.Games.Walk(ctx, nil, func(gameId string, game types.Game) (bool, error) {
+		if len(game.Waitlist) > 0 || len(game.Reservations) > 0 {
+			gameIds = append(gameIds, gameId)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, gameId := range gameIds {
+		game, err := k.Games.Get(ctx, gameId)
+		if err != nil {
+			return err
+		}
+		state, err := k.GameStates.Get(ctx, gameId)
+		if err != nil {
+			sdkCtx.Logger().Error("❌ Failed to advance waitlist", "gameId", gameId, "error", err)
+			continue
+		}
+		if !advanceWaitlist(sdkCtx, &game, state, params) {
+			continue
+		}
+		if err := k.Games.Set(ctx, gameId, game); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// advanceWaitlist updates a table's reservations, reporting whether any
+// changed
+func advanceWaitlist(ctx sdk.Context, game *types.Game, state types.TexasHoldemStateDTO, params types.Params) bool {
+	now := ctx.BlockTime()
+	changed := false
+
+	var reservations []types.SeatReservation
+	for _, r := range game.Reservations {
+		if now.Before(r.ExpiresAt) {
+			reservations = append(reservations, r)
+			continue
+		}
+		changed = true
+		ctx.Logger().Info("🕒 Seat reservation lapsed", "gameId", game.GameId, "player", r.Player, "seat", r.Seat)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"seat_reservation_expired",
+				sdk.NewAttribute("game_id", game.GameId),
+				sdk.NewAttribute("player", r.Player),
+				sdk.NewAttribute("seat", strconv.FormatUint(r.Seat, 10)),
+			),
+		})
+	}
+	game.Reservations = reservations
+
+	expiresAt := now.Add(time.Duration(params.SeatReservationWindow) * time.Second)
+	for _, seat := range game.FreeSeats(state) {
+		if len(game.Waitlist) == 0 {
+			break
+		}
+		player := game.Waitlist[0]
+		game.Waitlist = game.Waitlist[1:]
+		if len(game.Waitlist) == 0 {
+			game.Waitlist = nil
+		}
+		game.Reservations = append(game.Reservations, types.SeatReservation{Player: player, Seat: seat, ExpiresAt: expiresAt})
+		changed = true
+
+		ctx.Logger().Info("🪑 Seat offered from waitlist", "gameId", game.GameId, "player", player, "seat", seat, "expiresAt", expiresAt)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"seat_reserved",
+				sdk.NewAttribute("game_id", game.GameId),
+				sdk.NewAttribute("player", player),
+				sdk.NewAttribute("seat", strconv.FormatUint(seat, 10)),
+				sdk.NewAttribute("expires_at", strconv.FormatInt(expiresAt.Unix(), 10)),
+			),
+		})
+	}
+	return changed
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/simulation"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestWaitlist(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	start := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.SeatReservationWindow = 30
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	var players []string
+	for _, name := range []string{"alice_______________", "bob_________________", "carol_______________", "dave________________", "erin________________"} {
+		addr, err := f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+		fundUSDC(t, f, bank, addr, 10_000)
+		players = append(players, addr)
+	}
+	alice, bob, carol, dave, erin := players[0], players[1], players[2], players[3], players[4]
+
+	_, err = ms.CreateGame(ctx, &types.MsgCreateGame{
+		Creator: alice, MinBuyIn: 100, MaxBuyIn: 1000, MinPlayers: 2, MaxPlayers: 2,
+		SmallBlind: 5, BigBlind: 10, Timeout: 30, GameType: "cash",
+	})
+	require.NoError(t, err)
+	var gameId string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameId = id
+		return true, nil
+	}))
+	join := func(player string, seat uint64) error {
+		_, err := ms.JoinGame(ctx, &types.MsgJoinGame{Player: player, GameId: gameId, Seat: seat, BuyInAmount: 100})
+		return err
+	}
+	position := func(player string) *types.QueryWaitlistPositionResponse {
+		res, err := qs.WaitlistPosition(ctx, &types.QueryWaitlistPositionRequest{GameId: gameId, Player: player})
+		require.NoError(t, err)
+		return res
+	}
+
+	// Players can only wait for a seat at a full table
+	require.NoError(t, join(alice, 1))
+	_, err = ms.JoinWaitlist(ctx, &types.MsgJoinWaitlist{Player: carol, GameId: gameId})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	require.NoError(t, join(bob, 2))
+	_, err = ms.JoinWaitlist(ctx, &types.MsgJoinWaitlist{Player: bob, GameId: gameId})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	res, err := ms.JoinWaitlist(ctx, &types.MsgJoinWaitlist{Player: carol, GameId: gameId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Position)
+	res, err = ms.JoinWaitlist(ctx, &types.MsgJoinWaitlist{Player: dave, GameId: gameId})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Position)
+	_, err = ms.JoinWaitlist(ctx, &types.MsgJoinWaitlist{Player: dave, GameId: gameId})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	require.Equal(t, uint64(2), position(dave).Position)
+	require.Equal(t, uint64(2), position(dave).WaitlistSize)
+
+	// A freed seat can't be taken by whichever join lands first
+	_, err = ms.LeaveGame(ctx, &types.MsgLeaveGame{Creator: bob, GameId: gameId})
+	require.NoError(t, err)
+	require.ErrorIs(t, join(erin, 2), types.ErrSeatReserved)
+	require.ErrorIs(t, join(dave, 0), types.ErrInvalidRequest)
+
+	// It is offered to the head of the waitlist at the end of the block
+	require.NoError(t, f.keeper.AdvanceWaitlists(ctx))
+	carolPosition := position(carol)
+	require.Equal(t, uint64(2), carolPosition.ReservedSeat)
+	require.Equal(t, start.Add(30*time.Second).Unix(), carolPosition.ReservedUntil)
+	require.Equal(t, uint64(1), position(dave).Position)
+	require.ErrorIs(t, join(dave, 2), types.ErrSeatReserved)
+
+	var offered []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "seat_reserved" {
+			player, _ := event.GetAttribute("player")
+			offered = append(offered, player.Value)
+		}
+	}
+	require.Equal(t, []string{carol}, offered)
+
+	// A lapsed reservation goes to the next player
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Second))
+	require.NoError(t, f.keeper.AdvanceWaitlists(ctx))
+	require.Equal(t, &types.QueryWaitlistPositionResponse{}, position(carol))
+	require.Equal(t, uint64(2), position(dave).ReservedSeat)
+	require.ErrorIs(t, join(carol, 0), types.ErrInvalidRequest)
+
+	require.NoError(t, join(dave, 0))
+	game, err := f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Empty(t, game.Waitlist)
+	require.Empty(t, game.Reservations)
+	require.Contains(t, game.Players, dave)
+
+	// Leaving the waitlist gives up the player's place
+	_, err = ms.JoinWaitlist(ctx, &types.MsgJoinWaitlist{Player: erin, GameId: gameId})
+	require.NoError(t, err)
+	_, err = ms.LeaveWaitlist(ctx, &types.MsgLeaveWaitlist{Player: erin, GameId: gameId})
+	require.NoError(t, err)
+	_, err = ms.LeaveWaitlist(ctx, &types.MsgLeaveWaitlist{Player: erin, GameId: gameId})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
+
+func TestWaitlist_Cap(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	f.keeper.SetGameEngine(simulation.NewEngine())
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	var players []string
+	for i := range 3 + 2*types.WaitlistSeatsMultiple {
+		addr, err := f.addressCodec.BytesToString(sdk.AccAddress(fmt.Sprintf("player%02d____________", i)))
+		require.NoError(t, err)
+		fundUSDC(t, f, bank, addr, 10_000)
+		players = append(players, addr)
+	}
+
+	_, err := ms.CreateGame(ctx, &types.MsgCreateGame{
+		Creator: players[0], MinBuyIn: 100, MaxBuyIn: 1000, MinPlayers: 2, MaxPlayers: 2,
+		SmallBlind: 5, BigBlind: 10, Timeout: 30, GameType: "cash",
+	})
+	require.NoError(t, err)
+	var gameId string
+	require.NoError(t, f.keeper.Games.Walk(ctx, nil, func(id string, _ types.Game) (bool, error) {
+		gameId = id
+		return true, nil
+	}))
+	for seat, player := range players[:2] {
+		_, err = ms.JoinGame(ctx, &types.MsgJoinGame{Player: player, GameId: gameId, Seat: uint64(seat + 1), BuyInAmount: 100})
+		require.NoError(t, err)
+	}
+
+	// A two-seat table queues WaitlistSeatsMultiple players per seat
+	waiting := players[2:]
+	for _, player := range waiting[:len(waiting)-1] {
+		_, err = ms.JoinWaitlist(ctx, &types.MsgJoinWaitlist{Player: player, GameId: gameId})
+		require.NoError(t, err)
+	}
+	_, err = ms.JoinWaitlist(ctx, &types.MsgJoinWaitlist{Player: waiting[len(waiting)-1], GameId: gameId})
+	require.ErrorIs(t, err, types.ErrWaitlistFull)
+
+	game, err := f.keeper.Games.Get(ctx, gameId)
+	require.NoError(t, err)
+	require.Len(t, game.Waitlist, 2*types.WaitlistSeatsMultiple)
+
+	// A place frees up when a player leaves the waitlist
+	_, err = ms.LeaveWaitlist(ctx, &types.MsgLeaveWaitlist{Player: waiting[0], GameId: gameId})
+	require.NoError(t, err)
+	_, err = ms.JoinWaitlist(ctx, &types.MsgJoinWaitlist{Player: waiting[len(waiting)-1], GameId: gameId})
+	require.NoError(t, err)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// WithdrawalFee returns the fee charged on a withdrawal of amount and the net
+// amount paid out on Base. The fee is the flat fee plus withdrawal_fee_bps of
+// the amount, rounded down.
+func WithdrawalFee(params types.Params, amount uint64) (fee uint64, net uint64, err error) {
+	total := math.NewIntFromUint64(amount)
+	proportional := total.Mul(math.NewIntFromUint64(params.WithdrawalFeeBps)).Quo(math.NewIntFromUint64(types.MaxWithdrawalFeeBps))
+	feeInt := proportional.Add(math.NewIntFromUint64(params.WithdrawalFeeFlat))
+
+	if feeInt.GTE(total) {
+		return 0, 0, errorsmod.Wrapf(types.ErrInvalidAmount, "withdrawal of %d does not cover the fee of %s", amount, feeInt)
+	}
+	fee = feeInt.Uint64()
+	return fee, amount - fee, nil
+}
+
+// collectWithdrawalFee moves the fee from the withdrawer to the fee treasury,
+// or to the community pool when no treasury is set
+func (k Keeper) collectWithdrawalFee(ctx context.Context, params types.Params, from sdk.AccAddress, nonce string, fee uint64) error {
+	if fee == 0 {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	feeCoins := sdk.NewCoins(sdk.NewCoin(USDC_DENOM, math.NewIntFromUint64(fee)))
+
+	recipient := params.FeeTreasury
+	if recipient != "" {
+		treasury, err := k.addressCodec.StringToBytes(recipient)
+		if err != nil {
+			return fmt.Errorf("invalid fee treasury address: %w", err)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, from, treasury, feeCoins); err != nil {
+			return fmt.Errorf("failed to pay withdrawal fee to treasury: %w", err)
+		}
+	} else {
+		if k.distrKeeper == nil {
+			return fmt.Errorf("no fee treasury set and distribution keeper unavailable")
+		}
+		if err := k.distrKeeper.FundCommunityPool(ctx, feeCoins, from); err != nil {
+			return fmt.Errorf("failed to pay withdrawal fee to community pool: %w", err)
+		}
+		recipient = "community_pool"
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"withdrawal_fee_collected",
+			sdk.NewAttribute("nonce", nonce),
+			sdk.NewAttribute("fee", fmt.Sprintf("%d", fee)),
+			sdk.NewAttribute("recipient", recipient),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestWithdrawalFee(t *testing.T) {
+	tests := []struct {
+		name    string
+		flat    uint64
+		bps     uint64
+		amount  uint64
+		fee     uint64
+		net     uint64
+		invalid bool
+	}{
+		{name: "no fee", amount: 1_000, fee: 0, net: 1_000},
+		{name: "flat", flat: 250, amount: 1_000, fee: 250, net: 750},
+		{name: "bps rounds down", bps: 30, amount: 1_999, fee: 5, net: 1_994},
+		{name: "flat and bps", flat: 100, bps: 50, amount: 10_000, fee: 150, net: 9_850},
+		{name: "no overflow", bps: 9_999, amount: ^uint64(0), fee: 18_444_899_399_302_180_659, net: 1_844_674_407_370_956},
+		{name: "fee equals amount", flat: 1_000, amount: 1_000, invalid: true},
+		{name: "fee exceeds amount", flat: 10, bps: 9_000, amount: 100, invalid: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.WithdrawalFeeFlat = tc.flat
+			params.WithdrawalFeeBps = tc.bps
+			fee, net, err := keeper.WithdrawalFee(params, tc.amount)
+			if tc.invalid {
+				require.ErrorIs(t, err, types.ErrInvalidAmount)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.fee, fee)
+			require.Equal(t, tc.net, net)
+		})
+	}
+}
+
+func TestInitiateWithdrawal_CollectsFee(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	aliceAddr := sdk.AccAddress("alice_______________")
+	treasuryAddr := sdk.AccAddress("treasury____________")
+	alice, err := f.addressCodec.BytesToString(aliceAddr)
+	require.NoError(t, err)
+	treasury, err := f.addressCodec.BytesToString(treasuryAddr)
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, alice, 10_000)
+
+	params := types.DefaultParams()
+	params.WithdrawalFeeFlat = 100
+	params.WithdrawalFeeBps = 50
+	params.FeeTreasury = treasury
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	estimate, err := qs.EstimateWithdrawalFee(f.ctx, &types.QueryEstimateWithdrawalFeeRequest{Amount: 10_000})
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), estimate.Fee)
+	require.Equal(t, uint64(9_850), estimate.NetAmount)
+	require.Equal(t, treasury, estimate.FeeTreasury)
+
+	_, err = qs.EstimateWithdrawalFee(f.ctx, &types.QueryEstimateWithdrawalFeeRequest{Amount: 100})
+	require.Error(t, err)
+
+	resp, err := ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: alice, Amount: 10_000, BaseAddress: testBaseAddress})
+	require.NoError(t, err)
+	require.Equal(t, estimate.Fee, resp.Fee)
+	require.Equal(t, estimate.NetAmount, resp.NetAmount)
+
+	// The signed amount is the net amount, and the fee went to the treasury
+	request, err := f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9_850), request.Amount)
+	require.Equal(t, uint64(150), request.Fee)
+	require.True(t, bank.SpendableCoins(f.ctx, aliceAddr).AmountOf(keeper.USDC_DENOM).IsZero())
+	require.Equal(t, int64(150), bank.SpendableCoins(f.ctx, treasuryAddr).AmountOf(keeper.USDC_DENOM).Int64())
+
+	// A withdrawal that does not cover the fee is rejected before anything moves
+	fundUSDC(t, f, bank, alice, 100)
+	_, err = ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: alice, Amount: 100, BaseAddress: testBaseAddress})
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+	require.Equal(t, int64(100), bank.SpendableCoins(f.ctx, aliceAddr).AmountOf(keeper.USDC_DENOM).Int64())
+
+	// A refund returns the net amount and reports the fee it keeps
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	sdkCtx = sdkCtx.WithBlockTime(time.Unix(request.ExpiresAt, 0)).WithEventManager(sdk.NewEventManager())
+	cancel, err := ms.CancelWithdrawal(sdkCtx, &types.MsgCancelWithdrawal{Creator: alice, Nonce: resp.Nonce})
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusRefunded, cancel.Status)
+	require.Equal(t, int64(9_950), bank.SpendableCoins(f.ctx, aliceAddr).AmountOf(keeper.USDC_DENOM).Int64())
+	var feeKept string
+	for _, event := range sdkCtx.EventManager().Events() {
+		if event.Type == "withdrawal_refunded" {
+			attr, ok := event.GetAttribute("fee_kept")
+			require.True(t, ok)
+			feeKept = attr.Value
+		}
+	}
+	require.Equal(t, "150", feeKept)
+}
@@ -2,10 +2,11 @@ package keeper
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -16,12 +17,12 @@ import (
 
 // Withdrawal configuration constants
 const (
-	// WITHDRAWAL_FEE_PERCENT is the fee charged on withdrawals (0 = no fee, can be changed here)
-	WITHDRAWAL_FEE_PERCENT = 0.0
-
 	// USDC denomination for this chain
 	USDC_DENOM = "usdc"
 
+	// WithdrawalStatusQueued means a large withdrawal is waiting out its delay before it can be signed
+	WithdrawalStatusQueued = "queued"
+
 	// WithdrawalStatusPending means withdrawal request created, awaiting signature
 	WithdrawalStatusPending = "pending"
 
@@ -30,6 +31,13 @@ const (
 
 	// WithdrawalStatusCompleted means withdrawal completed on Base chain
 	WithdrawalStatusCompleted = "completed"
+
+	// WithdrawalStatusCancelling means an expired signed withdrawal was cancelled,
+	// awaiting proof that its nonce was invalidated on Base
+	WithdrawalStatusCancelling = "cancelling"
+
+	// WithdrawalStatusRefunded means the withdrawal was re-minted to its Cosmos owner
+	WithdrawalStatusRefunded = "refunded"
 )
 
 // InitiateWithdrawal burns USDC on Cosmos and creates a withdrawal request
@@ -37,40 +45,61 @@ const (
 //
 // Flow:
 // 1. Validate inputs (amount, Base address format)
-// 2. Check user has sufficient balance
+// 2. Check user has sufficient balance and the bridge limits allow the amount
 // 3. Generate unique nonce
-// 4. Burn USDC from user account
-// 5. Create withdrawal request with "pending" status
+// 4. Collect the withdrawal fee and burn the net amount from user account
+// 5. Create withdrawal request with "pending" status ("queued" if large)
 // 6. Store in state
-// 7. Return nonce for tracking
-func (k Keeper) InitiateWithdrawal(ctx context.Context, creator string, baseAddress string, amount uint64) (string, error) {
+// 7. Return nonce and fee for tracking
+//
+// The withdrawal request records the net amount, which is what the validator
+// signs and what the Base contract pays out.
+func (k Keeper) InitiateWithdrawal(ctx context.Context, creator string, baseAddress string, amount uint64) (nonce string, fee uint64, err error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	if err := k.checkBridgeNotPaused(ctx); err != nil {
+		return "", 0, err
+	}
+
 	// Validate Base/Ethereum address format
 	if !strings.HasPrefix(baseAddress, "0x") || len(baseAddress) != 42 {
-		return "", fmt.Errorf("invalid Base address format: must be 0x... (42 characters)")
+		return "", 0, fmt.Errorf("invalid Base address format: must be 0x... (42 characters)")
 	}
 
 	// Validate it's a valid Ethereum address
 	if !common.IsHexAddress(baseAddress) {
-		return "", fmt.Errorf("invalid Base address: %s", baseAddress)
+		return "", 0, fmt.Errorf("invalid Base address: %s", baseAddress)
 	}
 
 	// Validate amount
 	if amount == 0 {
-		return "", fmt.Errorf("withdrawal amount must be greater than 0")
+		return "", 0, fmt.Errorf("withdrawal amount must be greater than 0")
 	}
 
 	// Check user balance
 	creatorAddr, err := k.addressCodec.StringToBytes(creator)
 	if err != nil {
-		return "", fmt.Errorf("invalid creator address: %w", err)
+		return "", 0, fmt.Errorf("invalid creator address: %w", err)
 	}
 
 	spendableCoins := k.bankKeeper.SpendableCoins(sdkCtx, creatorAddr)
 	usdcBalance := spendableCoins.AmountOf(USDC_DENOM)
 	if usdcBalance.Uint64() < amount {
-		return "", fmt.Errorf("insufficient balance: have %d, need %d", usdcBalance.Uint64(), amount)
+		return "", 0, fmt.Errorf("insufficient balance: have %d, need %d", usdcBalance.Uint64(), amount)
+	}
+
+	params, err := k.Params.Get(sdkCtx)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get params: %w", err)
+	}
+	fee, netAmount, err := WithdrawalFee(params, amount)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// Enforce the rolling withdrawal limits on what leaves the chain
+	if err := k.checkWithdrawalLimits(ctx, params, creator, netAmount); err != nil {
+		return "", 0, err
 	}
 
 	// Generate unique nonce
@@ -84,7 +113,7 @@ func (k Keeper) InitiateWithdrawal(ctx context.Context, creator string, baseAddr
 
 	nonceSeq, err := k.WithdrawalNonce.Next(sdkCtx)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate nonce: %w", err)
+		return "", 0, fmt.Errorf("failed to generate nonce: %w", err)
 	}
 
 	// Sequences start at 0, but we want nonces to start at 1
@@ -100,18 +129,36 @@ func (k Keeper) InitiateWithdrawal(ctx context.Context, creator string, baseAddr
 	)
 
 	// Format nonce as hex string (32 bytes for Base contract compatibility)
-	nonce := fmt.Sprintf("0x%064x", nonceSeq)
+	nonce = fmt.Sprintf("0x%064x", nonceSeq)
 
 	sdkCtx.Logger().Info("🔢 Formatted nonce as hex", "nonce", nonce)
 
+	if err := k.collectWithdrawalFee(ctx, params, creatorAddr, nonce, fee); err != nil {
+		return "", 0, err
+	}
+
 	// Burn USDC from creator
-	burnCoins := sdk.NewCoins(sdk.NewCoin(USDC_DENOM, math.NewIntFromUint64(amount)))
+	burnCoins := sdk.NewCoins(sdk.NewCoin(USDC_DENOM, math.NewIntFromUint64(netAmount)))
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, creatorAddr, types.ModuleName, burnCoins); err != nil {
-		return "", fmt.Errorf("failed to transfer USDC: %w", err)
+		return "", 0, fmt.Errorf("failed to transfer USDC: %w", err)
 	}
 
 	if err := k.bankKeeper.BurnCoins(sdkCtx, types.ModuleName, burnCoins); err != nil {
-		return "", fmt.Errorf("failed to burn USDC: %w", err)
+		return "", 0, fmt.Errorf("failed to burn USDC: %w", err)
+	}
+
+	// Large withdrawals wait in the queue before they can be signed
+	status := WithdrawalStatusPending
+	var releaseAt int64
+	if params.LargeWithdrawalThreshold > 0 && netAmount >= params.LargeWithdrawalThreshold {
+		status = WithdrawalStatusQueued
+		releaseAt = sdkCtx.BlockTime().Unix() + int64(params.LargeWithdrawalDelay)
+	}
+
+	// Unclaimed withdrawals can be cancelled and refunded after the expiry
+	var expiresAt int64
+	if params.WithdrawalExpiry > 0 {
+		expiresAt = max(sdkCtx.BlockTime().Unix(), releaseAt) + int64(params.WithdrawalExpiry)
 	}
 
 	// Create withdrawal request
@@ -119,16 +166,29 @@ func (k Keeper) InitiateWithdrawal(ctx context.Context, creator string, baseAddr
 		Nonce:         nonce,
 		CosmosAddress: creator,
 		BaseAddress:   baseAddress,
-		Amount:        amount,
-		Status:        WithdrawalStatusPending,
+		Amount:        netAmount,
+		Fee:           fee,
+		Status:        status,
 		Signature:     nil, // Will be filled by EndBlocker
 		CreatedAt:     sdkCtx.BlockTime().Unix(),
 		CompletedAt:   0,
+		ExpiresAt:     expiresAt,
+		ReleaseAt:     releaseAt,
 	}
 
 	// Store withdrawal request
 	if err := k.WithdrawalRequests.Set(sdkCtx, nonce, withdrawalRequest); err != nil {
-		return "", fmt.Errorf("failed to store withdrawal request: %w", err)
+		return "", 0, fmt.Errorf("failed to store withdrawal request: %w", err)
+	}
+	if params.WithdrawalWindow > 0 {
+		if err := k.recordWithdrawalOutflow(ctx, withdrawalRequest); err != nil {
+			return "", 0, fmt.Errorf("failed to record withdrawal outflow: %w", err)
+		}
+	}
+	if status == WithdrawalStatusQueued {
+		if err := k.WithdrawalQueue.Set(ctx, collections.Join(releaseAt, nonce)); err != nil {
+			return "", 0, fmt.Errorf("failed to queue withdrawal: %w", err)
+		}
 	}
 
 	// Emit event
@@ -137,84 +197,134 @@ func (k Keeper) InitiateWithdrawal(ctx context.Context, creator string, baseAddr
 			"withdrawal_initiated",
 			sdk.NewAttribute("creator", creator),
 			sdk.NewAttribute("nonce", nonce),
-			sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", netAmount)),
+			sdk.NewAttribute("fee", fmt.Sprintf("%d", fee)),
 			sdk.NewAttribute("base_address", baseAddress),
+			sdk.NewAttribute("status", status),
 		),
 	)
 
-	return nonce, nil
+	return nonce, fee, nil
 }
 
-// SignWithdrawal generates a validator signature for a withdrawal request.
-// This is called automatically by EndBlocker for all pending withdrawals.
+// WithdrawalMessageHash returns the hash a validator signs for a withdrawal
+// request: its claim, or for a cancelling withdrawal its cancellation.
 //
-// Signature format (compatible with Base CosmosBridge contract):
-// - Message: keccak256(abi.encodePacked(receiver, amount, nonce))
-// - Signer: Validator's Ethereum private key
-func (k Keeper) SignWithdrawal(ctx context.Context, nonce string, validatorPrivKey *ecdsa.PrivateKey) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	// Get withdrawal request
-	request, err := k.WithdrawalRequests.Get(sdkCtx, nonce)
-	if err != nil {
-		return fmt.Errorf("withdrawal request not found: %w", err)
-	}
-
-	// Skip if already signed
-	if request.Status == WithdrawalStatusSigned || request.Status == WithdrawalStatusCompleted {
-		return nil
+// Message formats (compatible with the Base CosmosBridge contract):
+// - Claim: keccak256(abi.encodePacked(receiver, amount, nonce))
+// - Cancellation: keccak256(abi.encodePacked("cancelWithdrawal", nonce))
+func WithdrawalMessageHash(request types.WithdrawalRequest) common.Hash {
+	nonceBytes := common.HexToHash(request.Nonce)
+	if request.Status == WithdrawalStatusCancelling {
+		return crypto.Keccak256Hash(append([]byte("cancelWithdrawal"), nonceBytes.Bytes()...))
 	}
 
-	// Prepare message for signing (matches Solidity: keccak256(abi.encodePacked(receiver, amount, nonce)))
-	// This must match the Base contract's withdraw() verification logic
+	// Pack data like Solidity abi.encodePacked (no padding between fields for packed encoding)
 	receiver := common.HexToAddress(request.BaseAddress)
 	amountBytes := common.LeftPadBytes(math.NewIntFromUint64(request.Amount).BigInt().Bytes(), 32)
-	nonceBytes := common.HexToHash(nonce)
-
-	// Pack data like Solidity abi.encodePacked (no padding between fields for packed encoding)
 	message := append(receiver.Bytes(), amountBytes...)
 	message = append(message, nonceBytes.Bytes()...)
+	return crypto.Keccak256Hash(message)
+}
 
-	// Hash the message with keccak256
-	messageHash := crypto.Keccak256Hash(message)
+// SignWithdrawalRequest signs a withdrawal request, or its cancellation, with
+// the validator's bridge key. It runs off chain: in the bridge signer sidecar,
+// which submits the signature with MsgSignWithdrawal.
+func SignWithdrawalRequest(ctx context.Context, request types.WithdrawalRequest, signer WithdrawalSigner) ([]byte, error) {
+	signature, err := signEthMessage(ctx, WithdrawalMessageHash(request), signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign withdrawal %s: %w", request.Nonce, err)
+	}
+	return signature, nil
+}
 
-	// Add Ethereum signed message prefix (to match Solidity's getEthSignedMessageHash)
-	// The contract does: keccak256(abi.encodePacked("\x19Ethereum Signed Message:\n32", messageHash))
-	prefix := []byte("\x19Ethereum Signed Message:\n32")
-	prefixedMessage := append(prefix, messageHash.Bytes()...)
-	prefixedHash := crypto.Keccak256Hash(prefixedMessage)
+// SubmitWithdrawalSignature stores a validator signature of a withdrawal, or
+// of its cancellation for a cancelling withdrawal. The signature must be over
+// the request's WithdrawalMessageHash by one of the bridge signers in params.
+// It replaces a stored signature that no current bridge signer made.
+func (k Keeper) SubmitWithdrawalSignature(ctx context.Context, nonce string, signature []byte) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// Sign the prefixed hash with validator's Ethereum private key
-	signature, err := crypto.Sign(prefixedHash.Bytes(), validatorPrivKey)
+	request, err := k.WithdrawalRequests.Get(sdkCtx, nonce)
+	if err != nil {
+		return fmt.Errorf("withdrawal request not found: %w", err)
+	}
+	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to sign withdrawal: %w", err)
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	// Skip if queued, already signed, completed or refunded
+	if !WithdrawalNeedsSignature(request, params) {
+		return nil
 	}
+	cancelling := request.Status == WithdrawalStatusCancelling
 
-	// Ethereum signatures need recovery id adjusted (v = 27 + v)
-	if signature[64] < 27 {
-		signature[64] += 27
+	// No new withdrawal signatures while the bridge is paused
+	if !cancelling {
+		if err := k.checkBridgeNotPaused(ctx); err != nil {
+			return err
+		}
 	}
 
-	// Update withdrawal request
-	request.Status = WithdrawalStatusSigned
-	request.Signature = signature
+	signer, signature, err := recoverEthSigner(WithdrawalMessageHash(request), signature)
+	if err != nil {
+		return err
+	}
+	if !params.IsBridgeSigner(signer) {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not a bridge signer", signer.Hex())
+	}
 
+	eventType := "withdrawal_signed"
+	if cancelling {
+		request.CancelSignature = signature
+		eventType = "withdrawal_cancellation_signed"
+	} else {
+		request.Status = WithdrawalStatusSigned
+		request.Signature = signature
+	}
 	if err := k.WithdrawalRequests.Set(sdkCtx, nonce, request); err != nil {
 		return fmt.Errorf("failed to update withdrawal request: %w", err)
 	}
 
-	// Emit event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			"withdrawal_signed",
+			eventType,
 			sdk.NewAttribute("nonce", nonce),
-			sdk.NewAttribute("status", WithdrawalStatusSigned),
+			sdk.NewAttribute("status", request.Status),
+			sdk.NewAttribute("signer", signer.Hex()),
 		),
 	)
 
 	return nil
 }
 
+// WithdrawalNeedsSignature reports whether a withdrawal is waiting for a
+// validator signature, either to be claimed or to be cancelled on Base. A
+// stored signature that is not from one of the bridge signers in params
+// counts as missing.
+func WithdrawalNeedsSignature(request types.WithdrawalRequest, params types.Params) bool {
+	switch request.Status {
+	case WithdrawalStatusPending:
+		return true
+	case WithdrawalStatusSigned:
+		return !signedByBridgeSigner(request, request.Signature, params)
+	case WithdrawalStatusCancelling:
+		return !signedByBridgeSigner(request, request.CancelSignature, params)
+	default:
+		return false
+	}
+}
+
+// signedByBridgeSigner reports whether signature is a bridge signer's
+// signature of the request's WithdrawalMessageHash
+func signedByBridgeSigner(request types.WithdrawalRequest, signature []byte, params types.Params) bool {
+	if len(signature) == 0 {
+		return false
+	}
+	signer, _, err := recoverEthSigner(WithdrawalMessageHash(request), signature)
+	return err == nil && params.IsBridgeSigner(signer)
+}
+
 // getWithdrawalRequest retrieves a withdrawal request by nonce (internal, lowercase)
 func (k Keeper) getWithdrawalRequest(ctx context.Context, nonce string) (*types.WithdrawalRequest, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -252,8 +362,10 @@ func (k Keeper) ListWithdrawalRequestsInternal(ctx context.Context, cosmosAddres
 }
 
 // MarkWithdrawalCompleted marks a withdrawal as completed after it's been claimed on Base chain.
-// This should be called when the user successfully calls withdraw() on the Base CosmosBridge contract.
-func (k Keeper) MarkWithdrawalCompleted(ctx context.Context, nonce string, baseTxHash string) error {
+// This is called once the Withdrawn event emitted by the Base CosmosBridge contract has been
+// proven against a tracked header. A cancelling withdrawal can still complete if the user
+// claimed it before the nonce was invalidated.
+func (k Keeper) MarkWithdrawalCompleted(ctx context.Context, nonce string, ethBlockHeight uint64) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// Get withdrawal request
@@ -263,7 +375,7 @@ func (k Keeper) MarkWithdrawalCompleted(ctx context.Context, nonce string, baseT
 	}
 
 	// Validate it's signed
-	if request.Status != WithdrawalStatusSigned {
+	if request.Status != WithdrawalStatusSigned && request.Status != WithdrawalStatusCancelling {
 		return fmt.Errorf("withdrawal must be signed before completion (current status: %s)", request.Status)
 	}
 
@@ -280,8 +392,163 @@ func (k Keeper) MarkWithdrawalCompleted(ctx context.Context, nonce string, baseT
 		sdk.NewEvent(
 			"withdrawal_completed",
 			sdk.NewAttribute("nonce", nonce),
-			sdk.NewAttribute("base_tx_hash", baseTxHash),
+			sdk.NewAttribute("eth_block_height", fmt.Sprintf("%d", ethBlockHeight)),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", request.Amount)),
+		),
+	)
+
+	return nil
+}
+
+// CancelWithdrawal cancels an expired withdrawal on behalf of its Cosmos owner.
+//
+// A pending withdrawal has no signature, so it can never be claimed on Base and
+// is refunded immediately. A signed withdrawal may still be claimed with its
+// signature, so it moves to "cancelling": validators sign a cancellation that
+// invalidates the nonce on Base, and the refund happens once that is proven.
+// Cancelling a cancelling withdrawal again resets its cancellation (see
+// resetCancellation).
+//
+// Returns the new status.
+func (k Keeper) CancelWithdrawal(ctx context.Context, creator string, nonce string) (string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	request, err := k.WithdrawalRequests.Get(sdkCtx, nonce)
+	if err != nil {
+		return "", fmt.Errorf("withdrawal request not found: %w", err)
+	}
+	if request.Status == WithdrawalStatusCancelling {
+		return k.resetCancellation(sdkCtx, creator, request)
+	}
+
+	if request.CosmosAddress != creator {
+		return "", fmt.Errorf("only the withdrawal owner %s can cancel it", request.CosmosAddress)
+	}
+	if request.ExpiresAt == 0 || sdkCtx.BlockTime().Unix() < request.ExpiresAt {
+		return "", fmt.Errorf("withdrawal has not expired (expires at %d)", request.ExpiresAt)
+	}
+
+	switch request.Status {
+	case WithdrawalStatusPending:
+		if err := k.refundWithdrawal(sdkCtx, request); err != nil {
+			return "", err
+		}
+		return WithdrawalStatusRefunded, nil
+	case WithdrawalStatusSigned:
+		request.Status = WithdrawalStatusCancelling
+		request.CancelledAt = sdkCtx.BlockTime().Unix()
+		if err := k.WithdrawalRequests.Set(sdkCtx, nonce, request); err != nil {
+			return "", fmt.Errorf("failed to update withdrawal status: %w", err)
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"withdrawal_cancelled",
+				sdk.NewAttribute("nonce", nonce),
+				sdk.NewAttribute("status", WithdrawalStatusCancelling),
+			),
+		)
+		return WithdrawalStatusCancelling, nil
+	default:
+		return "", fmt.Errorf("withdrawal cannot be cancelled (current status: %s)", request.Status)
+	}
+}
+
+// resetCancellation clears the cancellation signature of a cancelling
+// withdrawal so the bridge signers sign it again, for when a stored signature
+// never gets the nonce invalidated on Base. The module authority may reset it
+// at any time; the owner once withdrawal_expiry has passed since the
+// withdrawal was cancelled. A cancellation signature only invalidates the
+// nonce, so signing it again cannot release funds.
+func (k Keeper) resetCancellation(sdkCtx sdk.Context, creator string, request types.WithdrawalRequest) (string, error) {
+	creatorAddr, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return "", fmt.Errorf("invalid creator address: %w", err)
+	}
+	params, err := k.Params.Get(sdkCtx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get params: %w", err)
+	}
+
+	if !sdk.AccAddress(creatorAddr).Equals(sdk.AccAddress(k.GetAuthority())) {
+		if request.CosmosAddress != creator {
+			return "", fmt.Errorf("only the withdrawal owner %s or the module authority can reset its cancellation", request.CosmosAddress)
+		}
+		if len(request.CancelSignature) == 0 {
+			return "", fmt.Errorf("withdrawal cancellation is awaiting a signature")
+		}
+		resetAt := request.CancelledAt + int64(params.WithdrawalExpiry)
+		if params.WithdrawalExpiry == 0 || sdkCtx.BlockTime().Unix() < resetAt {
+			return "", fmt.Errorf("withdrawal cancellation cannot be reset yet (cancelled at %d)", request.CancelledAt)
+		}
+	}
+
+	request.CancelSignature = nil
+	request.CancelledAt = sdkCtx.BlockTime().Unix()
+	if err := k.WithdrawalRequests.Set(sdkCtx, request.Nonce, request); err != nil {
+		return "", fmt.Errorf("failed to update withdrawal request: %w", err)
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"withdrawal_cancellation_reset",
+			sdk.NewAttribute("nonce", request.Nonce),
+			sdk.NewAttribute("reset_by", creator),
+		),
+	)
+
+	return WithdrawalStatusCancelling, nil
+}
+
+// RefundWithdrawal re-mints a cancelling withdrawal to its Cosmos owner. This
+// is called once the WithdrawalCancelled event emitted by the Base CosmosBridge
+// contract has been proven against a tracked header.
+func (k Keeper) RefundWithdrawal(ctx context.Context, nonce string) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	request, err := k.WithdrawalRequests.Get(sdkCtx, nonce)
+	if err != nil {
+		return 0, fmt.Errorf("withdrawal request not found: %w", err)
+	}
+	if request.Status != WithdrawalStatusCancelling {
+		return 0, fmt.Errorf("withdrawal must be cancelling before refund (current status: %s)", request.Status)
+	}
+
+	if err := k.refundWithdrawal(sdkCtx, request); err != nil {
+		return 0, err
+	}
+	return request.Amount, nil
+}
+
+// refundWithdrawal mints the burned USDC back to the withdrawal owner and marks
+// the request refunded. The withdrawal fee is not returned; the refunded event
+// reports it as fee_kept.
+func (k Keeper) refundWithdrawal(sdkCtx sdk.Context, request types.WithdrawalRequest) error {
+	ownerAddr, err := k.addressCodec.StringToBytes(request.CosmosAddress)
+	if err != nil {
+		return fmt.Errorf("invalid withdrawal owner address: %w", err)
+	}
+
+	refundCoins := sdk.NewCoins(sdk.NewCoin(USDC_DENOM, math.NewIntFromUint64(request.Amount)))
+	if err := k.bankKeeper.MintCoins(sdkCtx, types.ModuleName, refundCoins); err != nil {
+		return fmt.Errorf("failed to mint USDC: %w", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, ownerAddr, refundCoins); err != nil {
+		return fmt.Errorf("failed to refund USDC: %w", err)
+	}
+
+	request.Status = WithdrawalStatusRefunded
+	request.CompletedAt = sdkCtx.BlockTime().Unix()
+	if err := k.WithdrawalRequests.Set(sdkCtx, request.Nonce, request); err != nil {
+		return fmt.Errorf("failed to update withdrawal status: %w", err)
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"withdrawal_refunded",
+			sdk.NewAttribute("nonce", request.Nonce),
+			sdk.NewAttribute("cosmos_address", request.CosmosAddress),
 			sdk.NewAttribute("amount", fmt.Sprintf("%d", request.Amount)),
+			sdk.NewAttribute("fee_kept", fmt.Sprintf("%d", request.Fee)),
 		),
 	)
 
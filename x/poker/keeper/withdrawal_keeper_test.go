@@ -0,0 +1,504 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+const testWithdrawalNonce = "0x0000000000000000000000000000000000000000000000000000000000000007"
+
+// withdrawnLog builds a Withdrawn(address indexed receiver, uint256 amount, bytes32 indexed nonce) log
+func withdrawnLog(contract common.Address, receiver string, amount uint64, nonce string) *ethtypes.Log {
+	data := make([]byte, 32)
+	new(big.Int).SetUint64(amount).FillBytes(data)
+	return &ethtypes.Log{
+		Address: contract,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Withdrawn(address,uint256,bytes32)")),
+			common.BytesToHash(common.HexToAddress(receiver).Bytes()),
+			common.HexToHash(nonce),
+		},
+		Data: data,
+	}
+}
+
+// withdrawalCancelledLog builds a WithdrawalCancelled(bytes32 indexed nonce) log
+func withdrawalCancelledLog(contract common.Address, nonce string) *ethtypes.Log {
+	return &ethtypes.Log{
+		Address: contract,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("WithdrawalCancelled(bytes32)")),
+			common.HexToHash(nonce),
+		},
+	}
+}
+
+// setBridgeSigners makes the keys' addresses the bridge signers in params
+func setBridgeSigners(t *testing.T, f *fixture, keys ...*ecdsa.PrivateKey) {
+	t.Helper()
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.BridgeSigners = nil
+	for _, key := range keys {
+		params.BridgeSigners = append(params.BridgeSigners, crypto.PubkeyToAddress(key.PublicKey).Hex())
+	}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+}
+
+// signWithdrawal signs a stored withdrawal request with key, as the bridge
+// signer sidecar does, and submits the signature
+func signWithdrawal(t *testing.T, f *fixture, nonce string, key *ecdsa.PrivateKey) error {
+	t.Helper()
+	request, err := f.keeper.WithdrawalRequests.Get(f.ctx, nonce)
+	require.NoError(t, err)
+	signature, err := keeper.SignWithdrawalRequest(f.ctx, request, keeper.NewPrivateKeySigner(key))
+	require.NoError(t, err)
+	submitter, err := f.addressCodec.BytesToString(sdk.AccAddress("submitter___________"))
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(f.keeper).SignWithdrawal(f.ctx, types.NewMsgSignWithdrawal(submitter, nonce, signature))
+	return err
+}
+
+// setupWithdrawal stores a withdrawal request and tracks block 100 with the given receipts
+func setupWithdrawal(t *testing.T, f *fixture, request types.WithdrawalRequest, receipts ethtypes.Receipts) [][]byte {
+	t.Helper()
+	require.NoError(t, f.keeper.WithdrawalRequests.Set(f.ctx, request.Nonce, request))
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	root, proof, err := keeper.BuildReceiptProof(encodeReceipts(t, receipts), 1)
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(f.keeper).SubmitEthHeaders(f.ctx, &types.MsgSubmitEthHeaders{
+		Relayer: authority,
+		Headers: headerChain(t, 100, 1, common.Hash{}, root),
+	})
+	require.NoError(t, err)
+	return proof
+}
+
+func TestCompleteWithdrawal(t *testing.T) {
+	baseAddress := "0x1111111111111111111111111111111111111111"
+
+	tests := []struct {
+		name    string
+		status  string
+		log     *ethtypes.Log
+		wantErr error
+	}{
+		{
+			name:   "signed withdrawal",
+			status: keeper.WithdrawalStatusSigned,
+			log:    withdrawnLog(testDepositContract, baseAddress, 500, testWithdrawalNonce),
+		},
+		{
+			name:   "claimed while cancelling",
+			status: keeper.WithdrawalStatusCancelling,
+			log:    withdrawnLog(testDepositContract, baseAddress, 500, testWithdrawalNonce),
+		},
+		{
+			name:    "amount mismatch",
+			status:  keeper.WithdrawalStatusSigned,
+			log:     withdrawnLog(testDepositContract, baseAddress, 499, testWithdrawalNonce),
+			wantErr: types.ErrInvalidProof,
+		},
+		{
+			name:    "receiver mismatch",
+			status:  keeper.WithdrawalStatusSigned,
+			log:     withdrawnLog(testDepositContract, "0x2222222222222222222222222222222222222222", 500, testWithdrawalNonce),
+			wantErr: types.ErrInvalidProof,
+		},
+		{
+			name:    "another nonce",
+			status:  keeper.WithdrawalStatusSigned,
+			log:     withdrawnLog(testDepositContract, baseAddress, 500, "0x08"),
+			wantErr: types.ErrInvalidProof,
+		},
+		{
+			name:    "cancellation is not a withdrawal",
+			status:  keeper.WithdrawalStatusSigned,
+			log:     withdrawalCancelledLog(testDepositContract, testWithdrawalNonce),
+			wantErr: types.ErrInvalidProof,
+		},
+		{
+			name:    "not yet signed",
+			status:  keeper.WithdrawalStatusPending,
+			log:     withdrawnLog(testDepositContract, baseAddress, 500, testWithdrawalNonce),
+			wantErr: types.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := initFixture(t)
+			ms := keeper.NewMsgServerImpl(f.keeper)
+			creator, err := f.addressCodec.BytesToString(sdk.AccAddress("creator_____________"))
+			require.NoError(t, err)
+
+			proof := setupWithdrawal(t, f, types.WithdrawalRequest{
+				Nonce:         testWithdrawalNonce,
+				CosmosAddress: creator,
+				BaseAddress:   baseAddress,
+				Amount:        500,
+				Status:        tt.status,
+			}, blockReceipts(t, ethtypes.ReceiptStatusSuccessful, tt.log))
+
+			_, err = ms.CompleteWithdrawal(f.ctx, &types.MsgCompleteWithdrawal{
+				Creator:        creator,
+				Nonce:          testWithdrawalNonce,
+				EthBlockHeight: 100,
+				TxIndex:        1,
+				ReceiptProof:   proof,
+			})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			request, err := f.keeper.WithdrawalRequests.Get(f.ctx, testWithdrawalNonce)
+			require.NoError(t, err)
+			require.Equal(t, keeper.WithdrawalStatusCompleted, request.Status)
+		})
+	}
+}
+
+func TestCancelWithdrawal(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	owner, err := f.addressCodec.BytesToString(sdk.AccAddress("owner_______________"))
+	require.NoError(t, err)
+	stranger, err := f.addressCodec.BytesToString(sdk.AccAddress("stranger____________"))
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	proof := setupWithdrawal(t, f, types.WithdrawalRequest{
+		Nonce:         testWithdrawalNonce,
+		CosmosAddress: owner,
+		BaseAddress:   "0x1111111111111111111111111111111111111111",
+		Amount:        500,
+		Status:        keeper.WithdrawalStatusSigned,
+		Signature:     []byte{0x01},
+		ExpiresAt:     now.Unix() + 60,
+	}, blockReceipts(t, ethtypes.ReceiptStatusSuccessful, withdrawalCancelledLog(testDepositContract, "0x08")))
+
+	msg := &types.MsgCancelWithdrawal{Creator: owner, Nonce: testWithdrawalNonce}
+
+	// Not expired yet
+	_, err = ms.CancelWithdrawal(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// Only the owner may cancel
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now.Add(time.Minute))
+	_, err = ms.CancelWithdrawal(f.ctx, &types.MsgCancelWithdrawal{Creator: stranger, Nonce: testWithdrawalNonce})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// A signed withdrawal waits for its nonce to be invalidated on Base
+	resp, err := ms.CancelWithdrawal(f.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusCancelling, resp.Status)
+	_, err = ms.CancelWithdrawal(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// Validators sign the cancellation once
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	setBridgeSigners(t, f, key)
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+
+	request, err := f.keeper.WithdrawalRequests.Get(f.ctx, testWithdrawalNonce)
+	require.NoError(t, err)
+	require.True(t, keeper.WithdrawalNeedsSignature(request, params))
+
+	require.NoError(t, signWithdrawal(t, f, testWithdrawalNonce, key))
+
+	request, err = f.keeper.WithdrawalRequests.Get(f.ctx, testWithdrawalNonce)
+	require.NoError(t, err)
+	require.False(t, keeper.WithdrawalNeedsSignature(request, params))
+	require.Equal(t, []byte{0x01}, request.Signature, "withdrawal signature is kept")
+	require.Len(t, request.CancelSignature, 65)
+
+	// The cancellation signature recovers to the validator key
+	message := crypto.Keccak256Hash(append([]byte("cancelWithdrawal"), common.HexToHash(testWithdrawalNonce).Bytes()...))
+	prefixed := crypto.Keccak256Hash(append([]byte("\x19Ethereum Signed Message:\n32"), message.Bytes()...))
+	sig := append([]byte{}, request.CancelSignature...)
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(prefixed.Bytes(), sig)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), crypto.PubkeyToAddress(*pub))
+
+	// The refund requires the cancellation of this exact nonce
+	_, err = ms.RefundWithdrawal(f.ctx, &types.MsgRefundWithdrawal{
+		Creator:        stranger,
+		Nonce:          testWithdrawalNonce,
+		EthBlockHeight: 100,
+		TxIndex:        1,
+		ReceiptProof:   proof,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+}
+
+func TestWithdrawalMessages_ValidateBasic(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	require.NoError(t, types.NewMsgCancelWithdrawal(creator, testWithdrawalNonce).ValidateBasic())
+	require.Error(t, types.NewMsgCancelWithdrawal(creator, "0x07").ValidateBasic())
+	require.Error(t, types.NewMsgCancelWithdrawal(creator, "0x"+strings.Repeat("z", 64)).ValidateBasic())
+
+	require.NoError(t, types.NewMsgSignWithdrawal(creator, testWithdrawalNonce, make([]byte, 65)).ValidateBasic())
+	require.Error(t, types.NewMsgSignWithdrawal(creator, testWithdrawalNonce, nil).ValidateBasic())
+
+	require.NoError(t, types.NewMsgRefundWithdrawal(creator, testWithdrawalNonce, 100, 1, 0, [][]byte{{0x01}}).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgRefundWithdrawal(creator, testWithdrawalNonce, 100, 1, 0, nil).ValidateBasic(), types.ErrInvalidProof)
+	require.ErrorIs(t, types.NewMsgCompleteWithdrawal(creator, testWithdrawalNonce, 100, 1, 0, nil).ValidateBasic(), types.ErrInvalidProof)
+}
+
+func TestCancelWithdrawal_RefundsPending(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString(sdk.AccAddress("owner_______________"))
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, owner, 1_000)
+
+	now := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	resp, err := ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: owner, Amount: 400, BaseAddress: testBaseAddress})
+	require.NoError(t, err)
+	ownerAddr := sdk.AccAddress("owner_______________")
+	require.Equal(t, int64(600), bank.SpendableCoins(f.ctx, ownerAddr).AmountOf(keeper.USDC_DENOM).Int64())
+
+	// A never-signed withdrawal is refunded as soon as it expires
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now.Add(time.Duration(types.DefaultWithdrawalExpiry) * time.Second))
+	cancel, err := ms.CancelWithdrawal(f.ctx, &types.MsgCancelWithdrawal{Creator: owner, Nonce: resp.Nonce})
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusRefunded, cancel.Status)
+	require.Equal(t, int64(1_000), bank.SpendableCoins(f.ctx, ownerAddr).AmountOf(keeper.USDC_DENOM).Int64())
+
+	// It can no longer be signed
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	setBridgeSigners(t, f, key)
+	require.NoError(t, signWithdrawal(t, f, resp.Nonce, key))
+	request, err := f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusRefunded, request.Status)
+	require.Empty(t, request.Signature)
+}
+
+func TestCancelWithdrawal_ResetCancellation(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	owner, err := f.addressCodec.BytesToString(sdk.AccAddress("owner_______________"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	require.NoError(t, f.keeper.WithdrawalRequests.Set(f.ctx, testWithdrawalNonce, types.WithdrawalRequest{
+		Nonce:         testWithdrawalNonce,
+		CosmosAddress: owner,
+		BaseAddress:   testBaseAddress,
+		Amount:        500,
+		Status:        keeper.WithdrawalStatusSigned,
+		Signature:     []byte{0x01},
+		ExpiresAt:     now.Unix(),
+	}))
+	msg := &types.MsgCancelWithdrawal{Creator: owner, Nonce: testWithdrawalNonce}
+	_, err = ms.CancelWithdrawal(f.ctx, msg)
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	setBridgeSigners(t, f, key)
+
+	// Nothing to reset until the cancellation is signed
+	_, err = ms.CancelWithdrawal(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	require.NoError(t, signWithdrawal(t, f, testWithdrawalNonce, key))
+
+	// The owner has to wait withdrawal_expiry for the cancellation to take effect
+	_, err = ms.CancelWithdrawal(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// The authority can reset it at any time
+	resp, err := ms.CancelWithdrawal(f.ctx, &types.MsgCancelWithdrawal{Creator: authority, Nonce: testWithdrawalNonce})
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusCancelling, resp.Status)
+	request, err := f.keeper.WithdrawalRequests.Get(f.ctx, testWithdrawalNonce)
+	require.NoError(t, err)
+	require.Empty(t, request.CancelSignature)
+	require.NoError(t, signWithdrawal(t, f, testWithdrawalNonce, key))
+
+	// And the owner once the timeout has passed
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now.Add(time.Duration(types.DefaultWithdrawalExpiry) * time.Second))
+	_, err = ms.CancelWithdrawal(f.ctx, msg)
+	require.NoError(t, err)
+	request, err = f.keeper.WithdrawalRequests.Get(f.ctx, testWithdrawalNonce)
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusCancelling, request.Status)
+	require.Empty(t, request.CancelSignature)
+	require.Equal(t, []byte{0x01}, request.Signature, "withdrawal signature is kept")
+}
+
+// impostorSigner claims one address but signs with another key
+type impostorSigner struct {
+	keeper.WithdrawalSigner
+	claimed common.Address
+}
+
+func (s impostorSigner) Address() common.Address { return s.claimed }
+
+func TestSignWithdrawal_RejectsWrongSigner(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString(sdk.AccAddress("owner_______________"))
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, owner, 1_000)
+	resp, err := ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: owner, Amount: 400, BaseAddress: testBaseAddress})
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	validator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	setBridgeSigners(t, f, validator)
+	impostor := impostorSigner{
+		WithdrawalSigner: keeper.NewPrivateKeySigner(key),
+		claimed:          crypto.PubkeyToAddress(validator.PublicKey),
+	}
+	request, err := f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+	_, err = keeper.SignWithdrawalRequest(f.ctx, request, impostor)
+	require.Error(t, err)
+
+	// The real key signs it
+	require.NoError(t, signWithdrawal(t, f, resp.Nonce, validator))
+	request, err = f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusSigned, request.Status)
+}
+
+func TestSignWithdrawal_SubmittedSignature(t *testing.T) {
+	bank := newMockBankKeeper()
+	f := initFixtureWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString(sdk.AccAddress("owner_______________"))
+	require.NoError(t, err)
+	fundUSDC(t, f, bank, owner, 1_000)
+	resp, err := ms.InitiateWithdrawal(f.ctx, &types.MsgInitiateWithdrawal{Creator: owner, Amount: 400, BaseAddress: testBaseAddress})
+	require.NoError(t, err)
+	request, err := f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+
+	validator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	setBridgeSigners(t, f, validator)
+	signature, err := keeper.SignWithdrawalRequest(f.ctx, request, keeper.NewPrivateKeySigner(validator))
+	require.NoError(t, err)
+
+	// A signature that recovers to no key is rejected
+	_, err = ms.SignWithdrawal(f.ctx, types.NewMsgSignWithdrawal(owner, resp.Nonce, make([]byte, 65)))
+	require.Error(t, err)
+
+	// So is a valid signature by a key that is not a bridge signer
+	stranger, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	forged, err := keeper.SignWithdrawalRequest(f.ctx, request, keeper.NewPrivateKeySigner(stranger))
+	require.NoError(t, err)
+	_, err = ms.SignWithdrawal(f.ctx, types.NewMsgSignWithdrawal(owner, resp.Nonce, forged))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// No signatures are accepted while the bridge is paused
+	require.NoError(t, f.keeper.BridgePause.Set(f.ctx, types.BridgePauseState{Paused: true}))
+	_, err = ms.SignWithdrawal(f.ctx, types.NewMsgSignWithdrawal(owner, resp.Nonce, signature))
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+	require.NoError(t, f.keeper.BridgePause.Set(f.ctx, types.BridgePauseState{}))
+
+	res, err := ms.SignWithdrawal(f.ctx, types.NewMsgSignWithdrawal(owner, resp.Nonce, signature))
+	require.NoError(t, err)
+	require.Equal(t, signature, res.Signature)
+	request, err = f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, keeper.WithdrawalStatusSigned, request.Status)
+	require.Equal(t, signature, request.Signature)
+
+	// Once stored, the signature is not replaced
+	_, err = ms.SignWithdrawal(f.ctx, types.NewMsgSignWithdrawal(owner, resp.Nonce, forged))
+	require.NoError(t, err)
+	request, err = f.keeper.WithdrawalRequests.Get(f.ctx, resp.Nonce)
+	require.NoError(t, err)
+	require.Equal(t, signature, request.Signature)
+}
+
+func TestSignWithdrawal_ReplacesUnauthorizedSignature(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	owner, err := f.addressCodec.BytesToString(sdk.AccAddress("owner_______________"))
+	require.NoError(t, err)
+
+	old, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	validator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	oldSigner := keeper.NewPrivateKeySigner(old)
+
+	// Withdrawals signed, and a cancellation signed, by a key that has since
+	// been removed from the bridge signers
+	request := types.WithdrawalRequest{
+		Nonce:         testWithdrawalNonce,
+		CosmosAddress: owner,
+		BaseAddress:   testBaseAddress,
+		Amount:        500,
+		Status:        keeper.WithdrawalStatusSigned,
+	}
+	request.Signature, err = keeper.SignWithdrawalRequest(f.ctx, request, oldSigner)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.WithdrawalRequests.Set(f.ctx, request.Nonce, request))
+
+	cancelling := request
+	cancelling.Nonce = "0x0000000000000000000000000000000000000000000000000000000000000008"
+	cancelling.Status = keeper.WithdrawalStatusCancelling
+	cancelling.CancelSignature, err = keeper.SignWithdrawalRequest(f.ctx, cancelling, oldSigner)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.WithdrawalRequests.Set(f.ctx, cancelling.Nonce, cancelling))
+
+	setBridgeSigners(t, f, validator)
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+
+	for _, stored := range []types.WithdrawalRequest{request, cancelling} {
+		require.True(t, keeper.WithdrawalNeedsSignature(stored, params))
+
+		signature, err := keeper.SignWithdrawalRequest(f.ctx, stored, keeper.NewPrivateKeySigner(validator))
+		require.NoError(t, err)
+		res, err := ms.SignWithdrawal(f.ctx, types.NewMsgSignWithdrawal(owner, stored.Nonce, signature))
+		require.NoError(t, err)
+		require.Equal(t, signature, res.Signature)
+
+		updated, err := f.keeper.WithdrawalRequests.Get(f.ctx, stored.Nonce)
+		require.NoError(t, err)
+		require.Equal(t, stored.Status, updated.Status)
+		require.False(t, keeper.WithdrawalNeedsSignature(updated, params))
+	}
+}
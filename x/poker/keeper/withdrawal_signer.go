@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// WithdrawalSigner signs bridge messages with the validator's Ethereum key.
+// The keeper only hands it digests; whether the key is held in memory, in an
+// encrypted keystore or by a remote signer is up to the implementation (see
+// x/poker/signer).
+type WithdrawalSigner interface {
+	// Address returns the Ethereum address of the signing key
+	Address() common.Address
+
+	// SignDigest returns the 65-byte [R || S || V] signature of a 32-byte
+	// digest, with V in {0, 1}
+	SignDigest(ctx context.Context, digest common.Hash) ([]byte, error)
+}
+
+// privateKeySigner signs with a key held in process memory
+type privateKeySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewPrivateKeySigner returns a signer for an in-memory private key
+func NewPrivateKeySigner(key *ecdsa.PrivateKey) WithdrawalSigner {
+	return &privateKeySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func (s *privateKeySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *privateKeySigner) SignDigest(_ context.Context, digest common.Hash) ([]byte, error) {
+	return crypto.Sign(digest.Bytes(), s.key)
+}
+
+// signEthMessage signs a message hash the way Solidity's getEthSignedMessageHash
+// and ecrecover expect it. The signature is checked against the signer's
+// address, so a misbehaving remote signer cannot store a bad signature.
+func signEthMessage(ctx context.Context, messageHash common.Hash, signer WithdrawalSigner) ([]byte, error) {
+	// Add Ethereum signed message prefix (to match Solidity's getEthSignedMessageHash)
+	// The contract does: keccak256(abi.encodePacked("\x19Ethereum Signed Message:\n32", messageHash))
+	prefix := []byte("\x19Ethereum Signed Message:\n32")
+	prefixedMessage := append(prefix, messageHash.Bytes()...)
+	prefixedHash := crypto.Keccak256Hash(prefixedMessage)
+
+	signature, err := signer.SignDigest(ctx, prefixedHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("signer returned a %d-byte signature", len(signature))
+	}
+	signature = append([]byte(nil), signature...)
+
+	pubKey, err := crypto.SigToPub(prefixedHash.Bytes(), signature)
+	if err != nil {
+		return nil, fmt.Errorf("signer returned an invalid signature: %w", err)
+	}
+	if recovered := crypto.PubkeyToAddress(*pubKey); recovered != signer.Address() {
+		return nil, fmt.Errorf("signature recovers to %s, not signer %s", recovered.Hex(), signer.Address().Hex())
+	}
+
+	// Ethereum signatures need recovery id adjusted (v = 27 + v)
+	if signature[64] < 27 {
+		signature[64] += 27
+	}
+	return signature, nil
+}
+
+// recoverEthSigner returns the address that signed messageHash the way
+// signEthMessage does, and the signature with V in {27, 28}
+func recoverEthSigner(messageHash common.Hash, signature []byte) (common.Address, []byte, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, nil, fmt.Errorf("invalid signature length: expected %d bytes, got %d", crypto.SignatureLength, len(signature))
+	}
+	prefixedHash := crypto.Keccak256Hash(append([]byte("\x19Ethereum Signed Message:\n32"), messageHash.Bytes()...))
+
+	sig := append([]byte(nil), signature...)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pubKey, err := crypto.SigToPub(prefixedHash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	sig[64] += 27
+	return crypto.PubkeyToAddress(*pubKey), sig, nil
+}
@@ -0,0 +1,196 @@
+// Package v2 migrates the poker module store from consensus version 1 to 2.
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// MigrateStore backfills the fields that params, games and game states
+// stored at consensus version 1 may be missing:
+//
+//   - Params: version 1 had no params, so every unset field with a non-zero
+//     default gets its DefaultParams value
+//   - Game: Denom (TokenDenom, the only table currency before per-game
+//     denominations), Status, RakeOwner (the creator), UpdatedAt and Players
+//   - TexasHoldemStateDTO: Type, Address, Round, HandNumber, the table
+//     options CreateGame copies from the game, and nil lists (which the PVM
+//     rejects as null)
+//
+// Fields that are already set are left alone, so running it twice is a no-op.
+func MigrateStore(
+	ctx context.Context,
+	params collections.Item[types.Params],
+	games collections.Map[string, types.Game],
+	states collections.Map[string, types.TexasHoldemStateDTO],
+) error {
+	if err := migrateParams(ctx, params); err != nil {
+		return err
+	}
+
+	// Collect first: collections must not be written while being iterated
+	var all []types.Game
+	if err := games.Walk(ctx, nil, func(_ string, game types.Game) (bool, error) {
+		all = append(all, game)
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("failed to read games: %w", err)
+	}
+
+	for _, game := range all {
+		migrateGame(&game)
+		if err := games.Set(ctx, game.GameId, game); err != nil {
+			return fmt.Errorf("failed to store game %s: %w", game.GameId, err)
+		}
+
+		state, err := states.Get(ctx, game.GameId)
+		if err != nil {
+			// Games without a state are reported by the game-states invariant;
+			// there is no state to backfill
+			continue
+		}
+		migrateGameState(game, &state)
+		if err := states.Set(ctx, game.GameId, state); err != nil {
+			return fmt.Errorf("failed to store game state %s: %w", game.GameId, err)
+		}
+	}
+	return nil
+}
+
+func migrateParams(ctx context.Context, item collections.Item[types.Params]) error {
+	params, err := item.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("failed to read params: %w", err)
+	}
+
+	defaults := types.DefaultParams()
+	setDefault(&params.DepositConfirmations, defaults.DepositConfirmations)
+	setDefault(&params.HeaderRetention, defaults.HeaderRetention)
+	setDefault(&params.DepositContractAddress, defaults.DepositContractAddress)
+	setDefault(&params.WithdrawalExpiry, defaults.WithdrawalExpiry)
+	setDefault(&params.WithdrawalWindow, defaults.WithdrawalWindow)
+	setDefault(&params.LargeWithdrawalDelay, defaults.LargeWithdrawalDelay)
+	setDefault(&params.HandStartDelay, defaults.HandStartDelay)
+	setDefault(&params.TimeBank, defaults.TimeBank)
+	setDefault(&params.TimeBankIncrement, defaults.TimeBankIncrement)
+	setDefault(&params.TimeBankReplenishHands, defaults.TimeBankReplenishHands)
+	setDefault(&params.TimeBankMax, defaults.TimeBankMax)
+	setDefault(&params.SeatReservationWindow, defaults.SeatReservationWindow)
+	if len(params.AllowedGameDenoms) == 0 {
+		params.AllowedGameDenoms = defaults.AllowedGameDenoms
+	}
+
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("migrated params are invalid: %w", err)
+	}
+	if err := item.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to store params: %w", err)
+	}
+	return nil
+}
+
+// setDefault sets an unset (zero) field to its default
+func setDefault[T comparable](field *T, def T) {
+	var zero T
+	if *field == zero {
+		*field = def
+	}
+}
+
+func migrateGame(game *types.Game) {
+	if game.Denom == "" {
+		game.Denom = types.TokenDenom
+	}
+	if game.RakeOwner == "" {
+		game.RakeOwner = game.Creator
+	}
+	if game.UpdatedAt.IsZero() {
+		game.UpdatedAt = game.CreatedAt
+	}
+	if game.Players == nil {
+		game.Players = []string{}
+	}
+	game.Status = game.SeatedStatus()
+}
+
+func migrateGameState(game types.Game, state *types.TexasHoldemStateDTO) {
+	if state.Type == "" {
+		state.Type = types.GameTypeTexasHoldem
+	}
+	if state.Address == "" {
+		state.Address = game.GameId
+	}
+	if state.Round == "" {
+		state.Round = types.RoundAnte
+	}
+	if state.HandNumber == 0 {
+		state.HandNumber = 1
+	}
+
+	options := &state.GameOptions
+	if options.MinBuyIn == nil {
+		options.MinBuyIn = ptr(strconv.FormatUint(game.MinBuyIn, 10))
+	}
+	if options.MaxBuyIn == nil {
+		options.MaxBuyIn = ptr(strconv.FormatUint(game.MaxBuyIn, 10))
+	}
+	if options.SmallBlind == nil {
+		options.SmallBlind = ptr(strconv.FormatUint(game.SmallBlind, 10))
+	}
+	if options.BigBlind == nil {
+		options.BigBlind = ptr(strconv.FormatUint(game.BigBlind, 10))
+	}
+	if options.MinPlayers == nil {
+		options.MinPlayers = ptr(int(game.MinPlayers))
+	}
+	if options.MaxPlayers == nil {
+		options.MaxPlayers = ptr(int(game.MaxPlayers))
+	}
+	if options.Type == nil {
+		options.Type = ptr(gameType(game.GameType))
+	}
+	if options.Owner == nil {
+		options.Owner = ptr(game.RakeOwner)
+	}
+
+	if state.Players == nil {
+		state.Players = []types.PlayerDTO{}
+	}
+	if state.CommunityCards == nil {
+		state.CommunityCards = []string{}
+	}
+	if state.Pots == nil {
+		state.Pots = []string{}
+	}
+	if state.PreviousActions == nil {
+		state.PreviousActions = []types.ActionDTO{}
+	}
+	if state.Winners == nil {
+		state.Winners = []types.WinnerDTO{}
+	}
+	if state.Results == nil {
+		state.Results = []types.ResultDTO{}
+	}
+}
+
+// gameType maps Game.GameType as CreateGame did at version 1
+func gameType(s string) types.GameType {
+	switch s {
+	case "sit-and-go":
+		return types.GameTypeSitAndGo
+	case "tournament":
+		return types.GameTypeTournament
+	default:
+		return types.GameTypeCash
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
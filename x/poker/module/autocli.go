@@ -44,6 +44,41 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query legal-actions",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}, {ProtoField: "player_address"}},
 				},
+				{
+					RpcMethod: "EthHeaderTip",
+					Use:       "eth-header-tip",
+					Short:     "Query the latest tracked Ethereum header",
+				},
+				{
+					RpcMethod: "BridgeStatus",
+					Use:       "bridge-status",
+					Short:     "Query the bridge pause state and remaining withdrawal headroom",
+					Long:      "Query the bridge pause state and the withdrawal limits left in the current window. Pass --address to include the headroom of an address.",
+				},
+				{
+					RpcMethod:      "EstimateWithdrawalFee",
+					Use:            "estimate-withdrawal-fee [amount]",
+					Short:          "Estimate the fee and net payout of a withdrawal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "EthHeader",
+					Use:            "eth-header [number]",
+					Short:          "Query a tracked Ethereum header by block number",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "number"}},
+				},
+				{
+					RpcMethod:      "WaitlistPosition",
+					Use:            "waitlist-position [game-id] [player]",
+					Short:          "Query a player's place in a table's waitlist and any seat held for them",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}, {ProtoField: "player"}},
+				},
+				{
+					RpcMethod:      "GameStateSpectator",
+					Use:            "game-state-spectator [game-id]",
+					Short:          "Query what spectators of a table see, with its spectator policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}},
+				},
 
 				// this line is used by ignite scaffolding # autocli/query
 			},
@@ -88,9 +123,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "Mint",
-					Use:            "mint [recipient] [amount] [eth-tx-hash] [nonce]",
+					Use:            "mint [recipient] [amount] [eth-tx-hash] [nonce] [eth-block-height] [tx-index] [log-index]",
 					Short:          "Send a mint tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount"}, {ProtoField: "eth_tx_hash"}, {ProtoField: "nonce"}},
+					Long:           "Mint bridged USDC for a deposit proven against a tracked Ethereum header. Pass the receipt proof nodes with --receipt-proof.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount"}, {ProtoField: "eth_tx_hash"}, {ProtoField: "nonce"}, {ProtoField: "eth_block_height"}, {ProtoField: "tx_index"}, {ProtoField: "log_index"}},
 				},
 				{
 					RpcMethod:      "Burn",
@@ -100,9 +136,80 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "ProcessDeposit",
-					Use:            "process-deposit [deposit-index] [eth-block-height]",
+					Use:            "process-deposit [deposit-index] [eth-block-height] [recipient] [tx-index] [log-index]",
 					Short:          "Process an Ethereum bridge deposit by index",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "deposit_index"}, {ProtoField: "eth_block_height"}},
+					Long:           "Process a bridge deposit proven against a tracked Ethereum header. Pass the receipt proof nodes with --receipt-proof.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "deposit_index"}, {ProtoField: "eth_block_height"}, {ProtoField: "recipient"}, {ProtoField: "tx_index"}, {ProtoField: "log_index"}},
+				},
+				{
+					RpcMethod:      "SubmitEthHeaders",
+					Use:            "submit-eth-headers [headers...]",
+					Short:          "Submit RLP-encoded Ethereum headers to the deposit light client",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "headers", Varargs: true}},
+				},
+				{
+					RpcMethod:      "CompleteWithdrawal",
+					Use:            "complete-withdrawal [nonce] [eth-block-height] [tx-index] [log-index]",
+					Short:          "Mark a withdrawal completed by proving its Withdrawn event on Base",
+					Long:           "Complete a signed withdrawal proven against a tracked Ethereum header. Pass the receipt proof nodes with --receipt-proof.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "nonce"}, {ProtoField: "eth_block_height"}, {ProtoField: "tx_index"}, {ProtoField: "log_index"}},
+				},
+				{
+					RpcMethod:      "CancelWithdrawal",
+					Use:            "cancel-withdrawal [nonce]",
+					Short:          "Cancel an expired withdrawal that was not claimed on Base",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "nonce"}},
+				},
+				{
+					RpcMethod:      "RefundWithdrawal",
+					Use:            "refund-withdrawal [nonce] [eth-block-height] [tx-index] [log-index]",
+					Short:          "Refund a cancelled withdrawal by proving its nonce was invalidated on Base",
+					Long:           "Refund a cancelling withdrawal proven against a tracked Ethereum header. Pass the receipt proof nodes with --receipt-proof.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "nonce"}, {ProtoField: "eth_block_height"}, {ProtoField: "tx_index"}, {ProtoField: "log_index"}},
+				},
+				{
+					RpcMethod:      "SetBridgePaused",
+					Use:            "set-bridge-paused [paused] [reason]",
+					Short:          "Pause or unpause the bridge (guardians may only pause)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "paused"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "SetAutoPostBlinds",
+					Use:            "set-auto-post-blinds [game-id] [enabled]",
+					Short:          "Have the chain post your blinds automatically at a table",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}, {ProtoField: "enabled"}},
+				},
+				{
+					RpcMethod:      "UpdateAllowlist",
+					Use:            "update-allowlist [game-id]",
+					Short:          "Add and remove the players allowed to sit at a table you created",
+					Long:           "Update a table's allowlist. Pass the addresses to allow with --add and those to remove with --remove.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}},
+				},
+				{
+					RpcMethod:      "JoinWaitlist",
+					Use:            "join-waitlist [game-id]",
+					Short:          "Wait for the next free seat at a full table",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}},
+				},
+				{
+					RpcMethod:      "LeaveWaitlist",
+					Use:            "leave-waitlist [game-id]",
+					Short:          "Stop waiting for a seat at a table",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}},
+				},
+				{
+					RpcMethod:      "SetAutoTopUp",
+					Use:            "set-auto-top-up [game-id] [mode] [target-stack]",
+					Short:          "Rebuy when busted, or top up to a target stack, automatically between hands",
+					Long:           "Gives a standing instruction, paid from your spendable balance between hands. Mode \"rebuy\" buys back in for the target stack once you are busted; \"top-up\" brings your stack back up to it. The target must be within the table's buy-in limits.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}, {ProtoField: "mode"}, {ProtoField: "target_stack"}},
+				},
+				{
+					RpcMethod:      "CancelAutoTopUp",
+					Use:            "cancel-auto-top-up [game-id]",
+					Short:          "Cancel your automatic rebuy or top-up at a table",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "game_id"}},
 				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
@@ -0,0 +1,100 @@
+package poker
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the ICS-20 transfer module and converts USDC arriving
+// over an allowlisted route into usdc chips, and refunds of failed IBC
+// withdrawals back into chips
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper *keeper.Keeper
+}
+
+// NewIBCMiddleware wraps the transfer module's IBC application
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{IBCModule: app, keeper: k}
+}
+
+// OnRecvPacket converts received USDC once the transfer succeeded. A failed
+// conversion fails the packet, so the whole transfer is reverted and refunded.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	data, err := ibctransfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return ack
+	}
+	if err := im.keeper.OnRecvIBCUSDC(ctx, packet, data); err != nil {
+		ctx.Logger().Error("❌ Failed to convert IBC USDC", "sequence", packet.Sequence, "error", err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
+}
+
+// OnAcknowledgementPacket converts the refund of a failed IBC withdrawal
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || ack.Success() {
+		return nil
+	}
+	im.convertRefund(ctx, channelVersion, packet)
+	return nil
+}
+
+// OnTimeoutPacket converts the refund of a timed out IBC withdrawal
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+	im.convertRefund(ctx, channelVersion, packet)
+	return nil
+}
+
+// convertRefund converts refunded USDC back into chips. The refund itself has
+// already happened, so a failed conversion only leaves the sender holding the
+// IBC denom and must not fail the packet.
+func (im IBCMiddleware) convertRefund(ctx sdk.Context, channelVersion string, packet channeltypes.Packet) {
+	data, err := ibctransfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return
+	}
+	cacheCtx, write := ctx.CacheContext()
+	if err := im.keeper.OnIBCUSDCRefund(cacheCtx, packet, data); err != nil {
+		ctx.Logger().Error("❌ Failed to convert refunded IBC USDC", "sequence", packet.Sequence, "error", err)
+		return
+	}
+	write()
+}
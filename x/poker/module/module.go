@@ -2,7 +2,6 @@ package poker
 
 import (
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
@@ -12,7 +11,6 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 
@@ -24,6 +22,7 @@ var (
 	_ module.AppModuleBasic = (*AppModule)(nil)
 	_ module.AppModule      = (*AppModule)(nil)
 	_ module.HasGenesis     = (*AppModule)(nil)
+	_ module.HasInvariants  = (*AppModule)(nil)
 
 	_ appmodule.AppModule       = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
@@ -70,6 +69,11 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtim
 	}
 }
 
+// RegisterInvariants registers the poker module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
 // RegisterInterfaces registers a module's interface types and their concrete implementations as proto.Message.
 func (AppModule) RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registrar)
@@ -80,6 +84,15 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(am.keeper))
 
+	// The module manager registers services through its Configurator, which
+	// also holds the store migrations run by upgrade handlers
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := keeper.NewMigrator(am.keeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+			return fmt.Errorf("failed to register %s migration from version 1: %w", types.ModuleName, err)
+		}
+	}
+
 	return nil
 }
 
@@ -130,7 +143,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return types.ConsensusVersion }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -141,99 +154,35 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 // EndBlock contains the logic that is automatically triggered at the end of each block.
 // The end block implementation is optional.
 func (am AppModule) EndBlock(ctx context.Context) error {
-	// AUTOMATIC DEPOSIT SYNCHRONIZATION
-	// Validators automatically sync deposits from Ethereum in EndBlock.
-	//
-	// This is DETERMINISTIC because:
-	// 1. The eth_block_height is stored in consensus state (set by relayer via MsgProcessDeposit)
-	// 2. All validators query Ethereum at the SAME stored block height
-	// 3. Deposits are processed sequentially by index
-	// 4. The LastProcessedDepositIndex tracks progress in consensus state
-	//
-	// Flow:
-	// 1. Relayer detects new deposit on Ethereum
-	// 2. Relayer submits MsgProcessDeposit with current eth_block_height
-	// 3. MsgProcessDeposit stores the eth_block_height in state
-	// 4. EndBlock auto-sync uses that stored height for subsequent deposits
-	// 5. All validators query at the same height = deterministic results
-	//
-	// If no eth_block_height is set yet (fresh chain), auto-sync waits for relayer
-	// to process the first deposit, which will set the initial height.
-	//
-	// Process up to 5 deposits per block to avoid timeouts
-	for i := 0; i < 5; i++ {
-		processed, err := am.keeper.ProcessNextDeposit(ctx)
-		if err != nil {
-			// Log error but don't halt chain
-			break
-		}
-		if !processed {
-			// No more deposits to process (or no eth_block_height set yet)
-			break
-		}
-	}
-
-	// WITHDRAWAL AUTO-SIGNING:
-	// Unlike deposits, withdrawal signing CAN be done in EndBlocker because:
-	// 1. Withdrawal data is already in consensus state (from MsgInitiateWithdrawal)
-	// 2. Signing is deterministic IF all validators use the same signing approach
-	// 3. Only pending withdrawals (already in state) are signed
-	//
-	// IMPORTANT: For production, configure validator signing key properly.
-	// For now, this is a placeholder - actual signing requires validator key configuration.
-	//
-	// TODO: Add proper validator key management for withdrawal signing
-	// Options:
-	// - Environment variable with validator Ethereum private key
-	// - Keyring integration
-	// - External signing service
-	//
-	// For testing/development: Withdrawal signing is currently disabled in EndBlocker.
-	// Use a separate MsgSignWithdrawal transaction or off-chain signing process.
-
-	// Check for pending withdrawals that need signing
-	pendingWithdrawals, err := am.keeper.ListWithdrawalRequestsInternal(ctx, "")
-	if err != nil {
-		return nil // Don't halt the chain for this
+	// DEPOSIT SYNCHRONIZATION:
+	// Deposits are NOT fetched here. Making RPC calls to Ethereum during block
+	// execution is non-deterministic. Instead either:
+	// - relayers submit source chain headers (MsgSubmitEthHeaders) and receipt
+	//   proofs (MsgProcessDeposit), verified against the tracked receipts roots, or
+	// - validators report deposits in vote extensions, and the oracle PreBlocker
+	//   (x/poker/oracle) mints those that reach a two-thirds quorum.
+
+	// WITHDRAWAL SIGNING:
+	// Withdrawals are NOT signed here either. Signing calls out to each
+	// validator's own key or remote signer, which can fail or time out on some
+	// nodes and not others. Instead the bridge signer sidecar (cmd/bridge-signer)
+	// watches for withdrawals awaiting a signature, signs them off chain and
+	// submits the signatures with MsgSignWithdrawal.
+
+	// Release queued large withdrawals whose delay has passed (held while the bridge is paused)
+	if err := am.keeper.ReleaseQueuedWithdrawals(ctx); err != nil {
+		return err
 	}
 
-	// Count pending withdrawals
-	pendingCount := 0
-	for _, wr := range pendingWithdrawals {
-		if wr.Status == "pending" {
-			pendingCount++
-		}
+	// Offer free seats to waitlisted players and release lapsed reservations
+	if err := am.keeper.AdvanceWaitlists(ctx); err != nil {
+		return err
 	}
 
-	if pendingCount > 0 {
-		// Get validator Ethereum private key from keeper config
-		// This key is set via bridge.validator_eth_private_key in config.yml
-		validatorEthKeyHex := am.keeper.GetValidatorEthPrivateKey()
-
-		if validatorEthKeyHex != "" {
-			// Parse the private key
-			keyHex := validatorEthKeyHex
-			if len(keyHex) > 2 && keyHex[:2] == "0x" {
-				keyHex = keyHex[2:]
-			}
-
-			keyBytes, err := hex.DecodeString(keyHex)
-			if err == nil && len(keyBytes) == 32 {
-				validatorPrivKey, err := crypto.ToECDSA(keyBytes)
-				if err == nil {
-					// Sign all pending withdrawals automatically
-					for _, wr := range pendingWithdrawals {
-						if wr.Status == "pending" {
-							if err := am.keeper.SignWithdrawal(ctx, wr.Nonce, validatorPrivKey); err != nil {
-								// Log error but don't halt chain
-								// In production, consider more sophisticated error handling
-							}
-						}
-					}
-				}
-			}
-		}
-		// If no validator key configured, pending withdrawals must be signed manually via MsgSignWithdrawal
+	// Start hands, post opted-in blinds and deal at tables that are waiting
+	// on bookkeeping actions. Per-table errors are logged, not returned.
+	if err := am.keeper.AdvanceHands(ctx); err != nil {
+		return err
 	}
 
 	return nil
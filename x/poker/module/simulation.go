@@ -3,6 +3,7 @@ package poker
 import (
 	"math/rand"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -17,21 +18,26 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	// Simulated accounts are funded in the bond denom, so allow tables in it
+	params := types.DefaultParams()
+	params.AllowedGameDenoms = []string{types.TokenDenom, sdk.DefaultBondDenom}
 	pokerGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params: params,
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&pokerGenesis)
 }
 
 // RegisterStoreDecoder registers a decoder.
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 	const (
-		opWeightMsgCreateGame          = "op_weight_msg_poker"
-		defaultWeightMsgCreateGame int = 100
+		opWeightMsgCreateGame          = "op_weight_msg_create_game"
+		defaultWeightMsgCreateGame int = 20
 	)
 
 	var weightMsgCreateGame int
@@ -45,8 +51,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pokersimulation.SimulateMsgCreateGame(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgJoinGame          = "op_weight_msg_poker"
-		defaultWeightMsgJoinGame int = 100
+		opWeightMsgJoinGame          = "op_weight_msg_join_game"
+		defaultWeightMsgJoinGame int = 50
 	)
 
 	var weightMsgJoinGame int
@@ -60,8 +66,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pokersimulation.SimulateMsgJoinGame(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgLeaveGame          = "op_weight_msg_poker"
-		defaultWeightMsgLeaveGame int = 100
+		opWeightMsgLeaveGame          = "op_weight_msg_leave_game"
+		defaultWeightMsgLeaveGame int = 10
 	)
 
 	var weightMsgLeaveGame int
@@ -75,8 +81,113 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pokersimulation.SimulateMsgLeaveGame(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgDealCards          = "op_weight_msg_poker"
-		defaultWeightMsgDealCards int = 100
+		opWeightMsgTopUp          = "op_weight_msg_top_up"
+		defaultWeightMsgTopUp int = 10
+	)
+
+	var weightMsgTopUp int
+	simState.AppParams.GetOrGenerate(opWeightMsgTopUp, &weightMsgTopUp, nil,
+		func(_ *rand.Rand) {
+			weightMsgTopUp = defaultWeightMsgTopUp
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTopUp,
+		pokersimulation.SimulateMsgTopUp(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgSetAutoPostBlinds          = "op_weight_msg_set_auto_post_blinds"
+		defaultWeightMsgSetAutoPostBlinds int = 5
+	)
+
+	var weightMsgSetAutoPostBlinds int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetAutoPostBlinds, &weightMsgSetAutoPostBlinds, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoPostBlinds = defaultWeightMsgSetAutoPostBlinds
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetAutoPostBlinds,
+		pokersimulation.SimulateMsgSetAutoPostBlinds(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgUpdateAllowlist          = "op_weight_msg_update_allowlist"
+		defaultWeightMsgUpdateAllowlist int = 3
+	)
+
+	var weightMsgUpdateAllowlist int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateAllowlist, &weightMsgUpdateAllowlist, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateAllowlist = defaultWeightMsgUpdateAllowlist
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateAllowlist,
+		pokersimulation.SimulateMsgUpdateAllowlist(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgJoinWaitlist          = "op_weight_msg_join_waitlist"
+		defaultWeightMsgJoinWaitlist int = 10
+	)
+
+	var weightMsgJoinWaitlist int
+	simState.AppParams.GetOrGenerate(opWeightMsgJoinWaitlist, &weightMsgJoinWaitlist, nil,
+		func(_ *rand.Rand) {
+			weightMsgJoinWaitlist = defaultWeightMsgJoinWaitlist
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgJoinWaitlist,
+		pokersimulation.SimulateMsgJoinWaitlist(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgLeaveWaitlist          = "op_weight_msg_leave_waitlist"
+		defaultWeightMsgLeaveWaitlist int = 3
+	)
+
+	var weightMsgLeaveWaitlist int
+	simState.AppParams.GetOrGenerate(opWeightMsgLeaveWaitlist, &weightMsgLeaveWaitlist, nil,
+		func(_ *rand.Rand) {
+			weightMsgLeaveWaitlist = defaultWeightMsgLeaveWaitlist
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgLeaveWaitlist,
+		pokersimulation.SimulateMsgLeaveWaitlist(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgSetAutoTopUp          = "op_weight_msg_set_auto_top_up"
+		defaultWeightMsgSetAutoTopUp int = 5
+	)
+
+	var weightMsgSetAutoTopUp int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetAutoTopUp, &weightMsgSetAutoTopUp, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoTopUp = defaultWeightMsgSetAutoTopUp
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetAutoTopUp,
+		pokersimulation.SimulateMsgSetAutoTopUp(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgCancelAutoTopUp          = "op_weight_msg_cancel_auto_top_up"
+		defaultWeightMsgCancelAutoTopUp int = 2
+	)
+
+	var weightMsgCancelAutoTopUp int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelAutoTopUp, &weightMsgCancelAutoTopUp, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelAutoTopUp = defaultWeightMsgCancelAutoTopUp
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelAutoTopUp,
+		pokersimulation.SimulateMsgCancelAutoTopUp(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgDealCards          = "op_weight_msg_deal_cards"
+		defaultWeightMsgDealCards int = 5
 	)
 
 	var weightMsgDealCards int
@@ -90,7 +201,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pokersimulation.SimulateMsgDealCards(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgPerformAction          = "op_weight_msg_poker"
+		opWeightMsgPerformAction          = "op_weight_msg_perform_action"
 		defaultWeightMsgPerformAction int = 100
 	)
 
@@ -105,8 +216,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pokersimulation.SimulateMsgPerformAction(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgMint          = "op_weight_msg_poker"
-		defaultWeightMsgMint int = 100
+		opWeightMsgMint          = "op_weight_msg_mint"
+		defaultWeightMsgMint int = 5
 	)
 
 	var weightMsgMint int
@@ -120,8 +231,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pokersimulation.SimulateMsgMint(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgBurn          = "op_weight_msg_poker"
-		defaultWeightMsgBurn int = 100
+		opWeightMsgBurn          = "op_weight_msg_burn"
+		defaultWeightMsgBurn int = 5
 	)
 
 	var weightMsgBurn int
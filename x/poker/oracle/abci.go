@@ -0,0 +1,176 @@
+package oracle
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+)
+
+// observeTimeout bounds how long ExtendVote waits on the source chain RPC
+const observeTimeout = 2 * time.Second
+
+// Handler implements the ABCI++ handlers of the oracle
+type Handler struct {
+	logger   log.Logger
+	keeper   *keeper.Keeper
+	valStore baseapp.ValidatorStore
+	observer Observer // nil if this node does not observe Base
+
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewHandler creates the oracle handlers. The default proposal handler is
+// used for mempool transactions; the oracle only adds the injected tx.
+func NewHandler(logger log.Logger, k *keeper.Keeper, valStore baseapp.ValidatorStore, observer Observer, proposals *baseapp.DefaultProposalHandler) *Handler {
+	return &Handler{
+		logger:          logger.With("module", "poker/oracle"),
+		keeper:          k,
+		valStore:        valStore,
+		observer:        observer,
+		prepareProposal: proposals.PrepareProposalHandler(),
+		processProposal: proposals.ProcessProposalHandler(),
+	}
+}
+
+// ExtendVoteHandler attaches this validator's observation of Base to its
+// precommit. Observation failures produce an empty extension rather than an
+// error so the validator still votes.
+func (h *Handler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		if h.observer == nil {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		params, err := h.keeper.Params.Get(ctx)
+		if err != nil {
+			h.logger.Error("Failed to read params for vote extension", "error", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+		next, err := h.keeper.NextDepositIndex(ctx)
+		if err != nil {
+			h.logger.Error("Failed to read next deposit index", "error", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		observeCtx, cancel := context.WithTimeout(ctx, observeTimeout)
+		defer cancel()
+		ext, err := h.observer.Observe(observeCtx, params.DepositContractAddress, next)
+		if err != nil {
+			h.logger.Error("Failed to observe source chain", "height", req.Height, "error", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		bz, err := ext.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		h.logger.Debug("Extended vote",
+			"height", req.Height,
+			"eth_block_height", ext.EthBlockHeight,
+			"deposits", len(ext.Deposits),
+		)
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler rejects malformed vote extensions
+func (h *Handler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if _, err := DecodeVoteExtension(req.VoteExtension); err != nil {
+			h.logger.Info("Rejecting vote extension", "height", req.Height, "validator", req.ValidatorAddress, "error", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PrepareProposalHandler injects the previous block's extended commit as the
+// first tx of the proposal
+func (h *Handler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx) {
+			return h.prepareProposal(ctx, req)
+		}
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			// Propose without an oracle update rather than an invalid block
+			h.logger.Error("Invalid vote extensions, proposing without oracle tx", "height", req.Height, "error", err)
+			return h.prepareProposal(ctx, req)
+		}
+
+		injected, err := EncodeInjectedTx(req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+		req.MaxTxBytes -= int64(len(injected))
+		resp, err := h.prepareProposal(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Txs = append([][]byte{injected}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler verifies the injected extended commit and passes the
+// remaining txs to the default handler
+func (h *Handler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		txs := req.Txs
+		if len(txs) > 0 && IsInjectedTx(txs[0]) {
+			if !voteExtensionsEnabled(ctx) {
+				h.logger.Error("Oracle tx proposed before vote extensions are enabled", "height", req.Height)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			commit, err := DecodeInjectedTx(txs[0])
+			if err != nil {
+				h.logger.Error("Failed to decode oracle tx", "height", req.Height, "error", err)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), commit); err != nil {
+				h.logger.Error("Invalid vote extensions in oracle tx", "height", req.Height, "error", err)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			txs = txs[1:]
+		}
+
+		stripped := *req
+		stripped.Txs = txs
+		return h.processProposal(ctx, &stripped)
+	}
+}
+
+// PreBlocker aggregates the injected vote extensions and applies the result.
+// It is a no-op for blocks without an oracle tx.
+func (h *Handler) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+	if len(req.Txs) == 0 || !IsInjectedTx(req.Txs[0]) {
+		return nil
+	}
+	commit, err := DecodeInjectedTx(req.Txs[0])
+	if err != nil {
+		// ProcessProposal rejects undecodable oracle txs, so this cannot happen
+		// in a committed block
+		return err
+	}
+
+	result := Aggregate(VotesFromCommit(commit))
+	h.logger.Debug("Applying oracle result",
+		"height", req.Height,
+		"eth_block_height", result.EthBlockHeight,
+		"deposits", len(result.Deposits),
+	)
+	return h.keeper.ApplyOracleResult(ctx, result)
+}
+
+// voteExtensionsEnabled reports whether the current block carries vote
+// extensions from the previous height
+func voteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 && ctx.BlockHeight() > cp.Abci.VoteExtensionsEnableHeight
+}
@@ -0,0 +1,103 @@
+package oracle
+
+import (
+	"sort"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// Vote is one validator's vote extension weighted by its voting power
+type Vote struct {
+	Power     int64
+	Extension types.OracleVoteExtension
+}
+
+// depositKey identifies a deposit report; validators only agree on a deposit
+// if they report exactly the same recipient and amount for an index
+type depositKey struct {
+	index     uint64
+	recipient string
+	amount    uint64
+}
+
+// Aggregate combines the votes of one block into an oracle result.
+//
+// The height is the stake-weighted median of the reported heights, and is
+// only set when validators with more than two thirds of the total power
+// reported one. A deposit is included when validators with more than two
+// thirds of the total power reported it identically.
+func Aggregate(votes []Vote) types.OracleResult {
+	var total int64
+	for _, v := range votes {
+		if v.Power > 0 {
+			total += v.Power
+		}
+	}
+	if total == 0 {
+		return types.OracleResult{}
+	}
+
+	return types.OracleResult{
+		EthBlockHeight: medianHeight(votes, total),
+		Deposits:       quorumDeposits(votes, total),
+	}
+}
+
+// hasQuorum reports whether power is more than two thirds of total
+func hasQuorum(power, total int64) bool {
+	return 3*power > 2*total
+}
+
+// medianHeight returns the stake-weighted median of the reported heights
+func medianHeight(votes []Vote, total int64) uint64 {
+	var reports []Vote
+	var reported int64
+	for _, v := range votes {
+		if v.Power > 0 && v.Extension.EthBlockHeight > 0 {
+			reports = append(reports, v)
+			reported += v.Power
+		}
+	}
+	if !hasQuorum(reported, total) {
+		return 0
+	}
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Extension.EthBlockHeight < reports[j].Extension.EthBlockHeight
+	})
+	var cumulative int64
+	for _, r := range reports {
+		cumulative += r.Power
+		if 2*cumulative >= reported {
+			return r.Extension.EthBlockHeight
+		}
+	}
+	return reports[len(reports)-1].Extension.EthBlockHeight
+}
+
+// quorumDeposits returns the deposits reported identically by a quorum, by index
+func quorumDeposits(votes []Vote, total int64) []types.OracleDeposit {
+	power := make(map[depositKey]int64)
+	for _, v := range votes {
+		if v.Power <= 0 {
+			continue
+		}
+		seen := make(map[depositKey]bool)
+		for _, d := range v.Extension.Deposits {
+			key := depositKey{d.Index, d.Recipient, d.Amount}
+			if !seen[key] {
+				seen[key] = true
+				power[key] += v.Power
+			}
+		}
+	}
+
+	var deposits []types.OracleDeposit
+	for key, p := range power {
+		if hasQuorum(p, total) {
+			deposits = append(deposits, types.OracleDeposit{Index: key.index, Recipient: key.recipient, Amount: key.amount})
+		}
+	}
+	sort.Slice(deposits, func(i, j int) bool { return deposits[i].Index < deposits[j].Index })
+	return deposits
+}
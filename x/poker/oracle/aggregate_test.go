@@ -0,0 +1,107 @@
+package oracle
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func vote(power int64, height uint64, deposits ...types.OracleDeposit) Vote {
+	return Vote{Power: power, Extension: types.OracleVoteExtension{EthBlockHeight: height, Deposits: deposits}}
+}
+
+func deposit(index uint64, recipient string, amount uint64) types.OracleDeposit {
+	return types.OracleDeposit{Index: index, Recipient: recipient, Amount: amount}
+}
+
+func TestAggregate_Height(t *testing.T) {
+	tests := []struct {
+		name  string
+		votes []Vote
+		want  uint64
+	}{
+		{"equal power median", []Vote{vote(10, 100), vote(10, 102), vote(10, 101)}, 101},
+		{"stake weighted", []Vote{vote(60, 100), vote(20, 200), vote(20, 300)}, 100},
+		{"heavy outlier cannot drag median", []Vote{vote(30, 100), vote(30, 101), vote(40, 999)}, 101},
+		{"no quorum of reporters", []Vote{vote(10, 100), vote(10, 100), vote(20, 0)}, 0},
+		{"exactly two thirds is not quorum", []Vote{vote(10, 100), vote(10, 100), vote(10, 0)}, 0},
+		{"no votes", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Aggregate(tt.votes).EthBlockHeight)
+		})
+	}
+}
+
+func TestAggregate_Deposits(t *testing.T) {
+	d1 := deposit(1, "alice", 100)
+	d2 := deposit(2, "bob", 200)
+	d2forged := deposit(2, "mallory", 200)
+
+	result := Aggregate([]Vote{
+		vote(30, 100, d1, d2),
+		vote(30, 100, d1, d2),
+		vote(25, 100, d1, d2forged),
+		vote(15, 100), // lagging validator
+	})
+
+	// d1 has 85% of power, d2 only 60%
+	require.Equal(t, []types.OracleDeposit{d1}, result.Deposits)
+
+	result = Aggregate([]Vote{vote(30, 100, d2, d1), vote(30, 100, d2), vote(30, 100, d2, d2), vote(10, 100)})
+	require.Equal(t, []types.OracleDeposit{d2}, result.Deposits, "duplicates within a vote count once")
+}
+
+func TestValidateVoteExtension(t *testing.T) {
+	require.NoError(t, ValidateVoteExtension(types.OracleVoteExtension{EthBlockHeight: 1}))
+	require.NoError(t, ValidateVoteExtension(vote(1, 1, deposit(1, "a", 1), deposit(3, "b", 1)).Extension))
+	require.Error(t, ValidateVoteExtension(vote(1, 1, deposit(3, "a", 1), deposit(1, "b", 1)).Extension))
+	require.Error(t, ValidateVoteExtension(vote(1, 1, deposit(1, "", 1)).Extension))
+	require.Error(t, ValidateVoteExtension(vote(1, 1, deposit(1, "a", 0)).Extension))
+
+	var tooMany []types.OracleDeposit
+	for i := uint64(0); i <= MaxDepositsPerVote; i++ {
+		tooMany = append(tooMany, deposit(i, "a", 1))
+	}
+	require.Error(t, ValidateVoteExtension(vote(1, 1, tooMany...).Extension))
+}
+
+func TestInjectedTx_RoundTrip(t *testing.T) {
+	ext := vote(0, 123, deposit(4, "alice", 50)).Extension
+	bz, err := ext.Marshal()
+	require.NoError(t, err)
+
+	commit := abci.ExtendedCommitInfo{
+		Round: 1,
+		Votes: []abci.ExtendedVoteInfo{
+			{Validator: abci.Validator{Address: []byte("val1"), Power: 70}, VoteExtension: bz, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: abci.Validator{Address: []byte("val2"), Power: 20}, VoteExtension: []byte("garbage"), BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: abci.Validator{Address: []byte("val3"), Power: 10}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+		},
+	}
+
+	tx, err := EncodeInjectedTx(commit)
+	require.NoError(t, err)
+	require.True(t, IsInjectedTx(tx))
+	require.False(t, IsInjectedTx([]byte{0x0a, 0x01}))
+
+	decoded, err := DecodeInjectedTx(tx)
+	require.NoError(t, err)
+	require.Equal(t, commit, decoded)
+
+	// Invalid and absent votes count towards total power as empty votes
+	votes := VotesFromCommit(decoded)
+	require.Len(t, votes, 3)
+	require.Equal(t, ext, votes[0].Extension)
+	require.Equal(t, types.OracleVoteExtension{}, votes[1].Extension)
+
+	result := Aggregate(votes)
+	require.Equal(t, uint64(123), result.EthBlockHeight)
+	require.Equal(t, []types.OracleDeposit{deposit(4, "alice", 50)}, result.Deposits)
+}
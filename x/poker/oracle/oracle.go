@@ -0,0 +1,158 @@
+// Package oracle reports the finalized Base block height and new bridge
+// deposits through ABCI++ vote extensions.
+//
+// Each validator observes Base in ExtendVote and attaches what it saw to its
+// precommit. The next proposer injects the signed extended commit into its
+// proposal, ProcessProposal checks the signatures, and the PreBlocker
+// aggregates the votes (stake-weighted median height, deposits backed by more
+// than two thirds of voting power) and hands the result to the keeper.
+//
+// Only ExtendVote performs network I/O. Everything that runs during block
+// execution works on the extended commit alone and is deterministic.
+package oracle
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// MaxDepositsPerVote bounds the deposits a validator reports in one vote
+const MaxDepositsPerVote = 10
+
+// injectedTxPrefix marks the extended commit injected as the first block tx
+var injectedTxPrefix = []byte("poker-oracle/v1:")
+
+// Observer reads the source chain for ExtendVote
+type Observer interface {
+	// Observe returns the latest finalized height and the deposits starting at
+	// nextIndex that exist at that height
+	Observe(ctx context.Context, depositContract string, nextIndex uint64) (*types.OracleVoteExtension, error)
+}
+
+// ChainObserver observes Base through a chain reader
+type ChainObserver struct {
+	reader keeper.EthChainReader
+}
+
+// NewChainObserver creates an observer reading through the given chain reader
+func NewChainObserver(reader keeper.EthChainReader) *ChainObserver {
+	return &ChainObserver{reader: reader}
+}
+
+// Observe implements Observer
+func (o *ChainObserver) Observe(ctx context.Context, depositContract string, nextIndex uint64) (*types.OracleVoteExtension, error) {
+	verifier := keeper.NewBridgeVerifierWithReader(o.reader, depositContract)
+
+	height, err := verifier.FinalizedBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := &types.OracleVoteExtension{EthBlockHeight: height}
+	if depositContract == "" {
+		return ext, nil
+	}
+	for index := nextIndex; index < nextIndex+MaxDepositsPerVote; index++ {
+		deposit, err := verifier.GetDepositByIndex(ctx, index, height)
+		if err != nil {
+			// Not deposited yet (or not finalized)
+			break
+		}
+		if !deposit.Amount.IsUint64() {
+			return nil, fmt.Errorf("deposit %d amount %s out of range", index, deposit.Amount)
+		}
+		ext.Deposits = append(ext.Deposits, types.OracleDeposit{
+			Index:     index,
+			Recipient: deposit.Account,
+			Amount:    deposit.Amount.Uint64(),
+		})
+	}
+	return ext, nil
+}
+
+// ValidateVoteExtension checks the shape of a vote extension. It does not
+// check the reported values, which is what the quorum is for.
+func ValidateVoteExtension(ext types.OracleVoteExtension) error {
+	if len(ext.Deposits) > MaxDepositsPerVote {
+		return fmt.Errorf("%d deposits exceeds maximum of %d", len(ext.Deposits), MaxDepositsPerVote)
+	}
+	for i, deposit := range ext.Deposits {
+		if i > 0 && deposit.Index <= ext.Deposits[i-1].Index {
+			return fmt.Errorf("deposits must be in strictly ascending index order")
+		}
+		if deposit.Recipient == "" || len(deposit.Recipient) > 128 {
+			return fmt.Errorf("deposit %d has an invalid recipient", deposit.Index)
+		}
+		if deposit.Amount == 0 {
+			return fmt.Errorf("deposit %d has zero amount", deposit.Index)
+		}
+	}
+	return nil
+}
+
+// DecodeVoteExtension decodes and validates a vote extension. An empty
+// extension (a validator without an RPC endpoint) decodes to an empty vote.
+func DecodeVoteExtension(bz []byte) (types.OracleVoteExtension, error) {
+	var ext types.OracleVoteExtension
+	if len(bz) == 0 {
+		return ext, nil
+	}
+	if err := ext.Unmarshal(bz); err != nil {
+		return types.OracleVoteExtension{}, err
+	}
+	if err := ValidateVoteExtension(ext); err != nil {
+		return types.OracleVoteExtension{}, err
+	}
+	return ext, nil
+}
+
+// VotesFromCommit extracts the weighted votes from an extended commit. Every
+// validator in the commit counts towards the total power; absent validators
+// and invalid extensions count as empty votes.
+func VotesFromCommit(commit abci.ExtendedCommitInfo) []Vote {
+	votes := make([]Vote, 0, len(commit.Votes))
+	for _, v := range commit.Votes {
+		vote := Vote{Power: v.Validator.Power}
+		if v.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			if ext, err := DecodeVoteExtension(v.VoteExtension); err == nil {
+				vote.Extension = ext
+			}
+		}
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// EncodeInjectedTx encodes an extended commit as the oracle tx injected at
+// the start of a proposal
+func EncodeInjectedTx(commit abci.ExtendedCommitInfo) ([]byte, error) {
+	bz, err := commit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, injectedTxPrefix...), bz...), nil
+}
+
+// IsInjectedTx reports whether a block tx is the injected oracle tx
+func IsInjectedTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, injectedTxPrefix)
+}
+
+// DecodeInjectedTx decodes the extended commit from an injected oracle tx
+func DecodeInjectedTx(tx []byte) (abci.ExtendedCommitInfo, error) {
+	var commit abci.ExtendedCommitInfo
+	if !IsInjectedTx(tx) {
+		return commit, fmt.Errorf("not an oracle tx")
+	}
+	if err := commit.Unmarshal(tx[len(injectedTxPrefix):]); err != nil {
+		return commit, err
+	}
+	return commit, nil
+}
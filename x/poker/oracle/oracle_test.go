@@ -0,0 +1,37 @@
+package oracle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/testutil/ethsim"
+)
+
+func TestChainObserver_Observe(t *testing.T) {
+	sim := ethsim.NewBridge(t, common.Address{})
+	observer := NewChainObserver(sim.Reader())
+	ctx := context.Background()
+
+	for i := uint64(1); i <= 3; i++ {
+		sim.Deposit(t, "b52recipient", i*1_000)
+	}
+	tip := sim.Finalize(t)
+
+	ext, err := observer.Observe(ctx, sim.Address.Hex(), 1)
+	require.NoError(t, err)
+	require.Equal(t, tip, ext.EthBlockHeight)
+	require.Len(t, ext.Deposits, 2)
+	require.Equal(t, uint64(1), ext.Deposits[0].Index)
+	require.Equal(t, uint64(2_000), ext.Deposits[0].Amount)
+	require.Equal(t, "b52recipient", ext.Deposits[0].Recipient)
+	require.Equal(t, uint64(2), ext.Deposits[1].Index)
+
+	// Without a deposit contract only the height is reported
+	ext, err = observer.Observe(ctx, "", 0)
+	require.NoError(t, err)
+	require.Equal(t, tip, ext.EthBlockHeight)
+	require.Empty(t, ext.Deposits)
+}
@@ -0,0 +1,123 @@
+package pots
+
+import (
+	"sort"
+
+	"github.com/block52/pokerchain/x/poker/equity"
+)
+
+// Award is the outcome of a single pot
+type Award struct {
+	Pot
+	Rake    uint64   // taken from the pot before it is split
+	Winners []Payout // in odd-chip order (clockwise from the dealer's left)
+}
+
+// Split divides a pot evenly between its winners. Odd chips are handed out one
+// at a time to the winners closest to the left of the dealer.
+func Split(amount uint64, winners []Player, dealer int) []Payout {
+	if len(winners) == 0 {
+		return nil
+	}
+	ordered := make([]Player, len(winners))
+	copy(ordered, winners)
+	SortFromDealer(ordered, dealer)
+
+	share := amount / uint64(len(ordered))
+	oddChips := amount % uint64(len(ordered))
+	payouts := make([]Payout, len(ordered))
+	for i, p := range ordered {
+		payouts[i] = Payout{Player: p, Amount: share}
+		if uint64(i) < oddChips {
+			payouts[i].Amount++
+		}
+	}
+	return payouts
+}
+
+// Distribute awards every pot to its eligible players with the highest score.
+// Players missing from scores are treated as the weakest hand, which is only
+// correct when they are the sole eligible player (e.g. everyone else folded).
+func Distribute(pots []Pot, scores map[string]uint32, dealer int) []Award {
+	return DistributeRaked(pots, scores, dealer, Rake{})
+}
+
+// DistributeRaked is Distribute at a raked table: each pot's share of the
+// rake is taken before the rest is split between its winners
+func DistributeRaked(pots []Pot, scores map[string]uint32, dealer int, rake Rake) []Award {
+	rakes := rake.Take(pots)
+	awards := make([]Award, 0, len(pots))
+	for i, pot := range pots {
+		var best uint32
+		var winners []Player
+		for _, p := range pot.Eligible {
+			score := scores[p.Address]
+			switch {
+			case len(winners) == 0 || score > best:
+				best = score
+				winners = []Player{p}
+			case score == best:
+				winners = append(winners, p)
+			}
+		}
+		awards = append(awards, Award{Pot: pot, Rake: rakes[i], Winners: Split(pot.Amount-rakes[i], winners, dealer)})
+	}
+	return awards
+}
+
+// Totals sums the awards, net of rake, and an optional refund per address
+func Totals(awards []Award, refund *Payout) map[string]uint64 {
+	totals := make(map[string]uint64)
+	for _, a := range awards {
+		for _, w := range a.Winners {
+			totals[w.Address] += w.Amount
+		}
+	}
+	if refund != nil {
+		totals[refund.Address] += refund.Amount
+	}
+	return totals
+}
+
+// TotalRake sums the rake taken from the awards
+func TotalRake(awards []Award) uint64 {
+	var rake uint64
+	for _, a := range awards {
+		rake += a.Rake
+	}
+	return rake
+}
+
+// ScoreHands evaluates each player's hole cards with the shared board using the
+// equity evaluator. Scores are comparable across players (higher wins).
+func ScoreHands(board equity.CardMask, holes map[string]equity.CardMask) map[string]uint32 {
+	addresses := make([]string, 0, len(holes))
+	for address := range holes {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	masks := make([]equity.CardMask, len(addresses))
+	for i, address := range addresses {
+		masks[i] = holes[address]
+	}
+	results := make([]uint32, len(masks))
+	equity.EvaluateBatch(board, masks, results)
+
+	scores := make(map[string]uint32, len(addresses))
+	for i, address := range addresses {
+		scores[address] = results[i]
+	}
+	return scores
+}
+
+// SortFromDealer orders players clockwise starting left of the dealer
+func SortFromDealer(players []Player, dealer int) {
+	sort.SliceStable(players, func(i, j int) bool {
+		afterI, afterJ := players[i].Seat > dealer, players[j].Seat > dealer
+		if afterI != afterJ {
+			return afterI
+		}
+		return players[i].Seat < players[j].Seat
+	})
+}
@@ -0,0 +1,191 @@
+// Package pots computes main and side pots from per-player hand contributions
+// and distributes them to the winners.
+//
+// Everything here is pure integer arithmetic with deterministic ordering, so
+// it is safe to use during block execution.
+package pots
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	// ErrNoLivePlayers is returned when every contributor has folded
+	ErrNoLivePlayers = errors.New("no live players")
+	// ErrDuplicatePlayer is returned when a player or seat appears twice
+	ErrDuplicatePlayer = errors.New("duplicate player")
+	// ErrUnmatchedBet is returned when a live player who is not all-in has
+	// put in less than the bet they were facing
+	ErrUnmatchedBet = errors.New("unmatched bet")
+)
+
+// Player identifies a seated player
+type Player struct {
+	Address string
+	Seat    int
+}
+
+// Contribution is the total a player put into the current hand (SumOfBets)
+type Contribution struct {
+	Player
+	Amount uint64
+	Folded bool // folded players fund pots but cannot win them
+	AllIn  bool // all-in players may have put in less than the current bet
+}
+
+// Pot is a main or side pot and the players eligible to win it.
+// Eligible players are sorted by seat.
+type Pot struct {
+	Amount   uint64
+	Eligible []Player
+}
+
+// Payout is an amount paid to a player
+type Payout struct {
+	Player
+	Amount uint64
+}
+
+// Build splits contributions into a main pot followed by side pots.
+//
+// The part of the largest contribution that nobody else matched is an uncalled
+// bet; it is returned as a refund rather than put into a pot. A new pot is
+// closed at every distinct contribution level of a live (non-folded) player,
+// and each pot is contested by the live players who reached that level. Chips
+// folded above the highest live level go to the last pot.
+//
+// Chips are conserved: the pot amounts plus the refund always equal the sum of
+// all contributions.
+func Build(contributions []Contribution) ([]Pot, *Payout, error) {
+	if err := validate(contributions); err != nil {
+		return nil, nil, err
+	}
+
+	// Work on a copy sorted by seat so results don't depend on input order
+	contribs := make([]Contribution, len(contributions))
+	copy(contribs, contributions)
+	sort.Slice(contribs, func(i, j int) bool { return contribs[i].Seat < contribs[j].Seat })
+
+	refund := returnUncalled(contribs)
+
+	var levels []uint64
+	seen := make(map[uint64]bool)
+	for _, c := range contribs {
+		if !c.Folded && c.Amount > 0 && !seen[c.Amount] {
+			seen[c.Amount] = true
+			levels = append(levels, c.Amount)
+		}
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i] < levels[j] })
+
+	var pots []Pot
+	var previous uint64
+	for i, level := range levels {
+		last := i == len(levels)-1
+		pot := Pot{}
+		for _, c := range contribs {
+			top := c.Amount
+			if top > level && !last {
+				top = level
+			}
+			if top > previous {
+				pot.Amount += top - previous
+			}
+			if !c.Folded && c.Amount >= level {
+				pot.Eligible = append(pot.Eligible, c.Player)
+			}
+		}
+		pots = append(pots, pot)
+		previous = level
+	}
+
+	// No live player put anything in (only folded chips): one pot for all live players
+	if len(pots) == 0 {
+		pot := Pot{}
+		for _, c := range contribs {
+			pot.Amount += c.Amount
+			if !c.Folded {
+				pot.Eligible = append(pot.Eligible, c.Player)
+			}
+		}
+		pots = append(pots, pot)
+	}
+
+	return pots, refund, nil
+}
+
+// validate checks contributions for duplicates, live players and matched bets
+func validate(contribs []Contribution) error {
+	addresses := make(map[string]bool)
+	seats := make(map[int]bool)
+	live := 0
+	for _, c := range contribs {
+		if addresses[c.Address] || seats[c.Seat] {
+			return fmt.Errorf("%w: %s in seat %d", ErrDuplicatePlayer, c.Address, c.Seat)
+		}
+		addresses[c.Address] = true
+		seats[c.Seat] = true
+		if !c.Folded {
+			live++
+		}
+	}
+	if live == 0 {
+		return ErrNoLivePlayers
+	}
+
+	// Every live player who is not all-in must have called the bet, except the
+	// bettor of an uncalled bet who may be above everyone else
+	called := secondHighest(contribs)
+	for _, c := range contribs {
+		if !c.Folded && !c.AllIn && c.Amount < called {
+			return fmt.Errorf("%w: %s put in %d facing %d", ErrUnmatchedBet, c.Address, c.Amount, called)
+		}
+	}
+	return nil
+}
+
+// returnUncalled lowers the largest contribution to the second largest and
+// returns the difference as a refund (nil if the largest bet was called)
+func returnUncalled(contribs []Contribution) *Payout {
+	if len(contribs) == 0 {
+		return nil
+	}
+	top := 0
+	for i, c := range contribs {
+		if c.Amount > contribs[top].Amount {
+			top = i
+		}
+	}
+	called := secondHighest(contribs)
+	if contribs[top].Amount <= called {
+		return nil
+	}
+	refund := &Payout{Player: contribs[top].Player, Amount: contribs[top].Amount - called}
+	contribs[top].Amount = called
+	return refund
+}
+
+// secondHighest returns the second largest contribution (equal to the largest on a tie)
+func secondHighest(contribs []Contribution) uint64 {
+	var first, second uint64
+	for _, c := range contribs {
+		switch {
+		case c.Amount > first:
+			first, second = c.Amount, first
+		case c.Amount > second:
+			second = c.Amount
+		}
+	}
+	return second
+}
+
+// Total returns the sum of all contributions
+func Total(contributions []Contribution) uint64 {
+	var total uint64
+	for _, c := range contributions {
+		total += c.Amount
+	}
+	return total
+}
@@ -0,0 +1,414 @@
+package pots
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/block52/pokerchain/x/poker/equity"
+)
+
+// =============================================================================
+// Pot Building Tests
+// =============================================================================
+
+func contribution(seat int, amount uint64, flags ...string) Contribution {
+	c := Contribution{Player: Player{Address: fmt.Sprintf("p%d", seat), Seat: seat}, Amount: amount}
+	for _, f := range flags {
+		switch f {
+		case "folded":
+			c.Folded = true
+		case "all-in":
+			c.AllIn = true
+		}
+	}
+	return c
+}
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name     string
+		contribs []Contribution
+		pots     []uint64   // pot amounts
+		eligible [][]string // eligible addresses per pot
+		refund   *Payout
+	}{
+		{
+			name:     "single pot",
+			contribs: []Contribution{contribution(1, 100), contribution(2, 100), contribution(3, 100)},
+			pots:     []uint64{300},
+			eligible: [][]string{{"p1", "p2", "p3"}},
+		},
+		{
+			name:     "folded chips stay in the pot",
+			contribs: []Contribution{contribution(1, 40, "folded"), contribution(2, 100), contribution(3, 100)},
+			pots:     []uint64{240},
+			eligible: [][]string{{"p2", "p3"}},
+		},
+		{
+			name:     "short all-in creates side pot",
+			contribs: []Contribution{contribution(1, 50, "all-in"), contribution(2, 150), contribution(3, 150)},
+			pots:     []uint64{150, 200},
+			eligible: [][]string{{"p1", "p2", "p3"}, {"p2", "p3"}},
+		},
+		{
+			name: "two all-ins create two side pots",
+			contribs: []Contribution{
+				contribution(1, 20, "all-in"), contribution(2, 70, "all-in"),
+				contribution(3, 100), contribution(4, 100), contribution(5, 10, "folded"),
+			},
+			pots:     []uint64{90, 150, 60},
+			eligible: [][]string{{"p1", "p2", "p3", "p4"}, {"p2", "p3", "p4"}, {"p3", "p4"}},
+		},
+		{
+			name:     "uncalled bet is refunded",
+			contribs: []Contribution{contribution(1, 50, "all-in"), contribution(2, 150)},
+			pots:     []uint64{100},
+			eligible: [][]string{{"p1", "p2"}},
+			refund:   &Payout{Player: Player{Address: "p2", Seat: 2}, Amount: 100},
+		},
+		{
+			name:     "walk refunds the big blind excess",
+			contribs: []Contribution{contribution(1, 5, "folded"), contribution(2, 10)},
+			pots:     []uint64{10},
+			eligible: [][]string{{"p2"}},
+			refund:   &Payout{Player: Player{Address: "p2", Seat: 2}, Amount: 5},
+		},
+		{
+			name:     "folded chips above live levels go to last pot",
+			contribs: []Contribution{contribution(1, 30, "all-in"), contribution(2, 80, "folded"), contribution(3, 80, "folded")},
+			pots:     []uint64{190},
+			eligible: [][]string{{"p1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pots, refund, err := Build(tt.contribs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(pots) != len(tt.pots) {
+				t.Fatalf("expected %d pots, got %d: %+v", len(tt.pots), len(pots), pots)
+			}
+			for i, pot := range pots {
+				if pot.Amount != tt.pots[i] {
+					t.Errorf("pot %d: expected %d, got %d", i, tt.pots[i], pot.Amount)
+				}
+				if got := addresses(pot.Eligible); fmt.Sprint(got) != fmt.Sprint(tt.eligible[i]) {
+					t.Errorf("pot %d: expected eligible %v, got %v", i, tt.eligible[i], got)
+				}
+			}
+			if fmt.Sprint(refund) != fmt.Sprint(tt.refund) {
+				t.Errorf("expected refund %+v, got %+v", tt.refund, refund)
+			}
+		})
+	}
+}
+
+func TestBuild_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		contribs []Contribution
+		want     error
+	}{
+		{"everyone folded", []Contribution{contribution(1, 10, "folded"), contribution(2, 10, "folded")}, ErrNoLivePlayers},
+		{"duplicate seat", []Contribution{contribution(1, 10), contribution(1, 10)}, ErrDuplicatePlayer},
+		{"live player short without all-in", []Contribution{contribution(1, 50), contribution(2, 100), contribution(3, 100)}, ErrUnmatchedBet},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Build(tt.contribs); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestBuild_InputOrderDoesNotMatter(t *testing.T) {
+	a := []Contribution{contribution(3, 150), contribution(1, 50, "all-in"), contribution(2, 150)}
+	b := []Contribution{contribution(1, 50, "all-in"), contribution(2, 150), contribution(3, 150)}
+
+	potsA, _, _ := Build(a)
+	potsB, _, _ := Build(b)
+	if fmt.Sprint(potsA) != fmt.Sprint(potsB) {
+		t.Errorf("pots differ by input order: %+v vs %+v", potsA, potsB)
+	}
+}
+
+// =============================================================================
+// Award Tests
+// =============================================================================
+
+func TestSplit_OddChipsFromDealersLeft(t *testing.T) {
+	winners := []Player{{"p1", 1}, {"p4", 4}, {"p6", 6}}
+
+	// Dealer in seat 4: clockwise order is 6, 1, 4
+	payouts := Split(101, winners, 4)
+	want := []Payout{{Player{"p6", 6}, 34}, {Player{"p1", 1}, 34}, {Player{"p4", 4}, 33}}
+	if fmt.Sprint(payouts) != fmt.Sprint(want) {
+		t.Errorf("expected %v, got %v", want, payouts)
+	}
+}
+
+func TestDistribute_WithEquityScores(t *testing.T) {
+	board := mask(t, "2C", "7D", "9H", "JS", "KD")
+	scores := ScoreHands(board, map[string]equity.CardMask{
+		"p1": mask(t, "AS", "AH"), // pair of aces (all-in short)
+		"p2": mask(t, "KS", "QH"), // pair of kings
+		"p3": mask(t, "KC", "QD"), // pair of kings (chops side pot with p2)
+	})
+
+	pots, refund, err := Build([]Contribution{
+		contribution(1, 50, "all-in"), contribution(2, 151), contribution(3, 151),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	totals := Totals(Distribute(pots, scores, 1), refund)
+
+	if totals["p1"] != 150 {
+		t.Errorf("expected p1 to win main pot 150, got %d", totals["p1"])
+	}
+	// Side pot of 202 chopped; no odd chip
+	if totals["p2"] != 101 || totals["p3"] != 101 {
+		t.Errorf("expected side pot chopped 101/101, got %d/%d", totals["p2"], totals["p3"])
+	}
+}
+
+func TestDistributeRaked_SidePots(t *testing.T) {
+	board := mask(t, "2C", "7D", "9H", "JS", "KD")
+	scores := ScoreHands(board, map[string]equity.CardMask{
+		"p1": mask(t, "AS", "AH"), // pair of aces (all-in short)
+		"p2": mask(t, "KS", "QH"), // pair of kings
+		"p3": mask(t, "KC", "QD"), // pair of kings (chops the side pots with p2)
+		"p4": mask(t, "3S", "4H"), // king high (all-in, second side pot)
+	})
+
+	// Main pot 200, first side pot 3*50 = 150 and second side pot 2*100 = 200,
+	// with p4's uncalled 50 returned
+	pots, refund, err := Build([]Contribution{
+		contribution(1, 50, "all-in"), contribution(2, 200), contribution(3, 200), contribution(4, 100, "all-in"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refund != nil {
+		t.Fatalf("expected no refund, got %v", refund)
+	}
+
+	// 5% of 550 is 27, taken in proportion: 9 + 7 + 9, plus the odd 2 chips
+	// from the main pot
+	rake := Rake{Threshold: 100, Percent: 5}
+	awards := DistributeRaked(pots, scores, 1, rake)
+	if got := TotalRake(awards); got != 27 {
+		t.Fatalf("expected rake 27, got %d", got)
+	}
+	wantRakes := []uint64{11, 7, 9}
+	for i, a := range awards {
+		if a.Rake != wantRakes[i] {
+			t.Errorf("pot %d: expected rake %d, got %d", i, wantRakes[i], a.Rake)
+		}
+	}
+
+	totals := Totals(awards, refund)
+	// p2 is closest to the dealer's left, so takes both odd chips of 143 and 191
+	want := map[string]uint64{"p1": 189, "p2": 168, "p3": 166}
+	for address, amount := range want {
+		if totals[address] != amount {
+			t.Errorf("expected %s to win %d, got %d", address, amount, totals[address])
+		}
+	}
+	if totals["p4"] != 0 {
+		t.Errorf("expected p4 to win nothing, got %d", totals["p4"])
+	}
+
+	// A cap limits the rake, and pots below the threshold aren't raked
+	if got := TotalRake(DistributeRaked(pots, scores, 1, Rake{Percent: 5, Cap: 10})); got != 10 {
+		t.Errorf("expected capped rake 10, got %d", got)
+	}
+	if got := TotalRake(DistributeRaked(pots, scores, 1, Rake{Threshold: 551, Percent: 5})); got != 0 {
+		t.Errorf("expected no rake below the threshold, got %d", got)
+	}
+}
+
+func mask(t *testing.T, mnemonics ...string) equity.CardMask {
+	t.Helper()
+	cards, err := equity.CardsFromMnemonics(mnemonics)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return equity.MaskFromCards(cards)
+}
+
+func addresses(players []Player) []string {
+	out := make([]string, len(players))
+	for i, p := range players {
+		out[i] = p.Address
+	}
+	return out
+}
+
+// =============================================================================
+// Property Tests
+// =============================================================================
+
+// checkInvariants verifies chip conservation and eligibility for one table
+func checkInvariants(t *testing.T, contribs []Contribution, scores map[string]uint32, dealer int) {
+	t.Helper()
+
+	pots, refund, err := Build(contribs)
+	if err != nil {
+		if errors.Is(err, ErrNoLivePlayers) || errors.Is(err, ErrUnmatchedBet) {
+			return
+		}
+		t.Fatalf("%+v: unexpected error: %v", contribs, err)
+	}
+
+	byAddress := make(map[string]Contribution)
+	for _, c := range contribs {
+		byAddress[c.Address] = c
+	}
+
+	// Pots plus refund equal everything that was put in
+	var potTotal uint64
+	for _, pot := range pots {
+		potTotal += pot.Amount
+		if len(pot.Eligible) == 0 {
+			t.Fatalf("%+v: pot with no eligible players", contribs)
+		}
+		for _, p := range pot.Eligible {
+			if byAddress[p.Address].Folded {
+				t.Fatalf("%+v: folded player %s eligible", contribs, p.Address)
+			}
+		}
+	}
+	refunded := uint64(0)
+	if refund != nil {
+		refunded = refund.Amount
+	}
+	if potTotal+refunded != Total(contribs) {
+		t.Fatalf("%+v: pots %d + refund %d != contributed %d", contribs, potTotal, refunded, Total(contribs))
+	}
+
+	// Distributing the pots pays out exactly the pot total
+	awards := Distribute(pots, scores, dealer)
+	totals := Totals(awards, refund)
+	var paid uint64
+	for address, amount := range totals {
+		paid += amount
+		if amount > 0 && byAddress[address].Folded && (refund == nil || refund.Address != address) {
+			t.Fatalf("%+v: folded player %s paid %d", contribs, address, amount)
+		}
+	}
+	if paid != Total(contribs) {
+		t.Fatalf("%+v: paid %d, contributed %d", contribs, paid, Total(contribs))
+	}
+
+	// A rake only moves chips from the winners to the rake
+	raked := DistributeRaked(pots, scores, dealer, Rake{Percent: 7, Cap: potTotal / 3})
+	var rakedPaid uint64
+	for _, amount := range Totals(raked, refund) {
+		rakedPaid += amount
+	}
+	if rakedPaid+TotalRake(raked) != Total(contribs) || TotalRake(raked) != (Rake{Percent: 7, Cap: potTotal / 3}).Amount(potTotal) {
+		t.Fatalf("%+v: raked payouts %d + rake %d != contributed %d", contribs, rakedPaid, TotalRake(raked), Total(contribs))
+	}
+
+	// Split shares never differ by more than one chip, and no live player wins
+	// more than each opponent could have matched (refunds included)
+	for _, a := range awards {
+		var low, high uint64 = a.Amount, 0
+		for _, w := range a.Winners {
+			low, high = min(low, w.Amount), max(high, w.Amount)
+		}
+		if high-low > 1 {
+			t.Fatalf("%+v: uneven split %d..%d", contribs, low, high)
+		}
+	}
+	if foldedAboveLive(contribs) {
+		// Dead chips above every live player can only go to the last pot
+		return
+	}
+	for address, won := range totals {
+		c := byAddress[address]
+		if c.Folded {
+			continue
+		}
+		var cap uint64
+		for _, other := range contribs {
+			cap += min(other.Amount, c.Amount)
+		}
+		if won > cap {
+			t.Fatalf("%+v: %s won %d, more than the %d they could cover", contribs, address, won, cap)
+		}
+	}
+}
+
+// foldedAboveLive reports whether a folded player has more in the pot than any
+// live player once the uncalled bet is returned (which can't happen in play)
+func foldedAboveLive(contribs []Contribution) bool {
+	called := secondHighest(contribs)
+	var live, folded uint64
+	for _, c := range contribs {
+		amount := min(c.Amount, called)
+		if c.Folded {
+			folded = max(folded, amount)
+		} else {
+			live = max(live, amount)
+		}
+	}
+	return folded > live
+}
+
+func TestProperty_ExhaustiveSmallTables(t *testing.T) {
+	const maxAmount = 4
+	for players := 1; players <= 4; players++ {
+		cases := 1
+		for i := 0; i < players; i++ {
+			cases *= (maxAmount + 1) * 4 // amount x (folded, all-in) flags
+		}
+
+		for n := 0; n < cases; n++ {
+			contribs := make([]Contribution, players)
+			scores := make(map[string]uint32)
+			code := n
+			for i := range contribs {
+				amount := code % (maxAmount + 1)
+				code /= maxAmount + 1
+				flags := code % 4
+				code /= 4
+
+				contribs[i] = contribution(i+1, uint64(amount))
+				contribs[i].Folded = flags&1 != 0
+				contribs[i].AllIn = flags&2 != 0
+				// Deterministic scores with ties between neighbours
+				scores[contribs[i].Address] = uint32((n + i) % 3)
+			}
+			checkInvariants(t, contribs, scores, n%players+1)
+		}
+	}
+}
+
+func TestProperty_RandomFullTables(t *testing.T) {
+	rng := rand.New(rand.NewSource(28))
+	iterations := 20000
+	if testing.Short() {
+		iterations = 2000
+	}
+
+	for n := 0; n < iterations; n++ {
+		players := 2 + rng.Intn(8)
+		contribs := make([]Contribution, players)
+		scores := make(map[string]uint32)
+		for i := range contribs {
+			contribs[i] = contribution(i*2+1, uint64(rng.Intn(1000)))
+			contribs[i].Folded = rng.Intn(3) == 0
+			contribs[i].AllIn = true
+			scores[contribs[i].Address] = uint32(rng.Intn(4))
+		}
+		checkInvariants(t, contribs, scores, rng.Intn(2*players))
+	}
+}
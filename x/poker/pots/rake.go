@@ -0,0 +1,63 @@
+package pots
+
+import "math/bits"
+
+// MaxRakePercent is the largest rake percentage a table can charge
+const MaxRakePercent = 100
+
+// Rake is a table's rake: Percent of the chips in the pots, once they reach
+// Threshold, up to Cap per hand (0 for no cap). Uncalled bets are returned
+// before the rake is taken, so they are never raked.
+type Rake struct {
+	Threshold uint64
+	Percent   uint32
+	Cap       uint64
+}
+
+// Amount returns the rake on pots holding total chips
+func (r Rake) Amount(total uint64) uint64 {
+	if r.Percent == 0 || total == 0 || total < r.Threshold {
+		return 0
+	}
+	rake := mulDiv(total, uint64(min(r.Percent, MaxRakePercent)), 100)
+	if r.Cap > 0 && rake > r.Cap {
+		rake = r.Cap
+	}
+	return rake
+}
+
+// Take splits the rake on pots between them in proportion to their size,
+// and returns the rake taken from each pot. Chips lost to rounding are taken
+// from the main pot first, so the shares always add up to the rake.
+func (r Rake) Take(pots []Pot) []uint64 {
+	var total uint64
+	for _, pot := range pots {
+		total += pot.Amount
+	}
+	rake := r.Amount(total)
+	shares := make([]uint64, len(pots))
+	if rake == 0 {
+		return shares
+	}
+
+	var taken uint64
+	for i, pot := range pots {
+		shares[i] = mulDiv(rake, pot.Amount, total)
+		taken += shares[i]
+	}
+	// The pots hold at least the rake, so the remainder always fits
+	for i, pot := range pots {
+		extra := min(rake-taken, pot.Amount-shares[i])
+		shares[i] += extra
+		taken += extra
+	}
+	return shares
+}
+
+// mulDiv returns a*b/c rounded down without overflowing. The quotient must
+// fit in 64 bits, which holds whenever a or b is at most c.
+func mulDiv(a, b, c uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	quo, _ := bits.Div64(hi, lo, c)
+	return quo
+}
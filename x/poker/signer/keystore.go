@@ -0,0 +1,36 @@
+// Package signer provides keeper.WithdrawalSigner implementations that keep
+// the validator's Ethereum bridge key out of app.toml: an encrypted
+// go-ethereum keystore file, and a remote signer reached over gRPC.
+package signer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+)
+
+// LoadKeystore decrypts a go-ethereum keystore JSON file with a passphrase
+func LoadKeystore(path, passphrase string) (keeper.WithdrawalSigner, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %w", err)
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore %s: %w", path, err)
+	}
+	return keeper.NewPrivateKeySigner(key.PrivateKey), nil
+}
+
+// ReadPassphraseFile reads a keystore passphrase, dropping the trailing newline
+func ReadPassphraseFile(path string) (string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase file: %w", err)
+	}
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
@@ -0,0 +1,154 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// DefaultRemoteTimeout bounds each remote signing request
+const DefaultRemoteTimeout = 2 * time.Second
+
+// gogoCodec marshals the gogoproto messages of the RemoteSigner service
+type gogoCodec struct{}
+
+var _ encoding.Codec = gogoCodec{}
+
+func (gogoCodec) Marshal(v any) ([]byte, error) {
+	msg, ok := v.(interface{ Marshal() ([]byte, error) })
+	if !ok {
+		return nil, fmt.Errorf("cannot marshal %T", v)
+	}
+	return msg.Marshal()
+}
+
+func (gogoCodec) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(interface{ Unmarshal([]byte) error })
+	if !ok {
+		return fmt.Errorf("cannot unmarshal into %T", v)
+	}
+	return msg.Unmarshal(data)
+}
+
+func (gogoCodec) Name() string { return "proto" }
+
+// Remote is a WithdrawalSigner backed by a RemoteSigner gRPC service
+type Remote struct {
+	conn    *grpc.ClientConn
+	client  types.RemoteSignerClient
+	address common.Address
+	timeout time.Duration
+}
+
+var _ keeper.WithdrawalSigner = (*Remote)(nil)
+
+// DialRemote connects to a remote signer at unix:///path/to.sock or
+// tcp://host:port and fetches its address
+func DialRemote(ctx context.Context, target string) (*Remote, error) {
+	conn, err := grpc.NewClient(grpcTarget(target),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(gogoCodec{})),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remote signer client: %w", err)
+	}
+
+	r := &Remote{conn: conn, client: types.NewRemoteSignerClient(conn), timeout: DefaultRemoteTimeout}
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	resp, err := r.client.Address(ctx, &types.RemoteSignerAddressRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to get remote signer address: %w", err)
+	}
+	if !common.IsHexAddress(resp.Address) {
+		conn.Close()
+		return nil, fmt.Errorf("remote signer returned invalid address %q", resp.Address)
+	}
+	r.address = common.HexToAddress(resp.Address)
+	return r, nil
+}
+
+// grpcTarget maps the CometBFT-style tcp:// scheme to a gRPC target
+func grpcTarget(target string) string {
+	return strings.TrimPrefix(target, "tcp://")
+}
+
+// Address implements keeper.WithdrawalSigner
+func (r *Remote) Address() common.Address {
+	return r.address
+}
+
+// SignDigest implements keeper.WithdrawalSigner
+func (r *Remote) SignDigest(ctx context.Context, digest common.Hash) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	resp, err := r.client.SignDigest(ctx, &types.RemoteSignDigestRequest{Digest: digest.Bytes()})
+	if err != nil {
+		return nil, fmt.Errorf("remote signer: %w", err)
+	}
+	return resp.Signature, nil
+}
+
+// Close closes the connection to the remote signer
+func (r *Remote) Close() error {
+	return r.conn.Close()
+}
+
+// Server serves a WithdrawalSigner as a RemoteSigner gRPC service. Anyone who
+// can reach it can get digests signed, so it must only listen on a unix
+// socket or a private network.
+type Server struct {
+	signer keeper.WithdrawalSigner
+}
+
+var _ types.RemoteSignerServer = (*Server)(nil)
+
+// NewServer wraps a signer, usually one loaded with LoadKeystore
+func NewServer(signer keeper.WithdrawalSigner) *Server {
+	return &Server{signer: signer}
+}
+
+// Address implements types.RemoteSignerServer
+func (s *Server) Address(_ context.Context, _ *types.RemoteSignerAddressRequest) (*types.RemoteSignerAddressResponse, error) {
+	return &types.RemoteSignerAddressResponse{Address: s.signer.Address().Hex()}, nil
+}
+
+// SignDigest implements types.RemoteSignerServer
+func (s *Server) SignDigest(ctx context.Context, req *types.RemoteSignDigestRequest) (*types.RemoteSignDigestResponse, error) {
+	if len(req.Digest) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "digest must be %d bytes, got %d", common.HashLength, len(req.Digest))
+	}
+	signature, err := s.signer.SignDigest(ctx, common.BytesToHash(req.Digest))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.RemoteSignDigestResponse{Signature: signature}, nil
+}
+
+// Serve serves the signer on a listener until it is closed
+func (s *Server) Serve(lis net.Listener) error {
+	grpcServer := grpc.NewServer(grpc.ForceServerCodec(gogoCodec{}))
+	types.RegisterRemoteSignerServer(grpcServer, s)
+	return grpcServer.Serve(lis)
+}
+
+// Listen opens a listener for unix:///path/to.sock or tcp://host:port
+func Listen(address string) (net.Listener, error) {
+	if path, ok := strings.CutPrefix(address, "unix://"); ok {
+		return net.Listen("unix", path)
+	}
+	return net.Listen("tcp", strings.TrimPrefix(address, "tcp://"))
+}
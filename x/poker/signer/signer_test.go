@@ -0,0 +1,86 @@
+package signer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/signer"
+)
+
+// writeKeystore encrypts a fresh key with light scrypt parameters
+func writeKeystore(t *testing.T, passphrase string) (string, common.Address) {
+	t.Helper()
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key := &keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	keyJSON, err := keystore.EncryptKey(key, passphrase, keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "validator.json")
+	require.NoError(t, os.WriteFile(path, keyJSON, 0o600))
+	return path, key.Address
+}
+
+func requireSignedBy(t *testing.T, s keeper.WithdrawalSigner, address common.Address) {
+	t.Helper()
+	digest := crypto.Keccak256Hash([]byte("withdrawal"))
+	signature, err := s.SignDigest(context.Background(), digest)
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(digest.Bytes(), signature)
+	require.NoError(t, err)
+	require.Equal(t, address, crypto.PubkeyToAddress(*pub))
+}
+
+func TestLoadKeystore(t *testing.T) {
+	path, address := writeKeystore(t, "correct horse")
+
+	s, err := signer.LoadKeystore(path, "correct horse")
+	require.NoError(t, err)
+	require.Equal(t, address, s.Address())
+	requireSignedBy(t, s, address)
+
+	_, err = signer.LoadKeystore(path, "wrong")
+	require.Error(t, err)
+	_, err = signer.LoadKeystore(filepath.Join(t.TempDir(), "missing.json"), "correct horse")
+	require.Error(t, err)
+}
+
+func TestReadPassphraseFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(path, []byte("correct horse\n"), 0o600))
+	passphrase, err := signer.ReadPassphraseFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "correct horse", passphrase)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	socket := "unix://" + filepath.Join(t.TempDir(), "signer.sock")
+	lis, err := signer.Listen(socket)
+	require.NoError(t, err)
+	go signer.NewServer(keeper.NewPrivateKeySigner(key)).Serve(lis) //nolint:errcheck
+	t.Cleanup(func() { lis.Close() })
+
+	remote, err := signer.DialRemote(context.Background(), socket)
+	require.NoError(t, err)
+	t.Cleanup(func() { remote.Close() })
+
+	require.Equal(t, address, remote.Address())
+	requireSignedBy(t, remote, address)
+}
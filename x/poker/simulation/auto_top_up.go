@@ -0,0 +1,77 @@
+package simulation
+
+import (
+	"math/rand"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// SimulateMsgSetAutoTopUp gives a random seated player a standing rebuy or
+// top-up to a random stack within the table's buy-in limits
+func SimulateMsgSetAutoTopUp(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetAutoTopUp{}
+
+		players, err := seatedPlayers(ctx, k, ak, accs, func(t simTable, p types.PlayerDTO) bool {
+			return slices.Contains(t.game.Players, p.Address)
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list players"), nil, err
+		}
+		if len(players) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no seated players"), nil, nil
+		}
+		p := players[r.Intn(len(players))]
+		msg.Player = p.player.Address
+		msg.GameId = p.game.GameId
+		msg.Mode = string(types.AutoTopUpTopUp)
+		if r.Intn(2) == 0 {
+			msg.Mode = string(types.AutoTopUpRebuy)
+		}
+		msg.TargetStack = p.game.MinBuyIn + uint64(r.Int63n(int64(p.game.MaxBuyIn-p.game.MinBuyIn)+1))
+
+		return deliver(r, app, ctx, txGen, ak, bk, k, p.account, msg, nil)
+	}
+}
+
+// SimulateMsgCancelAutoTopUp cancels a random player's standing top-up
+func SimulateMsgCancelAutoTopUp(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgCancelAutoTopUp{}
+
+		players, err := seatedPlayers(ctx, k, ak, accs, func(t simTable, p types.PlayerDTO) bool {
+			_, ok := t.game.AutoTopUp(p.Address)
+			return ok
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list players"), nil, err
+		}
+		if len(players) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no auto top-ups"), nil, nil
+		}
+		p := players[r.Intn(len(players))]
+		msg.Player = p.player.Address
+		msg.GameId = p.game.GameId
+
+		return deliver(r, app, ctx, txGen, ak, bk, k, p.account, msg, nil)
+	}
+}
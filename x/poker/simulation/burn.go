@@ -25,8 +25,6 @@ func SimulateMsgBurn(
 			Creator: simAccount.Address.String(),
 		}
 
-		// TODO: Handle the Burn simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Burn simulation not implemented"), nil, nil
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Burn is not handled by the keeper"), nil, nil
 	}
 }
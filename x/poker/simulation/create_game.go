@@ -12,6 +12,8 @@ import (
 	"github.com/block52/pokerchain/x/poker/types"
 )
 
+// SimulateMsgCreateGame creates a cash table with random blinds, forced bets,
+// sit-out rules, buy-in range and size in one of the allowed table currencies
 func SimulateMsgCreateGame(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -25,8 +27,72 @@ func SimulateMsgCreateGame(
 			Creator: simAccount.Address.String(),
 		}
 
-		// TODO: Handle the CreateGame simulation
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to get params"), nil, err
+		}
+		denoms := params.AllowedGameDenoms
+		if len(denoms) == 0 {
+			denoms = []string{types.TokenDenom}
+		}
+		msg.Denom = denoms[r.Intn(len(denoms))]
+
+		cost := sdk.NewCoins(sdk.NewInt64Coin(msg.Denom, types.GameCreationCost))
+		if !bk.SpendableCoins(ctx, simAccount.Address).IsAllGTE(cost) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "insufficient funds to create a game"), nil, nil
+		}
+
+		// Game IDs are derived from the creator and block time
+		all, err := tables(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list games"), nil, err
+		}
+		for _, t := range all {
+			if t.game.Creator == msg.Creator && t.game.CreatedAt.Unix() == ctx.BlockTime().Unix() {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "creator already created a game this block"), nil, nil
+			}
+		}
+
+		msg.SmallBlind = uint64(simtypes.RandIntBetween(r, 1, 1000))
+		msg.BigBlind = 2 * msg.SmallBlind
+		msg.MinBuyIn = msg.BigBlind * uint64(simtypes.RandIntBetween(r, 10, 50))
+		msg.MaxBuyIn = msg.MinBuyIn * uint64(simtypes.RandIntBetween(r, 1, 5))
+		msg.MinPlayers = 2
+		msg.MaxPlayers = int64(simtypes.RandIntBetween(r, 2, 10))
+		msg.Timeout = int64(simtypes.RandIntBetween(r, 30, 301))
+		msg.GameType = "cash"
+
+		// Some tables add antes, a straddle or bomb pots to the blinds
+		if r.Intn(3) == 0 {
+			msg.Ante = uint64(simtypes.RandIntBetween(r, 1, int(msg.BigBlind)+1))
+			anteTypes := []types.AnteType{types.AnteTypePerPlayer, types.AnteTypeBigBlind, types.AnteTypeButton}
+			msg.AnteType = string(anteTypes[r.Intn(len(anteTypes))])
+		}
+		if r.Intn(3) == 0 {
+			straddles := []types.StraddleType{types.StraddleUTG, types.StraddleButton}
+			msg.Straddle = string(straddles[r.Intn(len(straddles))])
+		}
+		if r.Intn(4) == 0 {
+			msg.BombPotFrequency = uint64(simtypes.RandIntBetween(r, 2, 6))
+		}
+
+		// and most limit how long a player may sit out
+		if r.Intn(4) != 0 {
+			msg.MaxSitOutHands = uint64(simtypes.RandIntBetween(r, 1, 6))
+			msg.MaxSitOutMinutes = uint64(simtypes.RandIntBetween(r, 0, 30))
+			msg.PostMissedBlinds = r.Intn(2) == 0
+		}
+
+		// Some hold spectators back, or keep them out
+		switch r.Intn(4) {
+		case 0:
+			msg.Spectators = string(types.SpectatorsOff)
+		case 1:
+			msg.Spectators = string(types.SpectatorsDelayed)
+			msg.SpectatorDelayActions = uint64(simtypes.RandIntBetween(r, 0, types.MaxSpectatorDelayActions))
+			msg.SpectatorDelaySeconds = uint64(simtypes.RandIntBetween(r, 1, types.MaxSpectatorDelaySeconds))
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "CreateGame simulation not implemented"), nil, nil
+		return deliver(r, app, ctx, txGen, ak, bk, k, simAccount, msg, cost)
 	}
 }
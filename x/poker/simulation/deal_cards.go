@@ -25,8 +25,6 @@ func SimulateMsgDealCards(
 			Creator: simAccount.Address.String(),
 		}
 
-		// TODO: Handle the DealCards simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "DealCards simulation not implemented"), nil, nil
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "DealCards is not handled by the keeper"), nil, nil
 	}
 }
@@ -0,0 +1,750 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/block52/pokerchain/x/poker/equity"
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/pots"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// Engine is a deterministic in-process stand-in for the PVM, used so that
+// simulations can play hands without an external game server.
+//
+// It plays a simplified no-limit hold'em: blinds, antes, straddles and dead
+// blinds are posted automatically by new-hand, bomb pots start on the flop,
+// players sitting out are skipped, every bet
+// amount is the number of chips added, the minimum raise is always the big
+// blind, and every hand ends in a showdown settled with the same pot
+// arithmetic the keeper verifies. Players can only join, top up or leave
+// while they are not in a hand, and sit out or in between hands.
+type Engine struct{}
+
+var _ keeper.GameEngine = Engine{}
+
+// NewEngine returns a deterministic in-process game engine
+func NewEngine() Engine {
+	return Engine{}
+}
+
+// PerformAction applies a single action to a copy of the request's state
+func (Engine) PerformAction(_ context.Context, req keeper.GameEngineRequest) (types.TexasHoldemStateDTO, error) {
+	state := req.State
+	state.Players = make([]types.PlayerDTO, len(req.State.Players))
+	copy(state.Players, req.State.Players)
+
+	t := &table{
+		TexasHoldemStateDTO: &state,
+		smallBlind:          parseChips(ptrString(req.Options.SmallBlind)),
+		bigBlind:            parseChips(ptrString(req.Options.BigBlind)),
+		ante:                parseChips(ptrString(req.Options.Ante)),
+		bombPotAnte:         parseChips(ptrString(req.Options.BombPotAnte)),
+		maxPlayers:          9,
+	}
+	if req.Options.MaxPlayers != nil {
+		t.maxPlayers = *req.Options.MaxPlayers
+	}
+	if req.Options.AnteType != nil {
+		t.anteType = *req.Options.AnteType
+	}
+	if req.Options.Straddle != nil {
+		t.straddle = *req.Options.Straddle
+	}
+	if req.Options.BombPotFrequency != nil && *req.Options.BombPotFrequency > 0 {
+		t.bombPotFrequency = uint64(*req.Options.BombPotFrequency)
+	}
+	t.deadBlinds = req.Options.DeadBlinds
+	if t.bigBlind == 0 {
+		return state, fmt.Errorf("table has no big blind")
+	}
+
+	// Table changes start from a cleared table, since the keeper re-verifies
+	// every showdown state it is handed back
+	if req.Action != string(keeper.NewHand) {
+		t.clearSettledHand()
+	}
+
+	var err error
+	switch req.Action {
+	case string(keeper.Join):
+		err = t.join(req)
+	case string(keeper.Leave):
+		err = t.leave(req)
+	case "top-up":
+		err = t.topUp(req)
+	case string(keeper.SitOut), string(keeper.SitIn):
+		err = t.sit(req)
+	case string(keeper.NewHand):
+		err = t.newHand(req)
+	case string(keeper.Fold), string(keeper.Check), string(keeper.Call), string(keeper.Bet), string(keeper.Raise), string(keeper.AllIn):
+		err = t.bet(req)
+	default:
+		err = fmt.Errorf("unsupported action %q", req.Action)
+	}
+	if err != nil {
+		return state, err
+	}
+
+	t.setLegalActions()
+	return state, nil
+}
+
+// table wraps a game state with the forced bets from the game options
+type table struct {
+	*types.TexasHoldemStateDTO
+	smallBlind       uint64
+	bigBlind         uint64
+	ante             uint64
+	anteType         types.AnteType
+	straddle         types.StraddleType
+	bombPotFrequency uint64
+	bombPotAnte      uint64
+	deadBlinds       map[string]string
+	maxPlayers       int
+}
+
+func (t *table) handInProgress() bool {
+	return t.Round != types.RoundAnte && t.Round != types.RoundShowdown
+}
+
+func (t *table) player(address string) (*types.PlayerDTO, error) {
+	for i := range t.Players {
+		if t.Players[i].Address == address {
+			return &t.Players[i], nil
+		}
+	}
+	return nil, fmt.Errorf("player %s is not seated", address)
+}
+
+// inHand reports whether a player was dealt into the current hand
+func (t *table) inHand(p *types.PlayerDTO) bool {
+	if !t.handInProgress() {
+		return false
+	}
+	switch p.Status {
+	case types.StatusActive, types.StatusAllIn, types.StatusFolded:
+		return true
+	default:
+		return false
+	}
+}
+
+// record appends an action to the current hand
+func (t *table) record(req keeper.GameEngineRequest, p *types.PlayerDTO, amount uint64) {
+	action := types.ActionDTO{
+		PlayerId:  req.PlayerId,
+		Action:    req.Action,
+		Amount:    strconv.FormatUint(amount, 10),
+		Round:     t.Round,
+		Index:     req.Index,
+		Timestamp: req.Timestamp,
+	}
+	if p != nil {
+		action.Seat = p.Seat
+		last := action
+		p.LastAction = &last
+	}
+	t.PreviousActions = append(t.PreviousActions, action)
+}
+
+func (t *table) join(req keeper.GameEngineRequest) error {
+	if _, err := t.player(req.PlayerId); err == nil {
+		return fmt.Errorf("player %s is already seated", req.PlayerId)
+	}
+	seat, err := strconv.Atoi(strings.TrimPrefix(req.Data, "seat="))
+	if err != nil || seat < 1 || seat > t.maxPlayers {
+		return fmt.Errorf("invalid seat %q", req.Data)
+	}
+	for _, p := range t.Players {
+		if p.Seat == seat {
+			return fmt.Errorf("seat %d is taken", seat)
+		}
+	}
+	if req.Amount == 0 {
+		return fmt.Errorf("buy-in must be positive")
+	}
+
+	t.Players = append(t.Players, types.PlayerDTO{
+		Address:   req.PlayerId,
+		Seat:      seat,
+		Stack:     strconv.FormatUint(req.Amount, 10),
+		Status:    types.StatusSeated,
+		SumOfBets: "0",
+	})
+	sort.Slice(t.Players, func(i, j int) bool { return t.Players[i].Seat < t.Players[j].Seat })
+	p, _ := t.player(req.PlayerId)
+	t.record(req, p, req.Amount)
+	return nil
+}
+
+func (t *table) leave(req keeper.GameEngineRequest) error {
+	p, err := t.player(req.PlayerId)
+	if err != nil {
+		return err
+	}
+	if t.inHand(p) {
+		return fmt.Errorf("player %s is in a hand", req.PlayerId)
+	}
+	t.record(req, nil, parseChips(p.Stack))
+	for i := range t.Players {
+		if t.Players[i].Address == req.PlayerId {
+			t.Players = append(t.Players[:i], t.Players[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (t *table) topUp(req keeper.GameEngineRequest) error {
+	p, err := t.player(req.PlayerId)
+	if err != nil {
+		return err
+	}
+	if t.inHand(p) {
+		return fmt.Errorf("player %s is in a hand", req.PlayerId)
+	}
+	p.Stack = strconv.FormatUint(parseChips(p.Stack)+req.Amount, 10)
+	if p.Status == types.StatusBusted {
+		p.Status = types.StatusSeated
+	}
+	t.record(req, p, req.Amount)
+	return nil
+}
+
+// sit has a player sit out, or back in, between hands
+func (t *table) sit(req keeper.GameEngineRequest) error {
+	p, err := t.player(req.PlayerId)
+	if err != nil {
+		return err
+	}
+	if t.inHand(p) {
+		return fmt.Errorf("player %s is in a hand", req.PlayerId)
+	}
+	sittingOut := p.Status == types.StatusSittingOut
+	if sittingOut == (req.Action == string(keeper.SitOut)) {
+		return fmt.Errorf("player %s cannot %s while %s", req.PlayerId, req.Action, p.Status)
+	}
+	if sittingOut {
+		p.Status = types.StatusSeated
+	} else {
+		p.Status = types.StatusSittingOut
+	}
+	t.record(req, p, 0)
+	return nil
+}
+
+// eligible returns the seats with enough chips to post the big blind, other
+// than those sitting out
+func (t *table) eligible() []int {
+	var seats []int
+	for _, p := range t.Players {
+		if p.Status != types.StatusSittingOut && parseChips(p.Stack) >= t.bigBlind {
+			seats = append(seats, p.Seat)
+		}
+	}
+	return seats
+}
+
+func (t *table) newHand(req keeper.GameEngineRequest) error {
+	if t.handInProgress() {
+		return fmt.Errorf("hand %d is in progress", t.HandNumber)
+	}
+	if _, err := t.player(req.PlayerId); err != nil {
+		return err
+	}
+	seats := t.eligible()
+	if len(seats) < 2 {
+		return fmt.Errorf("need two players who can post the big blind")
+	}
+	deck, err := types.NewDeck(strings.TrimPrefix(req.Data, "deck="))
+	if err != nil {
+		return err
+	}
+
+	t.clearSettledHand()
+	t.ActionCount += len(t.PreviousActions)
+	t.PreviousActions = []types.ActionDTO{}
+
+	t.Dealer = nextSeat(seats, t.Dealer)
+	t.SmallBlindPosition = nextSeat(seats, t.Dealer)
+	t.BigBlindPosition = nextSeat(seats, t.SmallBlindPosition)
+	bombPot := t.isBombPot()
+
+	for i := range t.Players {
+		p := &t.Players[i]
+		p.SumOfBets = "0"
+		p.HoleCards = nil
+		p.LastAction = nil
+		p.IsDealer = p.Seat == t.Dealer
+		p.IsSmallBlind = p.Seat == t.SmallBlindPosition
+		p.IsBigBlind = p.Seat == t.BigBlindPosition
+		switch {
+		case p.Status == types.StatusSittingOut:
+		case parseChips(p.Stack) >= t.bigBlind:
+			p.Status = types.StatusActive
+			cards := mnemonics(deck.Deal(2))
+			p.HoleCards = &cards
+		case parseChips(p.Stack) == 0:
+			p.Status = types.StatusBusted
+		default:
+			p.Status = types.StatusSeated
+		}
+	}
+
+	// With the players dealt in, the forced bets go in. Antes are committed
+	// before the blinds, which roundBet relies on.
+	t.Round = types.RoundPreflop
+	for i := range t.Players {
+		p := &t.Players[i]
+		if p.Status != types.StatusActive {
+			continue
+		}
+		t.commit(p, t.deadMoneyDue(p))
+		if !bombPot {
+			t.commit(p, t.liveBlindDue(p))
+		}
+	}
+	actAfter := t.BigBlindPosition
+	if seat := t.straddleSeat(); seat != 0 {
+		actAfter = seat
+	}
+	if bombPot {
+		// Bomb pots skip preflop betting and start on the flop
+		t.Round = types.RoundFlop
+		t.CommunityCards = mnemonics(deck.Deal(3))
+		actAfter = t.Dealer
+	}
+	t.Deck = deck.ToString()
+
+	sender, _ := t.player(req.PlayerId)
+	t.record(req, sender, 0)
+	t.NextToAct = t.nextPending(actAfter)
+	t.updatePots()
+	if t.NextToAct == 0 {
+		t.settle() // the forced bets put everyone all-in
+	}
+	return nil
+}
+
+// isBombPot reports whether the current hand is a scheduled bomb pot
+func (t *table) isBombPot() bool {
+	return types.Game{BombPotFrequency: t.bombPotFrequency}.IsBombPot(t.HandNumber)
+}
+
+// deadMoneyDue is what a player dealt into the current hand owes that does
+// not count towards their bet: their ante and any blinds they missed
+func (t *table) deadMoneyDue(p *types.PlayerDTO) uint64 {
+	due := parseChips(t.deadBlinds[p.Address])
+	if t.isBombPot() {
+		return due + t.bombPotAnte
+	}
+	switch t.anteType {
+	case types.AnteTypePerPlayer:
+		due += t.ante
+	case types.AnteTypeBigBlind:
+		if p.IsBigBlind {
+			due += t.ante
+		}
+	case types.AnteTypeButton:
+		if p.IsDealer {
+			due += t.ante
+		}
+	}
+	return due
+}
+
+// liveBlindDue is what a player owes in blinds and straddle before the
+// cards are dealt; unlike antes, it counts towards their preflop bet
+func (t *table) liveBlindDue(p *types.PlayerDTO) uint64 {
+	var due uint64
+	if p.IsSmallBlind {
+		due += t.smallBlind
+	}
+	if p.IsBigBlind {
+		due += t.bigBlind
+	}
+	if p.Seat == t.straddleSeat() {
+		due += 2 * t.bigBlind
+	}
+	return due
+}
+
+// straddleSeat returns the seat that straddles the current hand, or 0. A
+// straddle needs three players dealt in, so that it never falls on a blind,
+// and there is none in a bomb pot.
+func (t *table) straddleSeat() int {
+	if t.straddle == types.StraddleNone || t.isBombPot() {
+		return 0
+	}
+	var seats []int
+	for i := range t.Players {
+		if t.inHand(&t.Players[i]) {
+			seats = append(seats, t.Players[i].Seat)
+		}
+	}
+	if len(seats) < 3 {
+		return 0
+	}
+	if t.straddle == types.StraddleButton {
+		return t.Dealer
+	}
+	return nextSeat(seats, t.BigBlindPosition)
+}
+
+// clearSettledHand moves on from a settled hand to the next hand number
+func (t *table) clearSettledHand() {
+	if t.Round != types.RoundShowdown {
+		return
+	}
+	t.Round = types.RoundAnte
+	t.HandNumber++
+	t.CommunityCards = []string{}
+	t.Winners = []types.WinnerDTO{}
+	for i := range t.Players {
+		t.Players[i].SumOfBets = "0"
+		t.Players[i].HoleCards = nil
+	}
+}
+
+// commit moves chips from a player's stack into the hand
+func (t *table) commit(p *types.PlayerDTO, amount uint64) {
+	stack := parseChips(p.Stack)
+	if amount > stack {
+		amount = stack
+	}
+	p.Stack = strconv.FormatUint(stack-amount, 10)
+	p.SumOfBets = strconv.FormatUint(parseChips(p.SumOfBets)+amount, 10)
+	if stack == amount && p.Status == types.StatusActive {
+		p.Status = types.StatusAllIn
+	}
+}
+
+// roundBet is what a player has put in during the current betting round
+func (t *table) roundBet(p *types.PlayerDTO) uint64 {
+	var total uint64
+	if t.Round == types.RoundPreflop {
+		sum := parseChips(p.SumOfBets)
+		dead := min(t.deadMoneyDue(p), sum)
+		total = min(t.liveBlindDue(p), sum-dead) // a short blind
+	}
+	for _, a := range t.PreviousActions {
+		if a.Round == t.Round && a.PlayerId == p.Address && isBettingAction(a.Action) {
+			total += parseChips(a.Amount)
+		}
+	}
+	return total
+}
+
+// acted reports whether a player has voluntarily acted in the current round
+func (t *table) acted(p *types.PlayerDTO) bool {
+	for _, a := range t.PreviousActions {
+		if a.Round == t.Round && a.PlayerId == p.Address && isBettingAction(a.Action) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *table) highestBet() uint64 {
+	var highest uint64
+	for i := range t.Players {
+		if t.inHand(&t.Players[i]) {
+			highest = max(highest, t.roundBet(&t.Players[i]))
+		}
+	}
+	return highest
+}
+
+// pending reports whether an active player still has to act this round
+func (t *table) pending(p *types.PlayerDTO) bool {
+	return p.Status == types.StatusActive && (!t.acted(p) || t.roundBet(p) < t.highestBet())
+}
+
+// nextPending returns the first seat after from that still has to act, or 0
+func (t *table) nextPending(from int) int {
+	n := len(t.Players)
+	start := sort.Search(n, func(i int) bool { return t.Players[i].Seat > from })
+	for i := 0; i < n; i++ {
+		p := &t.Players[(start+i)%n]
+		if t.pending(p) {
+			return p.Seat
+		}
+	}
+	return 0
+}
+
+func (t *table) bet(req keeper.GameEngineRequest) error {
+	if !t.handInProgress() {
+		return fmt.Errorf("no hand in progress")
+	}
+	p, err := t.player(req.PlayerId)
+	if err != nil {
+		return err
+	}
+	if p.Seat != t.NextToAct {
+		return fmt.Errorf("it is seat %d's turn, not seat %d", t.NextToAct, p.Seat)
+	}
+
+	stack := parseChips(p.Stack)
+	toCall := t.highestBet() - t.roundBet(p)
+	var amount uint64
+	switch keeper.PlayerActionType(req.Action) {
+	case keeper.Fold:
+		p.Status = types.StatusFolded
+	case keeper.Check:
+		if toCall > 0 {
+			return fmt.Errorf("cannot check facing %d", toCall)
+		}
+	case keeper.Call:
+		if toCall == 0 {
+			return fmt.Errorf("nothing to call")
+		}
+		amount = min(toCall, stack)
+	case keeper.Bet, keeper.Raise:
+		minAmount, ok := t.minBet(toCall, stack, req.Action)
+		if !ok {
+			return fmt.Errorf("%s is not allowed", req.Action)
+		}
+		if req.Amount < minAmount || req.Amount > stack {
+			return fmt.Errorf("%s must be between %d and %d", req.Action, minAmount, stack)
+		}
+		amount = req.Amount
+	case keeper.AllIn:
+		amount = stack
+	}
+	t.commit(p, amount)
+	t.record(req, p, amount)
+	t.advance(p.Seat)
+	return nil
+}
+
+// minBet returns the smallest bet or raise, in chips added, and whether it
+// is allowed at all
+func (t *table) minBet(toCall, stack uint64, action string) (uint64, bool) {
+	if stack <= toCall {
+		return 0, false
+	}
+	if (action == string(keeper.Bet)) != (toCall == 0) {
+		return 0, false
+	}
+	return min(toCall+t.bigBlind, stack), true
+}
+
+// advance moves to the next player, the next street or the showdown
+func (t *table) advance(from int) {
+	var live, canAct int
+	for i := range t.Players {
+		switch t.Players[i].Status {
+		case types.StatusActive:
+			live++
+			canAct++
+		case types.StatusAllIn:
+			live++
+		}
+	}
+	if live == 1 {
+		t.settle()
+		return
+	}
+	if next := t.nextPending(from); next != 0 {
+		t.NextToAct = next
+		t.updatePots()
+		return
+	}
+
+	// The betting round is over
+	if t.Round == types.RoundRiver || canAct < 2 {
+		t.settle()
+		return
+	}
+	deck, err := types.NewDeck(t.Deck)
+	if err != nil {
+		panic(err) // the deck was written by newHand
+	}
+	switch t.Round {
+	case types.RoundPreflop:
+		t.Round = types.RoundFlop
+		t.CommunityCards = append(t.CommunityCards, mnemonics(deck.Deal(3))...)
+	case types.RoundFlop:
+		t.Round = types.RoundTurn
+		t.CommunityCards = append(t.CommunityCards, mnemonics(deck.Deal(1))...)
+	case types.RoundTurn:
+		t.Round = types.RoundRiver
+		t.CommunityCards = append(t.CommunityCards, mnemonics(deck.Deal(1))...)
+	}
+	t.Deck = deck.ToString()
+	t.NextToAct = t.nextPending(t.Dealer)
+	t.updatePots()
+}
+
+// settle runs out the board if the pot is contested and pays the winners
+// exactly as the keeper's showdown verification expects
+func (t *table) settle() {
+	var contested int
+	for _, p := range t.Players {
+		if p.Status == types.StatusActive || p.Status == types.StatusAllIn {
+			contested++
+		}
+	}
+	if contested > 1 && len(t.CommunityCards) < 5 {
+		deck, err := types.NewDeck(t.Deck)
+		if err != nil {
+			panic(err)
+		}
+		t.CommunityCards = append(t.CommunityCards, mnemonics(deck.Deal(5-len(t.CommunityCards)))...)
+		t.Deck = deck.ToString()
+	}
+
+	board := cardMask(t.CommunityCards)
+	var contributions []pots.Contribution
+	holes := make(map[string]equity.CardMask)
+	for _, p := range t.Players {
+		contribution := parseChips(p.SumOfBets)
+		live := p.Status == types.StatusActive || p.Status == types.StatusAllIn
+		if !live && contribution == 0 {
+			continue
+		}
+		contributions = append(contributions, pots.Contribution{
+			Player: pots.Player{Address: p.Address, Seat: p.Seat},
+			Amount: contribution,
+			Folded: !live,
+			AllIn:  p.Status == types.StatusAllIn,
+		})
+		if live && board != 0 {
+			holes[p.Address] = cardMask(*p.HoleCards)
+		}
+	}
+
+	built, refund, err := pots.Build(contributions)
+	if err != nil {
+		panic(err) // the engine only produces matched bets
+	}
+	payouts := pots.Totals(pots.Distribute(built, pots.ScoreHands(board, holes), t.Dealer), refund)
+
+	t.Winners = []types.WinnerDTO{}
+	for i := range t.Players {
+		p := &t.Players[i]
+		if won := payouts[p.Address]; won > 0 {
+			p.Stack = strconv.FormatUint(parseChips(p.Stack)+won, 10)
+			t.Winners = append(t.Winners, types.WinnerDTO{Address: p.Address, Amount: strconv.FormatUint(won, 10)})
+		}
+	}
+	t.Round = types.RoundShowdown
+	t.NextToAct = 0
+	t.Pots = []string{}
+}
+
+func (t *table) updatePots() {
+	var total uint64
+	for _, p := range t.Players {
+		total += parseChips(p.SumOfBets)
+	}
+	t.Pots = []string{strconv.FormatUint(total, 10)}
+}
+
+// setLegalActions lists what every player may do next. All legal actions
+// share the index of the next action, as the keeper expects.
+func (t *table) setLegalActions() {
+	index := t.ActionCount + len(t.PreviousActions) + 1
+	canDeal := !t.handInProgress() && len(t.eligible()) >= 2
+
+	for i := range t.Players {
+		p := &t.Players[i]
+		actions := []types.LegalActionDTO{}
+		switch {
+		case t.inHand(p):
+			if p.Seat == t.NextToAct {
+				actions = t.bettingActions(p, index)
+			}
+		default:
+			if canDeal {
+				actions = append(actions, types.LegalActionDTO{Action: string(keeper.NewHand), Index: index})
+			}
+			sit := keeper.SitOut
+			if p.Status == types.StatusSittingOut {
+				sit = keeper.SitIn
+			}
+			actions = append(actions,
+				types.LegalActionDTO{Action: string(sit), Index: index},
+				types.LegalActionDTO{Action: string(keeper.Leave), Index: index})
+		}
+		p.LegalActions = actions
+	}
+}
+
+func (t *table) bettingActions(p *types.PlayerDTO, index int) []types.LegalActionDTO {
+	stack := parseChips(p.Stack)
+	toCall := t.highestBet() - t.roundBet(p)
+	legal := func(action keeper.PlayerActionType, minAmount, maxAmount uint64) types.LegalActionDTO {
+		minStr, maxStr := strconv.FormatUint(minAmount, 10), strconv.FormatUint(maxAmount, 10)
+		return types.LegalActionDTO{Action: string(action), Min: &minStr, Max: &maxStr, Index: index}
+	}
+
+	actions := []types.LegalActionDTO{legal(keeper.Fold, 0, 0)}
+	if toCall == 0 {
+		actions = append(actions, legal(keeper.Check, 0, 0))
+	} else {
+		actions = append(actions, legal(keeper.Call, min(toCall, stack), min(toCall, stack)))
+	}
+	for _, action := range []keeper.PlayerActionType{keeper.Bet, keeper.Raise} {
+		if minAmount, ok := t.minBet(toCall, stack, string(action)); ok {
+			actions = append(actions, legal(action, minAmount, stack))
+		}
+	}
+	if stack > 0 {
+		actions = append(actions, legal(keeper.AllIn, stack, stack))
+	}
+	return actions
+}
+
+// nextSeat returns the first of the sorted seats after from, wrapping around
+func nextSeat(seats []int, from int) int {
+	for _, seat := range seats {
+		if seat > from {
+			return seat
+		}
+	}
+	return seats[0]
+}
+
+func isBettingAction(action string) bool {
+	switch keeper.PlayerActionType(action) {
+	case keeper.Fold, keeper.Check, keeper.Call, keeper.Bet, keeper.Raise, keeper.AllIn:
+		return true
+	default:
+		return false
+	}
+}
+
+func mnemonics(cards []types.Card) []string {
+	out := make([]string, len(cards))
+	for i, c := range cards {
+		out[i] = c.Mnemonic
+	}
+	return out
+}
+
+func cardMask(mnemonics []string) equity.CardMask {
+	cards, err := equity.CardsFromMnemonics(mnemonics)
+	if err != nil {
+		panic(err) // every card came from a parsed deck
+	}
+	return equity.MaskFromCards(cards)
+}
+
+func parseChips(s string) uint64 {
+	chips, _ := strconv.ParseUint(s, 10, 64)
+	return chips
+}
+
+func ptrString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
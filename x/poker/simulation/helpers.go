@@ -0,0 +1,136 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// simTable is a game together with its current state
+type simTable struct {
+	game  types.Game
+	state types.TexasHoldemStateDTO
+}
+
+// seatedPlayer is a player at a table and their legal actions
+type seatedPlayer struct {
+	simTable
+	player  types.PlayerDTO
+	account simtypes.Account
+}
+
+// deliver signs and delivers msg with random fees, then checks the module
+// invariants against the resulting state
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	opMsg, futureOps, err := simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Msg:             msg,
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	})
+	if err != nil {
+		return opMsg, futureOps, err
+	}
+	if res, broken := keeper.AllInvariants(k)(ctx); broken {
+		return opMsg, futureOps, errors.New(res)
+	}
+	return opMsg, futureOps, nil
+}
+
+// tables returns every game and its state in key order
+func tables(ctx sdk.Context, k *keeper.Keeper) ([]simTable, error) {
+	var all []simTable
+	err := k.Games.Walk(ctx, nil, func(gameId string, game types.Game) (bool, error) {
+		state, err := k.GameStates.Get(ctx, gameId)
+		if err != nil {
+			return true, err
+		}
+		all = append(all, simTable{game: game, state: state})
+		return false, nil
+	})
+	return all, err
+}
+
+// seatedPlayers returns every simulated account seated at a table for which
+// keep returns true
+func seatedPlayers(ctx sdk.Context, k *keeper.Keeper, ak types.AuthKeeper, accs []simtypes.Account, keep func(simTable, types.PlayerDTO) bool) ([]seatedPlayer, error) {
+	all, err := tables(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+	var players []seatedPlayer
+	for _, t := range all {
+		for _, p := range t.state.Players {
+			if !keep(t, p) {
+				continue
+			}
+			addr, err := ak.AddressCodec().StringToBytes(p.Address)
+			if err != nil {
+				return nil, err
+			}
+			if account, ok := simtypes.FindAccount(accs, sdk.AccAddress(addr)); ok {
+				players = append(players, seatedPlayer{simTable: t, player: p, account: account})
+			}
+		}
+	}
+	return players, nil
+}
+
+// legalAction returns the player's legal action with the given name
+func legalAction(p types.PlayerDTO, action keeper.PlayerActionType) (types.LegalActionDTO, bool) {
+	for _, legal := range p.LegalActions {
+		if legal.Action == string(action) {
+			return legal, true
+		}
+	}
+	return types.LegalActionDTO{}, false
+}
+
+// isSeated reports whether address has a seat in the state
+func isSeated(state types.TexasHoldemStateDTO, address string) bool {
+	for _, p := range state.Players {
+		if p.Address == address {
+			return true
+		}
+	}
+	return false
+}
+
+// mayJoin reports whether the address is admitted by the table's access
+// policy without an invite code or token holding
+func mayJoin(game types.Game, address string) bool {
+	return !game.HasAccessPolicy() || game.Creator == address || game.IsAllowlisted(address)
+}
+
+// randChips returns a random amount in [lo, hi]
+func randChips(r *rand.Rand, lo, hi uint64) uint64 {
+	if hi <= lo {
+		return lo
+	}
+	return lo + uint64(r.Int63n(int64(hi-lo+1)))
+}
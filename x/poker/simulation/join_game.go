@@ -3,6 +3,7 @@ package simulation
 import (
 	"math/rand"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,8 @@ import (
 	"github.com/block52/pokerchain/x/poker/types"
 )
 
+// SimulateMsgJoinGame seats a random account at a random seat of a table open
+// to it, with a random buy-in it can afford
 func SimulateMsgJoinGame(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -25,8 +28,32 @@ func SimulateMsgJoinGame(
 			Player: simAccount.Address.String(),
 		}
 
-		// TODO: Handle the JoinGame simulation
+		all, err := tables(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list games"), nil, err
+		}
+		var open []simTable
+		for _, t := range all {
+			if len(t.game.OpenSeats(t.state, msg.Player)) > 0 && !isSeated(t.state, msg.Player) && mayJoin(t.game, msg.Player) {
+				open = append(open, t)
+			}
+		}
+		if len(open) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no open seats"), nil, nil
+		}
+		t := open[r.Intn(len(open))]
+		msg.GameId = t.game.GameId
+
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(t.game.TableDenom())
+		if !balance.IsUint64() || balance.Uint64() < t.game.MinBuyIn {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "insufficient funds to buy in"), nil, nil
+		}
+		msg.BuyInAmount = randChips(r, t.game.MinBuyIn, min(t.game.MaxBuyIn, balance.Uint64()))
+
+		free := t.game.OpenSeats(t.state, msg.Player)
+		msg.Seat = free[r.Intn(len(free))]
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "JoinGame simulation not implemented"), nil, nil
+		buyIn := sdk.NewCoins(sdk.NewCoin(t.game.TableDenom(), math.NewIntFromUint64(msg.BuyInAmount)))
+		return deliver(r, app, ctx, txGen, ak, bk, k, simAccount, msg, buyIn)
 	}
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/block52/pokerchain/x/poker/types"
 )
 
+// SimulateMsgLeaveGame has a random player leave their table. Players
+// between hands are cashed out; players in a hand leave once it is over.
 func SimulateMsgLeaveGame(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -20,13 +22,21 @@ func SimulateMsgLeaveGame(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgLeaveGame{
-			Creator: simAccount.Address.String(),
-		}
+		msg := &types.MsgLeaveGame{}
 
-		// TODO: Handle the LeaveGame simulation
+		players, err := seatedPlayers(ctx, k, ak, accs, func(t simTable, p types.PlayerDTO) bool {
+			return !t.game.IsLeaving(p.Address)
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list players"), nil, err
+		}
+		if len(players) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no player can leave"), nil, nil
+		}
+		p := players[r.Intn(len(players))]
+		msg.Creator = p.player.Address
+		msg.GameId = p.game.GameId
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "LeaveGame simulation not implemented"), nil, nil
+		return deliver(r, app, ctx, txGen, ak, bk, k, p.account, msg, nil)
 	}
 }
@@ -25,8 +25,6 @@ func SimulateMsgMint(
 			Creator: simAccount.Address.String(),
 		}
 
-		// TODO: Handle the Mint simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Mint simulation not implemented"), nil, nil
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Mint requires a proven Ethereum deposit"), nil, nil
 	}
 }
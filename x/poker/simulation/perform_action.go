@@ -12,6 +12,9 @@ import (
 	"github.com/block52/pokerchain/x/poker/types"
 )
 
+// SimulateMsgPerformAction picks a player with legal actions and performs
+// one of them at random, with a random amount in the legal range. Leaving is
+// simulated by SimulateMsgLeaveGame.
 func SimulateMsgPerformAction(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -20,13 +23,38 @@ func SimulateMsgPerformAction(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgPerformAction{
-			Player: simAccount.Address.String(),
+		msg := &types.MsgPerformAction{}
+
+		// Players leaving after the hand are folded by the module
+		players, err := seatedPlayers(ctx, k, ak, accs, func(t simTable, p types.PlayerDTO) bool {
+			return len(playableActions(p)) > 0 && !t.game.IsLeaving(p.Address)
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list players"), nil, err
+		}
+		if len(players) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no player has a legal action"), nil, nil
 		}
+		p := players[r.Intn(len(players))]
+
+		actions := playableActions(p.player)
+		action := actions[r.Intn(len(actions))]
+		msg.Player = p.player.Address
+		msg.GameId = p.game.GameId
+		msg.Action = action.Action
+		msg.Amount = randChips(r, parseChips(ptrString(action.Min)), parseChips(ptrString(action.Max)))
 
-		// TODO: Handle the PerformAction simulation
+		return deliver(r, app, ctx, txGen, ak, bk, k, p.account, msg, nil)
+	}
+}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "PerformAction simulation not implemented"), nil, nil
+// playableActions are the player's legal actions other than leaving
+func playableActions(p types.PlayerDTO) []types.LegalActionDTO {
+	var actions []types.LegalActionDTO
+	for _, action := range p.LegalActions {
+		if action.Action != string(keeper.Leave) {
+			actions = append(actions, action)
+		}
 	}
+	return actions
 }
@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// SimulateMsgSetAutoPostBlinds toggles automatic blind posting for a random
+// seated player
+func SimulateMsgSetAutoPostBlinds(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetAutoPostBlinds{}
+
+		players, err := seatedPlayers(ctx, k, ak, accs, func(t simTable, p types.PlayerDTO) bool {
+			for _, player := range t.game.Players {
+				if player == p.Address {
+					return true
+				}
+			}
+			return false
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list players"), nil, err
+		}
+		if len(players) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no seated players"), nil, nil
+		}
+		p := players[r.Intn(len(players))]
+		msg.Player = p.player.Address
+		msg.GameId = p.game.GameId
+		msg.Enabled = !p.game.AutoPostsBlinds(p.player.Address)
+
+		return deliver(r, app, ctx, txGen, ak, bk, k, p.account, msg, nil)
+	}
+}
@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// SimulateMsgTopUp adds a random amount, up to the table's max buy-in, to the
+// stack of a player who is not in a hand
+func SimulateMsgTopUp(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgTopUp{}
+
+		// Players who may leave are between hands, which is when top-ups apply
+		players, err := seatedPlayers(ctx, k, ak, accs, func(t simTable, p types.PlayerDTO) bool {
+			_, ok := legalAction(p, keeper.Leave)
+			return ok && parseChips(p.Stack) < t.game.MaxBuyIn
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list players"), nil, err
+		}
+		if len(players) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no player can top up"), nil, nil
+		}
+		p := players[r.Intn(len(players))]
+		msg.Player = p.player.Address
+		msg.GameId = p.game.GameId
+
+		balance := bk.SpendableCoins(ctx, p.account.Address).AmountOf(p.game.TableDenom())
+		if !balance.IsUint64() || balance.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "insufficient funds to top up"), nil, nil
+		}
+		msg.Amount = randChips(r, 1, min(p.game.MaxBuyIn-parseChips(p.player.Stack), balance.Uint64()))
+
+		topUp := sdk.NewCoins(sdk.NewCoin(p.game.TableDenom(), math.NewIntFromUint64(msg.Amount)))
+		return deliver(r, app, ctx, txGen, ak, bk, k, p.account, msg, topUp)
+	}
+}
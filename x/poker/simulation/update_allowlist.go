@@ -0,0 +1,61 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// SimulateMsgUpdateAllowlist has the creator of a random table allow a random
+// account to sit, or remove one they allowed before
+func SimulateMsgUpdateAllowlist(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgUpdateAllowlist{}
+
+		all, err := tables(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list games"), nil, err
+		}
+		type ownedTable struct {
+			game    types.Game
+			creator simtypes.Account
+		}
+		var owned []ownedTable
+		for _, t := range all {
+			addr, err := ak.AddressCodec().StringToBytes(t.game.Creator)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "invalid creator address"), nil, err
+			}
+			if creator, ok := simtypes.FindAccount(accs, sdk.AccAddress(addr)); ok {
+				owned = append(owned, ownedTable{game: t.game, creator: creator})
+			}
+		}
+		if len(owned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no tables with a simulated creator"), nil, nil
+		}
+		t := owned[r.Intn(len(owned))]
+		msg.Creator = t.game.Creator
+		msg.GameId = t.game.GameId
+
+		if len(t.game.Allowlist) > 0 && r.Intn(2) == 0 {
+			msg.Remove = []string{t.game.Allowlist[r.Intn(len(t.game.Allowlist))]}
+		} else {
+			account, _ := simtypes.RandomAcc(r, accs)
+			msg.Add = []string{account.Address.String()}
+		}
+
+		return deliver(r, app, ctx, txGen, ak, bk, k, t.creator, msg, nil)
+	}
+}
@@ -0,0 +1,97 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/block52/pokerchain/x/poker/keeper"
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+// SimulateMsgJoinWaitlist queues a random account for a seat at a random
+// table with no seat open to it
+func SimulateMsgJoinWaitlist(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgJoinWaitlist{
+			Player: simAccount.Address.String(),
+		}
+
+		all, err := tables(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list games"), nil, err
+		}
+		var full []simTable
+		for _, t := range all {
+			_, reserved := t.game.Reservation(msg.Player)
+			if len(t.game.OpenSeats(t.state, msg.Player)) > 0 || reserved || t.game.WaitlistPosition(msg.Player) > 0 {
+				continue
+			}
+			if !isSeated(t.state, msg.Player) && mayJoin(t.game, msg.Player) {
+				full = append(full, t)
+			}
+		}
+		if len(full) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no full tables"), nil, nil
+		}
+		msg.GameId = full[r.Intn(len(full))].game.GameId
+
+		return deliver(r, app, ctx, txGen, ak, bk, k, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgLeaveWaitlist takes a random waiting account off a waitlist
+func SimulateMsgLeaveWaitlist(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k *keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgLeaveWaitlist{}
+
+		all, err := tables(ctx, k)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to list games"), nil, err
+		}
+		type waiting struct {
+			gameId  string
+			account simtypes.Account
+		}
+		var players []waiting
+		for _, t := range all {
+			addresses := append([]string(nil), t.game.Waitlist...)
+			for _, reservation := range t.game.Reservations {
+				addresses = append(addresses, reservation.Player)
+			}
+			for _, address := range addresses {
+				addr, err := ak.AddressCodec().StringToBytes(address)
+				if err != nil {
+					return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "invalid player address"), nil, err
+				}
+				if account, ok := simtypes.FindAccount(accs, sdk.AccAddress(addr)); ok {
+					players = append(players, waiting{gameId: t.game.GameId, account: account})
+				}
+			}
+		}
+		if len(players) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no waitlisted players"), nil, nil
+		}
+		p := players[r.Intn(len(players))]
+		msg.Player = p.account.Address.String()
+		msg.GameId = p.gameId
+
+		return deliver(r, app, ctx, txGen, ak, bk, k, p.account, msg, nil)
+	}
+}
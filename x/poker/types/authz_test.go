@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/block52/pokerchain/x/poker/types"
+)
+
+func TestGameAuthorization(t *testing.T) {
+	actionURL := sdk.MsgTypeURL(&types.MsgPerformAction{})
+	a := types.NewGameAuthorization(actionURL, []string{"0xgame"}, 100)
+	require.NoError(t, a.ValidateBasic())
+
+	tests := []struct {
+		desc   string
+		msg    sdk.Msg
+		accept bool
+	}{
+		{"action at the table", &types.MsgPerformAction{GameId: "0xgame", Action: "call"}, true},
+		{"bet of the maximum", &types.MsgPerformAction{GameId: "0xgame", Action: "bet", Amount: 100}, true},
+		{"bet over the maximum", &types.MsgPerformAction{GameId: "0xgame", Action: "bet", Amount: 101}, false},
+		{"other table", &types.MsgPerformAction{GameId: "0xother", Action: "fold"}, false},
+		{"message not granted", &types.MsgTopUp{GameId: "0xgame", Amount: 10}, false},
+		{"message not grantable", &types.MsgLeaveGame{GameId: "0xgame"}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := a.Accept(context.Background(), tc.msg)
+			if tc.accept {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+				require.False(t, res.Delete)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// Without a maximum any amount is accepted
+	unlimited := types.NewGameAuthorization(actionURL, []string{"0xgame"}, 0)
+	_, err := unlimited.Accept(context.Background(), &types.MsgPerformAction{GameId: "0xgame", Action: "raise", Amount: 1_000_000})
+	require.NoError(t, err)
+
+	require.Error(t, types.NewGameAuthorization(actionURL, nil, 0).ValidateBasic())
+	require.Error(t, types.NewGameAuthorization(sdk.MsgTypeURL(&types.MsgLeaveGame{}), []string{"0xgame"}, 0).ValidateBasic())
+}
@@ -0,0 +1,76 @@
+package types
+
+import "slices"
+
+// AutoTopUpMode is how a standing top-up instruction refills a stack
+type AutoTopUpMode string
+
+const (
+	// AutoTopUpRebuy buys back in for the target once the player is busted
+	AutoTopUpRebuy AutoTopUpMode = "rebuy"
+	// AutoTopUpTopUp brings the stack back up to the target between hands
+	AutoTopUpTopUp AutoTopUpMode = "top-up"
+)
+
+// IsValid reports whether the mode is one the chain knows
+func (m AutoTopUpMode) IsValid() bool {
+	switch m {
+	case AutoTopUpRebuy, AutoTopUpTopUp:
+		return true
+	}
+	return false
+}
+
+// AutoTopUp is a seated player's standing instruction to refill their stack
+// from their wallet between hands
+type AutoTopUp struct {
+	Player string        `json:"player"`
+	Mode   AutoTopUpMode `json:"mode"`
+	Target uint64        `json:"target"`
+}
+
+// Due is how much the instruction would add to a stack, zero if nothing is
+// owed. The stack is never taken above the table's maximum buy-in.
+func (a AutoTopUp) Due(stack, maxBuyIn uint64) uint64 {
+	target := min(a.Target, maxBuyIn)
+	switch {
+	case a.Mode == AutoTopUpRebuy && stack > 0:
+		return 0
+	case stack >= target:
+		return 0
+	}
+	return target - stack
+}
+
+// AutoTopUp returns the player's standing top-up instruction
+func (g Game) AutoTopUp(player string) (AutoTopUp, bool) {
+	for _, a := range g.AutoTopUps {
+		if a.Player == player {
+			return a, true
+		}
+	}
+	return AutoTopUp{}, false
+}
+
+// SetAutoTopUp records a player's standing top-up instruction, replacing any
+// they already had
+func (g *Game) SetAutoTopUp(autoTopUp AutoTopUp) {
+	for i := range g.AutoTopUps {
+		if g.AutoTopUps[i].Player == autoTopUp.Player {
+			g.AutoTopUps[i] = autoTopUp
+			return
+		}
+	}
+	g.AutoTopUps = append(g.AutoTopUps, autoTopUp)
+}
+
+// RemoveAutoTopUp cancels a player's standing top-up instruction, reporting
+// whether they had one
+func (g *Game) RemoveAutoTopUp(player string) bool {
+	before := len(g.AutoTopUps)
+	g.AutoTopUps = slices.DeleteFunc(slices.Clone(g.AutoTopUps), func(a AutoTopUp) bool { return a.Player == player })
+	if len(g.AutoTopUps) == 0 {
+		g.AutoTopUps = nil
+	}
+	return len(g.AutoTopUps) != before
+}
@@ -4,9 +4,28 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAutoTopUp{},
+		&MsgCancelAutoTopUp{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgJoinWaitlist{},
+		&MsgLeaveWaitlist{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateAllowlist{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAutoPostBlinds{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgBurn{},
 	)
@@ -38,5 +57,8 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
+	registrar.RegisterImplementations((*authz.Authorization)(nil),
+		&GameAuthorization{},
+	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
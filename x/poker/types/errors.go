@@ -15,4 +15,18 @@ var (
 	ErrInvalidRequest     = errors.Register(ModuleName, 1104, "invalid request")
 	ErrInvalidAction      = errors.Register(ModuleName, 1105, "invalid poker action")
 	ErrGameNotFound       = errors.Register(ModuleName, 1106, "game not found")
+	ErrInvalidShowdown    = errors.Register(ModuleName, 1107, "invalid showdown state")
+	ErrShowdownMismatch   = errors.Register(ModuleName, 1108, "showdown result does not match on-chain evaluation")
+	ErrInvalidEthHeader   = errors.Register(ModuleName, 1109, "invalid ethereum header")
+	ErrEthHeaderNotFound  = errors.Register(ModuleName, 1110, "ethereum header not found")
+	ErrInvalidProof       = errors.Register(ModuleName, 1111, "invalid deposit receipt proof")
+	ErrUnauthorized       = errors.Register(ModuleName, 1112, "unauthorized")
+	ErrBridgePaused       = errors.Register(ModuleName, 1113, "bridge is paused")
+	ErrWithdrawalLimit    = errors.Register(ModuleName, 1114, "withdrawal limit exceeded")
+	ErrUnknownIBCRoute    = errors.Register(ModuleName, 1115, "not an allowlisted IBC USDC route")
+	ErrDenomNotAllowed    = errors.Register(ModuleName, 1116, "denomination not allowed for games")
+	ErrActionTimedOut     = errors.Register(ModuleName, 1117, "player ran out of time to act")
+	ErrTableAccessDenied  = errors.Register(ModuleName, 1118, "not allowed to sit at this table")
+	ErrSeatReserved       = errors.Register(ModuleName, 1119, "seat is held for a waitlisted player")
+	ErrWaitlistFull       = errors.Register(ModuleName, 1120, "waitlist is full")
 )
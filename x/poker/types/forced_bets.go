@@ -0,0 +1,90 @@
+package types
+
+import "strconv"
+
+// AnteType is who posts a table's ante
+type AnteType string
+
+const (
+	// AnteTypePerPlayer has every player dealt in post the ante
+	AnteTypePerPlayer AnteType = "per-player"
+	// AnteTypeBigBlind has the big blind post the ante for the whole table
+	AnteTypeBigBlind AnteType = "big-blind"
+	// AnteTypeButton has the button post the ante for the whole table
+	AnteTypeButton AnteType = "button"
+)
+
+// IsValid reports whether the ante type is known
+func (a AnteType) IsValid() bool {
+	switch a {
+	case AnteTypePerPlayer, AnteTypeBigBlind, AnteTypeButton:
+		return true
+	default:
+		return false
+	}
+}
+
+// StraddleType is the seat that posts a table's straddle
+type StraddleType string
+
+const (
+	// StraddleNone means the table has no straddle
+	StraddleNone StraddleType = ""
+	// StraddleUTG has the player after the big blind straddle; preflop
+	// action starts after them
+	StraddleUTG StraddleType = "utg"
+	// StraddleButton has the button straddle; preflop action starts with the
+	// small blind
+	StraddleButton StraddleType = "button"
+)
+
+// IsValid reports whether the straddle type is known
+func (s StraddleType) IsValid() bool {
+	switch s {
+	case StraddleNone, StraddleUTG, StraddleButton:
+		return true
+	default:
+		return false
+	}
+}
+
+// StraddleAmount is what a table's straddle costs: twice the big blind
+func (g Game) StraddleAmount() uint64 {
+	if g.Straddle == StraddleNone {
+		return 0
+	}
+	return 2 * g.BigBlind
+}
+
+// IsBombPot reports whether the hand with the given number is a scheduled
+// bomb pot, where every player antes and the hand starts on the flop
+func (g Game) IsBombPot(handNumber int) bool {
+	return g.BombPotFrequency > 0 && handNumber > 0 && uint64(handNumber)%g.BombPotFrequency == 0
+}
+
+// ForcedBetOptions returns the game options for the table's antes, straddle,
+// bomb pots and the dead blinds players owe, to hand to the game engine
+// alongside the blinds
+func (g Game) ForcedBetOptions(options GameOptionsDTO) GameOptionsDTO {
+	if g.Ante > 0 {
+		ante := strconv.FormatUint(g.Ante, 10)
+		anteType := g.AnteType
+		options.Ante, options.AnteType = &ante, &anteType
+	}
+	if g.Straddle != StraddleNone {
+		straddle := g.Straddle
+		options.Straddle = &straddle
+	}
+	if g.BombPotFrequency > 0 {
+		frequency := int(g.BombPotFrequency)
+		ante := strconv.FormatUint(g.BombPotAnte, 10)
+		options.BombPotFrequency, options.BombPotAnte = &frequency, &ante
+	}
+	if len(g.DeadBlinds) > 0 {
+		options.DeadBlinds = make(map[string]string, len(g.DeadBlinds))
+		for _, d := range g.DeadBlinds {
+			options.DeadBlinds[d.Player] = strconv.FormatUint(d.Amount, 10)
+		}
+	}
+	return options
+}
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +12,43 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Headers must form a single contiguous chain
+	for i, header := range gs.EthHeaders {
+		if header == nil {
+			return fmt.Errorf("eth header %d is nil", i)
+		}
+		if err := header.Validate(); err != nil {
+			return fmt.Errorf("eth header %d: %w", header.Number, err)
+		}
+		if i == 0 {
+			continue
+		}
+		prev := gs.EthHeaders[i-1]
+		if header.Number != prev.Number+1 {
+			return fmt.Errorf("eth header %d does not follow %d", header.Number, prev.Number)
+		}
+		if string(header.ParentHash) != string(prev.Hash) {
+			return fmt.Errorf("eth header %d parent hash does not match header %d", header.Number, prev.Number)
+		}
+	}
+	return nil
+}
+
+// Validate checks that every hash in the header is 32 bytes
+func (h EthHeader) Validate() error {
+	for name, hash := range map[string][]byte{
+		"hash":          h.Hash,
+		"parent hash":   h.ParentHash,
+		"receipts root": h.ReceiptsRoot,
+		"state root":    h.StateRoot,
+	} {
+		if len(hash) != 32 {
+			return fmt.Errorf("%s must be 32 bytes, got %d", name, len(hash))
+		}
+	}
+	return nil
 }
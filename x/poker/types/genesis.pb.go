@@ -26,14 +26,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // WithdrawalRequest represents a pending withdrawal from Cosmos to Base chain.
 type WithdrawalRequest struct {
-	Nonce         string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	CosmosAddress string `protobuf:"bytes,2,opt,name=cosmos_address,json=cosmosAddress,proto3" json:"cosmos_address,omitempty"`
-	BaseAddress   string `protobuf:"bytes,3,opt,name=base_address,json=baseAddress,proto3" json:"base_address,omitempty"`
-	Amount        uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Signature     []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
-	CreatedAt     int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CompletedAt   int64  `protobuf:"varint,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Nonce           string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	CosmosAddress   string `protobuf:"bytes,2,opt,name=cosmos_address,json=cosmosAddress,proto3" json:"cosmos_address,omitempty"`
+	BaseAddress     string `protobuf:"bytes,3,opt,name=base_address,json=baseAddress,proto3" json:"base_address,omitempty"`
+	Amount          uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status          string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Signature       []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	CreatedAt       int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt     int64  `protobuf:"varint,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExpiresAt       int64  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CancelSignature []byte `protobuf:"bytes,10,opt,name=cancel_signature,json=cancelSignature,proto3" json:"cancel_signature,omitempty"`
+	ReleaseAt       int64  `protobuf:"varint,11,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	Fee             uint64 `protobuf:"varint,12,opt,name=fee,proto3" json:"fee,omitempty"`
+	CancelledAt     int64  `protobuf:"varint,13,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
 }
 
 func (m *WithdrawalRequest) Reset()         { *m = WithdrawalRequest{} }
@@ -125,6 +130,111 @@ func (m *WithdrawalRequest) GetCompletedAt() int64 {
 	return 0
 }
 
+func (m *WithdrawalRequest) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *WithdrawalRequest) GetCancelSignature() []byte {
+	if m != nil {
+		return m.CancelSignature
+	}
+	return nil
+}
+
+func (m *WithdrawalRequest) GetReleaseAt() int64 {
+	if m != nil {
+		return m.ReleaseAt
+	}
+	return 0
+}
+
+func (m *WithdrawalRequest) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *WithdrawalRequest) GetCancelledAt() int64 {
+	if m != nil {
+		return m.CancelledAt
+	}
+	return 0
+}
+
+// BridgePauseState records whether the bridge is paused. While paused, deposits
+// are not minted and withdrawals are neither accepted, released nor signed.
+type BridgePauseState struct {
+	Paused    bool   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *BridgePauseState) Reset()         { *m = BridgePauseState{} }
+func (m *BridgePauseState) String() string { return proto.CompactTextString(m) }
+func (*BridgePauseState) ProtoMessage()    {}
+func (*BridgePauseState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f54ec3370909f59c, []int{1}
+}
+func (m *BridgePauseState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgePauseState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgePauseState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgePauseState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgePauseState.Merge(m, src)
+}
+func (m *BridgePauseState) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgePauseState) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgePauseState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgePauseState proto.InternalMessageInfo
+
+func (m *BridgePauseState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *BridgePauseState) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+func (m *BridgePauseState) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *BridgePauseState) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // DepositSyncState tracks the state of automatic deposit synchronization.
 // This is used by validators to process deposits in EndBlock deterministically.
 type DepositSyncState struct {
@@ -136,7 +246,7 @@ func (m *DepositSyncState) Reset()         { *m = DepositSyncState{} }
 func (m *DepositSyncState) String() string { return proto.CompactTextString(m) }
 func (*DepositSyncState) ProtoMessage()    {}
 func (*DepositSyncState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f54ec3370909f59c, []int{1}
+	return fileDescriptor_f54ec3370909f59c, []int{2}
 }
 func (m *DepositSyncState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,6 +289,92 @@ func (m *DepositSyncState) GetLastEthBlockHeight() uint64 {
 	return 0
 }
 
+// EthHeader is a source chain (Base/Ethereum) block header tracked by the
+// deposit light client. Only the fields needed for proof verification are kept.
+type EthHeader struct {
+	Number       uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Hash         []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash   []byte `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	ReceiptsRoot []byte `protobuf:"bytes,4,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	StateRoot    []byte `protobuf:"bytes,5,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	Timestamp    uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *EthHeader) Reset()         { *m = EthHeader{} }
+func (m *EthHeader) String() string { return proto.CompactTextString(m) }
+func (*EthHeader) ProtoMessage()    {}
+func (*EthHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f54ec3370909f59c, []int{3}
+}
+func (m *EthHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthHeader.Merge(m, src)
+}
+func (m *EthHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthHeader proto.InternalMessageInfo
+
+func (m *EthHeader) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *EthHeader) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *EthHeader) GetParentHash() []byte {
+	if m != nil {
+		return m.ParentHash
+	}
+	return nil
+}
+
+func (m *EthHeader) GetReceiptsRoot() []byte {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return nil
+}
+
+func (m *EthHeader) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *EthHeader) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 // GenesisState defines the poker module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -191,13 +387,17 @@ type GenesisState struct {
 	WithdrawalNonce uint64 `protobuf:"varint,4,opt,name=withdrawal_nonce,json=withdrawalNonce,proto3" json:"withdrawal_nonce,omitempty"`
 	// Deposit sync state - tracks automatic deposit synchronization
 	DepositSyncState *DepositSyncState `protobuf:"bytes,5,opt,name=deposit_sync_state,json=depositSyncState,proto3" json:"deposit_sync_state,omitempty"`
+	// Tracked source chain headers for deposit proof verification
+	EthHeaders []*EthHeader `protobuf:"bytes,6,rep,name=eth_headers,json=ethHeaders,proto3" json:"eth_headers,omitempty"`
+	// Bridge pause state
+	BridgePause *BridgePauseState `protobuf:"bytes,7,opt,name=bridge_pause,json=bridgePause,proto3" json:"bridge_pause,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f54ec3370909f59c, []int{2}
+	return fileDescriptor_f54ec3370909f59c, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,51 +461,83 @@ func (m *GenesisState) GetDepositSyncState() *DepositSyncState {
 	return nil
 }
 
+func (m *GenesisState) GetEthHeaders() []*EthHeader {
+	if m != nil {
+		return m.EthHeaders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgePause() *BridgePauseState {
+	if m != nil {
+		return m.BridgePause
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WithdrawalRequest)(nil), "pokerchain.poker.v1.WithdrawalRequest")
+	proto.RegisterType((*BridgePauseState)(nil), "pokerchain.poker.v1.BridgePauseState")
 	proto.RegisterType((*DepositSyncState)(nil), "pokerchain.poker.v1.DepositSyncState")
+	proto.RegisterType((*EthHeader)(nil), "pokerchain.poker.v1.EthHeader")
 	proto.RegisterType((*GenesisState)(nil), "pokerchain.poker.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("pokerchain/poker/v1/genesis.proto", fileDescriptor_f54ec3370909f59c) }
 
 var fileDescriptor_f54ec3370909f59c = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x9b, 0x34, 0x90, 0x4d, 0xa0, 0xc9, 0x36, 0x20, 0xab, 0x80, 0x49, 0x23, 0x15, 0x99,
-	0x22, 0xd9, 0x24, 0x88, 0x2b, 0x52, 0x22, 0x22, 0xe0, 0x82, 0x2a, 0x07, 0xa9, 0x12, 0x17, 0x6b,
-	0x63, 0xaf, 0x6c, 0xab, 0xb1, 0xd7, 0x78, 0xc7, 0x4d, 0x72, 0xe6, 0x07, 0xf8, 0x0c, 0x8e, 0x7c,
-	0x46, 0x8f, 0x95, 0xb8, 0x70, 0x42, 0x28, 0x39, 0xf0, 0x1b, 0x68, 0x77, 0xdd, 0xa4, 0x0a, 0xb9,
-	0x58, 0xb3, 0xef, 0xbd, 0xf1, 0x8c, 0xe6, 0xcd, 0xa0, 0xe3, 0x94, 0x5d, 0xd0, 0xcc, 0x0b, 0x49,
-	0x94, 0xd8, 0x32, 0xb4, 0x2f, 0x7b, 0x76, 0x40, 0x13, 0xca, 0x23, 0x6e, 0xa5, 0x19, 0x03, 0x86,
-	0x0f, 0x37, 0x12, 0x4b, 0x86, 0xd6, 0x65, 0xef, 0xa8, 0x45, 0xe2, 0x28, 0x61, 0xb6, 0xfc, 0x2a,
-	0xdd, 0x51, 0x3b, 0x60, 0x01, 0x93, 0xa1, 0x2d, 0xa2, 0x02, 0xed, 0xec, 0x2a, 0x90, 0x92, 0x8c,
-	0xc4, 0xc5, 0xff, 0xbb, 0x5f, 0xf7, 0x50, 0xeb, 0x3c, 0x82, 0xd0, 0xcf, 0xc8, 0x8c, 0x4c, 0x1d,
-	0xfa, 0x25, 0xa7, 0x1c, 0x70, 0x1b, 0xed, 0x27, 0x2c, 0xf1, 0xa8, 0xae, 0x75, 0x34, 0xb3, 0xe6,
-	0xa8, 0x07, 0x3e, 0x41, 0xf7, 0x3d, 0xc6, 0x63, 0xc6, 0x5d, 0xe2, 0xfb, 0x19, 0xe5, 0x5c, 0xdf,
-	0x93, 0xf4, 0x3d, 0x85, 0x0e, 0x14, 0x88, 0x8f, 0x51, 0x63, 0x42, 0x38, 0x5d, 0x8b, 0xca, 0x52,
-	0x54, 0x17, 0xd8, 0x8d, 0xe4, 0x21, 0xaa, 0x92, 0x98, 0xe5, 0x09, 0xe8, 0x95, 0x8e, 0x66, 0x56,
-	0x9c, 0xe2, 0x25, 0x70, 0x0e, 0x04, 0x72, 0xae, 0xef, 0xcb, 0xa4, 0xe2, 0x85, 0x1f, 0xa3, 0x1a,
-	0x8f, 0x82, 0x84, 0x40, 0x9e, 0x51, 0xbd, 0xda, 0xd1, 0xcc, 0x86, 0xb3, 0x01, 0xf0, 0x13, 0x84,
-	0xbc, 0x8c, 0x12, 0xa0, 0xbe, 0x4b, 0x40, 0xbf, 0xd3, 0xd1, 0xcc, 0xb2, 0x53, 0x2b, 0x90, 0x01,
-	0x88, 0x7e, 0x3c, 0x16, 0xa7, 0x53, 0x5a, 0x08, 0xee, 0x4a, 0x41, 0x7d, 0x8d, 0x0d, 0xa0, 0x3b,
-	0x43, 0xcd, 0xb7, 0x34, 0x65, 0x3c, 0x82, 0xf1, 0x22, 0xf1, 0xc6, 0x40, 0x80, 0xe2, 0x97, 0xa8,
-	0x3d, 0x25, 0x1c, 0xdc, 0x34, 0x63, 0x1e, 0xe5, 0x9c, 0xfa, 0x6e, 0x94, 0xf8, 0x74, 0x2e, 0x47,
-	0x52, 0x71, 0xb0, 0xe0, 0xce, 0x6e, 0xa8, 0x0f, 0x82, 0xc1, 0x3d, 0xf4, 0x40, 0x66, 0x50, 0x08,
-	0xdd, 0xc9, 0x94, 0x79, 0x17, 0x6e, 0x48, 0xa3, 0x20, 0x04, 0x39, 0xa6, 0x22, 0x65, 0x04, 0xe1,
-	0x50, 0x50, 0xef, 0x25, 0xd3, 0xfd, 0xb9, 0x87, 0x1a, 0xef, 0x94, 0xe1, 0xaa, 0xea, 0x1b, 0x54,
-	0x55, 0xfe, 0xc8, 0x3a, 0xf5, 0xfe, 0x23, 0x6b, 0xc7, 0x02, 0x58, 0x67, 0x52, 0x32, 0xac, 0x5d,
-	0xfd, 0x7e, 0x5a, 0xfa, 0xfe, 0xf7, 0xc7, 0xa9, 0xe6, 0x14, 0x59, 0xf8, 0x14, 0xb5, 0x36, 0x0d,
-	0x8b, 0x46, 0x60, 0x2e, 0x6c, 0x2a, 0x9b, 0x35, 0xe7, 0x60, 0x4d, 0x8c, 0x20, 0xfc, 0x34, 0xe7,
-	0xf8, 0x1c, 0x1d, 0xce, 0xd6, 0xd6, 0xbb, 0x99, 0xf2, 0x5e, 0xf8, 0x55, 0x36, 0xeb, 0xfd, 0x67,
-	0x3b, 0x0b, 0xff, 0xb7, 0x2a, 0x0e, 0x9e, 0x6d, 0x43, 0x1c, 0x3f, 0x47, 0xcd, 0x5b, 0x3f, 0x56,
-	0x9b, 0xa4, 0x8c, 0x3e, 0xd8, 0xe0, 0x1f, 0xe5, 0x4e, 0x8d, 0x11, 0xf6, 0xd5, 0xe4, 0x5d, 0xbe,
-	0x48, 0x3c, 0x57, 0x18, 0x4e, 0xa5, 0xfb, 0xf5, 0xfe, 0xc9, 0xce, 0x16, 0xb6, 0x8d, 0x72, 0x9a,
-	0xfe, 0x16, 0x32, 0x1c, 0x5d, 0x2d, 0x0d, 0xed, 0x7a, 0x69, 0x68, 0x7f, 0x96, 0x86, 0xf6, 0x6d,
-	0x65, 0x94, 0xae, 0x57, 0x46, 0xe9, 0xd7, 0xca, 0x28, 0x7d, 0x7e, 0x11, 0x44, 0x10, 0xe6, 0x13,
-	0xcb, 0x63, 0xb1, 0x2d, 0x2d, 0x7a, 0xdd, 0xb7, 0x6f, 0xdd, 0xc8, 0xbc, 0xb8, 0x12, 0x58, 0xa4,
-	0x94, 0x4f, 0xaa, 0xf2, 0x44, 0x5e, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x90, 0xb2, 0x85, 0x52,
-	0xa7, 0x03, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0xd7, 0x69, 0x76, 0x3d, 0x4e, 0x69, 0x3a, 0x5b, 0x90, 0xb5, 0x2c, 0xd9, 0x6c, 0xd0,
+	0xa2, 0xb0, 0x48, 0x09, 0x2d, 0xe2, 0x0a, 0x4a, 0x44, 0x45, 0xb9, 0xa0, 0x6a, 0x8a, 0xb4, 0x12,
+	0x17, 0x6b, 0x6c, 0x3f, 0x62, 0x6b, 0x63, 0x8f, 0x99, 0x19, 0x6f, 0x93, 0x1b, 0x3f, 0x81, 0xbf,
+	0xc0, 0x8d, 0x23, 0x67, 0x7e, 0xc1, 0x1e, 0xf7, 0xc8, 0xa9, 0x42, 0xed, 0x81, 0xbf, 0x81, 0xe6,
+	0xcd, 0x24, 0xae, 0x4a, 0xc4, 0xc5, 0x9a, 0xf7, 0x7d, 0x6f, 0x9e, 0xbf, 0x79, 0xf3, 0xbe, 0x21,
+	0xcf, 0x6b, 0xf1, 0x1a, 0x64, 0x9a, 0xf3, 0xa2, 0x9a, 0xe2, 0x72, 0xfa, 0xe6, 0x64, 0xba, 0x80,
+	0x0a, 0x54, 0xa1, 0x26, 0xb5, 0x14, 0x5a, 0xd0, 0xc7, 0x6d, 0xca, 0x04, 0x97, 0x93, 0x37, 0x27,
+	0x4f, 0x8e, 0x78, 0x59, 0x54, 0x62, 0x8a, 0x5f, 0x9b, 0xf7, 0xe4, 0x78, 0x21, 0x16, 0x02, 0x97,
+	0x53, 0xb3, 0x72, 0xe8, 0x70, 0xd7, 0x0f, 0x6a, 0x2e, 0x79, 0xe9, 0xea, 0x8f, 0x7e, 0xf3, 0xc9,
+	0xd1, 0xab, 0x42, 0xe7, 0x99, 0xe4, 0x57, 0x7c, 0xc9, 0xe0, 0xe7, 0x06, 0x94, 0xa6, 0xc7, 0x64,
+	0xbf, 0x12, 0x55, 0x0a, 0x91, 0x37, 0xf4, 0xc6, 0x01, 0xb3, 0x01, 0x7d, 0x41, 0xde, 0x4b, 0x85,
+	0x2a, 0x85, 0x8a, 0x79, 0x96, 0x49, 0x50, 0x2a, 0x7a, 0x80, 0xf4, 0x81, 0x45, 0x67, 0x16, 0xa4,
+	0xcf, 0x49, 0x2f, 0xe1, 0x0a, 0xb6, 0x49, 0x3e, 0x26, 0x85, 0x06, 0xdb, 0xa4, 0x7c, 0x40, 0xba,
+	0xbc, 0x14, 0x4d, 0xa5, 0xa3, 0xce, 0xd0, 0x1b, 0x77, 0x98, 0x8b, 0x0c, 0xae, 0x34, 0xd7, 0x8d,
+	0x8a, 0xf6, 0x71, 0x93, 0x8b, 0xe8, 0x53, 0x12, 0xa8, 0x62, 0x51, 0x71, 0xdd, 0x48, 0x88, 0xba,
+	0x43, 0x6f, 0xdc, 0x63, 0x2d, 0x40, 0x3f, 0x22, 0x24, 0x95, 0xc0, 0x35, 0x64, 0x31, 0xd7, 0xd1,
+	0xc3, 0xa1, 0x37, 0xf6, 0x59, 0xe0, 0x90, 0x99, 0x36, 0x7a, 0x52, 0x51, 0xd6, 0x4b, 0x70, 0x09,
+	0x8f, 0x30, 0x21, 0xdc, 0x62, 0x33, 0x6d, 0x2a, 0xc0, 0xaa, 0x2e, 0x24, 0x28, 0x93, 0x10, 0xd8,
+	0x0a, 0x0e, 0x99, 0x69, 0xfa, 0x29, 0xe9, 0xa7, 0xbc, 0x4a, 0x61, 0x19, 0xb7, 0x2a, 0x08, 0xaa,
+	0x38, 0xb4, 0xf8, 0xe5, 0x5d, 0x2d, 0x12, 0x96, 0x80, 0xe7, 0xd7, 0x51, 0x68, 0x2b, 0x39, 0x64,
+	0xa6, 0x69, 0x9f, 0xf8, 0x3f, 0x01, 0x44, 0x3d, 0x3c, 0xb5, 0x59, 0xa2, 0x3a, 0xac, 0xb1, 0xb4,
+	0xea, 0x0e, 0x9c, 0xba, 0x0d, 0x36, 0xd3, 0xa3, 0x5f, 0x3c, 0xd2, 0x9f, 0xcb, 0x22, 0x5b, 0xc0,
+	0x05, 0x6f, 0x14, 0x5c, 0x6a, 0xae, 0xc1, 0xb4, 0xaa, 0x36, 0x51, 0x86, 0x77, 0xf4, 0x88, 0xb9,
+	0xc8, 0x08, 0x68, 0xea, 0x0c, 0x9b, 0x91, 0xac, 0xdd, 0x05, 0x05, 0x0e, 0x99, 0xaf, 0xef, 0xd2,
+	0x5c, 0xe3, 0xd5, 0xf8, 0x5b, 0x7a, 0x86, 0x17, 0x20, 0x81, 0x2b, 0x51, 0xe1, 0xc5, 0x04, 0xcc,
+	0x45, 0xa3, 0x2b, 0xd2, 0xff, 0x06, 0x6a, 0xa1, 0x0a, 0x7d, 0xb9, 0xae, 0x52, 0xab, 0xe0, 0x73,
+	0x72, 0xbc, 0xe4, 0x4a, 0xc7, 0xb5, 0x14, 0x29, 0x28, 0x05, 0x59, 0x5c, 0x54, 0x19, 0xac, 0x50,
+	0x4f, 0x87, 0x51, 0xc3, 0x5d, 0x6c, 0xa8, 0xef, 0x0c, 0x43, 0x4f, 0xc8, 0xfb, 0xb8, 0x03, 0x74,
+	0x1e, 0x27, 0x4b, 0x91, 0xbe, 0x8e, 0x73, 0x28, 0x16, 0xb9, 0x8e, 0x1e, 0xb4, 0x5b, 0xce, 0x74,
+	0x3e, 0x37, 0xd4, 0x39, 0x32, 0xa3, 0x3f, 0x3d, 0x12, 0x9c, 0xe9, 0xfc, 0x1c, 0x78, 0x06, 0xd2,
+	0xc8, 0xab, 0x9a, 0x32, 0x01, 0xe9, 0x7e, 0xe2, 0x22, 0x4a, 0x49, 0x27, 0xe7, 0x2a, 0xc7, 0x3a,
+	0x3d, 0x86, 0x6b, 0xfa, 0x8c, 0x84, 0x35, 0x97, 0x50, 0xe9, 0x18, 0x29, 0x1f, 0x29, 0x62, 0xa1,
+	0x73, 0x93, 0xf0, 0x31, 0x39, 0x90, 0x90, 0x42, 0x51, 0x6b, 0x15, 0x4b, 0x21, 0xec, 0x2c, 0xf6,
+	0x58, 0x6f, 0x03, 0x32, 0x21, 0x70, 0x32, 0xcc, 0x0c, 0x82, 0xcd, 0xd8, 0x77, 0xa3, 0x67, 0x10,
+	0xa4, 0x9f, 0x92, 0x40, 0x17, 0x25, 0x28, 0xcd, 0xcb, 0x1a, 0x07, 0xb3, 0xc3, 0x5a, 0x60, 0x74,
+	0xed, 0x93, 0xde, 0xb7, 0xd6, 0xce, 0xb6, 0x65, 0x5f, 0x91, 0xae, 0x75, 0x1f, 0xea, 0x0f, 0x4f,
+	0x3f, 0x9c, 0xec, 0xb0, 0xf7, 0xe4, 0x02, 0x53, 0xe6, 0xc1, 0xdb, 0xeb, 0x67, 0x7b, 0xbf, 0xff,
+	0xf3, 0xc7, 0x4b, 0x8f, 0xb9, 0x5d, 0xf4, 0x25, 0x39, 0x6a, 0xbb, 0x6d, 0xba, 0xa8, 0x57, 0xc6,
+	0x84, 0xfe, 0x38, 0x60, 0x87, 0x5b, 0xe2, 0x4c, 0xe7, 0x3f, 0xac, 0x14, 0x7d, 0x45, 0x1e, 0x5f,
+	0x6d, 0x8d, 0x1d, 0x4b, 0xeb, 0x6c, 0xe3, 0x46, 0x7f, 0x1c, 0x9e, 0x7e, 0xb2, 0xf3, 0xc7, 0xff,
+	0x79, 0x08, 0x18, 0xbd, 0xba, 0x0f, 0x29, 0xe3, 0x86, 0x3b, 0x85, 0xed, 0x3b, 0x61, 0x6d, 0x7c,
+	0xd8, 0xe2, 0xdf, 0x1b, 0x98, 0x5e, 0x12, 0x9a, 0xd9, 0xb1, 0x89, 0xd5, 0xba, 0x4a, 0x63, 0x6c,
+	0x1c, 0x76, 0x31, 0x3c, 0x7d, 0xb1, 0x53, 0xc2, 0xfd, 0x29, 0x63, 0xfd, 0xec, 0x1e, 0x42, 0xbf,
+	0x26, 0xa1, 0x39, 0x7a, 0x8e, 0x23, 0xa1, 0xa2, 0x2e, 0x1e, 0x68, 0xb0, 0xb3, 0xda, 0x76, 0x72,
+	0x18, 0x81, 0xcd, 0x52, 0xd1, 0x73, 0xd2, 0x4b, 0xd0, 0x4e, 0x31, 0x7a, 0x26, 0x7a, 0xf8, 0x3f,
+	0x7a, 0xee, 0xfb, 0x8e, 0x85, 0x49, 0x8b, 0xcc, 0xcf, 0xde, 0xde, 0x0c, 0xbc, 0x77, 0x37, 0x03,
+	0xef, 0xef, 0x9b, 0x81, 0xf7, 0xeb, 0xed, 0x60, 0xef, 0xdd, 0xed, 0x60, 0xef, 0xaf, 0xdb, 0xc1,
+	0xde, 0x8f, 0x9f, 0x2d, 0x0a, 0x9d, 0x37, 0xc9, 0x24, 0x15, 0xe5, 0x14, 0x47, 0xfd, 0xcb, 0xd3,
+	0xe9, 0x9d, 0xc7, 0x78, 0x65, 0x83, 0xa9, 0x5e, 0xd7, 0xa0, 0x92, 0x2e, 0xbe, 0xc5, 0x5f, 0xfc,
+	0x3b, 0x00, 0x62, 0x4c, 0xeb, 0x99, 0x10, 0x06, 0x00, 0x00,
 }
 
 func (m *WithdrawalRequest) Marshal() (dAtA []byte, err error) {
@@ -328,6 +560,33 @@ func (m *WithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CancelledAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CancelledAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Fee != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ReleaseAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReleaseAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CancelSignature) > 0 {
+		i -= len(m.CancelSignature)
+		copy(dAtA[i:], m.CancelSignature)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CancelSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.CompletedAt != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CompletedAt))
 		i--
@@ -381,6 +640,58 @@ func (m *WithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BridgePauseState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgePauseState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgePauseState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DepositSyncState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,6 +725,67 @@ func (m *DepositSyncState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EthHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ParentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Number != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -434,6 +806,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BridgePause != nil {
+		{
+			size, err := m.BridgePause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EthHeaders) > 0 {
+		for iNdEx := len(m.EthHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.DepositSyncState != nil {
 		{
 			size, err := m.DepositSyncState.MarshalToSizedBuffer(dAtA[:i])
@@ -533,6 +931,45 @@ func (m *WithdrawalRequest) Size() (n int) {
 	if m.CompletedAt != 0 {
 		n += 1 + sovGenesis(uint64(m.CompletedAt))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovGenesis(uint64(m.ExpiresAt))
+	}
+	l = len(m.CancelSignature)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ReleaseAt != 0 {
+		n += 1 + sovGenesis(uint64(m.ReleaseAt))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovGenesis(uint64(m.Fee))
+	}
+	if m.CancelledAt != 0 {
+		n += 1 + sovGenesis(uint64(m.CancelledAt))
+	}
+	return n
+}
+
+func (m *BridgePauseState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovGenesis(uint64(m.UpdatedAt))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -551,17 +988,48 @@ func (m *DepositSyncState) Size() (n int) {
 	return n
 }
 
-func (m *GenesisState) Size() (n int) {
+func (m *EthHeader) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.ProcessedEthTxs) > 0 {
-		for _, s := range m.ProcessedEthTxs {
-			l = len(s)
+	if m.Number != 0 {
+		n += 1 + sovGenesis(uint64(m.Number))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ParentHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProcessedEthTxs) > 0 {
+		for _, s := range m.ProcessedEthTxs {
+			l = len(s)
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
@@ -578,6 +1046,16 @@ func (m *GenesisState) Size() (n int) {
 		l = m.DepositSyncState.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.EthHeaders) > 0 {
+		for _, e := range m.EthHeaders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgePause != nil {
+		l = m.BridgePause.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -835,61 +1313,83 @@ func (m *WithdrawalRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DepositSyncState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.CancelSignature = append(m.CancelSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.CancelSignature == nil {
+				m.CancelSignature = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAt", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ReleaseAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DepositSyncState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositSyncState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.LastProcessedIndex = 0
+			m.Fee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -899,16 +1399,16 @@ func (m *DepositSyncState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastProcessedIndex |= uint64(b&0x7F) << shift
+				m.Fee |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEthBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAt", wireType)
 			}
-			m.LastEthBlockHeight = 0
+			m.CancelledAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -918,7 +1418,7 @@ func (m *DepositSyncState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastEthBlockHeight |= uint64(b&0x7F) << shift
+				m.CancelledAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -944,7 +1444,7 @@ func (m *DepositSyncState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *BridgePauseState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -967,17 +1467,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgePauseState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgePauseState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -987,28 +1487,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedEthTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1036,13 +1523,32 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessedEthTxs = append(m.ProcessedEthTxs, string(dAtA[iNdEx:postIndex]))
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1052,31 +1558,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawalRequests = append(m.WithdrawalRequests, &WithdrawalRequest{})
-			if err := m.WithdrawalRequests[len(m.WithdrawalRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositSyncState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositSyncState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositSyncState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedIndex", wireType)
 			}
-			m.WithdrawalNonce = 0
+			m.LastProcessedIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1086,16 +1640,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawalNonce |= uint64(b&0x7F) << shift
+				m.LastProcessedIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositSyncState", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEthBlockHeight", wireType)
 			}
-			var msglen int
+			m.LastEthBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1105,12 +1659,423 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastEthBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentHash = append(m.ParentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentHash == nil {
+				m.ParentHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = append(m.ReceiptsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReceiptsRoot == nil {
+				m.ReceiptsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedEthTxs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessedEthTxs = append(m.ProcessedEthTxs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalRequests = append(m.WithdrawalRequests, &WithdrawalRequest{})
+			if err := m.WithdrawalRequests[len(m.WithdrawalRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalNonce", wireType)
+			}
+			m.WithdrawalNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositSyncState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			postIndex := iNdEx + msglen
@@ -1127,6 +2092,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthHeaders = append(m.EthHeaders, &EthHeader{})
+			if err := m.EthHeaders[len(m.EthHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgePause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BridgePause == nil {
+				m.BridgePause = &BridgePauseState{}
+			}
+			if err := m.BridgePause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
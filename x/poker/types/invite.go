@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Invite codes never appear on chain. The code, salted with the table
+// creator's address, seeds a secp256k1 key (InviteKey). The creator sends
+// only its public key in MsgCreateGame, and a player proves they know the
+// code by signing their address and the game ID with the key (SignInvite).
+// A signature admits only the player it names at the table it names, so
+// reading it from the mempool does not help anyone else sit.
+
+// InviteKey returns the key derived from a table's invite code
+func InviteKey(creator, code string) *secp256k1.PrivKey {
+	return secp256k1.GenPrivKeyFromSecret([]byte(creator + ":" + code))
+}
+
+// InvitePublicKey returns the hex public key of an invite code, as sent in
+// MsgCreateGame.InvitePublicKey
+func InvitePublicKey(creator, code string) string {
+	return hex.EncodeToString(InviteKey(creator, code).PubKey().Bytes())
+}
+
+// SignInvite returns the MsgJoinGame and MsgJoinWaitlist invite signature
+// that admits player to the table
+func SignInvite(gameId, creator, code, player string) ([]byte, error) {
+	return InviteKey(creator, code).Sign(inviteSignBytes(gameId, player))
+}
+
+// ValidateInvitePublicKey checks that an invite public key is a hex
+// compressed secp256k1 point
+func ValidateInvitePublicKey(publicKey string) error {
+	key, err := hex.DecodeString(publicKey)
+	if err != nil || len(key) != secp256k1.PubKeySize {
+		return fmt.Errorf("invite public key must be a hex compressed secp256k1 key (see InvitePublicKey)")
+	}
+	if _, err := crypto.DecompressPubkey(key); err != nil {
+		return fmt.Errorf("invalid invite public key: %w", err)
+	}
+	return nil
+}
+
+// VerifyInvite reports whether the signature proves the player knows the
+// table's invite code
+func (g Game) VerifyInvite(player string, signature []byte) bool {
+	if g.InvitePublicKey == "" || len(signature) == 0 {
+		return false
+	}
+	key, err := hex.DecodeString(g.InvitePublicKey)
+	if err != nil || len(key) != secp256k1.PubKeySize {
+		return false
+	}
+	pubKey := &secp256k1.PubKey{Key: key}
+	return pubKey.VerifySignature(inviteSignBytes(g.GameId, player), signature)
+}
+
+// inviteSignBytes returns the message an invite signature signs
+func inviteSignBytes(gameId, player string) []byte {
+	return []byte("pokerchain/invite:" + gameId + ":" + player)
+}
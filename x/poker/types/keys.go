@@ -13,6 +13,10 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// ConsensusVersion is the poker store's version. Bumping it requires a
+	// store migration registered in the module (see x/poker/migrations).
+	ConsensusVersion = 2
 )
 
 // ParamsKey is the prefix to retrieve all Params
@@ -38,3 +42,24 @@ var LastProcessedDepositIndexKey = collections.NewPrefix("last_processed_deposit
 
 // LastEthBlockHeightKey is the prefix for the last Ethereum block height used
 var LastEthBlockHeightKey = collections.NewPrefix("last_eth_block_height")
+
+// EthHeadersKey is the prefix to store verified source chain block headers by number
+var EthHeadersKey = collections.NewPrefix("eth_headers")
+
+// PendingEthHeadersKey is the prefix to store submitted headers awaiting a relayer quorum by (number, hash)
+var PendingEthHeadersKey = collections.NewPrefix("pending_eth_headers")
+
+// EthHeaderVotesKey is the prefix to store relayer submissions of pending headers by (number, hash, relayer)
+var EthHeaderVotesKey = collections.NewPrefix("eth_header_votes")
+
+// BridgePauseKey is the prefix for the bridge pause state
+var BridgePauseKey = collections.NewPrefix("bridge_pause")
+
+// WithdrawalOutflowsKey is the prefix to store recent withdrawal amounts by (created_at, nonce)
+var WithdrawalOutflowsKey = collections.NewPrefix("withdrawal_outflows")
+
+// AddressWithdrawalOutflowsKey is the prefix to store recent withdrawal amounts by (address, created_at, nonce)
+var AddressWithdrawalOutflowsKey = collections.NewPrefix("address_withdrawal_outflows")
+
+// WithdrawalQueueKey is the prefix to index queued large withdrawals by (release_at, nonce)
+var WithdrawalQueueKey = collections.NewPrefix("withdrawal_queue")
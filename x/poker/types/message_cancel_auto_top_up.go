@@ -0,0 +1,8 @@
+package types
+
+func NewMsgCancelAutoTopUp(player string, gameId string) *MsgCancelAutoTopUp {
+	return &MsgCancelAutoTopUp{
+		Player: player,
+		GameId: gameId,
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCancelWithdrawal{}
+
+func NewMsgCancelWithdrawal(creator string, nonce string) *MsgCancelWithdrawal {
+	return &MsgCancelWithdrawal{
+		Creator: creator,
+		Nonce:   nonce,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgCancelWithdrawal) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return validateWithdrawalNonce(msg.Nonce)
+}
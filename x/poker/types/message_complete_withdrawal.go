@@ -0,0 +1,53 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var _ sdk.Msg = &MsgCompleteWithdrawal{}
+
+func NewMsgCompleteWithdrawal(creator string, nonce string, ethBlockHeight uint64, txIndex uint64, logIndex uint64, receiptProof [][]byte) *MsgCompleteWithdrawal {
+	return &MsgCompleteWithdrawal{
+		Creator:        creator,
+		Nonce:          nonce,
+		EthBlockHeight: ethBlockHeight,
+		TxIndex:        txIndex,
+		LogIndex:       logIndex,
+		ReceiptProof:   receiptProof,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgCompleteWithdrawal) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := validateWithdrawalNonce(msg.Nonce); err != nil {
+		return err
+	}
+
+	// Validate receipt proof
+	if len(msg.ReceiptProof) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "receipt proof cannot be empty")
+	}
+
+	return nil
+}
+
+// validateWithdrawalNonce checks a withdrawal nonce is 0x followed by 64 hex characters
+func validateWithdrawalNonce(nonce string) error {
+	if !strings.HasPrefix(nonce, "0x") || len(nonce) != 66 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid nonce format: must be 0x followed by 64 hex characters")
+	}
+	if _, err := hexutil.Decode(nonce); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid nonce: must be valid hex")
+	}
+	return nil
+}
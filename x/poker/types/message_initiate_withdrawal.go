@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -24,6 +25,23 @@ func (msg *MsgInitiateWithdrawal) ValidateBasic() error {
 		return errors.Wrap(ErrInvalidAmount, "withdrawal amount must be greater than 0")
 	}
 
+	// IBC withdrawals go to a counterparty chain address instead of Base
+	if msg.IbcChannelId != "" {
+		if msg.BaseAddress != "" {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "set either a base address or an IBC channel, not both")
+		}
+		if err := host.ChannelIdentifierValidator(msg.IbcChannelId); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid IBC channel: %s", err)
+		}
+		if strings.TrimSpace(msg.IbcReceiver) == "" {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "IBC receiver cannot be empty")
+		}
+		return nil
+	}
+	if msg.IbcReceiver != "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "IBC receiver requires an IBC channel")
+	}
+
 	// Validate Base/Ethereum address format
 	if msg.BaseAddress == "" {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "base address cannot be empty")
@@ -0,0 +1,9 @@
+package types
+
+func NewMsgJoinWaitlist(player string, gameId string, inviteSignature []byte) *MsgJoinWaitlist {
+	return &MsgJoinWaitlist{
+		Player:          player,
+		GameId:          gameId,
+		InviteSignature: inviteSignature,
+	}
+}
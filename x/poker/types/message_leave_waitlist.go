@@ -0,0 +1,8 @@
+package types
+
+func NewMsgLeaveWaitlist(player string, gameId string) *MsgLeaveWaitlist {
+	return &MsgLeaveWaitlist{
+		Player: player,
+		GameId: gameId,
+	}
+}
@@ -5,13 +5,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func NewMsgMint(creator string, recipient string, amount uint64, ethTxHash string, nonce uint64) *MsgMint {
+func NewMsgMint(creator string, recipient string, amount uint64, ethTxHash string, nonce uint64, ethBlockHeight uint64, txIndex uint64, logIndex uint64, receiptProof [][]byte) *MsgMint {
 	return &MsgMint{
-		Creator:   creator,
-		Recipient: recipient,
-		Amount:    amount,
-		EthTxHash: ethTxHash,
-		Nonce:     nonce,
+		Creator:        creator,
+		Recipient:      recipient,
+		Amount:         amount,
+		EthTxHash:      ethTxHash,
+		Nonce:          nonce,
+		EthBlockHeight: ethBlockHeight,
+		TxIndex:        txIndex,
+		LogIndex:       logIndex,
+		ReceiptProof:   receiptProof,
 	}
 }
 
@@ -37,5 +41,10 @@ func (msg *MsgMint) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidSigner, "ethereum transaction hash cannot be empty")
 	}
 
+	// Validate receipt proof
+	if len(msg.ReceiptProof) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "receipt proof cannot be empty")
+	}
+
 	return nil
 }
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRefundWithdrawal{}
+
+func NewMsgRefundWithdrawal(creator string, nonce string, ethBlockHeight uint64, txIndex uint64, logIndex uint64, receiptProof [][]byte) *MsgRefundWithdrawal {
+	return &MsgRefundWithdrawal{
+		Creator:        creator,
+		Nonce:          nonce,
+		EthBlockHeight: ethBlockHeight,
+		TxIndex:        txIndex,
+		LogIndex:       logIndex,
+		ReceiptProof:   receiptProof,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgRefundWithdrawal) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := validateWithdrawalNonce(msg.Nonce); err != nil {
+		return err
+	}
+
+	// Validate receipt proof
+	if len(msg.ReceiptProof) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "receipt proof cannot be empty")
+	}
+
+	return nil
+}
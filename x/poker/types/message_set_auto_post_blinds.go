@@ -0,0 +1,9 @@
+package types
+
+func NewMsgSetAutoPostBlinds(player string, gameId string, enabled bool) *MsgSetAutoPostBlinds {
+	return &MsgSetAutoPostBlinds{
+		Player:  player,
+		GameId:  gameId,
+		Enabled: enabled,
+	}
+}
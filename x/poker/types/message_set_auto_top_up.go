@@ -0,0 +1,10 @@
+package types
+
+func NewMsgSetAutoTopUp(player string, gameId string, mode string, targetStack uint64) *MsgSetAutoTopUp {
+	return &MsgSetAutoTopUp{
+		Player:      player,
+		GameId:      gameId,
+		Mode:        mode,
+		TargetStack: targetStack,
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetBridgePaused{}
+
+func NewMsgSetBridgePaused(signer string, paused bool, reason string) *MsgSetBridgePaused {
+	return &MsgSetBridgePaused{
+		Signer: signer,
+		Paused: paused,
+		Reason: reason,
+	}
+}
+
+// ValidateBasic performs basic validation of the message
+func (msg *MsgSetBridgePaused) ValidateBasic() error {
+	// Validate signer address
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if len(msg.Reason) > 256 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot exceed 256 characters")
+	}
+
+	return nil
+}
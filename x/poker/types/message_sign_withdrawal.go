@@ -1,7 +1,6 @@
 package types
 
 import (
-	"encoding/hex"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -12,11 +11,13 @@ import (
 
 var _ sdk.Msg = &MsgSignWithdrawal{}
 
-func NewMsgSignWithdrawal(signer string, nonce string, validatorEthKeyHex string) *MsgSignWithdrawal {
+// NewMsgSignWithdrawal returns a message submitting a signature made off
+// chain by the validator's bridge signer
+func NewMsgSignWithdrawal(signer string, nonce string, signature []byte) *MsgSignWithdrawal {
 	return &MsgSignWithdrawal{
-		Signer:             signer,
-		Nonce:              nonce,
-		ValidatorEthKeyHex: validatorEthKeyHex,
+		Signer:    signer,
+		Nonce:     nonce,
+		Signature: signature,
 	}
 }
 
@@ -37,26 +38,8 @@ func (msg *MsgSignWithdrawal) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid nonce format: must be 0x followed by 64 hex characters")
 	}
 
-	// Validate Ethereum private key
-	if msg.ValidatorEthKeyHex == "" {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator Ethereum key cannot be empty")
-	}
-
-	// Remove 0x prefix if present for validation
-	keyHex := msg.ValidatorEthKeyHex
-	if strings.HasPrefix(keyHex, "0x") {
-		keyHex = keyHex[2:]
-	}
-
-	// Validate key length (should be 64 hex characters = 32 bytes)
-	if len(keyHex) != 64 {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
-			"invalid Ethereum private key length: expected 64 hex characters (optionally prefixed with 0x), got %d", len(keyHex))
-	}
-
-	// Validate it's valid hex
-	if _, err := hex.DecodeString(keyHex); err != nil {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid Ethereum private key: must be valid hex")
+	if len(msg.Signature) != 65 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid signature length: expected 65 bytes, got %d", len(msg.Signature))
 	}
 
 	return nil
@@ -0,0 +1,10 @@
+package types
+
+func NewMsgUpdateAllowlist(creator string, gameId string, add []string, remove []string) *MsgUpdateAllowlist {
+	return &MsgUpdateAllowlist{
+		Creator: creator,
+		GameId:  gameId,
+		Add:     add,
+		Remove:  remove,
+	}
+}
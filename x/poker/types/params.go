@@ -1,17 +1,338 @@
 package types
 
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// DefaultDepositConfirmations is the default number of tracked descendant
+	// headers required before a deposit can be proven. Relayers are expected to
+	// submit finalized headers, so no extra depth is required by default.
+	DefaultDepositConfirmations = uint64(0)
+
+	// DefaultHeaderRetention is the default number of recent headers kept in state
+	// (~1 day of Base blocks)
+	DefaultHeaderRetention = uint64(43200)
+
+	// DefaultDepositContractAddress is the CosmosBridge deposit contract on Base mainnet
+	DefaultDepositContractAddress = "0xcc391c8f1aFd6DB5D8b0e064BA81b1383b14FE5B"
+
+	// DefaultWithdrawalExpiry is the default time, in seconds, a withdrawal can
+	// be claimed on Base before it may be cancelled and refunded (7 days)
+	DefaultWithdrawalExpiry = uint64(7 * 24 * 60 * 60)
+
+	// DefaultWithdrawalWindow is the default rolling window, in seconds, the
+	// withdrawal limits apply to (1 day). The limits themselves default to
+	// unlimited and are set by governance.
+	DefaultWithdrawalWindow = uint64(24 * 60 * 60)
+
+	// DefaultLargeWithdrawalDelay is the default time, in seconds, large
+	// withdrawals are queued for (1 day)
+	DefaultLargeWithdrawalDelay = uint64(24 * 60 * 60)
+
+	// MaxWithdrawalFeeBps is the largest proportional withdrawal fee (100%)
+	MaxWithdrawalFeeBps = uint64(10_000)
+
+	// DefaultHandStartDelay is the default pause, in seconds, between the end
+	// of a hand and the module dealing the next one
+	DefaultHandStartDelay = uint64(5)
+
+	// DefaultTimeBank is the default time bank, in seconds, players start with
+	DefaultTimeBank = uint64(30)
+
+	// DefaultTimeBankIncrement is the default number of seconds added to a
+	// player's time bank every DefaultTimeBankReplenishHands hands
+	DefaultTimeBankIncrement = uint64(10)
+
+	// DefaultTimeBankReplenishHands is the default number of hands between
+	// time bank top-ups
+	DefaultTimeBankReplenishHands = uint64(10)
+
+	// DefaultTimeBankMax is the default cap, in seconds, on a time bank
+	DefaultTimeBankMax = uint64(60)
+
+	// DefaultSeatReservationWindow is the default time, in seconds, a seat
+	// offered to a waitlisted player is held for them
+	DefaultSeatReservationWindow = uint64(60)
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	headerRelayers []string,
+	depositConfirmations uint64,
+	headerRetention uint64,
+	depositContractAddress string,
+	withdrawalExpiry uint64,
+	bridgeGuardians []string,
+	withdrawalWindow uint64,
+	addressWithdrawalLimit uint64,
+	globalWithdrawalLimit uint64,
+	largeWithdrawalThreshold uint64,
+	largeWithdrawalDelay uint64,
+	withdrawalFeeFlat uint64,
+	withdrawalFeeBps uint64,
+	feeTreasury string,
+	ibcUSDCRoutes []IBCUSDCRoute,
+	allowedGameDenoms []string,
+	handStartDelay uint64,
+	timeBank uint64,
+	timeBankIncrement uint64,
+	timeBankReplenishHands uint64,
+	timeBankMax uint64,
+	seatReservationWindow uint64,
+	bridgeSigners []string,
+	headerRelayerQuorum uint64,
+) Params {
+	return Params{
+		HeaderRelayers:           headerRelayers,
+		DepositConfirmations:     depositConfirmations,
+		HeaderRetention:          headerRetention,
+		DepositContractAddress:   depositContractAddress,
+		WithdrawalExpiry:         withdrawalExpiry,
+		BridgeGuardians:          bridgeGuardians,
+		WithdrawalWindow:         withdrawalWindow,
+		AddressWithdrawalLimit:   addressWithdrawalLimit,
+		GlobalWithdrawalLimit:    globalWithdrawalLimit,
+		LargeWithdrawalThreshold: largeWithdrawalThreshold,
+		LargeWithdrawalDelay:     largeWithdrawalDelay,
+		WithdrawalFeeFlat:        withdrawalFeeFlat,
+		WithdrawalFeeBps:         withdrawalFeeBps,
+		FeeTreasury:              feeTreasury,
+		IbcUsdcRoutes:            ibcUSDCRoutes,
+		AllowedGameDenoms:        allowedGameDenoms,
+		HandStartDelay:           handStartDelay,
+		TimeBank:                 timeBank,
+		TimeBankIncrement:        timeBankIncrement,
+		TimeBankReplenishHands:   timeBankReplenishHands,
+		TimeBankMax:              timeBankMax,
+		SeatReservationWindow:    seatReservationWindow,
+		BridgeSigners:            bridgeSigners,
+		HeaderRelayerQuorum:      headerRelayerQuorum,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		nil,
+		DefaultDepositConfirmations,
+		DefaultHeaderRetention,
+		DefaultDepositContractAddress,
+		DefaultWithdrawalExpiry,
+		nil,
+		DefaultWithdrawalWindow,
+		0,
+		0,
+		0,
+		DefaultLargeWithdrawalDelay,
+		0,
+		0,
+		"",
+		nil,
+		[]string{TokenDenom},
+		DefaultHandStartDelay,
+		DefaultTimeBank,
+		DefaultTimeBankIncrement,
+		DefaultTimeBankReplenishHands,
+		DefaultTimeBankMax,
+		DefaultSeatReservationWindow,
+		nil,
+		0,
+	)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if err := validateAddressList("header relayer", p.HeaderRelayers); err != nil {
+		return err
+	}
+	if p.HeaderRelayerQuorum > uint64(len(p.HeaderRelayers)) {
+		return fmt.Errorf("header relayer quorum %d exceeds %d header relayers", p.HeaderRelayerQuorum, len(p.HeaderRelayers))
+	}
+	if err := validateAddressList("bridge guardian", p.BridgeGuardians); err != nil {
+		return err
+	}
+
+	seenSigners := make(map[common.Address]bool)
+	for _, signer := range p.BridgeSigners {
+		if !common.IsHexAddress(signer) {
+			return fmt.Errorf("invalid bridge signer address: %q", signer)
+		}
+		address := common.HexToAddress(signer)
+		if seenSigners[address] {
+			return fmt.Errorf("duplicate bridge signer %s", address.Hex())
+		}
+		seenSigners[address] = true
+	}
+
+	if p.HeaderRetention != 0 && p.HeaderRetention <= p.DepositConfirmations {
+		return fmt.Errorf("header retention %d must exceed deposit confirmations %d", p.HeaderRetention, p.DepositConfirmations)
+	}
+
+	// An empty contract address disables deposit proofs
+	if p.DepositContractAddress != "" && !common.IsHexAddress(p.DepositContractAddress) {
+		return fmt.Errorf("invalid deposit contract address: %q", p.DepositContractAddress)
+	}
+
+	// Durations are added to int64 unix seconds
+	if p.WithdrawalExpiry > math.MaxInt32 {
+		return fmt.Errorf("withdrawal expiry %d is too large", p.WithdrawalExpiry)
+	}
+	if p.WithdrawalWindow > math.MaxInt32 {
+		return fmt.Errorf("withdrawal window %d is too large", p.WithdrawalWindow)
+	}
+	if p.LargeWithdrawalDelay > math.MaxInt32 {
+		return fmt.Errorf("large withdrawal delay %d is too large", p.LargeWithdrawalDelay)
+	}
+	if p.HandStartDelay > math.MaxInt32 {
+		return fmt.Errorf("hand start delay %d is too large", p.HandStartDelay)
+	}
+	if p.TimeBankMax > math.MaxInt32 {
+		return fmt.Errorf("time bank max %d is too large", p.TimeBankMax)
+	}
+	if p.SeatReservationWindow > math.MaxInt32 {
+		return fmt.Errorf("seat reservation window %d is too large", p.SeatReservationWindow)
+	}
+	if p.TimeBank > p.TimeBankMax {
+		return fmt.Errorf("time bank %d exceeds time bank max %d", p.TimeBank, p.TimeBankMax)
+	}
+	if p.TimeBankIncrement > p.TimeBankMax {
+		return fmt.Errorf("time bank increment %d exceeds time bank max %d", p.TimeBankIncrement, p.TimeBankMax)
+	}
+	if p.LargeWithdrawalThreshold > 0 && p.LargeWithdrawalDelay == 0 {
+		return fmt.Errorf("large withdrawal delay must be set when a large withdrawal threshold is set")
+	}
+
+	if p.WithdrawalFeeBps > MaxWithdrawalFeeBps {
+		return fmt.Errorf("withdrawal fee %d bps exceeds %d", p.WithdrawalFeeBps, MaxWithdrawalFeeBps)
+	}
+	// An empty treasury sends fees to the community pool
+	if p.FeeTreasury != "" {
+		if _, err := sdk.AccAddressFromBech32(p.FeeTreasury); err != nil {
+			return fmt.Errorf("invalid fee treasury address %s: %w", p.FeeTreasury, err)
+		}
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, route := range p.IbcUsdcRoutes {
+		if err := host.ChannelIdentifierValidator(route.ChannelId); err != nil {
+			return fmt.Errorf("invalid IBC USDC route channel %q: %w", route.ChannelId, err)
+		}
+		if err := sdk.ValidateDenom(route.BaseDenom); err != nil {
+			return fmt.Errorf("invalid IBC USDC route denom %q: %w", route.BaseDenom, err)
+		}
+		if seenChannels[route.ChannelId] {
+			return fmt.Errorf("duplicate IBC USDC route for channel %s", route.ChannelId)
+		}
+		seenChannels[route.ChannelId] = true
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range p.AllowedGameDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid game denom %q: %w", denom, err)
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate game denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+// IsAllowedGameDenom reports whether tables may be created in the denom. An
+// empty list allows only TokenDenom.
+func (p Params) IsAllowedGameDenom(denom string) bool {
+	if len(p.AllowedGameDenoms) == 0 {
+		return denom == TokenDenom
+	}
+	for _, allowed := range p.AllowedGameDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// IBCUSDCRoute returns the allowlisted USDC route over a transfer channel
+func (p Params) IBCUSDCRoute(channelID string) (IBCUSDCRoute, bool) {
+	for _, route := range p.IbcUsdcRoutes {
+		if route.ChannelId == channelID {
+			return route, true
+		}
+	}
+	return IBCUSDCRoute{}, false
+}
+
+// IBCDenom returns the ibc/{hash} denom USDC has on this chain after
+// arriving over the route
+func (r IBCUSDCRoute) IBCDenom() string {
+	return r.Denom().IBCDenom()
+}
+
+// Denom returns the ICS-20 denom trace of USDC arriving over the route
+func (r IBCUSDCRoute) Denom() ibctransfertypes.Denom {
+	return ibctransfertypes.NewDenom(r.BaseDenom, ibctransfertypes.NewHop(ibctransfertypes.PortID, r.ChannelId))
+}
+
+// IsHeaderRelayer reports whether the address may submit source chain headers
+func (p Params) IsHeaderRelayer(address string) bool {
+	for _, relayer := range p.HeaderRelayers {
+		if relayer == address {
+			return true
+		}
+	}
+	return false
+}
+
+// HeaderQuorum returns the number of distinct header relayers that must submit
+// a header before it is tracked
+func (p Params) HeaderQuorum() uint64 {
+	if p.HeaderRelayerQuorum > 0 {
+		return p.HeaderRelayerQuorum
+	}
+	return uint64(len(p.HeaderRelayers))*2/3 + 1
+}
+
+// IsBridgeGuardian reports whether the address may pause the bridge
+func (p Params) IsBridgeGuardian(address string) bool {
+	for _, guardian := range p.BridgeGuardians {
+		if guardian == address {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBridgeSigner reports whether withdrawal signatures by the Ethereum
+// address are accepted
+func (p Params) IsBridgeSigner(address common.Address) bool {
+	for _, signer := range p.BridgeSigners {
+		if common.HexToAddress(signer) == address {
+			return true
+		}
+	}
+	return false
+}
 
+// validateAddressList checks a list of bech32 addresses has no duplicates
+func validateAddressList(name string, addresses []string) error {
+	seen := make(map[string]bool)
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid %s address %s: %w", name, address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate %s %s", name, address)
+		}
+		seen[address] = true
+	}
 	return nil
 }
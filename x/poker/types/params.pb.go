@@ -26,6 +26,71 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// Cosmos addresses allowed to submit Base/Ethereum block headers.
+	// Submitted headers are trusted as an attestation of the source chain, so
+	// relayers must only submit finalized headers. The module authority may
+	// always submit headers and re-anchor the header chain.
+	HeaderRelayers []string `protobuf:"bytes,1,rep,name=header_relayers,json=headerRelayers,proto3" json:"header_relayers,omitempty"`
+	// Number of tracked descendant headers required before a deposit in a block
+	// can be proven (0 = the header itself is enough).
+	DepositConfirmations uint64 `protobuf:"varint,2,opt,name=deposit_confirmations,json=depositConfirmations,proto3" json:"deposit_confirmations,omitempty"`
+	// Number of recent headers kept in state; older headers are pruned (0 = keep all).
+	HeaderRetention uint64 `protobuf:"varint,3,opt,name=header_retention,json=headerRetention,proto3" json:"header_retention,omitempty"`
+	// Address of the CosmosBridge deposit contract on the source chain (empty disables deposits).
+	DepositContractAddress string `protobuf:"bytes,4,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	// Seconds after initiation before a withdrawal not yet claimed on the source
+	// chain can be cancelled and refunded (0 = withdrawals never expire).
+	WithdrawalExpiry uint64 `protobuf:"varint,5,opt,name=withdrawal_expiry,json=withdrawalExpiry,proto3" json:"withdrawal_expiry,omitempty"`
+	// Addresses allowed to pause the bridge in an emergency. Only the module
+	// authority can unpause it.
+	BridgeGuardians []string `protobuf:"bytes,6,rep,name=bridge_guardians,json=bridgeGuardians,proto3" json:"bridge_guardians,omitempty"`
+	// Length in seconds of the rolling window the withdrawal limits apply to
+	// (0 disables the limits).
+	WithdrawalWindow uint64 `protobuf:"varint,7,opt,name=withdrawal_window,json=withdrawalWindow,proto3" json:"withdrawal_window,omitempty"`
+	// Maximum USDC (microunits) a single address may withdraw per window (0 = unlimited).
+	AddressWithdrawalLimit uint64 `protobuf:"varint,8,opt,name=address_withdrawal_limit,json=addressWithdrawalLimit,proto3" json:"address_withdrawal_limit,omitempty"`
+	// Maximum USDC (microunits) all addresses together may withdraw per window (0 = unlimited).
+	GlobalWithdrawalLimit uint64 `protobuf:"varint,9,opt,name=global_withdrawal_limit,json=globalWithdrawalLimit,proto3" json:"global_withdrawal_limit,omitempty"`
+	// Withdrawals of at least this amount are queued for large_withdrawal_delay
+	// before they can be signed (0 = no withdrawal is delayed).
+	LargeWithdrawalThreshold uint64 `protobuf:"varint,10,opt,name=large_withdrawal_threshold,json=largeWithdrawalThreshold,proto3" json:"large_withdrawal_threshold,omitempty"`
+	// Seconds a large withdrawal waits in the queue.
+	LargeWithdrawalDelay uint64 `protobuf:"varint,11,opt,name=large_withdrawal_delay,json=largeWithdrawalDelay,proto3" json:"large_withdrawal_delay,omitempty"`
+	// Flat withdrawal fee in USDC microunits.
+	WithdrawalFeeFlat uint64 `protobuf:"varint,12,opt,name=withdrawal_fee_flat,json=withdrawalFeeFlat,proto3" json:"withdrawal_fee_flat,omitempty"`
+	// Proportional withdrawal fee in basis points of the withdrawn amount (max 10000).
+	WithdrawalFeeBps uint64 `protobuf:"varint,13,opt,name=withdrawal_fee_bps,json=withdrawalFeeBps,proto3" json:"withdrawal_fee_bps,omitempty"`
+	// Account receiving withdrawal fees (empty = community pool).
+	FeeTreasury string `protobuf:"bytes,14,opt,name=fee_treasury,json=feeTreasury,proto3" json:"fee_treasury,omitempty"`
+	// IBC channels USDC is accepted from. Incoming ICS-20 USDC on these routes
+	// is escrowed and converted 1:1 into usdc chips.
+	IbcUsdcRoutes []IBCUSDCRoute `protobuf:"bytes,15,rep,name=ibc_usdc_routes,json=ibcUsdcRoutes,proto3" json:"ibc_usdc_routes"`
+	// Denominations tables may be created in (e.g. usdc, b52 or an ibc/ denom).
+	// An empty list allows only usdc.
+	AllowedGameDenoms []string `protobuf:"bytes,16,rep,name=allowed_game_denoms,json=allowedGameDenoms,proto3" json:"allowed_game_denoms,omitempty"`
+	// Seconds a table pauses after a hand ends before the module starts the
+	// next one in EndBlock (0 = start it in the same block).
+	HandStartDelay uint64 `protobuf:"varint,17,opt,name=hand_start_delay,json=handStartDelay,proto3" json:"hand_start_delay,omitempty"`
+	// Seconds of time bank each player starts with at a table. The time bank
+	// is drawn down when a player takes longer than the table's action timeout.
+	TimeBank uint64 `protobuf:"varint,18,opt,name=time_bank,json=timeBank,proto3" json:"time_bank,omitempty"`
+	// Seconds added to a player's time bank every time_bank_replenish_hands hands.
+	TimeBankIncrement uint64 `protobuf:"varint,19,opt,name=time_bank_increment,json=timeBankIncrement,proto3" json:"time_bank_increment,omitempty"`
+	// Hands a player must be dealt between time bank top-ups (0 = never replenish).
+	TimeBankReplenishHands uint64 `protobuf:"varint,20,opt,name=time_bank_replenish_hands,json=timeBankReplenishHands,proto3" json:"time_bank_replenish_hands,omitempty"`
+	// Largest time bank a player can build up, in seconds.
+	TimeBankMax uint64 `protobuf:"varint,21,opt,name=time_bank_max,json=timeBankMax,proto3" json:"time_bank_max,omitempty"`
+	// Seconds a seat offered to the head of a table's waitlist is held for
+	// them before it is offered to the next player.
+	SeatReservationWindow uint64 `protobuf:"varint,22,opt,name=seat_reservation_window,json=seatReservationWindow,proto3" json:"seat_reservation_window,omitempty"`
+	// Ethereum addresses of the validator bridge keys the Base CosmosBridge
+	// contract trusts. Only withdrawal and cancellation signatures from these
+	// keys are stored; an empty list accepts none.
+	BridgeSigners []string `protobuf:"bytes,23,rep,name=bridge_signers,json=bridgeSigners,proto3" json:"bridge_signers,omitempty"`
+	// Number of distinct header relayers that must submit the same header
+	// before it is tracked (0 = more than two thirds of header_relayers).
+	// Headers submitted by the module authority are tracked immediately.
+	HeaderRelayerQuorum uint64 `protobuf:"varint,24,opt,name=header_relayer_quorum,json=headerRelayerQuorum,proto3" json:"header_relayer_quorum,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,25 +126,288 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetHeaderRelayers() []string {
+	if m != nil {
+		return m.HeaderRelayers
+	}
+	return nil
+}
+
+func (m *Params) GetDepositConfirmations() uint64 {
+	if m != nil {
+		return m.DepositConfirmations
+	}
+	return 0
+}
+
+func (m *Params) GetHeaderRetention() uint64 {
+	if m != nil {
+		return m.HeaderRetention
+	}
+	return 0
+}
+
+func (m *Params) GetDepositContractAddress() string {
+	if m != nil {
+		return m.DepositContractAddress
+	}
+	return ""
+}
+
+func (m *Params) GetWithdrawalExpiry() uint64 {
+	if m != nil {
+		return m.WithdrawalExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeGuardians() []string {
+	if m != nil {
+		return m.BridgeGuardians
+	}
+	return nil
+}
+
+func (m *Params) GetWithdrawalWindow() uint64 {
+	if m != nil {
+		return m.WithdrawalWindow
+	}
+	return 0
+}
+
+func (m *Params) GetAddressWithdrawalLimit() uint64 {
+	if m != nil {
+		return m.AddressWithdrawalLimit
+	}
+	return 0
+}
+
+func (m *Params) GetGlobalWithdrawalLimit() uint64 {
+	if m != nil {
+		return m.GlobalWithdrawalLimit
+	}
+	return 0
+}
+
+func (m *Params) GetLargeWithdrawalThreshold() uint64 {
+	if m != nil {
+		return m.LargeWithdrawalThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetLargeWithdrawalDelay() uint64 {
+	if m != nil {
+		return m.LargeWithdrawalDelay
+	}
+	return 0
+}
+
+func (m *Params) GetWithdrawalFeeFlat() uint64 {
+	if m != nil {
+		return m.WithdrawalFeeFlat
+	}
+	return 0
+}
+
+func (m *Params) GetWithdrawalFeeBps() uint64 {
+	if m != nil {
+		return m.WithdrawalFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetFeeTreasury() string {
+	if m != nil {
+		return m.FeeTreasury
+	}
+	return ""
+}
+
+func (m *Params) GetIbcUsdcRoutes() []IBCUSDCRoute {
+	if m != nil {
+		return m.IbcUsdcRoutes
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedGameDenoms() []string {
+	if m != nil {
+		return m.AllowedGameDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetHandStartDelay() uint64 {
+	if m != nil {
+		return m.HandStartDelay
+	}
+	return 0
+}
+
+func (m *Params) GetTimeBank() uint64 {
+	if m != nil {
+		return m.TimeBank
+	}
+	return 0
+}
+
+func (m *Params) GetTimeBankIncrement() uint64 {
+	if m != nil {
+		return m.TimeBankIncrement
+	}
+	return 0
+}
+
+func (m *Params) GetTimeBankReplenishHands() uint64 {
+	if m != nil {
+		return m.TimeBankReplenishHands
+	}
+	return 0
+}
+
+func (m *Params) GetTimeBankMax() uint64 {
+	if m != nil {
+		return m.TimeBankMax
+	}
+	return 0
+}
+
+func (m *Params) GetSeatReservationWindow() uint64 {
+	if m != nil {
+		return m.SeatReservationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeSigners() []string {
+	if m != nil {
+		return m.BridgeSigners
+	}
+	return nil
+}
+
+func (m *Params) GetHeaderRelayerQuorum() uint64 {
+	if m != nil {
+		return m.HeaderRelayerQuorum
+	}
+	return 0
+}
+
+// IBCUSDCRoute allowlists USDC arriving over an IBC transfer channel.
+type IBCUSDCRoute struct {
+	// Transfer channel on this chain connected to the USDC issuing chain (e.g. channel-0 to Noble).
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Denom of USDC on the issuing chain (e.g. uusdc).
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+}
+
+func (m *IBCUSDCRoute) Reset()         { *m = IBCUSDCRoute{} }
+func (m *IBCUSDCRoute) String() string { return proto.CompactTextString(m) }
+func (*IBCUSDCRoute) ProtoMessage()    {}
+func (*IBCUSDCRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63c308c690bdc92e, []int{1}
+}
+func (m *IBCUSDCRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCUSDCRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCUSDCRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCUSDCRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCUSDCRoute.Merge(m, src)
+}
+func (m *IBCUSDCRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCUSDCRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCUSDCRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCUSDCRoute proto.InternalMessageInfo
+
+func (m *IBCUSDCRoute) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IBCUSDCRoute) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "pokerchain.poker.v1.Params")
+	proto.RegisterType((*IBCUSDCRoute)(nil), "pokerchain.poker.v1.IBCUSDCRoute")
 }
 
 func init() { proto.RegisterFile("pokerchain/poker/v1/params.proto", fileDescriptor_63c308c690bdc92e) }
 
 var fileDescriptor_63c308c690bdc92e = []byte{
-	// 176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0xc8, 0xcf, 0x4e,
-	0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0x33, 0xf5, 0xcb, 0x0c, 0xf5, 0x0b, 0x12, 0x8b,
-	0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0x11, 0x2a, 0xf4, 0xc0, 0x4c,
-	0xbd, 0x32, 0x43, 0x29, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x51, 0x27, 0x25,
-	0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x10, 0x51, 0x25, 0x1d, 0x2e, 0xb6, 0x00,
-	0xb0, 0x69, 0x56, 0x4a, 0x2f, 0x16, 0xc8, 0x33, 0x76, 0x3d, 0xdf, 0xa0, 0x25, 0x89, 0x64, 0x65,
-	0x05, 0xd4, 0x52, 0x88, 0x1a, 0x27, 0xd7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c,
-	0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63,
-	0x88, 0xd2, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xca, 0xc9,
-	0x4f, 0xce, 0x36, 0x35, 0xd2, 0xc7, 0x62, 0x4e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x6e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7c, 0xd8, 0xdd, 0x38, 0xdd, 0x00, 0x00, 0x00,
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xdd, 0x6e, 0x1c, 0x35,
+	0x14, 0xce, 0x90, 0x10, 0x3a, 0xce, 0xcf, 0x26, 0xce, 0x4f, 0xdd, 0x20, 0xb6, 0xdb, 0x48, 0x88,
+	0x85, 0xa2, 0x5d, 0x35, 0x05, 0x04, 0x15, 0x37, 0x6c, 0xd2, 0x94, 0x48, 0x20, 0x60, 0xd2, 0xaa,
+	0x12, 0x37, 0x96, 0x67, 0x7c, 0x32, 0x63, 0x65, 0xc6, 0x1e, 0x6c, 0x6f, 0x36, 0xfb, 0x0a, 0x5c,
+	0xf1, 0x08, 0x3c, 0x02, 0x2f, 0x81, 0xd4, 0xcb, 0x5e, 0x72, 0x85, 0x50, 0x72, 0x01, 0x8f, 0x81,
+	0xec, 0x99, 0xc9, 0x4e, 0xb7, 0xb9, 0x19, 0x59, 0xdf, 0xcf, 0x39, 0x9e, 0x73, 0x8e, 0x0f, 0xea,
+	0x95, 0xea, 0x1c, 0x74, 0x92, 0x31, 0x21, 0x87, 0xfe, 0x38, 0xbc, 0x78, 0x34, 0x2c, 0x99, 0x66,
+	0x85, 0x19, 0x94, 0x5a, 0x59, 0x85, 0xb7, 0x66, 0x8a, 0x81, 0x3f, 0x0e, 0x2e, 0x1e, 0xed, 0x6d,
+	0xb2, 0x42, 0x48, 0x35, 0xf4, 0xdf, 0x4a, 0xb7, 0xb7, 0x9d, 0xaa, 0x54, 0xf9, 0xe3, 0xd0, 0x9d,
+	0x2a, 0x74, 0xff, 0xcf, 0x10, 0x2d, 0xff, 0xe8, 0xc3, 0xe1, 0x8f, 0x50, 0x27, 0x03, 0xc6, 0x41,
+	0x53, 0x0d, 0x39, 0x9b, 0x82, 0x36, 0x24, 0xe8, 0x2d, 0xf6, 0xc3, 0x68, 0xbd, 0x82, 0xa3, 0x1a,
+	0xc5, 0x8f, 0xd1, 0x0e, 0x87, 0x52, 0x19, 0x61, 0x69, 0xa2, 0xe4, 0x99, 0xd0, 0x05, 0xb3, 0x42,
+	0x49, 0x43, 0xde, 0xe9, 0x05, 0xfd, 0xa5, 0x68, 0xbb, 0x26, 0x0f, 0xdb, 0x1c, 0xfe, 0x18, 0x6d,
+	0xdc, 0x44, 0xb7, 0x20, 0x1d, 0x48, 0x16, 0xbd, 0xbe, 0xd3, 0x84, 0xaf, 0x61, 0xfc, 0x25, 0x22,
+	0xad, 0xf8, 0x56, 0xb3, 0xc4, 0x52, 0xc6, 0xb9, 0x06, 0x63, 0xc8, 0x52, 0x2f, 0xe8, 0x87, 0xd1,
+	0xee, 0x2c, 0x85, 0xa7, 0xbf, 0xa9, 0x58, 0xfc, 0x10, 0x6d, 0x4e, 0x84, 0xcd, 0xb8, 0x66, 0x13,
+	0x96, 0x53, 0xb8, 0x2c, 0x85, 0x9e, 0x92, 0x77, 0x7d, 0x96, 0x8d, 0x19, 0xf1, 0xd4, 0xe3, 0xee,
+	0x46, 0xb1, 0x16, 0x3c, 0x05, 0x9a, 0x8e, 0x99, 0xe6, 0x82, 0x49, 0x43, 0x96, 0xfd, 0x0f, 0x77,
+	0x2a, 0xfc, 0x59, 0x03, 0xcf, 0xc5, 0x9d, 0x08, 0xc9, 0xd5, 0x84, 0xbc, 0x37, 0x1f, 0xf7, 0xa5,
+	0xc7, 0xdd, 0xf5, 0xeb, 0xdb, 0xd2, 0x96, 0x29, 0x17, 0x85, 0xb0, 0xe4, 0x8e, 0xf7, 0xec, 0xd6,
+	0xfc, 0xcb, 0x1b, 0xfa, 0x3b, 0xc7, 0xe2, 0x2f, 0xd0, 0xdd, 0x34, 0x57, 0xb1, 0x4f, 0x31, 0x67,
+	0x0c, 0xbd, 0x71, 0xa7, 0xa2, 0xe7, 0x7d, 0x5f, 0xa3, 0xbd, 0x9c, 0xe9, 0x14, 0xda, 0x36, 0x9b,
+	0x69, 0x30, 0x99, 0xca, 0x39, 0x41, 0xde, 0x4a, 0xbc, 0x62, 0xe6, 0x7c, 0xde, 0xf0, 0xf8, 0x33,
+	0xb4, 0xfb, 0x96, 0x9b, 0xbb, 0x5e, 0x93, 0x95, 0xaa, 0x9f, 0x73, 0xce, 0x23, 0xc7, 0xe1, 0x01,
+	0xda, 0x6a, 0xe9, 0xcf, 0x00, 0xe8, 0x59, 0xce, 0x2c, 0x59, 0xf5, 0x96, 0x56, 0xb5, 0x8e, 0x01,
+	0x8e, 0x73, 0x66, 0xf1, 0xa7, 0x08, 0xcf, 0xe9, 0xe3, 0xd2, 0x90, 0xb5, 0xf9, 0x1a, 0x1e, 0x03,
+	0x8c, 0x4a, 0x83, 0x1f, 0xa0, 0x55, 0x27, 0xb1, 0x1a, 0x98, 0x19, 0xeb, 0x29, 0x59, 0xf7, 0x6d,
+	0x5f, 0x39, 0x03, 0x78, 0x5e, 0x43, 0xf8, 0x07, 0xd4, 0x11, 0x71, 0x42, 0xc7, 0x86, 0x27, 0x54,
+	0xab, 0xb1, 0x05, 0x43, 0x3a, 0xbd, 0xc5, 0xfe, 0xca, 0xc1, 0x83, 0xc1, 0x2d, 0x2f, 0x62, 0x70,
+	0x32, 0x3a, 0x7c, 0x71, 0x7a, 0x74, 0x18, 0x39, 0xe5, 0x68, 0xe9, 0xd5, 0xdf, 0xf7, 0x17, 0xa2,
+	0x35, 0x11, 0x27, 0x2f, 0x0c, 0x4f, 0x3c, 0x66, 0xdc, 0x1f, 0xb1, 0x3c, 0x57, 0x13, 0xe0, 0x34,
+	0x65, 0x05, 0x50, 0x0e, 0x52, 0x15, 0x86, 0x6c, 0xf8, 0x91, 0xd8, 0xac, 0xa9, 0x67, 0xac, 0x80,
+	0x23, 0x4f, 0xe0, 0x3e, 0xda, 0xc8, 0x98, 0xe4, 0xd4, 0x58, 0xa6, 0x6d, 0x5d, 0xb1, 0x4d, 0xff,
+	0x3f, 0xeb, 0x0e, 0x3f, 0x75, 0x70, 0x55, 0xab, 0xf7, 0x51, 0x68, 0x45, 0x01, 0x34, 0x66, 0xf2,
+	0x9c, 0x60, 0x2f, 0xb9, 0xe3, 0x80, 0x11, 0x93, 0xe7, 0x2e, 0xed, 0x0d, 0x49, 0x85, 0x4c, 0x34,
+	0x14, 0x20, 0x2d, 0xd9, 0xaa, 0x0a, 0xd9, 0xc8, 0x4e, 0x1a, 0x02, 0x7f, 0x85, 0xee, 0xcd, 0xf4,
+	0x1a, 0xca, 0x1c, 0xa4, 0x30, 0x19, 0x75, 0x29, 0x0d, 0xd9, 0xae, 0xe6, 0xab, 0x71, 0x45, 0x0d,
+	0xfd, 0xad, 0x63, 0xf1, 0x3e, 0x5a, 0x9b, 0x59, 0x0b, 0x76, 0x49, 0x76, 0xbc, 0x7c, 0xa5, 0x91,
+	0x7f, 0xcf, 0x2e, 0xdd, 0x0c, 0x1a, 0x60, 0x96, 0x6a, 0x30, 0xa0, 0x2f, 0xfc, 0xe3, 0x6d, 0x06,
+	0x7e, 0xb7, 0x9a, 0x41, 0x47, 0x47, 0x33, 0xb6, 0x9e, 0xfa, 0x0f, 0xd1, 0x7a, 0xfd, 0x9a, 0x8c,
+	0x48, 0xa5, 0x5b, 0x1e, 0x77, 0x7d, 0xe1, 0xd6, 0x2a, 0xf4, 0xb4, 0x02, 0xf1, 0x01, 0xda, 0x79,
+	0x73, 0xc9, 0xd0, 0x5f, 0xc6, 0x4a, 0x8f, 0x0b, 0x42, 0x7c, 0xf0, 0xad, 0x37, 0x56, 0xcd, 0x4f,
+	0x9e, 0x7a, 0xb2, 0xff, 0xdf, 0xef, 0xf7, 0x83, 0x5f, 0xff, 0xfd, 0xe3, 0x93, 0x7b, 0xad, 0x65,
+	0x78, 0x59, 0xaf, 0xc3, 0x6a, 0x79, 0xed, 0x47, 0x68, 0xb5, 0xdd, 0x61, 0xfc, 0x01, 0x42, 0x49,
+	0xc6, 0xa4, 0x84, 0x9c, 0x0a, 0x4e, 0x02, 0x3f, 0x3e, 0x61, 0x8d, 0x9c, 0x70, 0x47, 0xc7, 0xcc,
+	0xd4, 0x3d, 0xf6, 0x7b, 0x2b, 0x8c, 0x42, 0x87, 0xf8, 0xde, 0x3e, 0x59, 0x72, 0x19, 0x47, 0x4f,
+	0x5f, 0x5d, 0x75, 0x83, 0xd7, 0x57, 0xdd, 0xe0, 0x9f, 0xab, 0x6e, 0xf0, 0xdb, 0x75, 0x77, 0xe1,
+	0xf5, 0x75, 0x77, 0xe1, 0xaf, 0xeb, 0xee, 0xc2, 0xcf, 0x0f, 0x53, 0x61, 0xb3, 0x71, 0x3c, 0x48,
+	0x54, 0x31, 0x8c, 0x73, 0x95, 0x9c, 0x7f, 0x7e, 0x30, 0xbc, 0xe5, 0x6e, 0x76, 0x5a, 0x82, 0x89,
+	0x97, 0xfd, 0xa6, 0x7d, 0xfc, 0xff, 0x00, 0x36, 0x42, 0x76, 0xb1, 0xcb, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -101,6 +429,130 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.HeaderRelayers) != len(that1.HeaderRelayers) {
+		return false
+	}
+	for i := range this.HeaderRelayers {
+		if this.HeaderRelayers[i] != that1.HeaderRelayers[i] {
+			return false
+		}
+	}
+	if this.DepositConfirmations != that1.DepositConfirmations {
+		return false
+	}
+	if this.HeaderRetention != that1.HeaderRetention {
+		return false
+	}
+	if this.DepositContractAddress != that1.DepositContractAddress {
+		return false
+	}
+	if this.WithdrawalExpiry != that1.WithdrawalExpiry {
+		return false
+	}
+	if len(this.BridgeGuardians) != len(that1.BridgeGuardians) {
+		return false
+	}
+	for i := range this.BridgeGuardians {
+		if this.BridgeGuardians[i] != that1.BridgeGuardians[i] {
+			return false
+		}
+	}
+	if this.WithdrawalWindow != that1.WithdrawalWindow {
+		return false
+	}
+	if this.AddressWithdrawalLimit != that1.AddressWithdrawalLimit {
+		return false
+	}
+	if this.GlobalWithdrawalLimit != that1.GlobalWithdrawalLimit {
+		return false
+	}
+	if this.LargeWithdrawalThreshold != that1.LargeWithdrawalThreshold {
+		return false
+	}
+	if this.LargeWithdrawalDelay != that1.LargeWithdrawalDelay {
+		return false
+	}
+	if this.WithdrawalFeeFlat != that1.WithdrawalFeeFlat {
+		return false
+	}
+	if this.WithdrawalFeeBps != that1.WithdrawalFeeBps {
+		return false
+	}
+	if this.FeeTreasury != that1.FeeTreasury {
+		return false
+	}
+	if len(this.IbcUsdcRoutes) != len(that1.IbcUsdcRoutes) {
+		return false
+	}
+	for i := range this.IbcUsdcRoutes {
+		if !this.IbcUsdcRoutes[i].Equal(&that1.IbcUsdcRoutes[i]) {
+			return false
+		}
+	}
+	if len(this.AllowedGameDenoms) != len(that1.AllowedGameDenoms) {
+		return false
+	}
+	for i := range this.AllowedGameDenoms {
+		if this.AllowedGameDenoms[i] != that1.AllowedGameDenoms[i] {
+			return false
+		}
+	}
+	if this.HandStartDelay != that1.HandStartDelay {
+		return false
+	}
+	if this.TimeBank != that1.TimeBank {
+		return false
+	}
+	if this.TimeBankIncrement != that1.TimeBankIncrement {
+		return false
+	}
+	if this.TimeBankReplenishHands != that1.TimeBankReplenishHands {
+		return false
+	}
+	if this.TimeBankMax != that1.TimeBankMax {
+		return false
+	}
+	if this.SeatReservationWindow != that1.SeatReservationWindow {
+		return false
+	}
+	if len(this.BridgeSigners) != len(that1.BridgeSigners) {
+		return false
+	}
+	for i := range this.BridgeSigners {
+		if this.BridgeSigners[i] != that1.BridgeSigners[i] {
+			return false
+		}
+	}
+	if this.HeaderRelayerQuorum != that1.HeaderRelayerQuorum {
+		return false
+	}
+	return true
+}
+func (this *IBCUSDCRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCUSDCRoute)
+	if !ok {
+		that2, ok := that.(IBCUSDCRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +575,210 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HeaderRelayerQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HeaderRelayerQuorum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.BridgeSigners) > 0 {
+		for iNdEx := len(m.BridgeSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BridgeSigners[iNdEx])
+			copy(dAtA[i:], m.BridgeSigners[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BridgeSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.SeatReservationWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SeatReservationWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.TimeBankMax != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeBankMax))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.TimeBankReplenishHands != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeBankReplenishHands))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.TimeBankIncrement != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeBankIncrement))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.TimeBank != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeBank))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.HandStartDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HandStartDelay))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.AllowedGameDenoms) > 0 {
+		for iNdEx := len(m.AllowedGameDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedGameDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedGameDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedGameDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.IbcUsdcRoutes) > 0 {
+		for iNdEx := len(m.IbcUsdcRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcUsdcRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.FeeTreasury) > 0 {
+		i -= len(m.FeeTreasury)
+		copy(dAtA[i:], m.FeeTreasury)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeTreasury)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.WithdrawalFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawalFeeBps))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.WithdrawalFeeFlat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawalFeeFlat))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LargeWithdrawalDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LargeWithdrawalDelay))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LargeWithdrawalThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LargeWithdrawalThreshold))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GlobalWithdrawalLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GlobalWithdrawalLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AddressWithdrawalLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AddressWithdrawalLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.WithdrawalWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawalWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BridgeGuardians) > 0 {
+		for iNdEx := len(m.BridgeGuardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BridgeGuardians[iNdEx])
+			copy(dAtA[i:], m.BridgeGuardians[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BridgeGuardians[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.WithdrawalExpiry != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawalExpiry))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DepositContractAddress) > 0 {
+		i -= len(m.DepositContractAddress)
+		copy(dAtA[i:], m.DepositContractAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DepositContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HeaderRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HeaderRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DepositConfirmations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositConfirmations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HeaderRelayers) > 0 {
+		for iNdEx := len(m.HeaderRelayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HeaderRelayers[iNdEx])
+			copy(dAtA[i:], m.HeaderRelayers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.HeaderRelayers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCUSDCRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCUSDCRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCUSDCRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -143,20 +799,126 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+	if len(m.HeaderRelayers) > 0 {
+		for _, s := range m.HeaderRelayers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.DepositConfirmations != 0 {
+		n += 1 + sovParams(uint64(m.DepositConfirmations))
+	}
+	if m.HeaderRetention != 0 {
+		n += 1 + sovParams(uint64(m.HeaderRetention))
+	}
+	l = len(m.DepositContractAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.WithdrawalExpiry != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawalExpiry))
+	}
+	if len(m.BridgeGuardians) > 0 {
+		for _, s := range m.BridgeGuardians {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.WithdrawalWindow != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawalWindow))
+	}
+	if m.AddressWithdrawalLimit != 0 {
+		n += 1 + sovParams(uint64(m.AddressWithdrawalLimit))
+	}
+	if m.GlobalWithdrawalLimit != 0 {
+		n += 1 + sovParams(uint64(m.GlobalWithdrawalLimit))
+	}
+	if m.LargeWithdrawalThreshold != 0 {
+		n += 1 + sovParams(uint64(m.LargeWithdrawalThreshold))
+	}
+	if m.LargeWithdrawalDelay != 0 {
+		n += 1 + sovParams(uint64(m.LargeWithdrawalDelay))
+	}
+	if m.WithdrawalFeeFlat != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawalFeeFlat))
+	}
+	if m.WithdrawalFeeBps != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawalFeeBps))
+	}
+	l = len(m.FeeTreasury)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.IbcUsdcRoutes) > 0 {
+		for _, e := range m.IbcUsdcRoutes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedGameDenoms) > 0 {
+		for _, s := range m.AllowedGameDenoms {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.HandStartDelay != 0 {
+		n += 2 + sovParams(uint64(m.HandStartDelay))
+	}
+	if m.TimeBank != 0 {
+		n += 2 + sovParams(uint64(m.TimeBank))
+	}
+	if m.TimeBankIncrement != 0 {
+		n += 2 + sovParams(uint64(m.TimeBankIncrement))
+	}
+	if m.TimeBankReplenishHands != 0 {
+		n += 2 + sovParams(uint64(m.TimeBankReplenishHands))
+	}
+	if m.TimeBankMax != 0 {
+		n += 2 + sovParams(uint64(m.TimeBankMax))
+	}
+	if m.SeatReservationWindow != 0 {
+		n += 2 + sovParams(uint64(m.SeatReservationWindow))
+	}
+	if len(m.BridgeSigners) > 0 {
+		for _, s := range m.BridgeSigners {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.HeaderRelayerQuorum != 0 {
+		n += 2 + sovParams(uint64(m.HeaderRelayerQuorum))
+	}
+	return n
+}
+
+func (m *IBCUSDCRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -181,6 +943,669 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRelayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderRelayers = append(m.HeaderRelayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositConfirmations", wireType)
+			}
+			m.DepositConfirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositConfirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRetention", wireType)
+			}
+			m.HeaderRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalExpiry", wireType)
+			}
+			m.WithdrawalExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeGuardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeGuardians = append(m.BridgeGuardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalWindow", wireType)
+			}
+			m.WithdrawalWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressWithdrawalLimit", wireType)
+			}
+			m.AddressWithdrawalLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressWithdrawalLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalWithdrawalLimit", wireType)
+			}
+			m.GlobalWithdrawalLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalWithdrawalLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeWithdrawalThreshold", wireType)
+			}
+			m.LargeWithdrawalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LargeWithdrawalThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeWithdrawalDelay", wireType)
+			}
+			m.LargeWithdrawalDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LargeWithdrawalDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalFeeFlat", wireType)
+			}
+			m.WithdrawalFeeFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalFeeFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalFeeBps", wireType)
+			}
+			m.WithdrawalFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalFeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTreasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTreasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcUsdcRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcUsdcRoutes = append(m.IbcUsdcRoutes, IBCUSDCRoute{})
+			if err := m.IbcUsdcRoutes[len(m.IbcUsdcRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedGameDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedGameDenoms = append(m.AllowedGameDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandStartDelay", wireType)
+			}
+			m.HandStartDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HandStartDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBank", wireType)
+			}
+			m.TimeBank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeBank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBankIncrement", wireType)
+			}
+			m.TimeBankIncrement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeBankIncrement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBankReplenishHands", wireType)
+			}
+			m.TimeBankReplenishHands = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeBankReplenishHands |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBankMax", wireType)
+			}
+			m.TimeBankMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeBankMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeatReservationWindow", wireType)
+			}
+			m.SeatReservationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeatReservationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeSigners = append(m.BridgeSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRelayerQuorum", wireType)
+			}
+			m.HeaderRelayerQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderRelayerQuorum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCUSDCRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCUSDCRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCUSDCRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
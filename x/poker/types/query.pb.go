@@ -206,6 +206,8 @@ func (m *QueryGameResponse) GetGame() string {
 
 // QueryListGamesRequest defines the QueryListGamesRequest message.
 type QueryListGamesRequest struct {
+	// Only list games in this denomination (empty = all)
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryListGamesRequest) Reset()         { *m = QueryListGamesRequest{} }
@@ -241,6 +243,13 @@ func (m *QueryListGamesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryListGamesRequest proto.InternalMessageInfo
 
+func (m *QueryListGamesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryListGamesResponse defines the QueryListGamesResponse message.
 type QueryListGamesResponse struct {
 	Games string `protobuf:"bytes,1,opt,name=games,proto3" json:"games,omitempty"`
@@ -678,6 +687,122 @@ func (m *QueryGameStatePublicResponse) GetGameState() string {
 	return ""
 }
 
+// QueryGameStateSpectatorRequest defines the QueryGameStateSpectatorRequest message.
+type QueryGameStateSpectatorRequest struct {
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (m *QueryGameStateSpectatorRequest) Reset()         { *m = QueryGameStateSpectatorRequest{} }
+func (m *QueryGameStateSpectatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGameStateSpectatorRequest) ProtoMessage()    {}
+func (*QueryGameStateSpectatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{14}
+}
+func (m *QueryGameStateSpectatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGameStateSpectatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGameStateSpectatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGameStateSpectatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGameStateSpectatorRequest.Merge(m, src)
+}
+func (m *QueryGameStateSpectatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGameStateSpectatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGameStateSpectatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGameStateSpectatorRequest proto.InternalMessageInfo
+
+func (m *QueryGameStateSpectatorRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
+}
+
+// QueryGameStateSpectatorResponse defines the spectator view of a table. It is
+// the current state: spectator channels hold it back by the table's delay,
+// but the chain applies no delay to this or any other query.
+type QueryGameStateSpectatorResponse struct {
+	GameState    string `protobuf:"bytes,1,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`
+	Spectators   string `protobuf:"bytes,2,opt,name=spectators,proto3" json:"spectators,omitempty"`
+	DelayActions uint64 `protobuf:"varint,3,opt,name=delay_actions,json=delayActions,proto3" json:"delay_actions,omitempty"`
+	DelaySeconds uint64 `protobuf:"varint,4,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+}
+
+func (m *QueryGameStateSpectatorResponse) Reset()         { *m = QueryGameStateSpectatorResponse{} }
+func (m *QueryGameStateSpectatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGameStateSpectatorResponse) ProtoMessage()    {}
+func (*QueryGameStateSpectatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{15}
+}
+func (m *QueryGameStateSpectatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGameStateSpectatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGameStateSpectatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGameStateSpectatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGameStateSpectatorResponse.Merge(m, src)
+}
+func (m *QueryGameStateSpectatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGameStateSpectatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGameStateSpectatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGameStateSpectatorResponse proto.InternalMessageInfo
+
+func (m *QueryGameStateSpectatorResponse) GetGameState() string {
+	if m != nil {
+		return m.GameState
+	}
+	return ""
+}
+
+func (m *QueryGameStateSpectatorResponse) GetSpectators() string {
+	if m != nil {
+		return m.Spectators
+	}
+	return ""
+}
+
+func (m *QueryGameStateSpectatorResponse) GetDelayActions() uint64 {
+	if m != nil {
+		return m.DelayActions
+	}
+	return 0
+}
+
+func (m *QueryGameStateSpectatorResponse) GetDelaySeconds() uint64 {
+	if m != nil {
+		return m.DelaySeconds
+	}
+	return 0
+}
+
 // QueryIsTxProcessedRequest defines the request for checking if a tx has been processed
 type QueryIsTxProcessedRequest struct {
 	EthTxHash string `protobuf:"bytes,1,opt,name=eth_tx_hash,json=ethTxHash,proto3" json:"eth_tx_hash,omitempty"`
@@ -687,7 +812,7 @@ func (m *QueryIsTxProcessedRequest) Reset()         { *m = QueryIsTxProcessedReq
 func (m *QueryIsTxProcessedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsTxProcessedRequest) ProtoMessage()    {}
 func (*QueryIsTxProcessedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{14}
+	return fileDescriptor_9d94828f419f7527, []int{16}
 }
 func (m *QueryIsTxProcessedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,7 +857,7 @@ func (m *QueryIsTxProcessedResponse) Reset()         { *m = QueryIsTxProcessedRe
 func (m *QueryIsTxProcessedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIsTxProcessedResponse) ProtoMessage()    {}
 func (*QueryIsTxProcessedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{15}
+	return fileDescriptor_9d94828f419f7527, []int{17}
 }
 func (m *QueryIsTxProcessedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -768,23 +893,22 @@ func (m *QueryIsTxProcessedResponse) GetProcessed() bool {
 	return false
 }
 
-// QueryGetWithdrawalRequestRequest defines the request for getting a withdrawal request
-type QueryGetWithdrawalRequestRequest struct {
-	Nonce string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+// QueryEthHeaderTipRequest defines the request for the latest tracked header
+type QueryEthHeaderTipRequest struct {
 }
 
-func (m *QueryGetWithdrawalRequestRequest) Reset()         { *m = QueryGetWithdrawalRequestRequest{} }
-func (m *QueryGetWithdrawalRequestRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetWithdrawalRequestRequest) ProtoMessage()    {}
-func (*QueryGetWithdrawalRequestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{16}
+func (m *QueryEthHeaderTipRequest) Reset()         { *m = QueryEthHeaderTipRequest{} }
+func (m *QueryEthHeaderTipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEthHeaderTipRequest) ProtoMessage()    {}
+func (*QueryEthHeaderTipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{18}
 }
-func (m *QueryGetWithdrawalRequestRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEthHeaderTipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetWithdrawalRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEthHeaderTipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetWithdrawalRequestRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEthHeaderTipRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -794,42 +918,35 @@ func (m *QueryGetWithdrawalRequestRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetWithdrawalRequestRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetWithdrawalRequestRequest.Merge(m, src)
+func (m *QueryEthHeaderTipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEthHeaderTipRequest.Merge(m, src)
 }
-func (m *QueryGetWithdrawalRequestRequest) XXX_Size() int {
+func (m *QueryEthHeaderTipRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetWithdrawalRequestRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetWithdrawalRequestRequest.DiscardUnknown(m)
+func (m *QueryEthHeaderTipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEthHeaderTipRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetWithdrawalRequestRequest proto.InternalMessageInfo
-
-func (m *QueryGetWithdrawalRequestRequest) GetNonce() string {
-	if m != nil {
-		return m.Nonce
-	}
-	return ""
-}
+var xxx_messageInfo_QueryEthHeaderTipRequest proto.InternalMessageInfo
 
-// QueryGetWithdrawalRequestResponse defines the response for getting a withdrawal request
-type QueryGetWithdrawalRequestResponse struct {
-	WithdrawalRequest *WithdrawalRequest `protobuf:"bytes,1,opt,name=withdrawal_request,json=withdrawalRequest,proto3" json:"withdrawal_request,omitempty"`
+// QueryEthHeaderTipResponse defines the response for the latest tracked header
+type QueryEthHeaderTipResponse struct {
+	Header *EthHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 }
 
-func (m *QueryGetWithdrawalRequestResponse) Reset()         { *m = QueryGetWithdrawalRequestResponse{} }
-func (m *QueryGetWithdrawalRequestResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetWithdrawalRequestResponse) ProtoMessage()    {}
-func (*QueryGetWithdrawalRequestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{17}
+func (m *QueryEthHeaderTipResponse) Reset()         { *m = QueryEthHeaderTipResponse{} }
+func (m *QueryEthHeaderTipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEthHeaderTipResponse) ProtoMessage()    {}
+func (*QueryEthHeaderTipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{19}
 }
-func (m *QueryGetWithdrawalRequestResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEthHeaderTipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetWithdrawalRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEthHeaderTipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetWithdrawalRequestResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEthHeaderTipResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -839,43 +956,42 @@ func (m *QueryGetWithdrawalRequestResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetWithdrawalRequestResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetWithdrawalRequestResponse.Merge(m, src)
+func (m *QueryEthHeaderTipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEthHeaderTipResponse.Merge(m, src)
 }
-func (m *QueryGetWithdrawalRequestResponse) XXX_Size() int {
+func (m *QueryEthHeaderTipResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetWithdrawalRequestResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetWithdrawalRequestResponse.DiscardUnknown(m)
+func (m *QueryEthHeaderTipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEthHeaderTipResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetWithdrawalRequestResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEthHeaderTipResponse proto.InternalMessageInfo
 
-func (m *QueryGetWithdrawalRequestResponse) GetWithdrawalRequest() *WithdrawalRequest {
+func (m *QueryEthHeaderTipResponse) GetHeader() *EthHeader {
 	if m != nil {
-		return m.WithdrawalRequest
+		return m.Header
 	}
 	return nil
 }
 
-// QueryListWithdrawalRequestsRequest defines the request for listing withdrawal requests
-type QueryListWithdrawalRequestsRequest struct {
-	CosmosAddress string             `protobuf:"bytes,1,opt,name=cosmos_address,json=cosmosAddress,proto3" json:"cosmos_address,omitempty"`
-	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryEthHeaderRequest defines the request for a tracked header by number
+type QueryEthHeaderRequest struct {
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (m *QueryListWithdrawalRequestsRequest) Reset()         { *m = QueryListWithdrawalRequestsRequest{} }
-func (m *QueryListWithdrawalRequestsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryListWithdrawalRequestsRequest) ProtoMessage()    {}
-func (*QueryListWithdrawalRequestsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{18}
+func (m *QueryEthHeaderRequest) Reset()         { *m = QueryEthHeaderRequest{} }
+func (m *QueryEthHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEthHeaderRequest) ProtoMessage()    {}
+func (*QueryEthHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{20}
 }
-func (m *QueryListWithdrawalRequestsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEthHeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListWithdrawalRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEthHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListWithdrawalRequestsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEthHeaderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -885,50 +1001,42 @@ func (m *QueryListWithdrawalRequestsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryListWithdrawalRequestsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListWithdrawalRequestsRequest.Merge(m, src)
+func (m *QueryEthHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEthHeaderRequest.Merge(m, src)
 }
-func (m *QueryListWithdrawalRequestsRequest) XXX_Size() int {
+func (m *QueryEthHeaderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListWithdrawalRequestsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListWithdrawalRequestsRequest.DiscardUnknown(m)
+func (m *QueryEthHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEthHeaderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListWithdrawalRequestsRequest proto.InternalMessageInfo
-
-func (m *QueryListWithdrawalRequestsRequest) GetCosmosAddress() string {
-	if m != nil {
-		return m.CosmosAddress
-	}
-	return ""
-}
+var xxx_messageInfo_QueryEthHeaderRequest proto.InternalMessageInfo
 
-func (m *QueryListWithdrawalRequestsRequest) GetPagination() *query.PageRequest {
+func (m *QueryEthHeaderRequest) GetNumber() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.Number
 	}
-	return nil
+	return 0
 }
 
-// QueryListWithdrawalRequestsResponse defines the response for listing withdrawal requests
-type QueryListWithdrawalRequestsResponse struct {
-	WithdrawalRequests []*WithdrawalRequest `protobuf:"bytes,1,rep,name=withdrawal_requests,json=withdrawalRequests,proto3" json:"withdrawal_requests,omitempty"`
-	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryEthHeaderResponse defines the response for a tracked header by number
+type QueryEthHeaderResponse struct {
+	Header *EthHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 }
 
-func (m *QueryListWithdrawalRequestsResponse) Reset()         { *m = QueryListWithdrawalRequestsResponse{} }
-func (m *QueryListWithdrawalRequestsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryListWithdrawalRequestsResponse) ProtoMessage()    {}
-func (*QueryListWithdrawalRequestsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{19}
+func (m *QueryEthHeaderResponse) Reset()         { *m = QueryEthHeaderResponse{} }
+func (m *QueryEthHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEthHeaderResponse) ProtoMessage()    {}
+func (*QueryEthHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{21}
 }
-func (m *QueryListWithdrawalRequestsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEthHeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListWithdrawalRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEthHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListWithdrawalRequestsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEthHeaderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -938,56 +1046,42 @@ func (m *QueryListWithdrawalRequestsResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryListWithdrawalRequestsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListWithdrawalRequestsResponse.Merge(m, src)
+func (m *QueryEthHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEthHeaderResponse.Merge(m, src)
 }
-func (m *QueryListWithdrawalRequestsResponse) XXX_Size() int {
+func (m *QueryEthHeaderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListWithdrawalRequestsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListWithdrawalRequestsResponse.DiscardUnknown(m)
+func (m *QueryEthHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEthHeaderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListWithdrawalRequestsResponse proto.InternalMessageInfo
-
-func (m *QueryListWithdrawalRequestsResponse) GetWithdrawalRequests() []*WithdrawalRequest {
-	if m != nil {
-		return m.WithdrawalRequests
-	}
-	return nil
-}
+var xxx_messageInfo_QueryEthHeaderResponse proto.InternalMessageInfo
 
-func (m *QueryListWithdrawalRequestsResponse) GetPagination() *query.PageResponse {
+func (m *QueryEthHeaderResponse) GetHeader() *EthHeader {
 	if m != nil {
-		return m.Pagination
+		return m.Header
 	}
 	return nil
 }
 
-// QueryCalculateEquityRequest defines the request for calculating hand equity
-type QueryCalculateEquityRequest struct {
-	// hands: Array of hole cards for each player, e.g., [["AS", "KS"], ["QH", "QD"]]
-	Hands []*HandCards `protobuf:"bytes,1,rep,name=hands,proto3" json:"hands,omitempty"`
-	// board: Community cards (0=preflop, 3=flop, 4=turn, 5=river)
-	Board []string `protobuf:"bytes,2,rep,name=board,proto3" json:"board,omitempty"`
-	// dead: Dead/mucked cards (optional)
-	Dead []string `protobuf:"bytes,3,rep,name=dead,proto3" json:"dead,omitempty"`
-	// simulations: Number of Monte Carlo simulations (default: 10000, max: 100000)
-	Simulations int32 `protobuf:"varint,4,opt,name=simulations,proto3" json:"simulations,omitempty"`
+// QueryBridgeStatusRequest defines the request for the bridge status
+type QueryBridgeStatusRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryCalculateEquityRequest) Reset()         { *m = QueryCalculateEquityRequest{} }
-func (m *QueryCalculateEquityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCalculateEquityRequest) ProtoMessage()    {}
-func (*QueryCalculateEquityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{20}
+func (m *QueryBridgeStatusRequest) Reset()         { *m = QueryBridgeStatusRequest{} }
+func (m *QueryBridgeStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeStatusRequest) ProtoMessage()    {}
+func (*QueryBridgeStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{22}
 }
-func (m *QueryCalculateEquityRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBridgeStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalculateEquityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBridgeStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalculateEquityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBridgeStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -997,63 +1091,52 @@ func (m *QueryCalculateEquityRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryCalculateEquityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalculateEquityRequest.Merge(m, src)
+func (m *QueryBridgeStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeStatusRequest.Merge(m, src)
 }
-func (m *QueryCalculateEquityRequest) XXX_Size() int {
+func (m *QueryBridgeStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalculateEquityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalculateEquityRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryCalculateEquityRequest proto.InternalMessageInfo
-
-func (m *QueryCalculateEquityRequest) GetHands() []*HandCards {
-	if m != nil {
-		return m.Hands
-	}
-	return nil
-}
-
-func (m *QueryCalculateEquityRequest) GetBoard() []string {
-	if m != nil {
-		return m.Board
-	}
-	return nil
+func (m *QueryBridgeStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCalculateEquityRequest) GetDead() []string {
-	if m != nil {
-		return m.Dead
-	}
-	return nil
-}
+var xxx_messageInfo_QueryBridgeStatusRequest proto.InternalMessageInfo
 
-func (m *QueryCalculateEquityRequest) GetSimulations() int32 {
+func (m *QueryBridgeStatusRequest) GetAddress() string {
 	if m != nil {
-		return m.Simulations
+		return m.Address
 	}
-	return 0
-}
-
-// HandCards represents hole cards for a single player
-type HandCards struct {
-	Cards []string `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	return ""
 }
 
-func (m *HandCards) Reset()         { *m = HandCards{} }
-func (m *HandCards) String() string { return proto.CompactTextString(m) }
-func (*HandCards) ProtoMessage()    {}
-func (*HandCards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{21}
+// QueryBridgeStatusResponse defines the response for the bridge status.
+// Limits of 0 are unlimited, in which case the remaining amount is 0.
+type QueryBridgeStatusResponse struct {
+	Pause                    BridgePauseState `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause"`
+	WithdrawalWindow         uint64           `protobuf:"varint,2,opt,name=withdrawal_window,json=withdrawalWindow,proto3" json:"withdrawal_window,omitempty"`
+	GlobalWithdrawalLimit    uint64           `protobuf:"varint,3,opt,name=global_withdrawal_limit,json=globalWithdrawalLimit,proto3" json:"global_withdrawal_limit,omitempty"`
+	GlobalWithdrawn          uint64           `protobuf:"varint,4,opt,name=global_withdrawn,json=globalWithdrawn,proto3" json:"global_withdrawn,omitempty"`
+	GlobalRemaining          uint64           `protobuf:"varint,5,opt,name=global_remaining,json=globalRemaining,proto3" json:"global_remaining,omitempty"`
+	AddressWithdrawalLimit   uint64           `protobuf:"varint,6,opt,name=address_withdrawal_limit,json=addressWithdrawalLimit,proto3" json:"address_withdrawal_limit,omitempty"`
+	AddressWithdrawn         uint64           `protobuf:"varint,7,opt,name=address_withdrawn,json=addressWithdrawn,proto3" json:"address_withdrawn,omitempty"`
+	AddressRemaining         uint64           `protobuf:"varint,8,opt,name=address_remaining,json=addressRemaining,proto3" json:"address_remaining,omitempty"`
+	LargeWithdrawalThreshold uint64           `protobuf:"varint,9,opt,name=large_withdrawal_threshold,json=largeWithdrawalThreshold,proto3" json:"large_withdrawal_threshold,omitempty"`
+	LargeWithdrawalDelay     uint64           `protobuf:"varint,10,opt,name=large_withdrawal_delay,json=largeWithdrawalDelay,proto3" json:"large_withdrawal_delay,omitempty"`
+}
+
+func (m *QueryBridgeStatusResponse) Reset()         { *m = QueryBridgeStatusResponse{} }
+func (m *QueryBridgeStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeStatusResponse) ProtoMessage()    {}
+func (*QueryBridgeStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{23}
 }
-func (m *HandCards) XXX_Unmarshal(b []byte) error {
+func (m *QueryBridgeStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HandCards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBridgeStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HandCards.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBridgeStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1063,147 +1146,105 @@ func (m *HandCards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *HandCards) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HandCards.Merge(m, src)
+func (m *QueryBridgeStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeStatusResponse.Merge(m, src)
 }
-func (m *HandCards) XXX_Size() int {
+func (m *QueryBridgeStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *HandCards) XXX_DiscardUnknown() {
-	xxx_messageInfo_HandCards.DiscardUnknown(m)
+func (m *QueryBridgeStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HandCards proto.InternalMessageInfo
+var xxx_messageInfo_QueryBridgeStatusResponse proto.InternalMessageInfo
 
-func (m *HandCards) GetCards() []string {
+func (m *QueryBridgeStatusResponse) GetPause() BridgePauseState {
 	if m != nil {
-		return m.Cards
+		return m.Pause
 	}
-	return nil
-}
-
-// EquityResult represents the equity calculation result for a single hand
-type EquityResult struct {
-	HandIndex int32    `protobuf:"varint,1,opt,name=hand_index,json=handIndex,proto3" json:"hand_index,omitempty"`
-	Hand      []string `protobuf:"bytes,2,rep,name=hand,proto3" json:"hand,omitempty"`
-	Wins      int32    `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
-	Ties      int32    `protobuf:"varint,4,opt,name=ties,proto3" json:"ties,omitempty"`
-	Losses    int32    `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
-	Equity    string   `protobuf:"bytes,6,opt,name=equity,proto3" json:"equity,omitempty"`
-	TieEquity string   `protobuf:"bytes,7,opt,name=tie_equity,json=tieEquity,proto3" json:"tie_equity,omitempty"`
-	Total     string   `protobuf:"bytes,8,opt,name=total,proto3" json:"total,omitempty"`
+	return BridgePauseState{}
 }
 
-func (m *EquityResult) Reset()         { *m = EquityResult{} }
-func (m *EquityResult) String() string { return proto.CompactTextString(m) }
-func (*EquityResult) ProtoMessage()    {}
-func (*EquityResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{22}
-}
-func (m *EquityResult) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EquityResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EquityResult.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *QueryBridgeStatusResponse) GetWithdrawalWindow() uint64 {
+	if m != nil {
+		return m.WithdrawalWindow
 	}
+	return 0
 }
-func (m *EquityResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EquityResult.Merge(m, src)
-}
-func (m *EquityResult) XXX_Size() int {
-	return m.Size()
-}
-func (m *EquityResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_EquityResult.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EquityResult proto.InternalMessageInfo
 
-func (m *EquityResult) GetHandIndex() int32 {
+func (m *QueryBridgeStatusResponse) GetGlobalWithdrawalLimit() uint64 {
 	if m != nil {
-		return m.HandIndex
+		return m.GlobalWithdrawalLimit
 	}
 	return 0
 }
 
-func (m *EquityResult) GetHand() []string {
+func (m *QueryBridgeStatusResponse) GetGlobalWithdrawn() uint64 {
 	if m != nil {
-		return m.Hand
+		return m.GlobalWithdrawn
 	}
-	return nil
+	return 0
 }
 
-func (m *EquityResult) GetWins() int32 {
+func (m *QueryBridgeStatusResponse) GetGlobalRemaining() uint64 {
 	if m != nil {
-		return m.Wins
+		return m.GlobalRemaining
 	}
 	return 0
 }
 
-func (m *EquityResult) GetTies() int32 {
+func (m *QueryBridgeStatusResponse) GetAddressWithdrawalLimit() uint64 {
 	if m != nil {
-		return m.Ties
+		return m.AddressWithdrawalLimit
 	}
 	return 0
 }
 
-func (m *EquityResult) GetLosses() int32 {
+func (m *QueryBridgeStatusResponse) GetAddressWithdrawn() uint64 {
 	if m != nil {
-		return m.Losses
+		return m.AddressWithdrawn
 	}
 	return 0
 }
 
-func (m *EquityResult) GetEquity() string {
+func (m *QueryBridgeStatusResponse) GetAddressRemaining() uint64 {
 	if m != nil {
-		return m.Equity
+		return m.AddressRemaining
 	}
-	return ""
+	return 0
 }
 
-func (m *EquityResult) GetTieEquity() string {
+func (m *QueryBridgeStatusResponse) GetLargeWithdrawalThreshold() uint64 {
 	if m != nil {
-		return m.TieEquity
+		return m.LargeWithdrawalThreshold
 	}
-	return ""
+	return 0
 }
 
-func (m *EquityResult) GetTotal() string {
+func (m *QueryBridgeStatusResponse) GetLargeWithdrawalDelay() uint64 {
 	if m != nil {
-		return m.Total
+		return m.LargeWithdrawalDelay
 	}
-	return ""
+	return 0
 }
 
-// QueryCalculateEquityResponse defines the response for calculating hand equity
-type QueryCalculateEquityResponse struct {
-	Results     []*EquityResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Simulations int32           `protobuf:"varint,2,opt,name=simulations,proto3" json:"simulations,omitempty"`
-	Stage       string          `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
-	DurationMs  string          `protobuf:"bytes,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
-	HandsPerSec string          `protobuf:"bytes,5,opt,name=hands_per_sec,json=handsPerSec,proto3" json:"hands_per_sec,omitempty"`
+// QueryEstimateWithdrawalFeeRequest defines the request for a withdrawal fee estimate
+type QueryEstimateWithdrawalFeeRequest struct {
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *QueryCalculateEquityResponse) Reset()         { *m = QueryCalculateEquityResponse{} }
-func (m *QueryCalculateEquityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCalculateEquityResponse) ProtoMessage()    {}
-func (*QueryCalculateEquityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{23}
+func (m *QueryEstimateWithdrawalFeeRequest) Reset()         { *m = QueryEstimateWithdrawalFeeRequest{} }
+func (m *QueryEstimateWithdrawalFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateWithdrawalFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateWithdrawalFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{24}
 }
-func (m *QueryCalculateEquityResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEstimateWithdrawalFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCalculateEquityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEstimateWithdrawalFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCalculateEquityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEstimateWithdrawalFeeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1213,69 +1254,103 @@ func (m *QueryCalculateEquityResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryCalculateEquityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCalculateEquityResponse.Merge(m, src)
+func (m *QueryEstimateWithdrawalFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateWithdrawalFeeRequest.Merge(m, src)
 }
-func (m *QueryCalculateEquityResponse) XXX_Size() int {
+func (m *QueryEstimateWithdrawalFeeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCalculateEquityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCalculateEquityResponse.DiscardUnknown(m)
+func (m *QueryEstimateWithdrawalFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateWithdrawalFeeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCalculateEquityResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEstimateWithdrawalFeeRequest proto.InternalMessageInfo
 
-func (m *QueryCalculateEquityResponse) GetResults() []*EquityResult {
+func (m *QueryEstimateWithdrawalFeeRequest) GetAmount() uint64 {
 	if m != nil {
-		return m.Results
+		return m.Amount
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryCalculateEquityResponse) GetSimulations() int32 {
-	if m != nil {
-		return m.Simulations
+// QueryEstimateWithdrawalFeeResponse defines the response for a withdrawal fee estimate
+type QueryEstimateWithdrawalFeeResponse struct {
+	Fee         uint64 `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	NetAmount   uint64 `protobuf:"varint,2,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	FeeTreasury string `protobuf:"bytes,3,opt,name=fee_treasury,json=feeTreasury,proto3" json:"fee_treasury,omitempty"`
+}
+
+func (m *QueryEstimateWithdrawalFeeResponse) Reset()         { *m = QueryEstimateWithdrawalFeeResponse{} }
+func (m *QueryEstimateWithdrawalFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateWithdrawalFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateWithdrawalFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{25}
+}
+func (m *QueryEstimateWithdrawalFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateWithdrawalFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateWithdrawalFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryEstimateWithdrawalFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateWithdrawalFeeResponse.Merge(m, src)
+}
+func (m *QueryEstimateWithdrawalFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateWithdrawalFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateWithdrawalFeeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCalculateEquityResponse) GetStage() string {
+var xxx_messageInfo_QueryEstimateWithdrawalFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateWithdrawalFeeResponse) GetFee() uint64 {
 	if m != nil {
-		return m.Stage
+		return m.Fee
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryCalculateEquityResponse) GetDurationMs() string {
+func (m *QueryEstimateWithdrawalFeeResponse) GetNetAmount() uint64 {
 	if m != nil {
-		return m.DurationMs
+		return m.NetAmount
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryCalculateEquityResponse) GetHandsPerSec() string {
+func (m *QueryEstimateWithdrawalFeeResponse) GetFeeTreasury() string {
 	if m != nil {
-		return m.HandsPerSec
+		return m.FeeTreasury
 	}
 	return ""
 }
 
-// QueryVersionRequest defines the request for getting version info
-type QueryVersionRequest struct {
+// QueryGetWithdrawalRequestRequest defines the request for getting a withdrawal request
+type QueryGetWithdrawalRequestRequest struct {
+	Nonce string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryVersionRequest) Reset()         { *m = QueryVersionRequest{} }
-func (m *QueryVersionRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVersionRequest) ProtoMessage()    {}
-func (*QueryVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{24}
+func (m *QueryGetWithdrawalRequestRequest) Reset()         { *m = QueryGetWithdrawalRequestRequest{} }
+func (m *QueryGetWithdrawalRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWithdrawalRequestRequest) ProtoMessage()    {}
+func (*QueryGetWithdrawalRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{26}
 }
-func (m *QueryVersionRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetWithdrawalRequestRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetWithdrawalRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVersionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetWithdrawalRequestRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1285,37 +1360,42 @@ func (m *QueryVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryVersionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVersionRequest.Merge(m, src)
+func (m *QueryGetWithdrawalRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWithdrawalRequestRequest.Merge(m, src)
 }
-func (m *QueryVersionRequest) XXX_Size() int {
+func (m *QueryGetWithdrawalRequestRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVersionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVersionRequest.DiscardUnknown(m)
+func (m *QueryGetWithdrawalRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWithdrawalRequestRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVersionRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetWithdrawalRequestRequest proto.InternalMessageInfo
 
-// ChainVersion contains chain version information
-type ChainVersion struct {
-	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version          string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	ConsensusVersion uint64 `protobuf:"varint,3,opt,name=consensus_version,json=consensusVersion,proto3" json:"consensus_version,omitempty"`
+func (m *QueryGetWithdrawalRequestRequest) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
 }
 
-func (m *ChainVersion) Reset()         { *m = ChainVersion{} }
-func (m *ChainVersion) String() string { return proto.CompactTextString(m) }
-func (*ChainVersion) ProtoMessage()    {}
-func (*ChainVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{25}
+// QueryGetWithdrawalRequestResponse defines the response for getting a withdrawal request
+type QueryGetWithdrawalRequestResponse struct {
+	WithdrawalRequest *WithdrawalRequest `protobuf:"bytes,1,opt,name=withdrawal_request,json=withdrawalRequest,proto3" json:"withdrawal_request,omitempty"`
 }
-func (m *ChainVersion) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetWithdrawalRequestResponse) Reset()         { *m = QueryGetWithdrawalRequestResponse{} }
+func (m *QueryGetWithdrawalRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWithdrawalRequestResponse) ProtoMessage()    {}
+func (*QueryGetWithdrawalRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{27}
+}
+func (m *QueryGetWithdrawalRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChainVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetWithdrawalRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChainVersion.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetWithdrawalRequestResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1325,59 +1405,43 @@ func (m *ChainVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ChainVersion) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChainVersion.Merge(m, src)
+func (m *QueryGetWithdrawalRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWithdrawalRequestResponse.Merge(m, src)
 }
-func (m *ChainVersion) XXX_Size() int {
+func (m *QueryGetWithdrawalRequestResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChainVersion) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChainVersion.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ChainVersion proto.InternalMessageInfo
-
-func (m *ChainVersion) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *QueryGetWithdrawalRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWithdrawalRequestResponse.DiscardUnknown(m)
 }
 
-func (m *ChainVersion) GetVersion() string {
-	if m != nil {
-		return m.Version
-	}
-	return ""
-}
+var xxx_messageInfo_QueryGetWithdrawalRequestResponse proto.InternalMessageInfo
 
-func (m *ChainVersion) GetConsensusVersion() uint64 {
+func (m *QueryGetWithdrawalRequestResponse) GetWithdrawalRequest() *WithdrawalRequest {
 	if m != nil {
-		return m.ConsensusVersion
+		return m.WithdrawalRequest
 	}
-	return 0
+	return nil
 }
 
-// PvmStatus contains PVM health and version information
-type PvmStatus struct {
-	Healthy  bool   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
-	Version  string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Error    string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+// QueryListWithdrawalRequestsRequest defines the request for listing withdrawal requests
+type QueryListWithdrawalRequestsRequest struct {
+	CosmosAddress string             `protobuf:"bytes,1,opt,name=cosmos_address,json=cosmosAddress,proto3" json:"cosmos_address,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *PvmStatus) Reset()         { *m = PvmStatus{} }
-func (m *PvmStatus) String() string { return proto.CompactTextString(m) }
-func (*PvmStatus) ProtoMessage()    {}
-func (*PvmStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{26}
+func (m *QueryListWithdrawalRequestsRequest) Reset()         { *m = QueryListWithdrawalRequestsRequest{} }
+func (m *QueryListWithdrawalRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListWithdrawalRequestsRequest) ProtoMessage()    {}
+func (*QueryListWithdrawalRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{28}
 }
-func (m *PvmStatus) XXX_Unmarshal(b []byte) error {
+func (m *QueryListWithdrawalRequestsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PvmStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListWithdrawalRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PvmStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListWithdrawalRequestsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1387,64 +1451,50 @@ func (m *PvmStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PvmStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PvmStatus.Merge(m, src)
+func (m *QueryListWithdrawalRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListWithdrawalRequestsRequest.Merge(m, src)
 }
-func (m *PvmStatus) XXX_Size() int {
+func (m *QueryListWithdrawalRequestsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PvmStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_PvmStatus.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PvmStatus proto.InternalMessageInfo
-
-func (m *PvmStatus) GetHealthy() bool {
-	if m != nil {
-		return m.Healthy
-	}
-	return false
+func (m *QueryListWithdrawalRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListWithdrawalRequestsRequest.DiscardUnknown(m)
 }
 
-func (m *PvmStatus) GetVersion() string {
-	if m != nil {
-		return m.Version
-	}
-	return ""
-}
+var xxx_messageInfo_QueryListWithdrawalRequestsRequest proto.InternalMessageInfo
 
-func (m *PvmStatus) GetEndpoint() string {
+func (m *QueryListWithdrawalRequestsRequest) GetCosmosAddress() string {
 	if m != nil {
-		return m.Endpoint
+		return m.CosmosAddress
 	}
 	return ""
 }
 
-func (m *PvmStatus) GetError() string {
+func (m *QueryListWithdrawalRequestsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Error
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryVersionResponse defines the response for getting version info
-type QueryVersionResponse struct {
-	Chain *ChainVersion `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
-	Pvm   *PvmStatus    `protobuf:"bytes,2,opt,name=pvm,proto3" json:"pvm,omitempty"`
+// QueryListWithdrawalRequestsResponse defines the response for listing withdrawal requests
+type QueryListWithdrawalRequestsResponse struct {
+	WithdrawalRequests []*WithdrawalRequest `protobuf:"bytes,1,rep,name=withdrawal_requests,json=withdrawalRequests,proto3" json:"withdrawal_requests,omitempty"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVersionResponse) Reset()         { *m = QueryVersionResponse{} }
-func (m *QueryVersionResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVersionResponse) ProtoMessage()    {}
-func (*QueryVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d94828f419f7527, []int{27}
+func (m *QueryListWithdrawalRequestsResponse) Reset()         { *m = QueryListWithdrawalRequestsResponse{} }
+func (m *QueryListWithdrawalRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListWithdrawalRequestsResponse) ProtoMessage()    {}
+func (*QueryListWithdrawalRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{29}
 }
-func (m *QueryVersionResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListWithdrawalRequestsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListWithdrawalRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVersionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListWithdrawalRequestsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1454,1616 +1504,1617 @@ func (m *QueryVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryVersionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVersionResponse.Merge(m, src)
+func (m *QueryListWithdrawalRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListWithdrawalRequestsResponse.Merge(m, src)
 }
-func (m *QueryVersionResponse) XXX_Size() int {
+func (m *QueryListWithdrawalRequestsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVersionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVersionResponse.DiscardUnknown(m)
+func (m *QueryListWithdrawalRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListWithdrawalRequestsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVersionResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListWithdrawalRequestsResponse proto.InternalMessageInfo
 
-func (m *QueryVersionResponse) GetChain() *ChainVersion {
+func (m *QueryListWithdrawalRequestsResponse) GetWithdrawalRequests() []*WithdrawalRequest {
 	if m != nil {
-		return m.Chain
+		return m.WithdrawalRequests
 	}
 	return nil
 }
 
-func (m *QueryVersionResponse) GetPvm() *PvmStatus {
+func (m *QueryListWithdrawalRequestsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Pvm
+		return m.Pagination
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pokerchain.poker.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pokerchain.poker.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGameRequest)(nil), "pokerchain.poker.v1.QueryGameRequest")
-	proto.RegisterType((*QueryGameResponse)(nil), "pokerchain.poker.v1.QueryGameResponse")
-	proto.RegisterType((*QueryListGamesRequest)(nil), "pokerchain.poker.v1.QueryListGamesRequest")
-	proto.RegisterType((*QueryListGamesResponse)(nil), "pokerchain.poker.v1.QueryListGamesResponse")
-	proto.RegisterType((*QueryPlayerGamesRequest)(nil), "pokerchain.poker.v1.QueryPlayerGamesRequest")
-	proto.RegisterType((*QueryPlayerGamesResponse)(nil), "pokerchain.poker.v1.QueryPlayerGamesResponse")
-	proto.RegisterType((*QueryLegalActionsRequest)(nil), "pokerchain.poker.v1.QueryLegalActionsRequest")
-	proto.RegisterType((*QueryLegalActionsResponse)(nil), "pokerchain.poker.v1.QueryLegalActionsResponse")
-	proto.RegisterType((*QueryGameStateRequest)(nil), "pokerchain.poker.v1.QueryGameStateRequest")
-	proto.RegisterType((*QueryGameStateResponse)(nil), "pokerchain.poker.v1.QueryGameStateResponse")
-	proto.RegisterType((*QueryGameStatePublicRequest)(nil), "pokerchain.poker.v1.QueryGameStatePublicRequest")
-	proto.RegisterType((*QueryGameStatePublicResponse)(nil), "pokerchain.poker.v1.QueryGameStatePublicResponse")
-	proto.RegisterType((*QueryIsTxProcessedRequest)(nil), "pokerchain.poker.v1.QueryIsTxProcessedRequest")
-	proto.RegisterType((*QueryIsTxProcessedResponse)(nil), "pokerchain.poker.v1.QueryIsTxProcessedResponse")
-	proto.RegisterType((*QueryGetWithdrawalRequestRequest)(nil), "pokerchain.poker.v1.QueryGetWithdrawalRequestRequest")
-	proto.RegisterType((*QueryGetWithdrawalRequestResponse)(nil), "pokerchain.poker.v1.QueryGetWithdrawalRequestResponse")
-	proto.RegisterType((*QueryListWithdrawalRequestsRequest)(nil), "pokerchain.poker.v1.QueryListWithdrawalRequestsRequest")
-	proto.RegisterType((*QueryListWithdrawalRequestsResponse)(nil), "pokerchain.poker.v1.QueryListWithdrawalRequestsResponse")
-	proto.RegisterType((*QueryCalculateEquityRequest)(nil), "pokerchain.poker.v1.QueryCalculateEquityRequest")
-	proto.RegisterType((*HandCards)(nil), "pokerchain.poker.v1.HandCards")
-	proto.RegisterType((*EquityResult)(nil), "pokerchain.poker.v1.EquityResult")
-	proto.RegisterType((*QueryCalculateEquityResponse)(nil), "pokerchain.poker.v1.QueryCalculateEquityResponse")
-	proto.RegisterType((*QueryVersionRequest)(nil), "pokerchain.poker.v1.QueryVersionRequest")
-	proto.RegisterType((*ChainVersion)(nil), "pokerchain.poker.v1.ChainVersion")
-	proto.RegisterType((*PvmStatus)(nil), "pokerchain.poker.v1.PvmStatus")
-	proto.RegisterType((*QueryVersionResponse)(nil), "pokerchain.poker.v1.QueryVersionResponse")
+// QueryCalculateEquityRequest defines the request for calculating hand equity
+type QueryCalculateEquityRequest struct {
+	// hands: Array of hole cards for each player, e.g., [["AS", "KS"], ["QH", "QD"]]
+	Hands []*HandCards `protobuf:"bytes,1,rep,name=hands,proto3" json:"hands,omitempty"`
+	// board: Community cards (0=preflop, 3=flop, 4=turn, 5=river)
+	Board []string `protobuf:"bytes,2,rep,name=board,proto3" json:"board,omitempty"`
+	// dead: Dead/mucked cards (optional)
+	Dead []string `protobuf:"bytes,3,rep,name=dead,proto3" json:"dead,omitempty"`
+	// simulations: Number of Monte Carlo simulations (default: 10000, max: 100000)
+	Simulations int32 `protobuf:"varint,4,opt,name=simulations,proto3" json:"simulations,omitempty"`
 }
 
-func init() { proto.RegisterFile("pokerchain/poker/v1/query.proto", fileDescriptor_9d94828f419f7527) }
-
-var fileDescriptor_9d94828f419f7527 = []byte{
-	// 1553 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0x26, 0x71, 0x82, 0x5f, 0xe0, 0x0b, 0x19, 0x02, 0xf8, 0x6b, 0x52, 0x93, 0x2c, 0x85,
-	0x84, 0x04, 0xbc, 0x49, 0x28, 0x24, 0x84, 0xfe, 0xe0, 0x87, 0x28, 0x20, 0x81, 0x94, 0x2e, 0x50,
-	0x24, 0x2e, 0xd6, 0xd8, 0x3b, 0xb2, 0x57, 0xd8, 0xbb, 0x9b, 0x9d, 0x71, 0x7e, 0x34, 0xca, 0xa1,
-	0x55, 0x0f, 0x55, 0xd5, 0x43, 0x25, 0xd4, 0x33, 0xb7, 0xaa, 0xa7, 0xaa, 0x87, 0x1e, 0x7a, 0xe8,
-	0xb5, 0x2d, 0xa7, 0x0a, 0xa9, 0x17, 0x4e, 0x55, 0x05, 0x95, 0xfa, 0x6f, 0x54, 0x33, 0xf3, 0xd6,
-	0x59, 0xdb, 0x9b, 0x8d, 0x23, 0xf5, 0x62, 0xcd, 0x7b, 0xf3, 0xde, 0x9b, 0xcf, 0x7b, 0xf3, 0xe6,
-	0xed, 0x47, 0x86, 0x53, 0x81, 0xff, 0x94, 0x85, 0x95, 0x1a, 0x75, 0x3d, 0x4b, 0x2d, 0xad, 0xb5,
-	0x79, 0x6b, 0xb5, 0xc9, 0xc2, 0xcd, 0x62, 0x10, 0xfa, 0xc2, 0x27, 0x47, 0x77, 0x0c, 0x8a, 0x6a,
-	0x59, 0x5c, 0x9b, 0xcf, 0x8f, 0xd2, 0x86, 0xeb, 0xf9, 0x96, 0xfa, 0xd5, 0x76, 0xf9, 0x99, 0x8a,
-	0xcf, 0x1b, 0x3e, 0xb7, 0xca, 0x94, 0x33, 0x1d, 0xc0, 0x5a, 0x9b, 0x2f, 0x33, 0x41, 0xe7, 0xad,
-	0x80, 0x56, 0x5d, 0x8f, 0x0a, 0xd7, 0xf7, 0xd0, 0x76, 0xac, 0xea, 0x57, 0x7d, 0xb5, 0xb4, 0xe4,
-	0x0a, 0xb5, 0xe3, 0x55, 0xdf, 0xaf, 0xd6, 0x99, 0x45, 0x03, 0xd7, 0xa2, 0x9e, 0xe7, 0x0b, 0xe5,
-	0xc2, 0x71, 0x77, 0x22, 0x09, 0x68, 0x40, 0x43, 0xda, 0x88, 0x2c, 0x26, 0x93, 0x2c, 0xaa, 0xcc,
-	0x63, 0xdc, 0x45, 0x13, 0x73, 0x0c, 0xc8, 0x47, 0x12, 0xda, 0x8a, 0xf2, 0xb3, 0xd9, 0x6a, 0x93,
-	0x71, 0x61, 0x3e, 0x82, 0xa3, 0x6d, 0x5a, 0x1e, 0xf8, 0x1e, 0x67, 0xe4, 0x7d, 0x18, 0xd2, 0xf1,
-	0x73, 0xc6, 0x84, 0x31, 0x3d, 0xb2, 0x70, 0xb2, 0x98, 0x50, 0x8a, 0xa2, 0x76, 0xba, 0x91, 0x7d,
-	0xf1, 0xe7, 0xa9, 0xbe, 0xef, 0xfe, 0xf9, 0x61, 0xc6, 0xb0, 0xd1, 0xcb, 0x9c, 0x85, 0x23, 0x2a,
-	0xec, 0x6d, 0xda, 0x60, 0x78, 0x14, 0x39, 0x01, 0xc3, 0x55, 0xda, 0x60, 0x25, 0xd7, 0x51, 0x41,
-	0xb3, 0xf6, 0x90, 0x14, 0xef, 0x3a, 0xe6, 0x14, 0x8c, 0xc6, 0x8c, 0x11, 0x01, 0x81, 0x41, 0xb9,
-	0x8d, 0xa6, 0x6a, 0x6d, 0x9e, 0x80, 0x63, 0xca, 0xf0, 0x9e, 0xcb, 0x85, 0x34, 0x6e, 0x65, 0x51,
-	0x84, 0xe3, 0x9d, 0x1b, 0x18, 0x66, 0x0c, 0x32, 0xd2, 0x95, 0x63, 0x1c, 0x2d, 0x98, 0xd7, 0xe0,
-	0x84, 0xce, 0xba, 0x4e, 0x37, 0x59, 0x18, 0x0f, 0x45, 0xce, 0xc0, 0xff, 0x02, 0xa5, 0x2d, 0x51,
-	0xc7, 0x09, 0x19, 0x8f, 0x3c, 0x0f, 0x69, 0xed, 0x75, 0xad, 0x34, 0xe7, 0x20, 0xd7, 0x1d, 0x21,
-	0xf5, 0xcc, 0x27, 0xe8, 0x71, 0x8f, 0x55, 0x69, 0xfd, 0x7a, 0x45, 0xdd, 0xef, 0x5e, 0xa5, 0x49,
-	0x40, 0xd3, 0x9f, 0x84, 0xe6, 0x12, 0xfc, 0x3f, 0x21, 0x36, 0xc2, 0xc9, 0xc1, 0x30, 0xd5, 0x2a,
-	0x0c, 0x1e, 0x89, 0xe6, 0x33, 0x03, 0x0b, 0x2a, 0xf1, 0x3f, 0x10, 0x54, 0xb0, 0xff, 0x08, 0x10,
-	0x19, 0x87, 0xac, 0x70, 0x1b, 0x8c, 0x0b, 0xda, 0x08, 0x72, 0x03, 0x13, 0xc6, 0xf4, 0x80, 0xbd,
-	0xa3, 0x90, 0xbb, 0xdc, 0xad, 0x7a, 0x54, 0x34, 0x43, 0x96, 0x1b, 0x54, 0xfe, 0x3b, 0x0a, 0x73,
-	0x11, 0x2f, 0x33, 0x06, 0x0a, 0x33, 0x79, 0x0b, 0x40, 0xa1, 0xe2, 0x52, 0x8b, 0xc0, 0xb2, 0xd5,
-	0xc8, 0xcc, 0xbc, 0x0c, 0x27, 0xdb, 0x1d, 0x57, 0x9a, 0xe5, 0xba, 0x5b, 0xd9, 0xb3, 0xff, 0xde,
-	0x83, 0xf1, 0x64, 0xbf, 0xde, 0x8e, 0xbd, 0x8a, 0xc5, 0xbf, 0xcb, 0x1f, 0x6e, 0xac, 0x84, 0x7e,
-	0x85, 0x71, 0xce, 0x9c, 0xe8, 0xd0, 0x02, 0x8c, 0x30, 0x51, 0x2b, 0x89, 0x8d, 0x52, 0x8d, 0xf2,
-	0x5a, 0xe4, 0xcc, 0x44, 0xed, 0xe1, 0xc6, 0x1d, 0xca, 0x6b, 0xe6, 0x32, 0xe4, 0x93, 0x9c, 0xf1,
-	0xe4, 0x71, 0xc8, 0x06, 0x91, 0x52, 0xf9, 0x1e, 0xb0, 0x77, 0x14, 0xe6, 0x12, 0x4c, 0x68, 0xdc,
-	0x4c, 0x3c, 0x76, 0x45, 0xcd, 0x09, 0xe9, 0x3a, 0xad, 0xe3, 0xc1, 0xd1, 0xf9, 0x63, 0x90, 0xf1,
-	0x7c, 0xaf, 0x12, 0xc1, 0xd6, 0x82, 0xf9, 0x09, 0x4c, 0xa6, 0x78, 0xe2, 0xe1, 0x8f, 0x80, 0xac,
-	0xb7, 0x36, 0x4b, 0xa1, 0xde, 0xc5, 0x79, 0x70, 0x36, 0x71, 0x1e, 0x74, 0xc7, 0x1a, 0x5d, 0xef,
-	0x54, 0xc9, 0xa6, 0x33, 0x5b, 0x8f, 0xb5, 0xcb, 0x23, 0xfe, 0x0e, 0xf5, 0x54, 0xed, 0x7c, 0x87,
-	0x5a, 0x1b, 0x35, 0xda, 0x87, 0x00, 0x3b, 0x23, 0x56, 0xf5, 0xa2, 0x04, 0xa7, 0x6d, 0x8a, 0x72,
-	0x1e, 0x17, 0xf5, 0x40, 0xc7, 0x79, 0x5c, 0x5c, 0xa1, 0xd5, 0xa8, 0xc9, 0xed, 0x98, 0xa7, 0xf9,
-	0x9b, 0x01, 0xa7, 0x53, 0x51, 0x61, 0x51, 0x1e, 0xc3, 0xd1, 0xee, 0xa2, 0x48, 0x6c, 0x03, 0xfb,
-	0xa8, 0x0a, 0xe9, 0xaa, 0x0a, 0x27, 0xb7, 0x13, 0x12, 0x99, 0xda, 0x33, 0x11, 0x8d, 0xaa, 0x2d,
-	0x93, 0xe7, 0x06, 0x3e, 0x83, 0x9b, 0xb4, 0x5e, 0x69, 0xd6, 0xa9, 0x60, 0xb7, 0x56, 0x9b, 0xae,
-	0xd8, 0x8c, 0x0a, 0xfb, 0x0e, 0x64, 0x6a, 0xd4, 0x73, 0x22, 0xcc, 0x85, 0x44, 0xcc, 0x77, 0xa8,
-	0xe7, 0xdc, 0xa4, 0xa1, 0xc3, 0x6d, 0x6d, 0x2c, 0xfb, 0xa8, 0xec, 0xd3, 0xd0, 0xc9, 0xf5, 0x4f,
-	0x0c, 0xc8, 0x3e, 0x52, 0x82, 0x1c, 0xd2, 0x0e, 0xa3, 0x4e, 0x6e, 0x40, 0x29, 0xd5, 0x9a, 0x4c,
-	0xc0, 0x08, 0x77, 0x1b, 0xf2, 0x60, 0x35, 0x72, 0xe4, 0xf3, 0xce, 0xd8, 0x71, 0x95, 0x39, 0x09,
-	0xd9, 0x56, 0x7c, 0x19, 0xb8, 0x22, 0x17, 0x0a, 0x4e, 0xd6, 0xd6, 0x82, 0xf9, 0xbb, 0x01, 0x07,
-	0x23, 0xd8, 0xbc, 0x59, 0x17, 0xf2, 0x0d, 0x4a, 0x20, 0x25, 0xd7, 0x73, 0xd8, 0x86, 0x6a, 0x85,
-	0x8c, 0x9d, 0x95, 0x9a, 0xbb, 0x52, 0x21, 0x81, 0x48, 0x01, 0xd1, 0xa9, 0xb5, 0xd4, 0xad, 0xbb,
-	0x1e, 0x57, 0xe3, 0x27, 0x63, 0xab, 0xb5, 0xd4, 0x09, 0x97, 0x45, 0xa8, 0xd4, 0x9a, 0x1c, 0x87,
-	0xa1, 0xba, 0xcf, 0x39, 0xe3, 0xb9, 0x8c, 0xd2, 0xa2, 0x24, 0xf5, 0x4c, 0x41, 0xc8, 0x0d, 0xe9,
-	0x71, 0xa1, 0x25, 0x09, 0x45, 0xb8, 0xac, 0x84, 0x7b, 0xc3, 0xfa, 0x45, 0x0b, 0x17, 0xcb, 0x2c,
-	0x13, 0x12, 0xbe, 0xa0, 0xf5, 0xdc, 0x01, 0xfd, 0xe2, 0x94, 0x60, 0xbe, 0x32, 0x70, 0xc8, 0x74,
-	0xdd, 0x0a, 0x36, 0xd6, 0x55, 0x18, 0x0e, 0x55, 0xaa, 0xd1, 0xc5, 0x4c, 0x26, 0x5e, 0x4c, 0xbc,
-	0x28, 0x76, 0xe4, 0xd1, 0x59, 0xf3, 0xfe, 0xae, 0x9a, 0x4b, 0x54, 0x5c, 0xd0, 0x2a, 0x53, 0xd5,
-	0xc8, 0xda, 0x5a, 0x20, 0xa7, 0x60, 0xc4, 0x69, 0x86, 0xca, 0xa4, 0xd4, 0xe0, 0x38, 0x8a, 0x21,
-	0x52, 0xdd, 0xe7, 0xc4, 0x84, 0x43, 0xea, 0xfe, 0x4b, 0x01, 0x0b, 0x4b, 0x9c, 0x55, 0x54, 0x89,
-	0xb2, 0xf6, 0x88, 0x52, 0xae, 0xb0, 0xf0, 0x01, 0xab, 0x98, 0xc7, 0x90, 0x42, 0x7c, 0xcc, 0x42,
-	0xee, 0xfa, 0x5e, 0xf4, 0xce, 0x5d, 0x38, 0x78, 0x53, 0x62, 0x47, 0xb5, 0x2c, 0xbd, 0x17, 0xfb,
-	0xa0, 0xcb, 0xb5, 0xfc, 0x34, 0xad, 0xe9, 0x6d, 0xfc, 0x8c, 0x44, 0x22, 0x99, 0x85, 0xd1, 0x8a,
-	0xac, 0x8b, 0xc7, 0x9b, 0xbc, 0x14, 0xd9, 0x48, 0xec, 0x83, 0xf6, 0x91, 0xd6, 0x06, 0x86, 0x36,
-	0x57, 0x21, 0xbb, 0xb2, 0xd6, 0x90, 0xd3, 0xb8, 0xc9, 0x65, 0xcc, 0x1a, 0xa3, 0x75, 0x51, 0xdb,
-	0xc4, 0x89, 0x19, 0x89, 0x29, 0xa7, 0xe5, 0xe1, 0x00, 0xf3, 0x9c, 0xc0, 0x77, 0x3d, 0x81, 0x05,
-	0x6a, 0xc9, 0xb2, 0x72, 0x2c, 0x0c, 0xfd, 0x10, 0xab, 0xa3, 0x05, 0xf3, 0x53, 0x03, 0xc6, 0xda,
-	0xb3, 0xc6, 0x7b, 0x5c, 0x84, 0x8c, 0xba, 0x32, 0x1c, 0x94, 0xc9, 0xb7, 0x18, 0x2f, 0x8c, 0xad,
-	0xed, 0xc9, 0x1c, 0x0c, 0x04, 0x6b, 0x0d, 0x7c, 0xf9, 0xc9, 0xaf, 0xb2, 0x95, 0xa4, 0x2d, 0x4d,
-	0x17, 0x3e, 0x3f, 0x02, 0x19, 0x85, 0x81, 0x7c, 0x61, 0xc0, 0x90, 0x26, 0x63, 0x64, 0x2a, 0xd1,
-	0xb3, 0x9b, 0xf9, 0xe5, 0xa7, 0xf7, 0x36, 0xd4, 0x29, 0x99, 0xb3, 0x9f, 0xfd, 0xf1, 0xf7, 0xb3,
-	0xfe, 0x33, 0xe4, 0xb4, 0x55, 0xae, 0xfb, 0x95, 0xa7, 0x97, 0x16, 0xac, 0xdd, 0xf9, 0x28, 0xf9,
-	0xd2, 0x80, 0x41, 0xf9, 0x21, 0x25, 0x67, 0x76, 0x8f, 0x1f, 0x63, 0x85, 0xf9, 0xb3, 0x7b, 0x99,
-	0x21, 0x88, 0x8b, 0x0a, 0xc4, 0x05, 0x32, 0x9b, 0x0a, 0x42, 0x7e, 0x95, 0xad, 0x2d, 0xfc, 0xcc,
-	0x6f, 0x93, 0x6f, 0x0c, 0xc8, 0xb6, 0x38, 0x21, 0x99, 0xd9, 0xfd, 0xa8, 0x4e, 0x46, 0x99, 0x9f,
-	0xed, 0xc9, 0x16, 0xb1, 0x59, 0x0a, 0xdb, 0x39, 0x32, 0x95, 0x8a, 0xad, 0xee, 0x72, 0x51, 0x52,
-	0x5c, 0x90, 0x7c, 0x6f, 0xc0, 0x48, 0x8c, 0x39, 0x92, 0xf3, 0x29, 0x77, 0xd1, 0x45, 0x51, 0xf3,
-	0x17, 0x7a, 0xb4, 0x46, 0x74, 0x37, 0x14, 0xba, 0x77, 0xc9, 0x72, 0xfa, 0xf5, 0x69, 0x56, 0xa7,
-	0xf0, 0x59, 0x5b, 0xed, 0x1c, 0x6f, 0x9b, 0xfc, 0x6c, 0xc0, 0xc1, 0x38, 0xb9, 0x24, 0x29, 0x18,
-	0x12, 0x08, 0x6e, 0xbe, 0xd8, 0xab, 0x39, 0x62, 0xbe, 0xaf, 0x30, 0xdf, 0x26, 0xb7, 0xd2, 0x2b,
-	0x2a, 0x5d, 0x4b, 0xc8, 0x66, 0x77, 0xae, 0xbd, 0x1b, 0xfe, 0x73, 0x03, 0xb2, 0x2d, 0x76, 0x97,
-	0xd6, 0x07, 0x9d, 0x44, 0x38, 0xad, 0x0f, 0xba, 0xf8, 0xa9, 0x79, 0x45, 0xa1, 0xbe, 0x48, 0xe6,
-	0xf7, 0xec, 0x51, 0xcd, 0x25, 0x63, 0x9d, 0xfa, 0x93, 0x01, 0x87, 0x3b, 0xf8, 0x27, 0x99, 0xeb,
-	0xe1, 0xec, 0x36, 0x8a, 0x9b, 0x9f, 0xdf, 0x87, 0x07, 0x62, 0xbe, 0xa6, 0x30, 0x2f, 0x93, 0xa5,
-	0x1e, 0x31, 0x97, 0x02, 0xe5, 0x1f, 0x83, 0xfe, 0xa3, 0x01, 0x87, 0xda, 0xe8, 0x2b, 0x49, 0xb9,
-	0xed, 0x24, 0x92, 0x9c, 0xb7, 0x7a, 0xb6, 0xdf, 0x57, 0x4b, 0xbb, 0x5c, 0xf2, 0xee, 0x16, 0x5f,
-	0xb6, 0xb6, 0x62, 0x4c, 0x7c, 0x9b, 0xfc, 0x6a, 0xc0, 0x58, 0x12, 0xff, 0x25, 0x97, 0x52, 0x8a,
-	0xb8, 0x3b, 0xd3, 0xce, 0x5f, 0xde, 0xaf, 0x1b, 0xe6, 0xf2, 0x81, 0xca, 0xe5, 0x0a, 0x59, 0x4c,
-	0xcd, 0xa5, 0x9b, 0x74, 0x5a, 0x5b, 0x8a, 0xcb, 0x6f, 0x93, 0x5f, 0x0c, 0x38, 0x9e, 0xcc, 0x5a,
-	0xc9, 0x62, 0xfa, 0x14, 0xdb, 0x95, 0x7d, 0xe7, 0x97, 0xf6, 0xef, 0x88, 0xe9, 0x2c, 0xa9, 0x74,
-	0x16, 0xc8, 0xdc, 0x3e, 0xd3, 0xe1, 0xe4, 0x5b, 0x03, 0x0e, 0x77, 0xb0, 0xa3, 0xb4, 0x27, 0x90,
-	0x4c, 0x6f, 0xd3, 0x9e, 0xc0, 0x2e, 0xd4, 0xcb, 0x2c, 0x2a, 0xc8, 0xd3, 0xcb, 0xc6, 0x8c, 0x99,
-	0xfe, 0x89, 0x43, 0x02, 0xf8, 0x95, 0x01, 0xc3, 0x11, 0xab, 0x49, 0xf9, 0x8a, 0xb6, 0xf3, 0xa1,
-	0xfc, 0xb9, 0x1e, 0x2c, 0x11, 0xd0, 0x79, 0x05, 0xe8, 0x2c, 0x79, 0x3b, 0x15, 0x0d, 0x92, 0x97,
-	0x1b, 0xb7, 0x5e, 0xbc, 0x2e, 0x18, 0x2f, 0x5f, 0x17, 0x8c, 0xbf, 0x5e, 0x17, 0x8c, 0xaf, 0xdf,
-	0x14, 0xfa, 0x5e, 0xbe, 0x29, 0xf4, 0xbd, 0x7a, 0x53, 0xe8, 0x7b, 0x32, 0x5b, 0x75, 0x45, 0xad,
-	0x59, 0x2e, 0x56, 0xfc, 0x46, 0x52, 0xa4, 0x0d, 0x8c, 0x25, 0x36, 0x03, 0xc6, 0xcb, 0x43, 0xea,
-	0x6f, 0xa2, 0x8b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xbe, 0x59, 0x1b, 0x16, 0x13, 0x00,
-	0x00,
+func (m *QueryCalculateEquityRequest) Reset()         { *m = QueryCalculateEquityRequest{} }
+func (m *QueryCalculateEquityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalculateEquityRequest) ProtoMessage()    {}
+func (*QueryCalculateEquityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{30}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Game Queries a list of Game items.
-	Game(ctx context.Context, in *QueryGameRequest, opts ...grpc.CallOption) (*QueryGameResponse, error)
-	// ListGames Queries a list of ListGames items.
-	ListGames(ctx context.Context, in *QueryListGamesRequest, opts ...grpc.CallOption) (*QueryListGamesResponse, error)
-	// PlayerGames Queries a list of PlayerGames items.
-	PlayerGames(ctx context.Context, in *QueryPlayerGamesRequest, opts ...grpc.CallOption) (*QueryPlayerGamesResponse, error)
-	// LegalActions Queries a list of LegalActions items.
-	LegalActions(ctx context.Context, in *QueryLegalActionsRequest, opts ...grpc.CallOption) (*QueryLegalActionsResponse, error)
-	// GameState Queries the detailed game state for a game (authenticated, shows your cards).
-	GameState(ctx context.Context, in *QueryGameStateRequest, opts ...grpc.CallOption) (*QueryGameStateResponse, error)
-	// GameStatePublic Queries the public game state (unauthenticated, all cards masked).
-	GameStatePublic(ctx context.Context, in *QueryGameStatePublicRequest, opts ...grpc.CallOption) (*QueryGameStatePublicResponse, error)
-	// IsTxProcessed checks if an Ethereum transaction hash has been processed
-	IsTxProcessed(ctx context.Context, in *QueryIsTxProcessedRequest, opts ...grpc.CallOption) (*QueryIsTxProcessedResponse, error)
-	// GetWithdrawalRequest queries a specific withdrawal request by nonce
-	GetWithdrawalRequest(ctx context.Context, in *QueryGetWithdrawalRequestRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalRequestResponse, error)
-	// ListWithdrawalRequests queries all withdrawal requests (optionally filtered by cosmos_address)
-	ListWithdrawalRequests(ctx context.Context, in *QueryListWithdrawalRequestsRequest, opts ...grpc.CallOption) (*QueryListWithdrawalRequestsResponse, error)
-	// CalculateEquity calculates hand equity using Monte Carlo simulation
-	CalculateEquity(ctx context.Context, in *QueryCalculateEquityRequest, opts ...grpc.CallOption) (*QueryCalculateEquityResponse, error)
-	// Version returns chain version info and PVM health status
-	Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error)
+func (m *QueryCalculateEquityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryCalculateEquityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalculateEquityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryCalculateEquityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalculateEquityRequest.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryCalculateEquityRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Game(ctx context.Context, in *QueryGameRequest, opts ...grpc.CallOption) (*QueryGameResponse, error) {
-	out := new(QueryGameResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/Game", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryCalculateEquityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalculateEquityRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListGames(ctx context.Context, in *QueryListGamesRequest, opts ...grpc.CallOption) (*QueryListGamesResponse, error) {
-	out := new(QueryListGamesResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/ListGames", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryCalculateEquityRequest proto.InternalMessageInfo
 
-func (c *queryClient) PlayerGames(ctx context.Context, in *QueryPlayerGamesRequest, opts ...grpc.CallOption) (*QueryPlayerGamesResponse, error) {
-	out := new(QueryPlayerGamesResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/PlayerGames", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCalculateEquityRequest) GetHands() []*HandCards {
+	if m != nil {
+		return m.Hands
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LegalActions(ctx context.Context, in *QueryLegalActionsRequest, opts ...grpc.CallOption) (*QueryLegalActionsResponse, error) {
-	out := new(QueryLegalActionsResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/LegalActions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCalculateEquityRequest) GetBoard() []string {
+	if m != nil {
+		return m.Board
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GameState(ctx context.Context, in *QueryGameStateRequest, opts ...grpc.CallOption) (*QueryGameStateResponse, error) {
-	out := new(QueryGameStateResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/GameState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCalculateEquityRequest) GetDead() []string {
+	if m != nil {
+		return m.Dead
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GameStatePublic(ctx context.Context, in *QueryGameStatePublicRequest, opts ...grpc.CallOption) (*QueryGameStatePublicResponse, error) {
-	out := new(QueryGameStatePublicResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/GameStatePublic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCalculateEquityRequest) GetSimulations() int32 {
+	if m != nil {
+		return m.Simulations
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) IsTxProcessed(ctx context.Context, in *QueryIsTxProcessedRequest, opts ...grpc.CallOption) (*QueryIsTxProcessedResponse, error) {
-	out := new(QueryIsTxProcessedResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/IsTxProcessed", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// HandCards represents hole cards for a single player
+type HandCards struct {
+	Cards []string `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
 }
 
-func (c *queryClient) GetWithdrawalRequest(ctx context.Context, in *QueryGetWithdrawalRequestRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalRequestResponse, error) {
-	out := new(QueryGetWithdrawalRequestResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/GetWithdrawalRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *HandCards) Reset()         { *m = HandCards{} }
+func (m *HandCards) String() string { return proto.CompactTextString(m) }
+func (*HandCards) ProtoMessage()    {}
+func (*HandCards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{31}
 }
-
-func (c *queryClient) ListWithdrawalRequests(ctx context.Context, in *QueryListWithdrawalRequestsRequest, opts ...grpc.CallOption) (*QueryListWithdrawalRequestsResponse, error) {
-	out := new(QueryListWithdrawalRequestsResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/ListWithdrawalRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *HandCards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) CalculateEquity(ctx context.Context, in *QueryCalculateEquityRequest, opts ...grpc.CallOption) (*QueryCalculateEquityResponse, error) {
-	out := new(QueryCalculateEquityResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/CalculateEquity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HandCards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandCards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error) {
-	out := new(QueryVersionResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/Version", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *HandCards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandCards.Merge(m, src)
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Game Queries a list of Game items.
-	Game(context.Context, *QueryGameRequest) (*QueryGameResponse, error)
-	// ListGames Queries a list of ListGames items.
-	ListGames(context.Context, *QueryListGamesRequest) (*QueryListGamesResponse, error)
-	// PlayerGames Queries a list of PlayerGames items.
-	PlayerGames(context.Context, *QueryPlayerGamesRequest) (*QueryPlayerGamesResponse, error)
-	// LegalActions Queries a list of LegalActions items.
-	LegalActions(context.Context, *QueryLegalActionsRequest) (*QueryLegalActionsResponse, error)
-	// GameState Queries the detailed game state for a game (authenticated, shows your cards).
-	GameState(context.Context, *QueryGameStateRequest) (*QueryGameStateResponse, error)
-	// GameStatePublic Queries the public game state (unauthenticated, all cards masked).
-	GameStatePublic(context.Context, *QueryGameStatePublicRequest) (*QueryGameStatePublicResponse, error)
-	// IsTxProcessed checks if an Ethereum transaction hash has been processed
-	IsTxProcessed(context.Context, *QueryIsTxProcessedRequest) (*QueryIsTxProcessedResponse, error)
-	// GetWithdrawalRequest queries a specific withdrawal request by nonce
-	GetWithdrawalRequest(context.Context, *QueryGetWithdrawalRequestRequest) (*QueryGetWithdrawalRequestResponse, error)
-	// ListWithdrawalRequests queries all withdrawal requests (optionally filtered by cosmos_address)
-	ListWithdrawalRequests(context.Context, *QueryListWithdrawalRequestsRequest) (*QueryListWithdrawalRequestsResponse, error)
-	// CalculateEquity calculates hand equity using Monte Carlo simulation
-	CalculateEquity(context.Context, *QueryCalculateEquityRequest) (*QueryCalculateEquityResponse, error)
-	// Version returns chain version info and PVM health status
-	Version(context.Context, *QueryVersionRequest) (*QueryVersionResponse, error)
+func (m *HandCards) XXX_Size() int {
+	return m.Size()
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *HandCards) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandCards.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) Game(ctx context.Context, req *QueryGameRequest) (*QueryGameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Game not implemented")
-}
-func (*UnimplementedQueryServer) ListGames(ctx context.Context, req *QueryListGamesRequest) (*QueryListGamesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
-}
-func (*UnimplementedQueryServer) PlayerGames(ctx context.Context, req *QueryPlayerGamesRequest) (*QueryPlayerGamesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlayerGames not implemented")
-}
-func (*UnimplementedQueryServer) LegalActions(ctx context.Context, req *QueryLegalActionsRequest) (*QueryLegalActionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LegalActions not implemented")
+var xxx_messageInfo_HandCards proto.InternalMessageInfo
+
+func (m *HandCards) GetCards() []string {
+	if m != nil {
+		return m.Cards
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GameState(ctx context.Context, req *QueryGameStateRequest) (*QueryGameStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GameState not implemented")
+
+// EquityResult represents the equity calculation result for a single hand
+type EquityResult struct {
+	HandIndex int32    `protobuf:"varint,1,opt,name=hand_index,json=handIndex,proto3" json:"hand_index,omitempty"`
+	Hand      []string `protobuf:"bytes,2,rep,name=hand,proto3" json:"hand,omitempty"`
+	Wins      int32    `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Ties      int32    `protobuf:"varint,4,opt,name=ties,proto3" json:"ties,omitempty"`
+	Losses    int32    `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
+	Equity    string   `protobuf:"bytes,6,opt,name=equity,proto3" json:"equity,omitempty"`
+	TieEquity string   `protobuf:"bytes,7,opt,name=tie_equity,json=tieEquity,proto3" json:"tie_equity,omitempty"`
+	Total     string   `protobuf:"bytes,8,opt,name=total,proto3" json:"total,omitempty"`
 }
-func (*UnimplementedQueryServer) GameStatePublic(ctx context.Context, req *QueryGameStatePublicRequest) (*QueryGameStatePublicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GameStatePublic not implemented")
+
+func (m *EquityResult) Reset()         { *m = EquityResult{} }
+func (m *EquityResult) String() string { return proto.CompactTextString(m) }
+func (*EquityResult) ProtoMessage()    {}
+func (*EquityResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{32}
 }
-func (*UnimplementedQueryServer) IsTxProcessed(ctx context.Context, req *QueryIsTxProcessedRequest) (*QueryIsTxProcessedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsTxProcessed not implemented")
+func (m *EquityResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetWithdrawalRequest(ctx context.Context, req *QueryGetWithdrawalRequestRequest) (*QueryGetWithdrawalRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalRequest not implemented")
+func (m *EquityResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EquityResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListWithdrawalRequests(ctx context.Context, req *QueryListWithdrawalRequestsRequest) (*QueryListWithdrawalRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListWithdrawalRequests not implemented")
+func (m *EquityResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquityResult.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CalculateEquity(ctx context.Context, req *QueryCalculateEquityRequest) (*QueryCalculateEquityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateEquity not implemented")
+func (m *EquityResult) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Version(ctx context.Context, req *QueryVersionRequest) (*QueryVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+func (m *EquityResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquityResult.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_EquityResult proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *EquityResult) GetHandIndex() int32 {
+	if m != nil {
+		return m.HandIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Game_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Game(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/Game",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Game(ctx, req.(*QueryGameRequest))
+func (m *EquityResult) GetHand() []string {
+	if m != nil {
+		return m.Hand
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListGamesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListGames(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/ListGames",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListGames(ctx, req.(*QueryListGamesRequest))
+func (m *EquityResult) GetWins() int32 {
+	if m != nil {
+		return m.Wins
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PlayerGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlayerGamesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PlayerGames(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/PlayerGames",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PlayerGames(ctx, req.(*QueryPlayerGamesRequest))
+func (m *EquityResult) GetTies() int32 {
+	if m != nil {
+		return m.Ties
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LegalActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLegalActionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LegalActions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/LegalActions",
+func (m *EquityResult) GetLosses() int32 {
+	if m != nil {
+		return m.Losses
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LegalActions(ctx, req.(*QueryLegalActionsRequest))
+	return 0
+}
+
+func (m *EquityResult) GetEquity() string {
+	if m != nil {
+		return m.Equity
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GameState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGameStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GameState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/GameState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GameState(ctx, req.(*QueryGameStateRequest))
+func (m *EquityResult) GetTieEquity() string {
+	if m != nil {
+		return m.TieEquity
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GameStatePublic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGameStatePublicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GameStatePublic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/GameStatePublic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GameStatePublic(ctx, req.(*QueryGameStatePublicRequest))
+func (m *EquityResult) GetTotal() string {
+	if m != nil {
+		return m.Total
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_IsTxProcessed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsTxProcessedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsTxProcessed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/IsTxProcessed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsTxProcessed(ctx, req.(*QueryIsTxProcessedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryCalculateEquityResponse defines the response for calculating hand equity
+type QueryCalculateEquityResponse struct {
+	Results     []*EquityResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Simulations int32           `protobuf:"varint,2,opt,name=simulations,proto3" json:"simulations,omitempty"`
+	Stage       string          `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	DurationMs  string          `protobuf:"bytes,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	HandsPerSec string          `protobuf:"bytes,5,opt,name=hands_per_sec,json=handsPerSec,proto3" json:"hands_per_sec,omitempty"`
 }
 
-func _Query_GetWithdrawalRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetWithdrawalRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWithdrawalRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/GetWithdrawalRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWithdrawalRequest(ctx, req.(*QueryGetWithdrawalRequestRequest))
+func (m *QueryCalculateEquityResponse) Reset()         { *m = QueryCalculateEquityResponse{} }
+func (m *QueryCalculateEquityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalculateEquityResponse) ProtoMessage()    {}
+func (*QueryCalculateEquityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{33}
+}
+func (m *QueryCalculateEquityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCalculateEquityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalculateEquityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCalculateEquityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalculateEquityResponse.Merge(m, src)
+}
+func (m *QueryCalculateEquityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalculateEquityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalculateEquityResponse.DiscardUnknown(m)
 }
 
-func _Query_ListWithdrawalRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListWithdrawalRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListWithdrawalRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/ListWithdrawalRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListWithdrawalRequests(ctx, req.(*QueryListWithdrawalRequestsRequest))
+var xxx_messageInfo_QueryCalculateEquityResponse proto.InternalMessageInfo
+
+func (m *QueryCalculateEquityResponse) GetResults() []*EquityResult {
+	if m != nil {
+		return m.Results
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_CalculateEquity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCalculateEquityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalculateEquity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/CalculateEquity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalculateEquity(ctx, req.(*QueryCalculateEquityRequest))
+func (m *QueryCalculateEquityResponse) GetSimulations() int32 {
+	if m != nil {
+		return m.Simulations
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Version(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Query/Version",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Version(ctx, req.(*QueryVersionRequest))
+func (m *QueryCalculateEquityResponse) GetStage() string {
+	if m != nil {
+		return m.Stage
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pokerchain.poker.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Game",
-			Handler:    _Query_Game_Handler,
-		},
-		{
-			MethodName: "ListGames",
-			Handler:    _Query_ListGames_Handler,
-		},
-		{
-			MethodName: "PlayerGames",
-			Handler:    _Query_PlayerGames_Handler,
-		},
-		{
-			MethodName: "LegalActions",
-			Handler:    _Query_LegalActions_Handler,
-		},
-		{
-			MethodName: "GameState",
-			Handler:    _Query_GameState_Handler,
-		},
-		{
-			MethodName: "GameStatePublic",
-			Handler:    _Query_GameStatePublic_Handler,
-		},
-		{
-			MethodName: "IsTxProcessed",
-			Handler:    _Query_IsTxProcessed_Handler,
-		},
-		{
-			MethodName: "GetWithdrawalRequest",
-			Handler:    _Query_GetWithdrawalRequest_Handler,
-		},
-		{
-			MethodName: "ListWithdrawalRequests",
-			Handler:    _Query_ListWithdrawalRequests_Handler,
-		},
-		{
-			MethodName: "CalculateEquity",
-			Handler:    _Query_CalculateEquity_Handler,
-		},
-		{
-			MethodName: "Version",
-			Handler:    _Query_Version_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pokerchain/poker/v1/query.proto",
+func (m *QueryCalculateEquityResponse) GetDurationMs() string {
+	if m != nil {
+		return m.DurationMs
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCalculateEquityResponse) GetHandsPerSec() string {
+	if m != nil {
+		return m.HandsPerSec
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVersionRequest defines the request for getting version info
+type QueryVersionRequest struct {
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryVersionRequest) Reset()         { *m = QueryVersionRequest{} }
+func (m *QueryVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVersionRequest) ProtoMessage()    {}
+func (*QueryVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{34}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVersionRequest.Merge(m, src)
+}
+func (m *QueryVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVersionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVersionRequest proto.InternalMessageInfo
+
+// ChainVersion contains chain version information
+type ChainVersion struct {
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version          string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ConsensusVersion uint64 `protobuf:"varint,3,opt,name=consensus_version,json=consensusVersion,proto3" json:"consensus_version,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *ChainVersion) Reset()         { *m = ChainVersion{} }
+func (m *ChainVersion) String() string { return proto.CompactTextString(m) }
+func (*ChainVersion) ProtoMessage()    {}
+func (*ChainVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{35}
+}
+func (m *ChainVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ChainVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainVersion.Merge(m, src)
 }
-
-func (m *QueryGameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChainVersion) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GameId) > 0 {
-		i -= len(m.GameId)
-		copy(dAtA[i:], m.GameId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ChainVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainVersion.DiscardUnknown(m)
 }
 
-func (m *QueryGameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_ChainVersion proto.InternalMessageInfo
 
-func (m *QueryGameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChainVersion) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *QueryGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Game) > 0 {
-		i -= len(m.Game)
-		copy(dAtA[i:], m.Game)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Game)))
-		i--
-		dAtA[i] = 0xa
+func (m *ChainVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryListGamesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChainVersion) GetConsensusVersion() uint64 {
+	if m != nil {
+		return m.ConsensusVersion
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListGamesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PvmStatus contains PVM health and version information
+type PvmStatus struct {
+	Healthy  bool   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Version  string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Error    string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *QueryListGamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *PvmStatus) Reset()         { *m = PvmStatus{} }
+func (m *PvmStatus) String() string { return proto.CompactTextString(m) }
+func (*PvmStatus) ProtoMessage()    {}
+func (*PvmStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{36}
 }
-
-func (m *QueryListGamesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PvmStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PvmStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PvmStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryListGamesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PvmStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PvmStatus.Merge(m, src)
 }
-
-func (m *QueryListGamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Games) > 0 {
-		i -= len(m.Games)
-		copy(dAtA[i:], m.Games)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Games)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PvmStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PvmStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PvmStatus.DiscardUnknown(m)
 }
 
-func (m *QueryPlayerGamesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PvmStatus proto.InternalMessageInfo
+
+func (m *PvmStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryPlayerGamesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PvmStatus) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
 }
 
-func (m *QueryPlayerGamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PlayerAddress) > 0 {
-		i -= len(m.PlayerAddress)
-		copy(dAtA[i:], m.PlayerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlayerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *PvmStatus) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryPlayerGamesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PvmStatus) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPlayerGamesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVersionResponse defines the response for getting version info
+type QueryVersionResponse struct {
+	Chain *ChainVersion `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Pvm   *PvmStatus    `protobuf:"bytes,2,opt,name=pvm,proto3" json:"pvm,omitempty"`
 }
 
-func (m *QueryPlayerGamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Games) > 0 {
-		i -= len(m.Games)
-		copy(dAtA[i:], m.Games)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Games)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryVersionResponse) Reset()         { *m = QueryVersionResponse{} }
+func (m *QueryVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVersionResponse) ProtoMessage()    {}
+func (*QueryVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{37}
 }
-
-func (m *QueryLegalActionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryLegalActionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVersionResponse.Merge(m, src)
+}
+func (m *QueryVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVersionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLegalActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PlayerAddress) > 0 {
-		i -= len(m.PlayerAddress)
-		copy(dAtA[i:], m.PlayerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlayerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GameId) > 0 {
-		i -= len(m.GameId)
-		copy(dAtA[i:], m.GameId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryVersionResponse proto.InternalMessageInfo
+
+func (m *QueryVersionResponse) GetChain() *ChainVersion {
+	if m != nil {
+		return m.Chain
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryLegalActionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVersionResponse) GetPvm() *PvmStatus {
+	if m != nil {
+		return m.Pvm
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLegalActionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryWaitlistPositionRequest defines the request for a player's waitlist position
+type QueryWaitlistPositionRequest struct {
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
 }
 
-func (m *QueryLegalActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Actions) > 0 {
-		i -= len(m.Actions)
-		copy(dAtA[i:], m.Actions)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Actions)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryWaitlistPositionRequest) Reset()         { *m = QueryWaitlistPositionRequest{} }
+func (m *QueryWaitlistPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWaitlistPositionRequest) ProtoMessage()    {}
+func (*QueryWaitlistPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{38}
 }
-
-func (m *QueryGameStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWaitlistPositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWaitlistPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWaitlistPositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGameStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWaitlistPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWaitlistPositionRequest.Merge(m, src)
+}
+func (m *QueryWaitlistPositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWaitlistPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWaitlistPositionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGameStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.PlayerAddress) > 0 {
-		i -= len(m.PlayerAddress)
-		copy(dAtA[i:], m.PlayerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlayerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GameId) > 0 {
-		i -= len(m.GameId)
-		copy(dAtA[i:], m.GameId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryWaitlistPositionRequest proto.InternalMessageInfo
+
+func (m *QueryWaitlistPositionRequest) GetGameId() string {
+	if m != nil {
+		return m.GameId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGameStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWaitlistPositionRequest) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGameStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryWaitlistPositionResponse defines the response for a player's waitlist
+// position. A player holding a seat reservation has position 0 and the
+// reserved seat; a player who is neither waiting nor holding one has
+// position 0 and seat 0.
+type QueryWaitlistPositionResponse struct {
+	Position      uint64 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	WaitlistSize  uint64 `protobuf:"varint,2,opt,name=waitlist_size,json=waitlistSize,proto3" json:"waitlist_size,omitempty"`
+	ReservedSeat  uint64 `protobuf:"varint,3,opt,name=reserved_seat,json=reservedSeat,proto3" json:"reserved_seat,omitempty"`
+	ReservedUntil int64  `protobuf:"varint,4,opt,name=reserved_until,json=reservedUntil,proto3" json:"reserved_until,omitempty"`
 }
 
-func (m *QueryGameStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GameState) > 0 {
-		i -= len(m.GameState)
-		copy(dAtA[i:], m.GameState)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameState)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryWaitlistPositionResponse) Reset()         { *m = QueryWaitlistPositionResponse{} }
+func (m *QueryWaitlistPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWaitlistPositionResponse) ProtoMessage()    {}
+func (*QueryWaitlistPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d94828f419f7527, []int{39}
 }
-
-func (m *QueryGameStatePublicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWaitlistPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWaitlistPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWaitlistPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryWaitlistPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWaitlistPositionResponse.Merge(m, src)
+}
+func (m *QueryWaitlistPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWaitlistPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWaitlistPositionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGameStatePublicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryWaitlistPositionResponse proto.InternalMessageInfo
+
+func (m *QueryWaitlistPositionResponse) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
 }
 
-func (m *QueryGameStatePublicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GameId) > 0 {
-		i -= len(m.GameId)
-		copy(dAtA[i:], m.GameId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryWaitlistPositionResponse) GetWaitlistSize() uint64 {
+	if m != nil {
+		return m.WaitlistSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGameStatePublicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWaitlistPositionResponse) GetReservedSeat() uint64 {
+	if m != nil {
+		return m.ReservedSeat
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGameStatePublicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWaitlistPositionResponse) GetReservedUntil() int64 {
+	if m != nil {
+		return m.ReservedUntil
+	}
+	return 0
 }
 
-func (m *QueryGameStatePublicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GameState) > 0 {
-		i -= len(m.GameState)
-		copy(dAtA[i:], m.GameState)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameState)))
-		i--
-		dAtA[i] = 0xa
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pokerchain.poker.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pokerchain.poker.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGameRequest)(nil), "pokerchain.poker.v1.QueryGameRequest")
+	proto.RegisterType((*QueryGameResponse)(nil), "pokerchain.poker.v1.QueryGameResponse")
+	proto.RegisterType((*QueryListGamesRequest)(nil), "pokerchain.poker.v1.QueryListGamesRequest")
+	proto.RegisterType((*QueryListGamesResponse)(nil), "pokerchain.poker.v1.QueryListGamesResponse")
+	proto.RegisterType((*QueryPlayerGamesRequest)(nil), "pokerchain.poker.v1.QueryPlayerGamesRequest")
+	proto.RegisterType((*QueryPlayerGamesResponse)(nil), "pokerchain.poker.v1.QueryPlayerGamesResponse")
+	proto.RegisterType((*QueryLegalActionsRequest)(nil), "pokerchain.poker.v1.QueryLegalActionsRequest")
+	proto.RegisterType((*QueryLegalActionsResponse)(nil), "pokerchain.poker.v1.QueryLegalActionsResponse")
+	proto.RegisterType((*QueryGameStateRequest)(nil), "pokerchain.poker.v1.QueryGameStateRequest")
+	proto.RegisterType((*QueryGameStateResponse)(nil), "pokerchain.poker.v1.QueryGameStateResponse")
+	proto.RegisterType((*QueryGameStatePublicRequest)(nil), "pokerchain.poker.v1.QueryGameStatePublicRequest")
+	proto.RegisterType((*QueryGameStatePublicResponse)(nil), "pokerchain.poker.v1.QueryGameStatePublicResponse")
+	proto.RegisterType((*QueryGameStateSpectatorRequest)(nil), "pokerchain.poker.v1.QueryGameStateSpectatorRequest")
+	proto.RegisterType((*QueryGameStateSpectatorResponse)(nil), "pokerchain.poker.v1.QueryGameStateSpectatorResponse")
+	proto.RegisterType((*QueryIsTxProcessedRequest)(nil), "pokerchain.poker.v1.QueryIsTxProcessedRequest")
+	proto.RegisterType((*QueryIsTxProcessedResponse)(nil), "pokerchain.poker.v1.QueryIsTxProcessedResponse")
+	proto.RegisterType((*QueryEthHeaderTipRequest)(nil), "pokerchain.poker.v1.QueryEthHeaderTipRequest")
+	proto.RegisterType((*QueryEthHeaderTipResponse)(nil), "pokerchain.poker.v1.QueryEthHeaderTipResponse")
+	proto.RegisterType((*QueryEthHeaderRequest)(nil), "pokerchain.poker.v1.QueryEthHeaderRequest")
+	proto.RegisterType((*QueryEthHeaderResponse)(nil), "pokerchain.poker.v1.QueryEthHeaderResponse")
+	proto.RegisterType((*QueryBridgeStatusRequest)(nil), "pokerchain.poker.v1.QueryBridgeStatusRequest")
+	proto.RegisterType((*QueryBridgeStatusResponse)(nil), "pokerchain.poker.v1.QueryBridgeStatusResponse")
+	proto.RegisterType((*QueryEstimateWithdrawalFeeRequest)(nil), "pokerchain.poker.v1.QueryEstimateWithdrawalFeeRequest")
+	proto.RegisterType((*QueryEstimateWithdrawalFeeResponse)(nil), "pokerchain.poker.v1.QueryEstimateWithdrawalFeeResponse")
+	proto.RegisterType((*QueryGetWithdrawalRequestRequest)(nil), "pokerchain.poker.v1.QueryGetWithdrawalRequestRequest")
+	proto.RegisterType((*QueryGetWithdrawalRequestResponse)(nil), "pokerchain.poker.v1.QueryGetWithdrawalRequestResponse")
+	proto.RegisterType((*QueryListWithdrawalRequestsRequest)(nil), "pokerchain.poker.v1.QueryListWithdrawalRequestsRequest")
+	proto.RegisterType((*QueryListWithdrawalRequestsResponse)(nil), "pokerchain.poker.v1.QueryListWithdrawalRequestsResponse")
+	proto.RegisterType((*QueryCalculateEquityRequest)(nil), "pokerchain.poker.v1.QueryCalculateEquityRequest")
+	proto.RegisterType((*HandCards)(nil), "pokerchain.poker.v1.HandCards")
+	proto.RegisterType((*EquityResult)(nil), "pokerchain.poker.v1.EquityResult")
+	proto.RegisterType((*QueryCalculateEquityResponse)(nil), "pokerchain.poker.v1.QueryCalculateEquityResponse")
+	proto.RegisterType((*QueryVersionRequest)(nil), "pokerchain.poker.v1.QueryVersionRequest")
+	proto.RegisterType((*ChainVersion)(nil), "pokerchain.poker.v1.ChainVersion")
+	proto.RegisterType((*PvmStatus)(nil), "pokerchain.poker.v1.PvmStatus")
+	proto.RegisterType((*QueryVersionResponse)(nil), "pokerchain.poker.v1.QueryVersionResponse")
+	proto.RegisterType((*QueryWaitlistPositionRequest)(nil), "pokerchain.poker.v1.QueryWaitlistPositionRequest")
+	proto.RegisterType((*QueryWaitlistPositionResponse)(nil), "pokerchain.poker.v1.QueryWaitlistPositionResponse")
+}
+
+func init() { proto.RegisterFile("pokerchain/poker/v1/query.proto", fileDescriptor_9d94828f419f7527) }
+
+var fileDescriptor_9d94828f419f7527 = []byte{
+	// 2257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x5a, 0xa2, 0x64, 0x3e, 0xc9, 0xb5, 0x3c, 0x96, 0x65, 0x7a, 0xa3, 0xd0, 0xd2, 0xba,
+	0xfe, 0x92, 0x6c, 0xae, 0x25, 0xdb, 0x92, 0x6c, 0xc7, 0x4d, 0x24, 0x55, 0xb1, 0x0d, 0x38, 0xa8,
+	0xba, 0x92, 0x6b, 0x20, 0x17, 0x62, 0xc8, 0x1d, 0x93, 0x8b, 0x90, 0xbb, 0xf4, 0xce, 0x50, 0x1f,
+	0x16, 0x74, 0x68, 0x4f, 0x45, 0xd1, 0x43, 0x81, 0xa0, 0xd7, 0x06, 0xe9, 0xa1, 0x28, 0x8a, 0xa2,
+	0xe8, 0xa1, 0x87, 0x1e, 0x7a, 0x4c, 0xdb, 0x9c, 0xd2, 0x00, 0xbd, 0xe4, 0x54, 0x14, 0x76, 0x81,
+	0xfe, 0x1b, 0xc5, 0xcc, 0xbc, 0x25, 0x97, 0xe4, 0x6a, 0x45, 0xa2, 0xb9, 0x10, 0x3b, 0x6f, 0xde,
+	0x6f, 0xe6, 0xf7, 0xde, 0xbc, 0xf9, 0x78, 0x8f, 0x70, 0xa9, 0x11, 0x7c, 0xc2, 0xc2, 0x72, 0x95,
+	0x7a, 0xbe, 0xad, 0x3e, 0xed, 0x9d, 0x05, 0xfb, 0x55, 0x93, 0x85, 0xfb, 0x85, 0x46, 0x18, 0x88,
+	0x80, 0x9c, 0x6b, 0x2b, 0x14, 0xd4, 0x67, 0x61, 0x67, 0xc1, 0x3c, 0x4b, 0xeb, 0x9e, 0x1f, 0xd8,
+	0xea, 0x57, 0xeb, 0x99, 0x73, 0xe5, 0x80, 0xd7, 0x03, 0x6e, 0x97, 0x28, 0x67, 0x7a, 0x00, 0x7b,
+	0x67, 0xa1, 0xc4, 0x04, 0x5d, 0xb0, 0x1b, 0xb4, 0xe2, 0xf9, 0x54, 0x78, 0x81, 0x8f, 0xba, 0x93,
+	0x95, 0xa0, 0x12, 0xa8, 0x4f, 0x5b, 0x7e, 0xa1, 0x74, 0xba, 0x12, 0x04, 0x95, 0x1a, 0xb3, 0x69,
+	0xc3, 0xb3, 0xa9, 0xef, 0x07, 0x42, 0x41, 0x38, 0xf6, 0xce, 0x24, 0x11, 0x6d, 0xd0, 0x90, 0xd6,
+	0x23, 0x8d, 0xd9, 0x24, 0x8d, 0x0a, 0xf3, 0x19, 0xf7, 0x50, 0xc5, 0x9a, 0x04, 0xf2, 0x43, 0x49,
+	0x6d, 0x53, 0xe1, 0x1c, 0xf6, 0xaa, 0xc9, 0xb8, 0xb0, 0x9e, 0xc3, 0xb9, 0x0e, 0x29, 0x6f, 0x04,
+	0x3e, 0x67, 0xe4, 0x7b, 0x30, 0xa2, 0xc7, 0xcf, 0x19, 0x33, 0xc6, 0xf5, 0xb1, 0xc5, 0x77, 0x0a,
+	0x09, 0xae, 0x28, 0x68, 0xd0, 0x5a, 0xf6, 0xcb, 0x7f, 0x5d, 0x3a, 0xf1, 0xdb, 0xff, 0xfe, 0x71,
+	0xce, 0x70, 0x10, 0x65, 0xcd, 0xc3, 0x84, 0x1a, 0xf6, 0x31, 0xad, 0x33, 0x9c, 0x8a, 0x5c, 0x80,
+	0xd1, 0x0a, 0xad, 0xb3, 0xa2, 0xe7, 0xaa, 0x41, 0xb3, 0xce, 0x88, 0x6c, 0x3e, 0x75, 0xad, 0x6b,
+	0x70, 0x36, 0xa6, 0x8c, 0x0c, 0x08, 0x0c, 0xcb, 0x6e, 0x54, 0x55, 0xdf, 0xd6, 0x2d, 0x38, 0xaf,
+	0x14, 0x9f, 0x79, 0x5c, 0x48, 0xe5, 0xc8, 0x0a, 0x32, 0x09, 0x19, 0x97, 0xf9, 0x41, 0x1d, 0xb5,
+	0x75, 0xc3, 0x2a, 0xc0, 0x54, 0xb7, 0x3a, 0x0e, 0x3e, 0x09, 0x19, 0x39, 0x20, 0x8f, 0xf4, 0x55,
+	0xc3, 0xfa, 0x00, 0x2e, 0x68, 0x5f, 0xd4, 0xe8, 0x3e, 0x0b, 0x3b, 0x26, 0xb8, 0x02, 0xdf, 0x69,
+	0x28, 0x69, 0x91, 0xba, 0x6e, 0xc8, 0x78, 0x84, 0x3c, 0xad, 0xa5, 0xab, 0x5a, 0x68, 0xdd, 0x86,
+	0x5c, 0xef, 0x08, 0xa9, 0x73, 0x7e, 0x8c, 0x88, 0x67, 0xac, 0x42, 0x6b, 0xab, 0x65, 0xb5, 0xea,
+	0xc7, 0x39, 0x2c, 0x81, 0xcd, 0xc9, 0x24, 0x36, 0xf7, 0xe0, 0x62, 0xc2, 0xd8, 0x48, 0x27, 0x07,
+	0xa3, 0x54, 0x8b, 0x70, 0xf0, 0xa8, 0x69, 0x7d, 0x6a, 0xa0, 0x9b, 0x25, 0xff, 0x2d, 0x41, 0x05,
+	0xfb, 0x96, 0x08, 0x91, 0x69, 0xc8, 0x0a, 0xaf, 0xce, 0xb8, 0xa0, 0xf5, 0x46, 0x6e, 0x68, 0xc6,
+	0xb8, 0x3e, 0xe4, 0xb4, 0x05, 0xb2, 0x97, 0x7b, 0x15, 0x9f, 0x8a, 0x66, 0xc8, 0x72, 0xc3, 0x0a,
+	0xdf, 0x16, 0x58, 0xcb, 0x30, 0xd5, 0x4d, 0x0a, 0x2d, 0x79, 0x17, 0x40, 0xb1, 0xe2, 0x52, 0x8a,
+	0xc4, 0xb2, 0x95, 0x48, 0xcd, 0x5a, 0x82, 0x77, 0x3a, 0x81, 0x9b, 0xcd, 0x52, 0xcd, 0x2b, 0x1f,
+	0x1b, 0x95, 0x8f, 0x60, 0x3a, 0x19, 0xd7, 0xdf, 0xb4, 0xf7, 0x21, 0xdf, 0x09, 0xdf, 0x6a, 0xb0,
+	0xb2, 0xa0, 0x22, 0x08, 0x8f, 0x9d, 0xf9, 0x77, 0x06, 0x5c, 0x3a, 0x12, 0xdb, 0xd7, 0xec, 0x24,
+	0x0f, 0xc0, 0x23, 0x4c, 0xb4, 0x18, 0x31, 0x09, 0xb9, 0x0c, 0xa7, 0x5d, 0x56, 0xa3, 0xfb, 0xc5,
+	0x28, 0x06, 0xe4, 0x6a, 0x0c, 0x3b, 0xe3, 0x4a, 0x88, 0xa1, 0xd2, 0x56, 0xe2, 0xac, 0x1c, 0xf8,
+	0x2e, 0xcf, 0x0d, 0xc7, 0x94, 0xb6, 0xb4, 0xcc, 0x7a, 0x88, 0x41, 0xf6, 0x94, 0x6f, 0xef, 0x6d,
+	0x86, 0x41, 0x99, 0x71, 0xce, 0xdc, 0xc8, 0xc4, 0x3c, 0x8c, 0x31, 0x51, 0x2d, 0x8a, 0xbd, 0x62,
+	0x95, 0xf2, 0x6a, 0x44, 0x93, 0x89, 0xea, 0xf6, 0xde, 0x13, 0xca, 0xab, 0xd6, 0x03, 0x30, 0x93,
+	0xc0, 0x68, 0xe3, 0x34, 0x64, 0x1b, 0x91, 0x50, 0x61, 0x4f, 0x39, 0x6d, 0x81, 0x65, 0xe2, 0xce,
+	0xd9, 0x10, 0xd5, 0x27, 0x8c, 0xba, 0x2c, 0xdc, 0xf6, 0x1a, 0xd1, 0xa9, 0xb6, 0x05, 0x17, 0x13,
+	0xfa, 0x70, 0xd8, 0x25, 0x18, 0xa9, 0x2a, 0x21, 0x9e, 0x6d, 0xf9, 0xc4, 0xb3, 0xad, 0x05, 0x75,
+	0x50, 0xdb, 0xb2, 0x71, 0x5b, 0xb4, 0x7b, 0xd0, 0xca, 0x29, 0x18, 0xf1, 0x9b, 0xf5, 0x12, 0x0e,
+	0x38, 0xec, 0x60, 0xcb, 0xda, 0x84, 0xa9, 0x6e, 0xc0, 0xff, 0x49, 0xe1, 0x2e, 0xda, 0xbc, 0x16,
+	0x7a, 0x6e, 0x45, 0x2d, 0x75, 0xb3, 0x75, 0x5a, 0xc8, 0x0d, 0xdd, 0x71, 0x36, 0x45, 0x4d, 0xeb,
+	0xf3, 0x61, 0xb8, 0x98, 0x00, 0x43, 0x2e, 0xab, 0x90, 0x69, 0xd0, 0x26, 0x67, 0x48, 0xe5, 0x4a,
+	0x22, 0x15, 0x8d, 0xdc, 0x94, 0x7a, 0x12, 0xce, 0xd6, 0x86, 0xe5, 0x99, 0xef, 0x68, 0x24, 0x99,
+	0x87, 0xb3, 0xbb, 0x9e, 0xa8, 0xba, 0x21, 0xdd, 0xa5, 0xb5, 0xe2, 0xae, 0xe7, 0xbb, 0xc1, 0xae,
+	0x0a, 0xba, 0x61, 0x67, 0xa2, 0xdd, 0xf1, 0x42, 0xc9, 0xc9, 0x12, 0x5c, 0xa8, 0xd4, 0x82, 0x92,
+	0x52, 0x6c, 0x61, 0x6a, 0x5e, 0xdd, 0x13, 0x18, 0x84, 0xe7, 0x75, 0xf7, 0x8b, 0x56, 0xef, 0x33,
+	0xd9, 0x49, 0x6e, 0xc0, 0x44, 0x17, 0xce, 0xc7, 0x80, 0x3c, 0xd3, 0x09, 0xf0, 0x63, 0xaa, 0x21,
+	0xab, 0x53, 0xcf, 0xf7, 0xfc, 0x4a, 0x2e, 0x13, 0x57, 0x75, 0x22, 0x31, 0x59, 0x81, 0x1c, 0xba,
+	0xa9, 0x97, 0xce, 0x88, 0x82, 0x4c, 0x61, 0x7f, 0x37, 0x9f, 0x79, 0x38, 0xdb, 0x8d, 0xf4, 0x73,
+	0xa3, 0xda, 0xe8, 0x2e, 0x88, 0x1f, 0x57, 0x6e, 0x53, 0x3a, 0xd5, 0xa1, 0xdc, 0xe6, 0xf4, 0x1e,
+	0x98, 0x35, 0x1a, 0x56, 0x58, 0x9c, 0x91, 0xa8, 0x86, 0x8c, 0x57, 0x83, 0x9a, 0x9b, 0xcb, 0x2a,
+	0x54, 0x4e, 0x69, 0xb4, 0x39, 0x6d, 0x47, 0xfd, 0xe4, 0x2e, 0x4c, 0xf5, 0xa0, 0xd5, 0x8e, 0xcd,
+	0x81, 0x42, 0x4e, 0x76, 0x21, 0xbf, 0x2f, 0xfb, 0xac, 0x87, 0x30, 0xab, 0x63, 0x95, 0x0b, 0xaf,
+	0x4e, 0x45, 0xac, 0xff, 0x43, 0xc6, 0x62, 0x81, 0x4e, 0xeb, 0x41, 0xd3, 0x17, 0x51, 0xa0, 0xeb,
+	0x96, 0xb5, 0x07, 0x56, 0x1a, 0x18, 0x03, 0x6d, 0x02, 0x86, 0x5e, 0x32, 0x86, 0x50, 0xf9, 0x29,
+	0x0f, 0x31, 0x9f, 0x89, 0x22, 0x8e, 0xa9, 0x03, 0x26, 0xeb, 0x33, 0xb1, 0xaa, 0x04, 0x64, 0x16,
+	0xc6, 0x5f, 0x32, 0x56, 0x14, 0x21, 0xa3, 0xbc, 0x19, 0xee, 0xab, 0xf0, 0xc8, 0x3a, 0x63, 0x2f,
+	0x19, 0xdb, 0x46, 0x91, 0xb5, 0x02, 0x33, 0xfa, 0xa4, 0x64, 0xa2, 0x3d, 0x29, 0xd2, 0x8d, 0x3d,
+	0x0e, 0xfc, 0xc0, 0x2f, 0x47, 0xa7, 0xa4, 0x6e, 0x58, 0xaf, 0x61, 0x36, 0x05, 0x89, 0x94, 0x9f,
+	0x03, 0x89, 0x79, 0x31, 0xd4, 0xbd, 0xb8, 0x51, 0xae, 0x26, 0x6e, 0x94, 0xde, 0xb1, 0xce, 0xee,
+	0x76, 0x8b, 0xe4, 0x0d, 0x6b, 0xb5, 0x5e, 0x26, 0x3d, 0x88, 0xf8, 0xa3, 0x43, 0x3f, 0x2c, 0xbb,
+	0x1f, 0x1d, 0x5a, 0x1a, 0xdd, 0xaa, 0x1f, 0x02, 0xb4, 0x5f, 0x99, 0xb9, 0x93, 0x48, 0x4e, 0xeb,
+	0x14, 0xe4, 0x93, 0xb4, 0xa0, 0xdf, 0xb4, 0xf8, 0x24, 0x2d, 0x6c, 0xd2, 0x4a, 0xb4, 0xa2, 0x4e,
+	0x0c, 0x69, 0xfd, 0xdd, 0x80, 0xcb, 0xa9, 0xac, 0xd0, 0x29, 0x2f, 0xe0, 0x5c, 0xaf, 0x53, 0x24,
+	0xb7, 0xa1, 0x01, 0xbc, 0x42, 0x7a, 0xbc, 0xc2, 0xc9, 0xe3, 0x04, 0x43, 0xae, 0x1d, 0x6b, 0x88,
+	0x66, 0xd5, 0x61, 0xc9, 0x67, 0x06, 0xde, 0xf9, 0xeb, 0xb4, 0x56, 0x6e, 0xd6, 0xa8, 0x60, 0x1b,
+	0xaf, 0x9a, 0x9e, 0xd8, 0x8f, 0x1c, 0x7b, 0x17, 0x32, 0x55, 0xea, 0xbb, 0x11, 0xe7, 0xe4, 0xd3,
+	0xf7, 0x09, 0xf5, 0xdd, 0x75, 0x1a, 0xba, 0xdc, 0xd1, 0xca, 0x32, 0x8e, 0x4a, 0x01, 0x0d, 0xdd,
+	0xdc, 0xc9, 0x99, 0x21, 0x19, 0x47, 0xaa, 0x21, 0xdf, 0xa9, 0x2e, 0xa3, 0x6e, 0x6e, 0x48, 0x09,
+	0xd5, 0x37, 0x99, 0x81, 0x31, 0xee, 0xd5, 0xe5, 0xc4, 0xea, 0x6e, 0x95, 0xa7, 0x54, 0xc6, 0x89,
+	0x8b, 0xac, 0x59, 0xc8, 0xb6, 0xc6, 0x97, 0x03, 0x97, 0x69, 0x88, 0x74, 0xb2, 0x8e, 0x6e, 0x58,
+	0x5f, 0x19, 0x30, 0x1e, 0xd1, 0xe6, 0xcd, 0x9a, 0x90, 0xbb, 0x45, 0x12, 0x29, 0x7a, 0xbe, 0xcb,
+	0xf6, 0x54, 0x28, 0x64, 0x9c, 0xac, 0x94, 0x3c, 0x95, 0x02, 0x49, 0x44, 0x36, 0x90, 0x9d, 0xfa,
+	0x96, 0xb2, 0x5d, 0x0f, 0x6f, 0xf7, 0x8c, 0xa3, 0xbe, 0xa5, 0x4c, 0x78, 0x2c, 0x62, 0xa5, 0xbe,
+	0xe5, 0xc6, 0xae, 0x05, 0x9c, 0x33, 0xae, 0x8e, 0xc9, 0x8c, 0x83, 0x2d, 0x29, 0x67, 0x8a, 0x82,
+	0x3a, 0x0b, 0xb3, 0x0e, 0xb6, 0x24, 0x15, 0xe1, 0xb1, 0x22, 0xf6, 0x8d, 0xea, 0x6b, 0x5d, 0x78,
+	0xe8, 0x66, 0x69, 0x90, 0x08, 0x04, 0xad, 0xa9, 0x13, 0x2e, 0xeb, 0xe8, 0x86, 0xf5, 0x8d, 0x01,
+	0xd3, 0xc9, 0xab, 0x82, 0x81, 0xf5, 0x10, 0x46, 0x43, 0x65, 0x6a, 0xb4, 0x30, 0xb3, 0xc9, 0xd7,
+	0x62, 0xcc, 0x29, 0x4e, 0x84, 0xe8, 0xf6, 0xf9, 0xc9, 0x1e, 0x9f, 0x4b, 0x56, 0x5c, 0xd0, 0x0a,
+	0xc3, 0x73, 0x44, 0x37, 0xc8, 0x25, 0x18, 0x73, 0x9b, 0xa1, 0x52, 0x29, 0xd6, 0x39, 0xbe, 0x3b,
+	0x21, 0x12, 0x7d, 0xc4, 0x89, 0x05, 0xa7, 0xd5, 0xfa, 0x17, 0x1b, 0x2c, 0x94, 0x2f, 0x21, 0xe5,
+	0xa2, 0xac, 0x33, 0xa6, 0x84, 0x9b, 0x2c, 0xdc, 0x62, 0x65, 0xeb, 0x3c, 0x66, 0x51, 0x3f, 0x62,
+	0x21, 0xf7, 0x02, 0x3f, 0xda, 0xe7, 0x1e, 0x8c, 0xaf, 0x4b, 0xee, 0x28, 0x96, 0xae, 0xf7, 0x63,
+	0x39, 0x8d, 0xfc, 0x96, 0xd7, 0xf6, 0x8e, 0xee, 0xc6, 0x67, 0x5a, 0xd4, 0x94, 0x77, 0x46, 0x59,
+	0xfa, 0xc5, 0xe7, 0x4d, 0x5e, 0x8c, 0x74, 0xf4, 0x15, 0x39, 0xd1, 0xea, 0xc0, 0xa1, 0xad, 0x57,
+	0x90, 0xdd, 0xdc, 0xa9, 0xeb, 0xab, 0x5d, 0x8e, 0x59, 0x65, 0xb4, 0x26, 0xaa, 0xfb, 0xf8, 0x6c,
+	0x8a, 0x9a, 0x29, 0xb3, 0x99, 0x70, 0x8a, 0xf9, 0x6e, 0x23, 0xf0, 0x7c, 0x81, 0x0e, 0x6a, 0xb5,
+	0xa5, 0xe7, 0x58, 0x18, 0x06, 0x21, 0x7a, 0x47, 0x37, 0xac, 0x1f, 0x1b, 0x30, 0xd9, 0x69, 0x35,
+	0xae, 0xe3, 0x32, 0x64, 0xd4, 0x92, 0xe1, 0x41, 0x99, 0xbc, 0x8a, 0x71, 0xc7, 0x38, 0x5a, 0x9f,
+	0xdc, 0x86, 0xa1, 0xc6, 0x4e, 0x1d, 0x77, 0x7e, 0xf2, 0xae, 0x6c, 0x19, 0xe9, 0x48, 0x55, 0xeb,
+	0x07, 0x18, 0x52, 0x2f, 0xa8, 0x27, 0x6a, 0x1e, 0x17, 0x9b, 0x01, 0xf7, 0x44, 0x7b, 0x05, 0x8e,
+	0xce, 0x58, 0xa6, 0x60, 0x44, 0xe7, 0x26, 0xe8, 0x07, 0x6c, 0x59, 0xbf, 0x37, 0xe0, 0xdd, 0x23,
+	0x46, 0x44, 0xeb, 0x4c, 0x38, 0xd5, 0x40, 0x19, 0xde, 0x65, 0xad, 0xb6, 0x7c, 0x31, 0xef, 0x22,
+	0xae, 0xc8, 0xbd, 0xd7, 0x0c, 0xef, 0xb4, 0xf1, 0x48, 0xb8, 0xe5, 0xbd, 0x66, 0x52, 0x29, 0x64,
+	0x9c, 0x85, 0x3b, 0xcc, 0x2d, 0x72, 0x46, 0xa3, 0x67, 0xcf, 0x78, 0x24, 0xdc, 0x62, 0x54, 0x9d,
+	0xfd, 0x2d, 0xa5, 0xa6, 0x2f, 0xbc, 0x9a, 0xf2, 0xfd, 0x90, 0xd3, 0x82, 0x3e, 0x97, 0xc2, 0xc5,
+	0xaf, 0x72, 0x90, 0x51, 0x74, 0xc9, 0x4f, 0x0d, 0x18, 0xd1, 0xf9, 0x38, 0xb9, 0x96, 0xe8, 0xb9,
+	0xde, 0xe4, 0xdf, 0xbc, 0x7e, 0xbc, 0xa2, 0x36, 0xda, 0x9a, 0xff, 0xc9, 0x3f, 0xff, 0xf3, 0xe9,
+	0xc9, 0x2b, 0xe4, 0xb2, 0x5d, 0xaa, 0x05, 0xe5, 0x4f, 0xee, 0x2d, 0xda, 0x47, 0x97, 0x24, 0xc8,
+	0xcf, 0x0c, 0x18, 0x96, 0xa9, 0x0b, 0xb9, 0x72, 0xf4, 0xf8, 0xb1, 0xc2, 0x80, 0x79, 0xf5, 0x38,
+	0x35, 0x24, 0x71, 0x47, 0x91, 0xb8, 0x45, 0xe6, 0x53, 0x49, 0xc8, 0x05, 0xb6, 0x0f, 0x70, 0xd5,
+	0x0f, 0xc9, 0x2f, 0x0d, 0xc8, 0xb6, 0x0a, 0x00, 0x64, 0xee, 0xe8, 0xa9, 0xba, 0x8b, 0x0a, 0xe6,
+	0x7c, 0x5f, 0xba, 0xc8, 0xcd, 0x56, 0xdc, 0x6e, 0x90, 0x6b, 0xa9, 0xdc, 0x54, 0x60, 0x54, 0x14,
+	0x93, 0x3f, 0x18, 0x30, 0x16, 0x2b, 0x13, 0x90, 0x9b, 0x29, 0x6b, 0xd1, 0x53, 0x8f, 0x30, 0x6f,
+	0xf5, 0xa9, 0x8d, 0xec, 0xd6, 0x14, 0xbb, 0xf7, 0xc8, 0x83, 0xf4, 0xe5, 0xd3, 0x29, 0xbc, 0xe2,
+	0x67, 0x1f, 0x74, 0x26, 0xf4, 0x87, 0xe4, 0x2f, 0x06, 0x8c, 0xc7, 0x2b, 0x09, 0x24, 0x85, 0x43,
+	0x42, 0x35, 0xc3, 0x2c, 0xf4, 0xab, 0x8e, 0x9c, 0x3f, 0x52, 0x9c, 0x1f, 0x93, 0x8d, 0x74, 0x8f,
+	0x4a, 0x68, 0x94, 0xc5, 0xb6, 0x97, 0xbd, 0x97, 0xfe, 0x67, 0x06, 0x64, 0x5b, 0xf9, 0x74, 0x5a,
+	0x1c, 0x74, 0x57, 0x3d, 0xcc, 0xf9, 0xbe, 0x74, 0x91, 0xf5, 0x7d, 0xc5, 0xfa, 0x0e, 0x59, 0x38,
+	0x36, 0x46, 0x75, 0xea, 0x1e, 0x8b, 0xd4, 0x3f, 0x1b, 0x70, 0xa6, 0xab, 0xd8, 0x40, 0x6e, 0xf7,
+	0x31, 0x77, 0x47, 0x3d, 0xc3, 0x5c, 0x18, 0x00, 0x81, 0x9c, 0x3f, 0x50, 0x9c, 0x1f, 0x90, 0x95,
+	0x3e, 0x39, 0x17, 0x1b, 0x0a, 0x1f, 0xa3, 0xfe, 0x85, 0x01, 0xa4, 0xb7, 0x58, 0x41, 0xee, 0xf4,
+	0xc1, 0xa5, 0xbb, 0x2c, 0x62, 0xde, 0x1d, 0x0c, 0x84, 0x36, 0xac, 0x2b, 0x1b, 0x1e, 0x91, 0x87,
+	0xfd, 0xda, 0xd0, 0x2a, 0x86, 0xc4, 0xcc, 0xf8, 0x93, 0x01, 0xa7, 0x3b, 0x4a, 0x11, 0x24, 0x25,
+	0x68, 0x93, 0x0a, 0x1e, 0xa6, 0xdd, 0xb7, 0xfe, 0x40, 0x3b, 0xd3, 0xe3, 0xb2, 0x86, 0xd2, 0xaa,
+	0x7d, 0xd8, 0x07, 0xb1, 0xaa, 0xca, 0x21, 0xf9, 0xb5, 0x7c, 0x29, 0xc6, 0x2a, 0x1d, 0x69, 0x3b,
+	0x33, 0xa1, 0x5a, 0x62, 0x16, 0xfa, 0x55, 0x1f, 0xe8, 0x1c, 0x96, 0x14, 0x75, 0xd9, 0xa2, 0x28,
+	0xbc, 0x06, 0xf9, 0x95, 0x01, 0xd9, 0xd6, 0x68, 0x69, 0xfb, 0xaf, 0xbb, 0xbc, 0x62, 0xce, 0xf7,
+	0xa5, 0x8b, 0xdc, 0x56, 0x14, 0xb7, 0x45, 0x72, 0xbb, 0x4f, 0x6e, 0xf6, 0x81, 0x2e, 0xd6, 0x1c,
+	0x92, 0xcf, 0x0d, 0x18, 0x8f, 0x17, 0x48, 0xd2, 0xbc, 0x98, 0x50, 0x7f, 0x31, 0x0b, 0xfd, 0xaa,
+	0x23, 0xd3, 0x45, 0xc5, 0xf4, 0x26, 0x99, 0x4b, 0x65, 0x5a, 0x52, 0x50, 0x15, 0xb3, 0x4d, 0x4e,
+	0xfe, 0x61, 0xc0, 0xf9, 0xc4, 0x24, 0x9b, 0x2c, 0xa5, 0x38, 0x29, 0x25, 0xa5, 0x37, 0x97, 0x07,
+	0xc6, 0x21, 0xfd, 0x0d, 0x45, 0xff, 0x7d, 0xf2, 0x28, 0xdd, 0xd1, 0x38, 0x46, 0xbc, 0x18, 0xf1,
+	0x92, 0x31, 0xfb, 0x40, 0xe7, 0xfc, 0x87, 0xe4, 0x6f, 0x06, 0x4c, 0x26, 0xa5, 0xe0, 0xe4, 0x5e,
+	0xca, 0x31, 0x70, 0x74, 0xb2, 0x6f, 0x2e, 0x0d, 0x0a, 0x43, 0x73, 0xde, 0x57, 0xe6, 0xdc, 0x27,
+	0xcb, 0xa9, 0xe6, 0xf4, 0xe6, 0xbd, 0xf6, 0x81, 0x2a, 0x27, 0x1c, 0x92, 0xbf, 0x1a, 0x30, 0x95,
+	0x9c, 0x38, 0x93, 0xe5, 0xf4, 0x87, 0xc4, 0x91, 0x05, 0x00, 0x73, 0x65, 0x70, 0xe0, 0x40, 0xdb,
+	0xa0, 0xd7, 0x1c, 0x4e, 0x7e, 0x63, 0xc0, 0x99, 0xae, 0x04, 0x2d, 0xed, 0x16, 0x4a, 0xce, 0xb0,
+	0xcd, 0x85, 0x01, 0x10, 0x48, 0xb9, 0xa0, 0x28, 0x5f, 0x7f, 0x60, 0xcc, 0x59, 0xe9, 0xaf, 0x4c,
+	0xcc, 0x41, 0xbf, 0x30, 0x60, 0xa2, 0xfb, 0x91, 0x4e, 0x52, 0xe6, 0x3d, 0x22, 0x45, 0x30, 0x17,
+	0x07, 0x81, 0x20, 0xd7, 0xa7, 0x8a, 0xeb, 0x3a, 0x59, 0x4d, 0x77, 0x6f, 0x94, 0x0a, 0x44, 0xf9,
+	0x41, 0xef, 0xfb, 0xe4, 0x90, 0xfc, 0xdc, 0x80, 0xd1, 0x28, 0x3f, 0x4c, 0x79, 0x8f, 0x77, 0x66,
+	0x96, 0xe6, 0x8d, 0x3e, 0x34, 0x91, 0xeb, 0x4d, 0xc5, 0xf5, 0x2a, 0xf9, 0x6e, 0x2a, 0x57, 0x4c,
+	0x03, 0xd7, 0x36, 0xbe, 0x7c, 0x93, 0x37, 0xbe, 0x7e, 0x93, 0x37, 0xfe, 0xfd, 0x26, 0x6f, 0xfc,
+	0xe2, 0x6d, 0xfe, 0xc4, 0xd7, 0x6f, 0xf3, 0x27, 0xbe, 0x79, 0x9b, 0x3f, 0xf1, 0xf1, 0x7c, 0xc5,
+	0x13, 0xd5, 0x66, 0xa9, 0x50, 0x0e, 0xea, 0x49, 0x23, 0xed, 0xe1, 0x58, 0x62, 0xbf, 0xc1, 0x78,
+	0x69, 0x44, 0xfd, 0xe7, 0x78, 0xe7, 0x7f, 0x03, 0x00, 0x8f, 0xc6, 0x6b, 0x4b, 0x63, 0x1d, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Game Queries a list of Game items.
+	Game(ctx context.Context, in *QueryGameRequest, opts ...grpc.CallOption) (*QueryGameResponse, error)
+	// ListGames Queries a list of ListGames items.
+	ListGames(ctx context.Context, in *QueryListGamesRequest, opts ...grpc.CallOption) (*QueryListGamesResponse, error)
+	// PlayerGames Queries a list of PlayerGames items.
+	PlayerGames(ctx context.Context, in *QueryPlayerGamesRequest, opts ...grpc.CallOption) (*QueryPlayerGamesResponse, error)
+	// LegalActions Queries a list of LegalActions items.
+	LegalActions(ctx context.Context, in *QueryLegalActionsRequest, opts ...grpc.CallOption) (*QueryLegalActionsResponse, error)
+	// GameState Queries the detailed game state for a game (authenticated, shows your cards).
+	GameState(ctx context.Context, in *QueryGameStateRequest, opts ...grpc.CallOption) (*QueryGameStateResponse, error)
+	// GameStatePublic Queries the public game state (unauthenticated, all cards masked).
+	GameStatePublic(ctx context.Context, in *QueryGameStatePublicRequest, opts ...grpc.CallOption) (*QueryGameStatePublicResponse, error)
+	// GameStateSpectator Queries a table's spectator view and its spectator policy.
+	GameStateSpectator(ctx context.Context, in *QueryGameStateSpectatorRequest, opts ...grpc.CallOption) (*QueryGameStateSpectatorResponse, error)
+	// IsTxProcessed checks if an Ethereum transaction hash has been processed
+	IsTxProcessed(ctx context.Context, in *QueryIsTxProcessedRequest, opts ...grpc.CallOption) (*QueryIsTxProcessedResponse, error)
+	// EthHeaderTip queries the latest tracked source chain header
+	EthHeaderTip(ctx context.Context, in *QueryEthHeaderTipRequest, opts ...grpc.CallOption) (*QueryEthHeaderTipResponse, error)
+	// EthHeader queries a tracked source chain header by block number
+	EthHeader(ctx context.Context, in *QueryEthHeaderRequest, opts ...grpc.CallOption) (*QueryEthHeaderResponse, error)
+	// BridgeStatus queries the bridge pause state and the remaining withdrawal headroom
+	BridgeStatus(ctx context.Context, in *QueryBridgeStatusRequest, opts ...grpc.CallOption) (*QueryBridgeStatusResponse, error)
+	// EstimateWithdrawalFee queries the fee and net payout of a withdrawal amount
+	EstimateWithdrawalFee(ctx context.Context, in *QueryEstimateWithdrawalFeeRequest, opts ...grpc.CallOption) (*QueryEstimateWithdrawalFeeResponse, error)
+	// GetWithdrawalRequest queries a specific withdrawal request by nonce
+	GetWithdrawalRequest(ctx context.Context, in *QueryGetWithdrawalRequestRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalRequestResponse, error)
+	// ListWithdrawalRequests queries all withdrawal requests (optionally filtered by cosmos_address)
+	ListWithdrawalRequests(ctx context.Context, in *QueryListWithdrawalRequestsRequest, opts ...grpc.CallOption) (*QueryListWithdrawalRequestsResponse, error)
+	// CalculateEquity calculates hand equity using Monte Carlo simulation
+	CalculateEquity(ctx context.Context, in *QueryCalculateEquityRequest, opts ...grpc.CallOption) (*QueryCalculateEquityResponse, error)
+	// WaitlistPosition queries a player's place in a table's seat waitlist
+	WaitlistPosition(ctx context.Context, in *QueryWaitlistPositionRequest, opts ...grpc.CallOption) (*QueryWaitlistPositionResponse, error)
+	// Version returns chain version info and PVM health status
+	Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryIsTxProcessedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Game(ctx context.Context, in *QueryGameRequest, opts ...grpc.CallOption) (*QueryGameResponse, error) {
+	out := new(QueryGameResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/Game", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryIsTxProcessedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListGames(ctx context.Context, in *QueryListGamesRequest, opts ...grpc.CallOption) (*QueryListGamesResponse, error) {
+	out := new(QueryListGamesResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/ListGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryIsTxProcessedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthTxHash) > 0 {
-		i -= len(m.EthTxHash)
-		copy(dAtA[i:], m.EthTxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthTxHash)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PlayerGames(ctx context.Context, in *QueryPlayerGamesRequest, opts ...grpc.CallOption) (*QueryPlayerGamesResponse, error) {
+	out := new(QueryPlayerGamesResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/PlayerGames", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryIsTxProcessedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LegalActions(ctx context.Context, in *QueryLegalActionsRequest, opts ...grpc.CallOption) (*QueryLegalActionsResponse, error) {
+	out := new(QueryLegalActionsResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/LegalActions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryIsTxProcessedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GameState(ctx context.Context, in *QueryGameStateRequest, opts ...grpc.CallOption) (*QueryGameStateResponse, error) {
+	out := new(QueryGameStateResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/GameState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryIsTxProcessedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Processed {
-		i--
-		if m.Processed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GameStatePublic(ctx context.Context, in *QueryGameStatePublicRequest, opts ...grpc.CallOption) (*QueryGameStatePublicResponse, error) {
+	out := new(QueryGameStatePublicResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/GameStatePublic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetWithdrawalRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GameStateSpectator(ctx context.Context, in *QueryGameStateSpectatorRequest, opts ...grpc.CallOption) (*QueryGameStateSpectatorResponse, error) {
+	out := new(QueryGameStateSpectatorResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/GameStateSpectator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetWithdrawalRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IsTxProcessed(ctx context.Context, in *QueryIsTxProcessedRequest, opts ...grpc.CallOption) (*QueryIsTxProcessedResponse, error) {
+	out := new(QueryIsTxProcessedResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/IsTxProcessed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetWithdrawalRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EthHeaderTip(ctx context.Context, in *QueryEthHeaderTipRequest, opts ...grpc.CallOption) (*QueryEthHeaderTipResponse, error) {
+	out := new(QueryEthHeaderTipResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/EthHeaderTip", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetWithdrawalRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EthHeader(ctx context.Context, in *QueryEthHeaderRequest, opts ...grpc.CallOption) (*QueryEthHeaderResponse, error) {
+	out := new(QueryEthHeaderResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/EthHeader", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetWithdrawalRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BridgeStatus(ctx context.Context, in *QueryBridgeStatusRequest, opts ...grpc.CallOption) (*QueryBridgeStatusResponse, error) {
+	out := new(QueryBridgeStatusResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/BridgeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetWithdrawalRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithdrawalRequest != nil {
-		{
-			size, err := m.WithdrawalRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EstimateWithdrawalFee(ctx context.Context, in *QueryEstimateWithdrawalFeeRequest, opts ...grpc.CallOption) (*QueryEstimateWithdrawalFeeResponse, error) {
+	out := new(QueryEstimateWithdrawalFeeResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/EstimateWithdrawalFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListWithdrawalRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetWithdrawalRequest(ctx context.Context, in *QueryGetWithdrawalRequestRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalRequestResponse, error) {
+	out := new(QueryGetWithdrawalRequestResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/GetWithdrawalRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListWithdrawalRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListWithdrawalRequests(ctx context.Context, in *QueryListWithdrawalRequestsRequest, opts ...grpc.CallOption) (*QueryListWithdrawalRequestsResponse, error) {
+	out := new(QueryListWithdrawalRequestsResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/ListWithdrawalRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListWithdrawalRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) CalculateEquity(ctx context.Context, in *QueryCalculateEquityRequest, opts ...grpc.CallOption) (*QueryCalculateEquityResponse, error) {
+	out := new(QueryCalculateEquityResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/CalculateEquity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CosmosAddress) > 0 {
-		i -= len(m.CosmosAddress)
-		copy(dAtA[i:], m.CosmosAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosAddress)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) WaitlistPosition(ctx context.Context, in *QueryWaitlistPositionRequest, opts ...grpc.CallOption) (*QueryWaitlistPositionResponse, error) {
+	out := new(QueryWaitlistPositionResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/WaitlistPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryListWithdrawalRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error) {
+	out := new(QueryVersionResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Query/Version", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListWithdrawalRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Game Queries a list of Game items.
+	Game(context.Context, *QueryGameRequest) (*QueryGameResponse, error)
+	// ListGames Queries a list of ListGames items.
+	ListGames(context.Context, *QueryListGamesRequest) (*QueryListGamesResponse, error)
+	// PlayerGames Queries a list of PlayerGames items.
+	PlayerGames(context.Context, *QueryPlayerGamesRequest) (*QueryPlayerGamesResponse, error)
+	// LegalActions Queries a list of LegalActions items.
+	LegalActions(context.Context, *QueryLegalActionsRequest) (*QueryLegalActionsResponse, error)
+	// GameState Queries the detailed game state for a game (authenticated, shows your cards).
+	GameState(context.Context, *QueryGameStateRequest) (*QueryGameStateResponse, error)
+	// GameStatePublic Queries the public game state (unauthenticated, all cards masked).
+	GameStatePublic(context.Context, *QueryGameStatePublicRequest) (*QueryGameStatePublicResponse, error)
+	// GameStateSpectator Queries a table's spectator view and its spectator policy.
+	GameStateSpectator(context.Context, *QueryGameStateSpectatorRequest) (*QueryGameStateSpectatorResponse, error)
+	// IsTxProcessed checks if an Ethereum transaction hash has been processed
+	IsTxProcessed(context.Context, *QueryIsTxProcessedRequest) (*QueryIsTxProcessedResponse, error)
+	// EthHeaderTip queries the latest tracked source chain header
+	EthHeaderTip(context.Context, *QueryEthHeaderTipRequest) (*QueryEthHeaderTipResponse, error)
+	// EthHeader queries a tracked source chain header by block number
+	EthHeader(context.Context, *QueryEthHeaderRequest) (*QueryEthHeaderResponse, error)
+	// BridgeStatus queries the bridge pause state and the remaining withdrawal headroom
+	BridgeStatus(context.Context, *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error)
+	// EstimateWithdrawalFee queries the fee and net payout of a withdrawal amount
+	EstimateWithdrawalFee(context.Context, *QueryEstimateWithdrawalFeeRequest) (*QueryEstimateWithdrawalFeeResponse, error)
+	// GetWithdrawalRequest queries a specific withdrawal request by nonce
+	GetWithdrawalRequest(context.Context, *QueryGetWithdrawalRequestRequest) (*QueryGetWithdrawalRequestResponse, error)
+	// ListWithdrawalRequests queries all withdrawal requests (optionally filtered by cosmos_address)
+	ListWithdrawalRequests(context.Context, *QueryListWithdrawalRequestsRequest) (*QueryListWithdrawalRequestsResponse, error)
+	// CalculateEquity calculates hand equity using Monte Carlo simulation
+	CalculateEquity(context.Context, *QueryCalculateEquityRequest) (*QueryCalculateEquityResponse, error)
+	// WaitlistPosition queries a player's place in a table's seat waitlist
+	WaitlistPosition(context.Context, *QueryWaitlistPositionRequest) (*QueryWaitlistPositionResponse, error)
+	// Version returns chain version info and PVM health status
+	Version(context.Context, *QueryVersionRequest) (*QueryVersionResponse, error)
 }
 
-func (m *QueryListWithdrawalRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.WithdrawalRequests) > 0 {
-		for iNdEx := len(m.WithdrawalRequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithdrawalRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryCalculateEquityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Game(ctx context.Context, req *QueryGameRequest) (*QueryGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Game not implemented")
+}
+func (*UnimplementedQueryServer) ListGames(ctx context.Context, req *QueryListGamesRequest) (*QueryListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (*UnimplementedQueryServer) PlayerGames(ctx context.Context, req *QueryPlayerGamesRequest) (*QueryPlayerGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerGames not implemented")
+}
+func (*UnimplementedQueryServer) LegalActions(ctx context.Context, req *QueryLegalActionsRequest) (*QueryLegalActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LegalActions not implemented")
+}
+func (*UnimplementedQueryServer) GameState(ctx context.Context, req *QueryGameStateRequest) (*QueryGameStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GameState not implemented")
+}
+func (*UnimplementedQueryServer) GameStatePublic(ctx context.Context, req *QueryGameStatePublicRequest) (*QueryGameStatePublicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GameStatePublic not implemented")
+}
+func (*UnimplementedQueryServer) GameStateSpectator(ctx context.Context, req *QueryGameStateSpectatorRequest) (*QueryGameStateSpectatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GameStateSpectator not implemented")
+}
+func (*UnimplementedQueryServer) IsTxProcessed(ctx context.Context, req *QueryIsTxProcessedRequest) (*QueryIsTxProcessedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsTxProcessed not implemented")
+}
+func (*UnimplementedQueryServer) EthHeaderTip(ctx context.Context, req *QueryEthHeaderTipRequest) (*QueryEthHeaderTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthHeaderTip not implemented")
+}
+func (*UnimplementedQueryServer) EthHeader(ctx context.Context, req *QueryEthHeaderRequest) (*QueryEthHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthHeader not implemented")
+}
+func (*UnimplementedQueryServer) BridgeStatus(ctx context.Context, req *QueryBridgeStatusRequest) (*QueryBridgeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeStatus not implemented")
+}
+func (*UnimplementedQueryServer) EstimateWithdrawalFee(ctx context.Context, req *QueryEstimateWithdrawalFeeRequest) (*QueryEstimateWithdrawalFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateWithdrawalFee not implemented")
+}
+func (*UnimplementedQueryServer) GetWithdrawalRequest(ctx context.Context, req *QueryGetWithdrawalRequestRequest) (*QueryGetWithdrawalRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalRequest not implemented")
+}
+func (*UnimplementedQueryServer) ListWithdrawalRequests(ctx context.Context, req *QueryListWithdrawalRequestsRequest) (*QueryListWithdrawalRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWithdrawalRequests not implemented")
+}
+func (*UnimplementedQueryServer) CalculateEquity(ctx context.Context, req *QueryCalculateEquityRequest) (*QueryCalculateEquityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateEquity not implemented")
+}
+func (*UnimplementedQueryServer) WaitlistPosition(ctx context.Context, req *QueryWaitlistPositionRequest) (*QueryWaitlistPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitlistPosition not implemented")
+}
+func (*UnimplementedQueryServer) Version(ctx context.Context, req *QueryVersionRequest) (*QueryVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
 
-func (m *QueryCalculateEquityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryCalculateEquityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Simulations != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Simulations))
-		i--
-		dAtA[i] = 0x20
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Dead) > 0 {
-		for iNdEx := len(m.Dead) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Dead[iNdEx])
-			copy(dAtA[i:], m.Dead[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Dead[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if len(m.Board) > 0 {
-		for iNdEx := len(m.Board) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Board[iNdEx])
-			copy(dAtA[i:], m.Board[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Board[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/Params",
 	}
-	if len(m.Hands) > 0 {
-		for iNdEx := len(m.Hands) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Hands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HandCards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Game_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGameRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Game(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/Game",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Game(ctx, req.(*QueryGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HandCards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/ListGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListGames(ctx, req.(*QueryListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HandCards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cards) > 0 {
-		for iNdEx := len(m.Cards) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Cards[iNdEx])
-			copy(dAtA[i:], m.Cards[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Cards[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_PlayerGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlayerGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PlayerGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/PlayerGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlayerGames(ctx, req.(*QueryPlayerGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EquityResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LegalActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLegalActionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EquityResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).LegalActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/LegalActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LegalActions(ctx, req.(*QueryLegalActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EquityResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		i -= len(m.Total)
-		copy(dAtA[i:], m.Total)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Total)))
-		i--
-		dAtA[i] = 0x42
+func _Query_GameState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGameStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TieEquity) > 0 {
-		i -= len(m.TieEquity)
-		copy(dAtA[i:], m.TieEquity)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TieEquity)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(QueryServer).GameState(ctx, in)
 	}
-	if len(m.Equity) > 0 {
-		i -= len(m.Equity)
-		copy(dAtA[i:], m.Equity)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Equity)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/GameState",
 	}
-	if m.Losses != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Losses))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GameState(ctx, req.(*QueryGameStateRequest))
 	}
-	if m.Ties != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Ties))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GameStatePublic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGameStatePublicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Wins != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Wins))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).GameStatePublic(ctx, in)
 	}
-	if len(m.Hand) > 0 {
-		for iNdEx := len(m.Hand) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Hand[iNdEx])
-			copy(dAtA[i:], m.Hand[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Hand[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/GameStatePublic",
 	}
-	if m.HandIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.HandIndex))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GameStatePublic(ctx, req.(*QueryGameStatePublicRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalculateEquityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GameStateSpectator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGameStateSpectatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryCalculateEquityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GameStateSpectator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/GameStateSpectator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GameStateSpectator(ctx, req.(*QueryGameStateSpectatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCalculateEquityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HandsPerSec) > 0 {
-		i -= len(m.HandsPerSec)
-		copy(dAtA[i:], m.HandsPerSec)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.HandsPerSec)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.DurationMs) > 0 {
-		i -= len(m.DurationMs)
-		copy(dAtA[i:], m.DurationMs)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DurationMs)))
-		i--
-		dAtA[i] = 0x22
+func _Query_IsTxProcessed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsTxProcessedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Stage) > 0 {
-		i -= len(m.Stage)
-		copy(dAtA[i:], m.Stage)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Stage)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).IsTxProcessed(ctx, in)
 	}
-	if m.Simulations != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Simulations))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/IsTxProcessed",
 	}
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsTxProcessed(ctx, req.(*QueryIsTxProcessedRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EthHeaderTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEthHeaderTipRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EthHeaderTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/EthHeaderTip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthHeaderTip(ctx, req.(*QueryEthHeaderTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EthHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEthHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/EthHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthHeader(ctx, req.(*QueryEthHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_BridgeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/BridgeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeStatus(ctx, req.(*QueryBridgeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ChainVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateWithdrawalFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateWithdrawalFeeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateWithdrawalFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/EstimateWithdrawalFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateWithdrawalFee(ctx, req.(*QueryEstimateWithdrawalFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ChainVersion) MarshalTo(dAtA []byte) (int, error) {
+func _Query_GetWithdrawalRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWithdrawalRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWithdrawalRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/GetWithdrawalRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWithdrawalRequest(ctx, req.(*QueryGetWithdrawalRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListWithdrawalRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListWithdrawalRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListWithdrawalRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/ListWithdrawalRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListWithdrawalRequests(ctx, req.(*QueryListWithdrawalRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalculateEquity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalculateEquityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalculateEquity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/CalculateEquity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalculateEquity(ctx, req.(*QueryCalculateEquityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WaitlistPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWaitlistPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WaitlistPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/WaitlistPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WaitlistPosition(ctx, req.(*QueryWaitlistPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Query/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Version(ctx, req.(*QueryVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pokerchain.poker.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Game",
+			Handler:    _Query_Game_Handler,
+		},
+		{
+			MethodName: "ListGames",
+			Handler:    _Query_ListGames_Handler,
+		},
+		{
+			MethodName: "PlayerGames",
+			Handler:    _Query_PlayerGames_Handler,
+		},
+		{
+			MethodName: "LegalActions",
+			Handler:    _Query_LegalActions_Handler,
+		},
+		{
+			MethodName: "GameState",
+			Handler:    _Query_GameState_Handler,
+		},
+		{
+			MethodName: "GameStatePublic",
+			Handler:    _Query_GameStatePublic_Handler,
+		},
+		{
+			MethodName: "GameStateSpectator",
+			Handler:    _Query_GameStateSpectator_Handler,
+		},
+		{
+			MethodName: "IsTxProcessed",
+			Handler:    _Query_IsTxProcessed_Handler,
+		},
+		{
+			MethodName: "EthHeaderTip",
+			Handler:    _Query_EthHeaderTip_Handler,
+		},
+		{
+			MethodName: "EthHeader",
+			Handler:    _Query_EthHeader_Handler,
+		},
+		{
+			MethodName: "BridgeStatus",
+			Handler:    _Query_BridgeStatus_Handler,
+		},
+		{
+			MethodName: "EstimateWithdrawalFee",
+			Handler:    _Query_EstimateWithdrawalFee_Handler,
+		},
+		{
+			MethodName: "GetWithdrawalRequest",
+			Handler:    _Query_GetWithdrawalRequest_Handler,
+		},
+		{
+			MethodName: "ListWithdrawalRequests",
+			Handler:    _Query_ListWithdrawalRequests_Handler,
+		},
+		{
+			MethodName: "CalculateEquity",
+			Handler:    _Query_CalculateEquity_Handler,
+		},
+		{
+			MethodName: "WaitlistPosition",
+			Handler:    _Query_WaitlistPosition_Handler,
+		},
+		{
+			MethodName: "Version",
+			Handler:    _Query_Version_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pokerchain/poker/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChainVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConsensusVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusVersion))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PvmStatus) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3073,51 +3124,30 @@ func (m *PvmStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PvmStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PvmStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Endpoint) > 0 {
-		i -= len(m.Endpoint)
-		copy(dAtA[i:], m.Endpoint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Endpoint)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Healthy {
-		i--
-		if m.Healthy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVersionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGameRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3127,519 +3157,2675 @@ func (m *QueryVersionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGameRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pvm != nil {
-		{
-			size, err := m.Pvm.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Chain != nil {
-		{
-			size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGameRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GameId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Game) > 0 {
+		i -= len(m.Game)
+		copy(dAtA[i:], m.Game)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Game)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGameResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Game)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListGamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListGamesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryListGamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListGamesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListGamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Games)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlayerGamesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PlayerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListGamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPlayerGamesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Games)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryListGamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLegalActionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListGamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GameId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PlayerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Games) > 0 {
+		i -= len(m.Games)
+		copy(dAtA[i:], m.Games)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Games)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLegalActionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Actions)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPlayerGamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGameStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GameId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PlayerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPlayerGamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGameStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPlayerGamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GameState)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PlayerAddress) > 0 {
+		i -= len(m.PlayerAddress)
+		copy(dAtA[i:], m.PlayerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlayerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGameStatePublicRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GameId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPlayerGamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGameStatePublicResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPlayerGamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlayerGamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GameState)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Games) > 0 {
+		i -= len(m.Games)
+		copy(dAtA[i:], m.Games)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Games)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsTxProcessedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EthTxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLegalActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIsTxProcessedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Processed {
-		n += 2
-	}
-	return n
+func (m *QueryLegalActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetWithdrawalRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLegalActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PlayerAddress) > 0 {
+		i -= len(m.PlayerAddress)
+		copy(dAtA[i:], m.PlayerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlayerAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetWithdrawalRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLegalActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLegalActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLegalActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WithdrawalRequest != nil {
-		l = m.WithdrawalRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Actions) > 0 {
+		i -= len(m.Actions)
+		copy(dAtA[i:], m.Actions)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Actions)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListWithdrawalRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGameStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGameStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGameStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.PlayerAddress) > 0 {
+		i -= len(m.PlayerAddress)
+		copy(dAtA[i:], m.PlayerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlayerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListWithdrawalRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGameStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGameStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGameStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.WithdrawalRequests) > 0 {
-		for _, e := range m.WithdrawalRequests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GameState) > 0 {
+		i -= len(m.GameState)
+		copy(dAtA[i:], m.GameState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameState)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculateEquityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGameStatePublicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGameStatePublicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGameStatePublicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Hands) > 0 {
-		for _, e := range m.Hands {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Board) > 0 {
-		for _, s := range m.Board {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Dead) > 0 {
-		for _, s := range m.Dead {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Simulations != 0 {
-		n += 1 + sovQuery(uint64(m.Simulations))
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HandCards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGameStatePublicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGameStatePublicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGameStatePublicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Cards) > 0 {
-		for _, s := range m.Cards {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.GameState) > 0 {
+		i -= len(m.GameState)
+		copy(dAtA[i:], m.GameState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameState)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EquityResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGameStateSpectatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGameStateSpectatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGameStateSpectatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HandIndex != 0 {
-		n += 1 + sovQuery(uint64(m.HandIndex))
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Hand) > 0 {
-		for _, s := range m.Hand {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGameStateSpectatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Wins != 0 {
-		n += 1 + sovQuery(uint64(m.Wins))
+	return dAtA[:n], nil
+}
+
+func (m *QueryGameStateSpectatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGameStateSpectatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelaySeconds))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Ties != 0 {
-		n += 1 + sovQuery(uint64(m.Ties))
+	if m.DelayActions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelayActions))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Losses != 0 {
-		n += 1 + sovQuery(uint64(m.Losses))
+	if len(m.Spectators) > 0 {
+		i -= len(m.Spectators)
+		copy(dAtA[i:], m.Spectators)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spectators)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Equity)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GameState) > 0 {
+		i -= len(m.GameState)
+		copy(dAtA[i:], m.GameState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameState)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.TieEquity)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsTxProcessedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Total)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsTxProcessedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsTxProcessedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculateEquityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsTxProcessedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsTxProcessedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsTxProcessedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Processed {
+		i--
+		if m.Processed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Simulations != 0 {
-		n += 1 + sovQuery(uint64(m.Simulations))
-	}
-	l = len(m.Stage)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DurationMs)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.HandsPerSec)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEthHeaderTipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthHeaderTipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthHeaderTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainVersion) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEthHeaderTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthHeaderTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthHeaderTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ConsensusVersion != 0 {
-		n += 1 + sovQuery(uint64(m.ConsensusVersion))
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PvmStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEthHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Healthy {
-		n += 2
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Endpoint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEthHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LargeWithdrawalDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LargeWithdrawalDelay))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LargeWithdrawalThreshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LargeWithdrawalThreshold))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AddressRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddressRemaining))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AddressWithdrawn != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddressWithdrawn))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AddressWithdrawalLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddressWithdrawalLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GlobalRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalRemaining))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GlobalWithdrawn != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalWithdrawn))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GlobalWithdrawalLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalWithdrawalLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WithdrawalWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WithdrawalWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateWithdrawalFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateWithdrawalFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateWithdrawalFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateWithdrawalFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateWithdrawalFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateWithdrawalFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeTreasury) > 0 {
+		i -= len(m.FeeTreasury)
+		copy(dAtA[i:], m.FeeTreasury)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeTreasury)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NetAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NetAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Fee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWithdrawalRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWithdrawalRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWithdrawalRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawalRequest != nil {
+		{
+			size, err := m.WithdrawalRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListWithdrawalRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListWithdrawalRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListWithdrawalRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosAddress) > 0 {
+		i -= len(m.CosmosAddress)
+		copy(dAtA[i:], m.CosmosAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListWithdrawalRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListWithdrawalRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListWithdrawalRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WithdrawalRequests) > 0 {
+		for iNdEx := len(m.WithdrawalRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculateEquityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculateEquityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculateEquityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Simulations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Simulations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Dead) > 0 {
+		for iNdEx := len(m.Dead) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dead[iNdEx])
+			copy(dAtA[i:], m.Dead[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Dead[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Board) > 0 {
+		for iNdEx := len(m.Board) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Board[iNdEx])
+			copy(dAtA[i:], m.Board[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Board[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hands) > 0 {
+		for iNdEx := len(m.Hands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandCards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandCards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandCards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cards) > 0 {
+		for iNdEx := len(m.Cards) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cards[iNdEx])
+			copy(dAtA[i:], m.Cards[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Cards[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EquityResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EquityResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EquityResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TieEquity) > 0 {
+		i -= len(m.TieEquity)
+		copy(dAtA[i:], m.TieEquity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TieEquity)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Equity) > 0 {
+		i -= len(m.Equity)
+		copy(dAtA[i:], m.Equity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Equity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Losses != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Losses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Ties != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Ties))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Wins != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Wins))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hand) > 0 {
+		for iNdEx := len(m.Hand) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hand[iNdEx])
+			copy(dAtA[i:], m.Hand[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Hand[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HandIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HandIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculateEquityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculateEquityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculateEquityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HandsPerSec) > 0 {
+		i -= len(m.HandsPerSec)
+		copy(dAtA[i:], m.HandsPerSec)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HandsPerSec)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DurationMs) > 0 {
+		i -= len(m.DurationMs)
+		copy(dAtA[i:], m.DurationMs)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DurationMs)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Stage) > 0 {
+		i -= len(m.Stage)
+		copy(dAtA[i:], m.Stage)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Stage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Simulations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Simulations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsensusVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PvmStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PvmStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PvmStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pvm != nil {
+		{
+			size, err := m.Pvm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Chain != nil {
+		{
+			size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWaitlistPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWaitlistPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWaitlistPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWaitlistPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWaitlistPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWaitlistPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReservedUntil != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReservedUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReservedSeat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReservedSeat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WaitlistSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WaitlistSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Game)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListGamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListGamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Games)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlayerGamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlayerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlayerGamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Games)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLegalActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PlayerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLegalActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Actions)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGameStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PlayerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGameStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGameStatePublicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGameStatePublicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGameStateSpectatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGameStateSpectatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Spectators)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DelayActions != 0 {
+		n += 1 + sovQuery(uint64(m.DelayActions))
+	}
+	if m.DelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.DelaySeconds))
+	}
+	return n
+}
+
+func (m *QueryIsTxProcessedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsTxProcessedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Processed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEthHeaderTipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEthHeaderTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEthHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryEthHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pause.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WithdrawalWindow != 0 {
+		n += 1 + sovQuery(uint64(m.WithdrawalWindow))
+	}
+	if m.GlobalWithdrawalLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalWithdrawalLimit))
+	}
+	if m.GlobalWithdrawn != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalWithdrawn))
+	}
+	if m.GlobalRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalRemaining))
+	}
+	if m.AddressWithdrawalLimit != 0 {
+		n += 1 + sovQuery(uint64(m.AddressWithdrawalLimit))
+	}
+	if m.AddressWithdrawn != 0 {
+		n += 1 + sovQuery(uint64(m.AddressWithdrawn))
+	}
+	if m.AddressRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.AddressRemaining))
+	}
+	if m.LargeWithdrawalThreshold != 0 {
+		n += 1 + sovQuery(uint64(m.LargeWithdrawalThreshold))
+	}
+	if m.LargeWithdrawalDelay != 0 {
+		n += 1 + sovQuery(uint64(m.LargeWithdrawalDelay))
+	}
+	return n
+}
+
+func (m *QueryEstimateWithdrawalFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *QueryEstimateWithdrawalFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fee != 0 {
+		n += 1 + sovQuery(uint64(m.Fee))
+	}
+	if m.NetAmount != 0 {
+		n += 1 + sovQuery(uint64(m.NetAmount))
+	}
+	l = len(m.FeeTreasury)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWithdrawalRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWithdrawalRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithdrawalRequest != nil {
+		l = m.WithdrawalRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListWithdrawalRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListWithdrawalRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WithdrawalRequests) > 0 {
+		for _, e := range m.WithdrawalRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculateEquityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hands) > 0 {
+		for _, e := range m.Hands {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Board) > 0 {
+		for _, s := range m.Board {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Dead) > 0 {
+		for _, s := range m.Dead {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Simulations != 0 {
+		n += 1 + sovQuery(uint64(m.Simulations))
+	}
+	return n
+}
+
+func (m *HandCards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cards) > 0 {
+		for _, s := range m.Cards {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EquityResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HandIndex != 0 {
+		n += 1 + sovQuery(uint64(m.HandIndex))
+	}
+	if len(m.Hand) > 0 {
+		for _, s := range m.Hand {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Wins != 0 {
+		n += 1 + sovQuery(uint64(m.Wins))
+	}
+	if m.Ties != 0 {
+		n += 1 + sovQuery(uint64(m.Ties))
+	}
+	if m.Losses != 0 {
+		n += 1 + sovQuery(uint64(m.Losses))
+	}
+	l = len(m.Equity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TieEquity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculateEquityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Simulations != 0 {
+		n += 1 + sovQuery(uint64(m.Simulations))
+	}
+	l = len(m.Stage)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DurationMs)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HandsPerSec)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ChainVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsensusVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ConsensusVersion))
+	}
+	return n
+}
+
+func (m *PvmStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Healthy {
+		n += 2
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chain != nil {
+		l = m.Chain.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pvm != nil {
+		l = m.Pvm.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWaitlistPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWaitlistPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	if m.WaitlistSize != 0 {
+		n += 1 + sovQuery(uint64(m.WaitlistSize))
+	}
+	if m.ReservedSeat != 0 {
+		n += 1 + sovQuery(uint64(m.ReservedSeat))
+	}
+	if m.ReservedUntil != 0 {
+		n += 1 + sovQuery(uint64(m.ReservedUntil))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Game", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Game = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListGamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListGamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListGamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListGamesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListGamesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListGamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Games", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Games = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlayerGamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlayerGamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlayerGamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Chain != nil {
-		l = m.Chain.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pvm != nil {
-		l = m.Pvm.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPlayerGamesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3662,12 +5848,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPlayerGamesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPlayerGamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Games", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Games = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3689,7 +5907,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLegalActionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3712,17 +5930,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLegalActionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLegalActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3732,24 +5950,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerAddress", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3772,7 +6021,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLegalActionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3795,15 +6044,15 @@ func (m *QueryGameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLegalActionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLegalActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3831,7 +6080,7 @@ func (m *QueryGameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameId = string(dAtA[iNdEx:postIndex])
+			m.Actions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3854,7 +6103,7 @@ func (m *QueryGameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGameStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3877,15 +6126,15 @@ func (m *QueryGameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGameStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGameStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Game", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3913,7 +6162,90 @@ func (m *QueryGameResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Game = string(dAtA[iNdEx:postIndex])
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3936,7 +6268,7 @@ func (m *QueryGameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListGamesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGameStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3959,12 +6291,44 @@ func (m *QueryListGamesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListGamesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGameStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListGamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGameStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3986,7 +6350,7 @@ func (m *QueryListGamesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListGamesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGameStatePublicRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4009,15 +6373,15 @@ func (m *QueryListGamesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListGamesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGameStatePublicRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListGamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGameStatePublicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Games", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4045,7 +6409,7 @@ func (m *QueryListGamesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Games = string(dAtA[iNdEx:postIndex])
+			m.GameId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4068,7 +6432,7 @@ func (m *QueryListGamesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlayerGamesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGameStatePublicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4091,15 +6455,15 @@ func (m *QueryPlayerGamesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlayerGamesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGameStatePublicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlayerGamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGameStatePublicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlayerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4127,7 +6491,7 @@ func (m *QueryPlayerGamesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlayerAddress = string(dAtA[iNdEx:postIndex])
+			m.GameState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4150,7 +6514,7 @@ func (m *QueryPlayerGamesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlayerGamesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGameStateSpectatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4173,15 +6537,15 @@ func (m *QueryPlayerGamesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlayerGamesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGameStateSpectatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlayerGamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGameStateSpectatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Games", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4209,7 +6573,7 @@ func (m *QueryPlayerGamesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Games = string(dAtA[iNdEx:postIndex])
+			m.GameId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4232,7 +6596,7 @@ func (m *QueryPlayerGamesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLegalActionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGameStateSpectatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4255,15 +6619,15 @@ func (m *QueryLegalActionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLegalActionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGameStateSpectatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLegalActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGameStateSpectatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4291,11 +6655,11 @@ func (m *QueryLegalActionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameId = string(dAtA[iNdEx:postIndex])
+			m.GameState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlayerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spectators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4323,8 +6687,46 @@ func (m *QueryLegalActionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlayerAddress = string(dAtA[iNdEx:postIndex])
+			m.Spectators = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayActions", wireType)
+			}
+			m.DelayActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelaySeconds", wireType)
+			}
+			m.DelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4346,7 +6748,7 @@ func (m *QueryLegalActionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLegalActionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsTxProcessedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4369,15 +6771,15 @@ func (m *QueryLegalActionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLegalActionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsTxProcessedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLegalActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsTxProcessedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4405,7 +6807,7 @@ func (m *QueryLegalActionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = string(dAtA[iNdEx:postIndex])
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4428,7 +6830,7 @@ func (m *QueryLegalActionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGameStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsTxProcessedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4451,17 +6853,17 @@ func (m *QueryGameStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGameStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsTxProcessedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGameStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsTxProcessedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4471,29 +6873,117 @@ func (m *QueryGameStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Processed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEthHeaderTipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthHeaderTipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthHeaderTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEthHeaderTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthHeaderTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthHeaderTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlayerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4503,48 +6993,83 @@ func (m *QueryGameStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlayerAddress = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &EthHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEthHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4554,24 +7079,11 @@ func (m *QueryGameStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Number |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4593,7 +7105,7 @@ func (m *QueryGameStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGameStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEthHeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4616,17 +7128,17 @@ func (m *QueryGameStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGameStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEthHeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGameStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEthHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4636,23 +7148,27 @@ func (m *QueryGameStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameState = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &EthHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4675,7 +7191,7 @@ func (m *QueryGameStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGameStatePublicRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4698,15 +7214,15 @@ func (m *QueryGameStatePublicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGameStatePublicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGameStatePublicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4734,7 +7250,7 @@ func (m *QueryGameStatePublicRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4757,7 +7273,7 @@ func (m *QueryGameStatePublicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGameStatePublicResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4780,17 +7296,17 @@ func (m *QueryGameStatePublicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGameStatePublicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGameStatePublicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4800,24 +7316,196 @@ func (m *QueryGameStatePublicResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameState = string(dAtA[iNdEx:postIndex])
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalWindow", wireType)
+			}
+			m.WithdrawalWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalWithdrawalLimit", wireType)
+			}
+			m.GlobalWithdrawalLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalWithdrawalLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalWithdrawn", wireType)
+			}
+			m.GlobalWithdrawn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalWithdrawn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRemaining", wireType)
+			}
+			m.GlobalRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressWithdrawalLimit", wireType)
+			}
+			m.AddressWithdrawalLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressWithdrawalLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressWithdrawn", wireType)
+			}
+			m.AddressWithdrawn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressWithdrawn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressRemaining", wireType)
+			}
+			m.AddressRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeWithdrawalThreshold", wireType)
+			}
+			m.LargeWithdrawalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LargeWithdrawalThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeWithdrawalDelay", wireType)
+			}
+			m.LargeWithdrawalDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LargeWithdrawalDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4839,7 +7527,7 @@ func (m *QueryGameStatePublicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsTxProcessedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateWithdrawalFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4862,17 +7550,17 @@ func (m *QueryIsTxProcessedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsTxProcessedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateWithdrawalFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsTxProcessedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateWithdrawalFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4882,24 +7570,11 @@ func (m *QueryIsTxProcessedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4921,7 +7596,7 @@ func (m *QueryIsTxProcessedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsTxProcessedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateWithdrawalFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4944,17 +7619,36 @@ func (m *QueryIsTxProcessedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsTxProcessedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateWithdrawalFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsTxProcessedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateWithdrawalFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
 			}
-			var v int
+			m.NetAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4964,12 +7658,43 @@ func (m *QueryIsTxProcessedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NetAmount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Processed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTreasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTreasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6570,6 +9295,246 @@ func (m *QueryVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryWaitlistPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWaitlistPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWaitlistPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWaitlistPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWaitlistPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWaitlistPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitlistSize", wireType)
+			}
+			m.WaitlistSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitlistSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedSeat", wireType)
+			}
+			m.ReservedSeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedSeat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedUntil", wireType)
+			}
+			m.ReservedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
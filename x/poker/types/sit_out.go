@@ -0,0 +1,94 @@
+package types
+
+import (
+	"slices"
+	"time"
+)
+
+// SitOut is a seated player who is sitting out, and what they have missed
+type SitOut struct {
+	Player string    `json:"player"`
+	Since  time.Time `json:"since"`
+	// Hands is the number of hands dealt without the player
+	Hands            uint64 `json:"hands"`
+	MissedSmallBlind bool   `json:"missedSmallBlind,omitempty"`
+	MissedBigBlind   bool   `json:"missedBigBlind,omitempty"`
+}
+
+// DeadBlind is what a returning player owes for the blinds they missed
+type DeadBlind struct {
+	Player string `json:"player"`
+	Amount uint64 `json:"amount"`
+}
+
+// SitOut returns the player's sit-out record
+func (g Game) SitOut(player string) (SitOut, bool) {
+	for _, s := range g.SitOuts {
+		if s.Player == player {
+			return s, true
+		}
+	}
+	return SitOut{}, false
+}
+
+// SetSitOut records that a player is sitting out
+func (g *Game) SetSitOut(sitOut SitOut) {
+	for i := range g.SitOuts {
+		if g.SitOuts[i].Player == sitOut.Player {
+			g.SitOuts[i] = sitOut
+			return
+		}
+	}
+	g.SitOuts = append(g.SitOuts, sitOut)
+}
+
+// RemoveSitOut forgets a player's sit-out record
+func (g *Game) RemoveSitOut(player string) {
+	g.SitOuts = slices.DeleteFunc(slices.Clone(g.SitOuts), func(s SitOut) bool { return s.Player == player })
+	if len(g.SitOuts) == 0 {
+		g.SitOuts = nil
+	}
+}
+
+// MissedBlinds is what a player who sat out owes on their return: the small
+// and big blind, at most once each, if they missed them
+func (g Game) MissedBlinds(sitOut SitOut) uint64 {
+	var owed uint64
+	if sitOut.MissedSmallBlind {
+		owed += g.SmallBlind
+	}
+	if sitOut.MissedBigBlind {
+		owed += g.BigBlind
+	}
+	return owed
+}
+
+// SitOutExpired reports whether a player has sat out longer than the table's
+// rules allow, in hands or in minutes
+func (g Game) SitOutExpired(sitOut SitOut, now time.Time) bool {
+	if g.MaxSitOutHands > 0 && sitOut.Hands >= g.MaxSitOutHands {
+		return true
+	}
+	return g.MaxSitOutMinutes > 0 && !now.Before(sitOut.Since.Add(time.Duration(g.MaxSitOutMinutes)*time.Minute))
+}
+
+// DeadBlind returns the dead blinds the player owes
+func (g Game) DeadBlind(player string) uint64 {
+	for _, d := range g.DeadBlinds {
+		if d.Player == player {
+			return d.Amount
+		}
+	}
+	return 0
+}
+
+// SetDeadBlind sets the dead blinds the player owes, forgetting them at zero
+func (g *Game) SetDeadBlind(player string, amount uint64) {
+	g.DeadBlinds = slices.DeleteFunc(slices.Clone(g.DeadBlinds), func(d DeadBlind) bool { return d.Player == player })
+	if amount > 0 {
+		g.DeadBlinds = append(g.DeadBlinds, DeadBlind{Player: player, Amount: amount})
+	}
+	if len(g.DeadBlinds) == 0 {
+		g.DeadBlinds = nil
+	}
+}
@@ -0,0 +1,40 @@
+package types
+
+// SpectatorMode is who may watch a table without sitting at it through the
+// spectator channel of the WebSocket server. It does not hide anything the
+// chain's public queries and transactions show.
+type SpectatorMode string
+
+const (
+	// SpectatorsOff keeps the table's state to its players
+	SpectatorsOff SpectatorMode = "off"
+	// SpectatorsLive shows spectators the public state as it happens
+	SpectatorsLive SpectatorMode = "live"
+	// SpectatorsDelayed shows spectators the table a number of actions or
+	// seconds late, with every hole card once the hand is over
+	SpectatorsDelayed SpectatorMode = "delayed"
+)
+
+// Bounds on how far behind the table delayed spectators may be held
+const (
+	MaxSpectatorDelayActions = 100
+	MaxSpectatorDelaySeconds = 3600
+)
+
+// IsValid reports whether the mode is one the chain knows
+func (m SpectatorMode) IsValid() bool {
+	switch m {
+	case SpectatorsOff, SpectatorsLive, SpectatorsDelayed:
+		return true
+	}
+	return false
+}
+
+// SpectatorMode returns the table's spectator policy. Tables created before
+// there was one can be watched live.
+func (g Game) SpectatorMode() SpectatorMode {
+	if g.Spectators == "" {
+		return SpectatorsLive
+	}
+	return g.Spectators
+}
@@ -141,6 +141,31 @@ type MsgCreateGame struct {
 	RakePercentage    uint32 `protobuf:"varint,11,opt,name=rake_percentage,json=rakePercentage,proto3" json:"rake_percentage,omitempty"`
 	RakeCap           uint64 `protobuf:"varint,12,opt,name=rake_cap,json=rakeCap,proto3" json:"rake_cap,omitempty"`
 	RakeOwner         string `protobuf:"bytes,13,opt,name=rake_owner,json=rakeOwner,proto3" json:"rake_owner,omitempty"`
+	Denom             string `protobuf:"bytes,14,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Optional access control
+	Visibility     string   `protobuf:"bytes,15,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Allowlist      []string `protobuf:"bytes,16,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	RequiredDenom  string   `protobuf:"bytes,18,opt,name=required_denom,json=requiredDenom,proto3" json:"required_denom,omitempty"`
+	RequiredAmount uint64   `protobuf:"varint,19,opt,name=required_amount,json=requiredAmount,proto3" json:"required_amount,omitempty"`
+	// Optional forced bets
+	Ante             uint64 `protobuf:"varint,20,opt,name=ante,proto3" json:"ante,omitempty"`
+	AnteType         string `protobuf:"bytes,21,opt,name=ante_type,json=anteType,proto3" json:"ante_type,omitempty"`
+	Straddle         string `protobuf:"bytes,22,opt,name=straddle,proto3" json:"straddle,omitempty"`
+	BombPotFrequency uint64 `protobuf:"varint,23,opt,name=bomb_pot_frequency,json=bombPotFrequency,proto3" json:"bomb_pot_frequency,omitempty"`
+	BombPotAnte      uint64 `protobuf:"varint,24,opt,name=bomb_pot_ante,json=bombPotAnte,proto3" json:"bomb_pot_ante,omitempty"`
+	// Optional sit-out rules
+	MaxSitOutHands   uint64 `protobuf:"varint,25,opt,name=max_sit_out_hands,json=maxSitOutHands,proto3" json:"max_sit_out_hands,omitempty"`
+	MaxSitOutMinutes uint64 `protobuf:"varint,26,opt,name=max_sit_out_minutes,json=maxSitOutMinutes,proto3" json:"max_sit_out_minutes,omitempty"`
+	PostMissedBlinds bool   `protobuf:"varint,27,opt,name=post_missed_blinds,json=postMissedBlinds,proto3" json:"post_missed_blinds,omitempty"`
+	// Optional spectator policy
+	Spectators            string `protobuf:"bytes,28,opt,name=spectators,proto3" json:"spectators,omitempty"`
+	SpectatorDelayActions uint64 `protobuf:"varint,29,opt,name=spectator_delay_actions,json=spectatorDelayActions,proto3" json:"spectator_delay_actions,omitempty"`
+	SpectatorDelaySeconds uint64 `protobuf:"varint,30,opt,name=spectator_delay_seconds,json=spectatorDelaySeconds,proto3" json:"spectator_delay_seconds,omitempty"`
+	// Optional invite code, which lets anyone who knows it sit. Only the hex
+	// compressed secp256k1 public key derived from "<creator>:<invite code>" is
+	// sent (see InvitePublicKey); players prove they know the code by signing
+	// their join with the derived key.
+	InvitePublicKey string `protobuf:"bytes,31,opt,name=invite_public_key,json=invitePublicKey,proto3" json:"invite_public_key,omitempty"`
 }
 
 func (m *MsgCreateGame) Reset()         { *m = MsgCreateGame{} }
@@ -267,6 +292,125 @@ func (m *MsgCreateGame) GetRakeOwner() string {
 	return ""
 }
 
+func (m *MsgCreateGame) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgCreateGame) GetVisibility() string {
+	if m != nil {
+		return m.Visibility
+	}
+	return ""
+}
+
+func (m *MsgCreateGame) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *MsgCreateGame) GetRequiredDenom() string {
+	if m != nil {
+		return m.RequiredDenom
+	}
+	return ""
+}
+
+func (m *MsgCreateGame) GetRequiredAmount() uint64 {
+	if m != nil {
+		return m.RequiredAmount
+	}
+	return 0
+}
+
+func (m *MsgCreateGame) GetAnte() uint64 {
+	if m != nil {
+		return m.Ante
+	}
+	return 0
+}
+
+func (m *MsgCreateGame) GetAnteType() string {
+	if m != nil {
+		return m.AnteType
+	}
+	return ""
+}
+
+func (m *MsgCreateGame) GetStraddle() string {
+	if m != nil {
+		return m.Straddle
+	}
+	return ""
+}
+
+func (m *MsgCreateGame) GetBombPotFrequency() uint64 {
+	if m != nil {
+		return m.BombPotFrequency
+	}
+	return 0
+}
+
+func (m *MsgCreateGame) GetBombPotAnte() uint64 {
+	if m != nil {
+		return m.BombPotAnte
+	}
+	return 0
+}
+
+func (m *MsgCreateGame) GetMaxSitOutHands() uint64 {
+	if m != nil {
+		return m.MaxSitOutHands
+	}
+	return 0
+}
+
+func (m *MsgCreateGame) GetMaxSitOutMinutes() uint64 {
+	if m != nil {
+		return m.MaxSitOutMinutes
+	}
+	return 0
+}
+
+func (m *MsgCreateGame) GetPostMissedBlinds() bool {
+	if m != nil {
+		return m.PostMissedBlinds
+	}
+	return false
+}
+
+func (m *MsgCreateGame) GetSpectators() string {
+	if m != nil {
+		return m.Spectators
+	}
+	return ""
+}
+
+func (m *MsgCreateGame) GetSpectatorDelayActions() uint64 {
+	if m != nil {
+		return m.SpectatorDelayActions
+	}
+	return 0
+}
+
+func (m *MsgCreateGame) GetSpectatorDelaySeconds() uint64 {
+	if m != nil {
+		return m.SpectatorDelaySeconds
+	}
+	return 0
+}
+
+func (m *MsgCreateGame) GetInvitePublicKey() string {
+	if m != nil {
+		return m.InvitePublicKey
+	}
+	return ""
+}
+
 // MsgCreateGameResponse defines the MsgCreateGameResponse message.
 type MsgCreateGameResponse struct {
 }
@@ -306,10 +450,11 @@ var xxx_messageInfo_MsgCreateGameResponse proto.InternalMessageInfo
 
 // MsgJoinGame defines the MsgJoinGame message.
 type MsgJoinGame struct {
-	Player      string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	GameId      string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Seat        uint64 `protobuf:"varint,3,opt,name=seat,proto3" json:"seat,omitempty"`
-	BuyInAmount uint64 `protobuf:"varint,4,opt,name=buy_in_amount,json=buyInAmount,proto3" json:"buy_in_amount,omitempty"`
+	Player          string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	GameId          string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Seat            uint64 `protobuf:"varint,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	BuyInAmount     uint64 `protobuf:"varint,4,opt,name=buy_in_amount,json=buyInAmount,proto3" json:"buy_in_amount,omitempty"`
+	InviteSignature []byte `protobuf:"bytes,6,opt,name=invite_signature,json=inviteSignature,proto3" json:"invite_signature,omitempty"`
 }
 
 func (m *MsgJoinGame) Reset()         { *m = MsgJoinGame{} }
@@ -373,6 +518,13 @@ func (m *MsgJoinGame) GetBuyInAmount() uint64 {
 	return 0
 }
 
+func (m *MsgJoinGame) GetInviteSignature() []byte {
+	if m != nil {
+		return m.InviteSignature
+	}
+	return nil
+}
+
 // MsgJoinGameResponse defines the MsgJoinGameResponse message.
 type MsgJoinGameResponse struct {
 }
@@ -703,6 +855,14 @@ type MsgMint struct {
 	Amount    uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	EthTxHash string `protobuf:"bytes,4,opt,name=eth_tx_hash,json=ethTxHash,proto3" json:"eth_tx_hash,omitempty"`
 	Nonce     uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Source chain block containing the deposit transaction (must be a tracked header).
+	EthBlockHeight uint64 `protobuf:"varint,6,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	// Index of the deposit transaction within the block.
+	TxIndex uint64 `protobuf:"varint,7,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Index of the Deposited log within the transaction receipt.
+	LogIndex uint64 `protobuf:"varint,8,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	// Merkle-Patricia proof nodes from the block's receipts root to the receipt.
+	ReceiptProof [][]byte `protobuf:"bytes,9,rep,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
 }
 
 func (m *MsgMint) Reset()         { *m = MsgMint{} }
@@ -773,6 +933,34 @@ func (m *MsgMint) GetNonce() uint64 {
 	return 0
 }
 
+func (m *MsgMint) GetEthBlockHeight() uint64 {
+	if m != nil {
+		return m.EthBlockHeight
+	}
+	return 0
+}
+
+func (m *MsgMint) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *MsgMint) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *MsgMint) GetReceiptProof() [][]byte {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
 // MsgMintResponse defines the MsgMintResponse message.
 type MsgMintResponse struct {
 }
@@ -909,15 +1097,21 @@ func (m *MsgBurnResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
 
 // MsgProcessDeposit defines the MsgProcessDeposit message.
-// Processes an Ethereum deposit by querying the bridge contract for the deposit index.
-// The eth_block_height ensures deterministic replay - all validators query at the same block.
+// Processes an Ethereum deposit by proving the Deposited log of the bridge contract
+// against the receipts root of a tracked header. No RPC calls are made on-chain.
 type MsgProcessDeposit struct {
 	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	DepositIndex uint64 `protobuf:"varint,2,opt,name=deposit_index,json=depositIndex,proto3" json:"deposit_index,omitempty"`
-	// Ethereum block height at which to query the deposit data.
-	// This ensures deterministic replay across all validators.
-	// If 0, the current block height will be fetched and stored.
+	// Source chain block containing the deposit transaction (must be a tracked header).
 	EthBlockHeight uint64 `protobuf:"varint,3,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	// Cosmos recipient of the deposit; its keccak256 hash must match the indexed account topic.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Index of the deposit transaction within the block.
+	TxIndex uint64 `protobuf:"varint,5,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Index of the Deposited log within the transaction receipt.
+	LogIndex uint64 `protobuf:"varint,6,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	// Merkle-Patricia proof nodes from the block's receipts root to the receipt.
+	ReceiptProof [][]byte `protobuf:"bytes,7,rep,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
 }
 
 func (m *MsgProcessDeposit) Reset()         { *m = MsgProcessDeposit{} }
@@ -974,12 +1168,40 @@ func (m *MsgProcessDeposit) GetEthBlockHeight() uint64 {
 	return 0
 }
 
+func (m *MsgProcessDeposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgProcessDeposit) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *MsgProcessDeposit) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *MsgProcessDeposit) GetReceiptProof() [][]byte {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
 // MsgProcessDepositResponse defines the MsgProcessDepositResponse message.
 type MsgProcessDepositResponse struct {
 	Recipient    string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Amount       string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	DepositIndex uint64 `protobuf:"varint,3,opt,name=deposit_index,json=depositIndex,proto3" json:"deposit_index,omitempty"`
-	// The Ethereum block height used to query the deposit data.
+	// The source chain block the deposit was proven against.
 	EthBlockHeight uint64 `protobuf:"varint,4,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
 }
 
@@ -1048,9 +1270,11 @@ func (m *MsgProcessDepositResponse) GetEthBlockHeight() uint64 {
 // Initiates a withdrawal by burning USDC on Cosmos and creating a withdrawal request
 // that can be completed on Base chain with a validator signature.
 type MsgInitiateWithdrawal struct {
-	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Amount      uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	BaseAddress string `protobuf:"bytes,3,opt,name=base_address,json=baseAddress,proto3" json:"base_address,omitempty"`
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount       uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	BaseAddress  string `protobuf:"bytes,3,opt,name=base_address,json=baseAddress,proto3" json:"base_address,omitempty"`
+	IbcChannelId string `protobuf:"bytes,4,opt,name=ibc_channel_id,json=ibcChannelId,proto3" json:"ibc_channel_id,omitempty"`
+	IbcReceiver  string `protobuf:"bytes,5,opt,name=ibc_receiver,json=ibcReceiver,proto3" json:"ibc_receiver,omitempty"`
 }
 
 func (m *MsgInitiateWithdrawal) Reset()         { *m = MsgInitiateWithdrawal{} }
@@ -1107,9 +1331,26 @@ func (m *MsgInitiateWithdrawal) GetBaseAddress() string {
 	return ""
 }
 
+func (m *MsgInitiateWithdrawal) GetIbcChannelId() string {
+	if m != nil {
+		return m.IbcChannelId
+	}
+	return ""
+}
+
+func (m *MsgInitiateWithdrawal) GetIbcReceiver() string {
+	if m != nil {
+		return m.IbcReceiver
+	}
+	return ""
+}
+
 // MsgInitiateWithdrawalResponse defines the MsgInitiateWithdrawalResponse message.
 type MsgInitiateWithdrawalResponse struct {
-	Nonce string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Nonce       string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Fee         uint64 `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	NetAmount   uint64 `protobuf:"varint,3,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	IbcSequence uint64 `protobuf:"varint,4,opt,name=ibc_sequence,json=ibcSequence,proto3" json:"ibc_sequence,omitempty"`
 }
 
 func (m *MsgInitiateWithdrawalResponse) Reset()         { *m = MsgInitiateWithdrawalResponse{} }
@@ -1152,13 +1393,36 @@ func (m *MsgInitiateWithdrawalResponse) GetNonce() string {
 	return ""
 }
 
+func (m *MsgInitiateWithdrawalResponse) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *MsgInitiateWithdrawalResponse) GetNetAmount() uint64 {
+	if m != nil {
+		return m.NetAmount
+	}
+	return 0
+}
+
+func (m *MsgInitiateWithdrawalResponse) GetIbcSequence() uint64 {
+	if m != nil {
+		return m.IbcSequence
+	}
+	return 0
+}
+
 // MsgSignWithdrawal defines the MsgSignWithdrawal message.
-// Allows validators to manually sign pending withdrawal requests.
-// The signer must provide their Ethereum private key to generate the signature.
+// Submits a validator signature of a pending withdrawal request, or of its
+// cancellation, made off chain by the bridge signer (see cmd/bridge-signer).
 type MsgSignWithdrawal struct {
-	Signer             string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Nonce              string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	ValidatorEthKeyHex string `protobuf:"bytes,3,opt,name=validator_eth_key_hex,json=validatorEthKeyHex,proto3" json:"validator_eth_key_hex,omitempty"`
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Nonce  string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Signature of the withdrawal, or of its cancellation, by one of the
+	// bridge signers in params (65 bytes)
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (m *MsgSignWithdrawal) Reset()         { *m = MsgSignWithdrawal{} }
@@ -1208,11 +1472,11 @@ func (m *MsgSignWithdrawal) GetNonce() string {
 	return ""
 }
 
-func (m *MsgSignWithdrawal) GetValidatorEthKeyHex() string {
+func (m *MsgSignWithdrawal) GetSignature() []byte {
 	if m != nil {
-		return m.ValidatorEthKeyHex
+		return m.Signature
 	}
-	return ""
+	return nil
 }
 
 // MsgSignWithdrawalResponse defines the MsgSignWithdrawalResponse message.
@@ -1260,26 +1524,34 @@ func (m *MsgSignWithdrawalResponse) GetSignature() []byte {
 	return nil
 }
 
-// MsgUpdateEthBlockHeight defines the MsgUpdateEthBlockHeight message.
-// Updates the Ethereum block height used for deterministic deposit queries.
-// This is CONSENSUS CRITICAL - all validators must use the same height when querying deposits.
-type MsgUpdateEthBlockHeight struct {
-	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	EthBlockHeight uint64 `protobuf:"varint,2,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
-}
-
-func (m *MsgUpdateEthBlockHeight) Reset()         { *m = MsgUpdateEthBlockHeight{} }
-func (m *MsgUpdateEthBlockHeight) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateEthBlockHeight) ProtoMessage()    {}
-func (*MsgUpdateEthBlockHeight) Descriptor() ([]byte, []int) {
+// MsgCompleteWithdrawal defines the MsgCompleteWithdrawal message.
+// Proves the Withdrawn event emitted when the withdrawal was claimed on the
+// CosmosBridge contract. Anyone may submit the proof.
+type MsgCompleteWithdrawal struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Nonce   string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Source chain block containing the withdraw transaction (must be a tracked header).
+	EthBlockHeight uint64 `protobuf:"varint,3,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	// Index of the withdraw transaction within the block.
+	TxIndex uint64 `protobuf:"varint,4,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Index of the Withdrawn log within the transaction receipt.
+	LogIndex uint64 `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	// Merkle-Patricia proof nodes from the block's receipts root to the receipt.
+	ReceiptProof [][]byte `protobuf:"bytes,6,rep,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
+}
+
+func (m *MsgCompleteWithdrawal) Reset()         { *m = MsgCompleteWithdrawal{} }
+func (m *MsgCompleteWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteWithdrawal) ProtoMessage()    {}
+func (*MsgCompleteWithdrawal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_847cce1c2790a5e1, []int{22}
 }
-func (m *MsgUpdateEthBlockHeight) XXX_Unmarshal(b []byte) error {
+func (m *MsgCompleteWithdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateEthBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCompleteWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateEthBlockHeight.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCompleteWithdrawal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1289,50 +1561,76 @@ func (m *MsgUpdateEthBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateEthBlockHeight) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateEthBlockHeight.Merge(m, src)
+func (m *MsgCompleteWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteWithdrawal.Merge(m, src)
 }
-func (m *MsgUpdateEthBlockHeight) XXX_Size() int {
+func (m *MsgCompleteWithdrawal) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateEthBlockHeight) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateEthBlockHeight.DiscardUnknown(m)
+func (m *MsgCompleteWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteWithdrawal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateEthBlockHeight proto.InternalMessageInfo
+var xxx_messageInfo_MsgCompleteWithdrawal proto.InternalMessageInfo
 
-func (m *MsgUpdateEthBlockHeight) GetAuthority() string {
+func (m *MsgCompleteWithdrawal) GetCreator() string {
 	if m != nil {
-		return m.Authority
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateEthBlockHeight) GetEthBlockHeight() uint64 {
+func (m *MsgCompleteWithdrawal) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *MsgCompleteWithdrawal) GetEthBlockHeight() uint64 {
 	if m != nil {
 		return m.EthBlockHeight
 	}
 	return 0
 }
 
-// MsgUpdateEthBlockHeightResponse defines the MsgUpdateEthBlockHeightResponse message.
-type MsgUpdateEthBlockHeightResponse struct {
-	OldHeight uint64 `protobuf:"varint,1,opt,name=old_height,json=oldHeight,proto3" json:"old_height,omitempty"`
-	NewHeight uint64 `protobuf:"varint,2,opt,name=new_height,json=newHeight,proto3" json:"new_height,omitempty"`
+func (m *MsgCompleteWithdrawal) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
 }
 
-func (m *MsgUpdateEthBlockHeightResponse) Reset()         { *m = MsgUpdateEthBlockHeightResponse{} }
-func (m *MsgUpdateEthBlockHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateEthBlockHeightResponse) ProtoMessage()    {}
-func (*MsgUpdateEthBlockHeightResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCompleteWithdrawal) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *MsgCompleteWithdrawal) GetReceiptProof() [][]byte {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
+// MsgCompleteWithdrawalResponse defines the MsgCompleteWithdrawalResponse message.
+type MsgCompleteWithdrawalResponse struct {
+}
+
+func (m *MsgCompleteWithdrawalResponse) Reset()         { *m = MsgCompleteWithdrawalResponse{} }
+func (m *MsgCompleteWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteWithdrawalResponse) ProtoMessage()    {}
+func (*MsgCompleteWithdrawalResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_847cce1c2790a5e1, []int{23}
 }
-func (m *MsgUpdateEthBlockHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCompleteWithdrawalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateEthBlockHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCompleteWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateEthBlockHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCompleteWithdrawalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1342,54 +1640,38 @@ func (m *MsgUpdateEthBlockHeightResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateEthBlockHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateEthBlockHeightResponse.Merge(m, src)
+func (m *MsgCompleteWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteWithdrawalResponse.Merge(m, src)
 }
-func (m *MsgUpdateEthBlockHeightResponse) XXX_Size() int {
+func (m *MsgCompleteWithdrawalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateEthBlockHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateEthBlockHeightResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgUpdateEthBlockHeightResponse proto.InternalMessageInfo
-
-func (m *MsgUpdateEthBlockHeightResponse) GetOldHeight() uint64 {
-	if m != nil {
-		return m.OldHeight
-	}
-	return 0
+func (m *MsgCompleteWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteWithdrawalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateEthBlockHeightResponse) GetNewHeight() uint64 {
-	if m != nil {
-		return m.NewHeight
-	}
-	return 0
-}
+var xxx_messageInfo_MsgCompleteWithdrawalResponse proto.InternalMessageInfo
 
-// MsgTopUp defines the MsgTopUp message.
-// Allows a player to add chips to their stack when not in an active hand.
-// Player must be in BUSTED, SITTING_OUT, or FOLDED status.
-// Total chips after top-up cannot exceed max_buy_in.
-type MsgTopUp struct {
-	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+// MsgCancelWithdrawal defines the MsgCancelWithdrawal message.
+// Cancels an expired withdrawal. A pending withdrawal is refunded immediately;
+// a signed one moves to "cancelling" until its nonce is invalidated on Base.
+type MsgCancelWithdrawal struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Nonce   string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *MsgTopUp) Reset()         { *m = MsgTopUp{} }
-func (m *MsgTopUp) String() string { return proto.CompactTextString(m) }
-func (*MsgTopUp) ProtoMessage()    {}
-func (*MsgTopUp) Descriptor() ([]byte, []int) {
+func (m *MsgCancelWithdrawal) Reset()         { *m = MsgCancelWithdrawal{} }
+func (m *MsgCancelWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdrawal) ProtoMessage()    {}
+func (*MsgCancelWithdrawal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_847cce1c2790a5e1, []int{24}
 }
-func (m *MsgTopUp) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelWithdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTopUp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelWithdrawal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1399,56 +1681,49 @@ func (m *MsgTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgTopUp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTopUp.Merge(m, src)
+func (m *MsgCancelWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdrawal.Merge(m, src)
 }
-func (m *MsgTopUp) XXX_Size() int {
+func (m *MsgCancelWithdrawal) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTopUp) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTopUp.DiscardUnknown(m)
+func (m *MsgCancelWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdrawal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTopUp proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelWithdrawal proto.InternalMessageInfo
 
-func (m *MsgTopUp) GetPlayer() string {
+func (m *MsgCancelWithdrawal) GetCreator() string {
 	if m != nil {
-		return m.Player
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgTopUp) GetGameId() string {
+func (m *MsgCancelWithdrawal) GetNonce() string {
 	if m != nil {
-		return m.GameId
+		return m.Nonce
 	}
 	return ""
 }
 
-func (m *MsgTopUp) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-// MsgTopUpResponse defines the MsgTopUpResponse message.
-type MsgTopUpResponse struct {
-	NewStack uint64 `protobuf:"varint,1,opt,name=new_stack,json=newStack,proto3" json:"new_stack,omitempty"`
+// MsgCancelWithdrawalResponse defines the MsgCancelWithdrawalResponse message.
+type MsgCancelWithdrawalResponse struct {
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (m *MsgTopUpResponse) Reset()         { *m = MsgTopUpResponse{} }
-func (m *MsgTopUpResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgTopUpResponse) ProtoMessage()    {}
-func (*MsgTopUpResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelWithdrawalResponse) Reset()         { *m = MsgCancelWithdrawalResponse{} }
+func (m *MsgCancelWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdrawalResponse) ProtoMessage()    {}
+func (*MsgCancelWithdrawalResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_847cce1c2790a5e1, []int{25}
 }
-func (m *MsgTopUpResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelWithdrawalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTopUpResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelWithdrawalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1458,2125 +1733,8286 @@ func (m *MsgTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgTopUpResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTopUpResponse.Merge(m, src)
+func (m *MsgCancelWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdrawalResponse.Merge(m, src)
 }
-func (m *MsgTopUpResponse) XXX_Size() int {
+func (m *MsgCancelWithdrawalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTopUpResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTopUpResponse.DiscardUnknown(m)
+func (m *MsgCancelWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdrawalResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTopUpResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelWithdrawalResponse proto.InternalMessageInfo
 
-func (m *MsgTopUpResponse) GetNewStack() uint64 {
+func (m *MsgCancelWithdrawalResponse) GetStatus() string {
 	if m != nil {
-		return m.NewStack
+		return m.Status
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "pokerchain.poker.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pokerchain.poker.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateGame)(nil), "pokerchain.poker.v1.MsgCreateGame")
-	proto.RegisterType((*MsgCreateGameResponse)(nil), "pokerchain.poker.v1.MsgCreateGameResponse")
-	proto.RegisterType((*MsgJoinGame)(nil), "pokerchain.poker.v1.MsgJoinGame")
-	proto.RegisterType((*MsgJoinGameResponse)(nil), "pokerchain.poker.v1.MsgJoinGameResponse")
-	proto.RegisterType((*MsgLeaveGame)(nil), "pokerchain.poker.v1.MsgLeaveGame")
-	proto.RegisterType((*MsgLeaveGameResponse)(nil), "pokerchain.poker.v1.MsgLeaveGameResponse")
-	proto.RegisterType((*MsgDealCards)(nil), "pokerchain.poker.v1.MsgDealCards")
-	proto.RegisterType((*MsgDealCardsResponse)(nil), "pokerchain.poker.v1.MsgDealCardsResponse")
-	proto.RegisterType((*MsgPerformAction)(nil), "pokerchain.poker.v1.MsgPerformAction")
-	proto.RegisterType((*MsgPerformActionResponse)(nil), "pokerchain.poker.v1.MsgPerformActionResponse")
-	proto.RegisterType((*MsgMint)(nil), "pokerchain.poker.v1.MsgMint")
-	proto.RegisterType((*MsgMintResponse)(nil), "pokerchain.poker.v1.MsgMintResponse")
-	proto.RegisterType((*MsgBurn)(nil), "pokerchain.poker.v1.MsgBurn")
-	proto.RegisterType((*MsgBurnResponse)(nil), "pokerchain.poker.v1.MsgBurnResponse")
-	proto.RegisterType((*MsgProcessDeposit)(nil), "pokerchain.poker.v1.MsgProcessDeposit")
-	proto.RegisterType((*MsgProcessDepositResponse)(nil), "pokerchain.poker.v1.MsgProcessDepositResponse")
-	proto.RegisterType((*MsgInitiateWithdrawal)(nil), "pokerchain.poker.v1.MsgInitiateWithdrawal")
-	proto.RegisterType((*MsgInitiateWithdrawalResponse)(nil), "pokerchain.poker.v1.MsgInitiateWithdrawalResponse")
-	proto.RegisterType((*MsgSignWithdrawal)(nil), "pokerchain.poker.v1.MsgSignWithdrawal")
-	proto.RegisterType((*MsgSignWithdrawalResponse)(nil), "pokerchain.poker.v1.MsgSignWithdrawalResponse")
-	proto.RegisterType((*MsgUpdateEthBlockHeight)(nil), "pokerchain.poker.v1.MsgUpdateEthBlockHeight")
-	proto.RegisterType((*MsgUpdateEthBlockHeightResponse)(nil), "pokerchain.poker.v1.MsgUpdateEthBlockHeightResponse")
-	proto.RegisterType((*MsgTopUp)(nil), "pokerchain.poker.v1.MsgTopUp")
-	proto.RegisterType((*MsgTopUpResponse)(nil), "pokerchain.poker.v1.MsgTopUpResponse")
+// MsgRefundWithdrawal defines the MsgRefundWithdrawal message.
+// Proves the WithdrawalCancelled event emitted when the cancellation signature
+// was submitted to the CosmosBridge contract, and re-mints the withdrawal to
+// its Cosmos owner. Anyone may submit the proof.
+type MsgRefundWithdrawal struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Nonce   string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Source chain block containing the cancel transaction (must be a tracked header).
+	EthBlockHeight uint64 `protobuf:"varint,3,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	// Index of the cancel transaction within the block.
+	TxIndex uint64 `protobuf:"varint,4,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Index of the WithdrawalCancelled log within the transaction receipt.
+	LogIndex uint64 `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	// Merkle-Patricia proof nodes from the block's receipts root to the receipt.
+	ReceiptProof [][]byte `protobuf:"bytes,6,rep,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
 }
 
-func init() { proto.RegisterFile("pokerchain/poker/v1/tx.proto", fileDescriptor_847cce1c2790a5e1) }
-
-var fileDescriptor_847cce1c2790a5e1 = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xdf, 0x6f, 0x1b, 0xc5,
-	0x16, 0xc7, 0xb3, 0x89, 0xf3, 0xc3, 0xc7, 0x4e, 0xda, 0x6c, 0xd3, 0xc6, 0x71, 0x93, 0x34, 0x75,
-	0x7f, 0xf9, 0xa6, 0xa9, 0x9d, 0xa4, 0x4d, 0xaf, 0x9a, 0x2b, 0xdd, 0xab, 0xa6, 0xed, 0xa5, 0x01,
-	0x2c, 0xa2, 0x6d, 0x2a, 0x24, 0x5e, 0x56, 0x63, 0xef, 0x74, 0x77, 0x88, 0x77, 0x67, 0xb5, 0x33,
-	0x4e, 0x6c, 0x24, 0x24, 0xd4, 0x27, 0x7e, 0x08, 0x09, 0x04, 0x2f, 0x80, 0x2a, 0x81, 0xc4, 0x03,
-	0x8f, 0x41, 0x02, 0xf1, 0xc0, 0x0b, 0x8f, 0x7d, 0xac, 0xe0, 0x01, 0x9e, 0x10, 0x6a, 0x91, 0xf2,
-	0x6f, 0xa0, 0x99, 0x5d, 0xaf, 0xbd, 0xae, 0xd7, 0x31, 0x51, 0x79, 0xa9, 0x3c, 0xe7, 0x7c, 0xe7,
-	0x9c, 0xcf, 0x9c, 0x33, 0x9d, 0x3d, 0x0a, 0xcc, 0xba, 0x74, 0x07, 0x7b, 0x15, 0x0b, 0x11, 0xa7,
-	0x28, 0x7f, 0x16, 0x77, 0x57, 0x8a, 0xbc, 0x5e, 0x70, 0x3d, 0xca, 0xa9, 0x7a, 0xa2, 0xe5, 0x2d,
-	0xc8, 0x9f, 0x85, 0xdd, 0x95, 0xec, 0x24, 0xb2, 0x89, 0x43, 0x8b, 0xf2, 0x5f, 0x5f, 0x97, 0x9d,
-	0xae, 0x50, 0x66, 0x53, 0x56, 0xb4, 0x99, 0x29, 0xf6, 0xdb, 0xcc, 0x0c, 0x1c, 0x33, 0xbe, 0x43,
-	0x97, 0xab, 0xa2, 0xbf, 0x08, 0x5c, 0x53, 0x26, 0x35, 0xa9, 0x6f, 0x17, 0xbf, 0x02, 0xeb, 0xac,
-	0x49, 0xa9, 0x59, 0xc5, 0x45, 0xe4, 0x92, 0x22, 0x72, 0x1c, 0xca, 0x11, 0x27, 0xd4, 0x69, 0xee,
-	0x59, 0xe8, 0x46, 0xeb, 0x22, 0x0f, 0xd9, 0x81, 0x22, 0xf7, 0x93, 0x02, 0xc7, 0x4a, 0xcc, 0xbc,
-	0xef, 0x1a, 0x88, 0xe3, 0x2d, 0xe9, 0x51, 0xaf, 0x43, 0x12, 0xd5, 0xb8, 0x45, 0x3d, 0xc2, 0x1b,
-	0x19, 0x65, 0x41, 0xc9, 0x27, 0x37, 0x32, 0x3f, 0x7f, 0x77, 0x65, 0x2a, 0xc0, 0xb9, 0x69, 0x18,
-	0x1e, 0x66, 0xec, 0x1e, 0xf7, 0x88, 0x63, 0x6a, 0x2d, 0xa9, 0xfa, 0x5f, 0x18, 0xf1, 0x63, 0x67,
-	0x06, 0x17, 0x94, 0x7c, 0x6a, 0xf5, 0x74, 0xa1, 0x4b, 0x39, 0x0a, 0x7e, 0x92, 0x8d, 0xe4, 0xe3,
-	0xdf, 0xcf, 0x0c, 0x7c, 0x73, 0xb0, 0xbf, 0xa8, 0x68, 0xc1, 0xae, 0xf5, 0xb5, 0x87, 0x07, 0xfb,
-	0x8b, 0xad, 0x78, 0xef, 0x1f, 0xec, 0x2f, 0xe6, 0xda, 0x0e, 0x50, 0x0f, 0x8e, 0xd0, 0x81, 0x9b,
-	0x9b, 0x81, 0xe9, 0x0e, 0x93, 0x86, 0x99, 0x4b, 0x1d, 0x86, 0x73, 0xbf, 0x0e, 0xc1, 0x78, 0x89,
-	0x99, 0xb7, 0x3c, 0x8c, 0x38, 0x7e, 0x09, 0xd9, 0x58, 0x5d, 0x85, 0xd1, 0x8a, 0x58, 0x51, 0xef,
-	0xd0, 0x93, 0x35, 0x85, 0xea, 0x2c, 0x80, 0x4d, 0x1c, 0xbd, 0x5c, 0x6b, 0xe8, 0xc4, 0x91, 0x67,
-	0x4b, 0x68, 0x63, 0x36, 0x71, 0x36, 0x6a, 0x8d, 0x4d, 0x47, 0x7a, 0x51, 0xbd, 0xe9, 0x1d, 0x0a,
-	0xbc, 0xa8, 0xee, 0x7b, 0xcf, 0x40, 0x4a, 0xec, 0x75, 0xab, 0xa8, 0x81, 0x3d, 0x96, 0x49, 0x2c,
-	0x28, 0xf9, 0x21, 0x4d, 0x84, 0xdb, 0xf2, 0x2d, 0x52, 0x80, 0xea, 0xa1, 0x60, 0x38, 0x10, 0xa0,
-	0x7a, 0x9b, 0x80, 0xd9, 0xa8, 0x5a, 0xd5, 0xcb, 0x55, 0xe2, 0x18, 0x99, 0x11, 0x99, 0x00, 0xa4,
-	0x69, 0x43, 0x58, 0xd4, 0xd3, 0x90, 0x2c, 0x13, 0x33, 0x70, 0x8f, 0xfa, 0xf9, 0xcb, 0xc4, 0xf4,
-	0x9d, 0x19, 0x18, 0xe5, 0xc4, 0xc6, 0xb4, 0xc6, 0x33, 0x63, 0x32, 0x74, 0x73, 0x29, 0xb6, 0x99,
-	0xc8, 0xc6, 0x3a, 0x6f, 0xb8, 0x38, 0x93, 0x14, 0xb5, 0xd0, 0xc6, 0x84, 0x61, 0xbb, 0xe1, 0x62,
-	0xb5, 0x00, 0x27, 0x3c, 0xb4, 0x83, 0xf5, 0x07, 0x1e, 0xc6, 0x3a, 0xb7, 0x3c, 0xcc, 0x2c, 0x5a,
-	0x35, 0x32, 0x20, 0xa3, 0x4f, 0x0a, 0xd7, 0xff, 0x3d, 0x8c, 0xb7, 0x9b, 0x0e, 0xf5, 0x12, 0x1c,
-	0x93, 0x7a, 0x17, 0x7b, 0x15, 0xec, 0x70, 0x64, 0xe2, 0x4c, 0x6a, 0x41, 0xc9, 0x8f, 0x6b, 0x13,
-	0xc2, 0xbc, 0x15, 0x5a, 0xd5, 0x19, 0x18, 0x93, 0xc2, 0x0a, 0x72, 0x33, 0x69, 0x19, 0x6d, 0x54,
-	0xac, 0x6f, 0x21, 0x57, 0x9d, 0x03, 0x90, 0x2e, 0xba, 0xe7, 0x60, 0x2f, 0x33, 0x2e, 0x89, 0x92,
-	0xc2, 0xf2, 0x9a, 0x30, 0xac, 0xa7, 0xc5, 0xed, 0x68, 0xf6, 0x24, 0x37, 0x0d, 0x27, 0x23, 0x8d,
-	0x0d, 0x5b, 0xfe, 0x48, 0x81, 0x54, 0x89, 0x99, 0x2f, 0x53, 0xe2, 0xc8, 0x86, 0x2f, 0xc3, 0x88,
-	0x5f, 0xdb, 0x43, 0xfb, 0x1d, 0xe8, 0xd4, 0x69, 0x18, 0x95, 0x85, 0x21, 0x86, 0xec, 0x75, 0x52,
-	0x1b, 0x11, 0xcb, 0x4d, 0x43, 0x55, 0x21, 0xc1, 0x30, 0xe2, 0x41, 0x8f, 0xe5, 0x6f, 0x35, 0x07,
-	0xe3, 0x7e, 0xe7, 0x75, 0x64, 0xd3, 0x9a, 0xc3, 0x65, 0x87, 0x13, 0x5a, 0xaa, 0x2c, 0xba, 0x7f,
-	0x53, 0x9a, 0xd6, 0x53, 0x82, 0x3c, 0x88, 0x9e, 0x3b, 0x09, 0x27, 0xda, 0xf0, 0x42, 0x6c, 0x02,
-	0xe9, 0x12, 0x33, 0x5f, 0xc5, 0x68, 0xf7, 0xe8, 0xf7, 0x34, 0x0e, 0xbc, 0xa3, 0x74, 0xa7, 0x60,
-	0xaa, 0x3d, 0x55, 0x07, 0xc2, 0x6d, 0x8c, 0xaa, 0xb7, 0x90, 0x67, 0xb0, 0x7f, 0x1e, 0x21, 0x4c,
-	0x15, 0x22, 0x7c, 0xae, 0xc0, 0xf1, 0x12, 0x33, 0xb7, 0xb0, 0xf7, 0x80, 0x7a, 0xf6, 0xcd, 0x8a,
-	0x78, 0xcb, 0x5e, 0x64, 0x07, 0x4f, 0xc1, 0x08, 0x92, 0x41, 0x65, 0x0f, 0x93, 0x5a, 0xb0, 0x92,
-	0xf6, 0xf6, 0xf6, 0x05, 0xab, 0x68, 0xe7, 0xb2, 0x90, 0xe9, 0x64, 0x0b, 0xc1, 0x7f, 0x50, 0x60,
-	0xb4, 0xc4, 0xcc, 0x12, 0x71, 0xf8, 0x11, 0x9f, 0x98, 0xa4, 0x87, 0x2b, 0xc4, 0x25, 0xd8, 0xe1,
-	0x01, 0x73, 0xcb, 0xd0, 0x86, 0x37, 0xd4, 0x8e, 0xa7, 0xce, 0x43, 0x0a, 0x73, 0x4b, 0xe7, 0x75,
-	0xdd, 0x42, 0xcc, 0x92, 0xec, 0x49, 0x2d, 0x89, 0xb9, 0xb5, 0x5d, 0xbf, 0x8b, 0x98, 0xa5, 0x4e,
-	0xc1, 0xb0, 0x43, 0x9d, 0x0a, 0x96, 0xaf, 0x4a, 0x42, 0xf3, 0x17, 0x1d, 0xad, 0x98, 0x94, 0xef,
-	0xbf, 0x00, 0x0f, 0x0f, 0xf3, 0xae, 0x7f, 0x98, 0x8d, 0x9a, 0xe7, 0x1c, 0xe9, 0x30, 0x2d, 0xdc,
-	0xc1, 0x08, 0xee, 0x39, 0x18, 0x17, 0xb8, 0xad, 0x83, 0xfa, 0x4d, 0x48, 0x63, 0x6e, 0x69, 0x4d,
-	0x5b, 0x57, 0x3a, 0x41, 0x12, 0xd2, 0x7d, 0xad, 0xc0, 0xa4, 0xe8, 0x83, 0x47, 0x2b, 0x98, 0xb1,
-	0xdb, 0xd8, 0xa5, 0x8c, 0x1c, 0xad, 0xe8, 0xe7, 0x60, 0xdc, 0xf0, 0xb7, 0xeb, 0xc4, 0x31, 0x70,
-	0x3d, 0xc0, 0x4d, 0x07, 0xc6, 0x4d, 0x61, 0x53, 0xf3, 0x70, 0x5c, 0x40, 0x97, 0xab, 0xb4, 0xb2,
-	0xa3, 0x5b, 0x98, 0x98, 0x56, 0xb3, 0x0b, 0x13, 0x98, 0x5b, 0x1b, 0xc2, 0x7c, 0x57, 0x5a, 0x3b,
-	0xc8, 0xbf, 0x54, 0x60, 0xe6, 0x39, 0xcc, 0xe6, 0x21, 0xa2, 0xfd, 0x56, 0xe2, 0xfb, 0x1d, 0x5c,
-	0xdf, 0x56, 0x01, 0xa3, 0xc0, 0x43, 0x7d, 0x02, 0x27, 0xba, 0x01, 0xe7, 0x3e, 0x55, 0xe4, 0x23,
-	0xba, 0xe9, 0x10, 0x4e, 0x10, 0xc7, 0xaf, 0x13, 0x6e, 0x19, 0x1e, 0xda, 0x43, 0xd5, 0x17, 0xda,
-	0xf5, 0xb3, 0x90, 0x2e, 0x23, 0x86, 0x75, 0xe4, 0x6f, 0x0b, 0x9a, 0x9e, 0x12, 0xb6, 0x20, 0x52,
-	0x47, 0xe5, 0xd6, 0x60, 0xae, 0x2b, 0x55, 0x58, 0xbc, 0xf0, 0x5a, 0xfb, 0x85, 0xf3, 0x17, 0xb9,
-	0x2f, 0xfc, 0x7b, 0x71, 0x8f, 0x98, 0x4e, 0xdb, 0x49, 0x96, 0x61, 0x84, 0x11, 0xd3, 0xe9, 0xe7,
-	0xf1, 0xf0, 0x75, 0xad, 0xe8, 0x83, 0x6d, 0xd1, 0xd5, 0x15, 0x38, 0xb9, 0x8b, 0xaa, 0xc4, 0x10,
-	0x84, 0xba, 0xa8, 0xef, 0x0e, 0x6e, 0xe8, 0x56, 0xd0, 0x82, 0xa4, 0xa6, 0x86, 0xce, 0x3b, 0xdc,
-	0x7a, 0x05, 0x37, 0xee, 0xe2, 0x7a, 0xf0, 0x78, 0xf8, 0x51, 0x73, 0x37, 0xe4, 0x6d, 0x88, 0xc2,
-	0xb5, 0xdf, 0x06, 0x21, 0x43, 0xbc, 0xe6, 0xf9, 0x87, 0x4a, 0x6b, 0x2d, 0x43, 0xee, 0x03, 0xa5,
-	0x6d, 0xc0, 0xb9, 0x13, 0x69, 0xe1, 0x91, 0x47, 0xb5, 0x6e, 0x97, 0x64, 0xb0, 0xeb, 0xad, 0x9e,
-	0x88, 0x0e, 0x65, 0x39, 0x1d, 0xce, 0xc4, 0xc0, 0x84, 0xc7, 0x99, 0x03, 0xa0, 0x55, 0xa3, 0x19,
-	0x56, 0x91, 0x61, 0x93, 0xb4, 0x6a, 0x04, 0xcc, 0x73, 0x00, 0x0e, 0xde, 0x8b, 0x66, 0x4d, 0x3a,
-	0x78, 0x2f, 0xb8, 0x95, 0x6f, 0xc1, 0x58, 0x89, 0x99, 0xdb, 0xd4, 0xbd, 0xef, 0xbe, 0xe8, 0xa7,
-	0xbf, 0xcb, 0x1b, 0x1a, 0x7d, 0xe2, 0x8b, 0xf2, 0xf3, 0x23, 0x73, 0x87, 0xa7, 0x39, 0x0d, 0x02,
-	0x4e, 0x67, 0x1c, 0x55, 0x76, 0x82, 0xc3, 0x8c, 0x39, 0x78, 0xef, 0x9e, 0x58, 0xaf, 0x7e, 0x7f,
-	0x0c, 0x86, 0x4a, 0xcc, 0x54, 0x3f, 0x53, 0x20, 0x1d, 0x99, 0xa1, 0xcf, 0x77, 0x9d, 0x7d, 0x3b,
-	0xe6, 0xd4, 0xec, 0x52, 0x3f, 0xaa, 0xf0, 0xe5, 0x5b, 0x7b, 0xf8, 0xcb, 0x9f, 0x9f, 0x0c, 0x16,
-	0xd7, 0x95, 0xc5, 0xdc, 0x62, 0x51, 0xb6, 0x6e, 0x6d, 0xb5, 0xd8, 0x6d, 0xc2, 0xaf, 0xc9, 0xdd,
-	0xba, 0x3f, 0x56, 0xab, 0x1f, 0x2b, 0x00, 0x6d, 0x13, 0x70, 0x2e, 0x2e, 0x67, 0x4b, 0x93, 0x5d,
-	0x3c, 0x5c, 0x13, 0x52, 0x5d, 0x95, 0x54, 0x57, 0x04, 0x55, 0xbe, 0x27, 0x95, 0xfc, 0xff, 0x8d,
-	0x75, 0xd1, 0x0f, 0xf5, 0x3d, 0x05, 0xc6, 0xc2, 0x11, 0x6d, 0x21, 0x2e, 0x5b, 0x53, 0x91, 0xcd,
-	0x1f, 0xa6, 0x08, 0x69, 0x56, 0x24, 0xcd, 0x65, 0x41, 0x73, 0xb1, 0x27, 0xcd, 0x9b, 0x94, 0x38,
-	0x3e, 0xcb, 0x87, 0x0a, 0x24, 0x5b, 0x83, 0xd7, 0xd9, 0xb8, 0x54, 0xa1, 0x24, 0xfb, 0xaf, 0x43,
-	0x25, 0x21, 0xce, 0xaa, 0xc4, 0x59, 0x12, 0x38, 0x97, 0x7a, 0xe2, 0x54, 0xc5, 0xd6, 0x16, 0x4f,
-	0x6b, 0x0a, 0x8b, 0xe5, 0x09, 0x25, 0xf1, 0x3c, 0xcf, 0x0f, 0x58, 0x7d, 0xf3, 0x18, 0x18, 0x55,
-	0xf5, 0x8a, 0x24, 0x78, 0xa4, 0xc0, 0x78, 0x74, 0x22, 0xbb, 0x10, 0x97, 0x30, 0x22, 0xcb, 0x5e,
-	0xe9, 0x4b, 0x16, 0xb2, 0x5d, 0x97, 0x6c, 0xcb, 0x82, 0xed, 0x72, 0x4f, 0x36, 0xd7, 0xdf, 0xae,
-	0x07, 0xc3, 0x5b, 0x1d, 0x12, 0x72, 0xee, 0x9a, 0x8d, 0x4b, 0x27, 0xbc, 0xd9, 0xf3, 0xbd, 0xbc,
-	0x21, 0xc3, 0x92, 0x64, 0xb8, 0x28, 0x18, 0xce, 0xf6, 0x64, 0xb0, 0x45, 0xc6, 0x3a, 0x24, 0xe4,
-	0x90, 0x14, 0x9b, 0x59, 0x78, 0xe3, 0x33, 0x47, 0xc6, 0x9a, 0xbe, 0x33, 0x97, 0x45, 0xc6, 0xaf,
-	0x14, 0x98, 0xe8, 0x98, 0x80, 0x2e, 0xc6, 0x56, 0x3b, 0xa2, 0xcb, 0x16, 0xfa, 0xd3, 0x85, 0x60,
-	0xff, 0x96, 0x60, 0x2b, 0x02, 0x6c, 0xa9, 0x77, 0x5b, 0xfc, 0xfd, 0x7a, 0x30, 0x8d, 0xa8, 0xdf,
-	0x2a, 0xa0, 0x76, 0x99, 0x2d, 0x62, 0xdf, 0x96, 0xe7, 0xb5, 0xd9, 0xd5, 0xfe, 0xb5, 0x21, 0xef,
-	0x7f, 0x24, 0xef, 0x9a, 0xe0, 0x5d, 0xee, 0xc9, 0x4b, 0x82, 0x18, 0xfa, 0x5e, 0x0b, 0x4e, 0xd4,
-	0xb5, 0x63, 0x82, 0x88, 0xad, 0x6b, 0x54, 0x17, 0x5f, 0xd7, 0xee, 0x1f, 0xfd, 0xfe, 0xeb, 0x2a,
-	0x26, 0x81, 0x76, 0xc6, 0x1f, 0x15, 0x98, 0xea, 0x3a, 0x0c, 0x1c, 0xf2, 0x35, 0x89, 0xaa, 0xb3,
-	0xd7, 0xfe, 0x8e, 0x3a, 0xa4, 0xfe, 0x9f, 0xa4, 0xbe, 0x21, 0xa8, 0xaf, 0xf5, 0xf3, 0x0d, 0xea,
-	0x9c, 0x32, 0xd4, 0xb7, 0x61, 0xd8, 0xff, 0xb6, 0xcf, 0xc5, 0xe5, 0x97, 0xee, 0xec, 0x85, 0x9e,
-	0xee, 0x90, 0xa7, 0x20, 0x79, 0xf2, 0x82, 0xe7, 0x5c, 0x4f, 0x1e, 0x4e, 0x5d, 0xbd, 0xe6, 0x66,
-	0x87, 0xdf, 0x39, 0xd8, 0x5f, 0x54, 0x36, 0xee, 0x3c, 0x7e, 0x3a, 0xaf, 0x3c, 0x79, 0x3a, 0xaf,
-	0xfc, 0xf1, 0x74, 0x5e, 0xf9, 0xe8, 0xd9, 0xfc, 0xc0, 0x93, 0x67, 0xf3, 0x03, 0xbf, 0x3d, 0x9b,
-	0x1f, 0x78, 0xe3, 0xb2, 0x49, 0xb8, 0x55, 0x2b, 0x17, 0x2a, 0xd4, 0xee, 0x16, 0xaf, 0xf9, 0x47,
-	0x28, 0xde, 0x70, 0x31, 0x2b, 0x8f, 0xc8, 0x3f, 0xa2, 0x5d, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0xcc, 0x71, 0xdf, 0x16, 0x14, 0x00, 0x00,
+func (m *MsgRefundWithdrawal) Reset()         { *m = MsgRefundWithdrawal{} }
+func (m *MsgRefundWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundWithdrawal) ProtoMessage()    {}
+func (*MsgRefundWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{26}
+}
+func (m *MsgRefundWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundWithdrawal.Merge(m, src)
+}
+func (m *MsgRefundWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundWithdrawal.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRefundWithdrawal proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateGame defines the CreateGame RPC.
-	CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error)
-	// JoinGame defines the JoinGame RPC.
-	JoinGame(ctx context.Context, in *MsgJoinGame, opts ...grpc.CallOption) (*MsgJoinGameResponse, error)
-	// LeaveGame defines the LeaveGame RPC.
-	LeaveGame(ctx context.Context, in *MsgLeaveGame, opts ...grpc.CallOption) (*MsgLeaveGameResponse, error)
-	// DealCards defines the DealCards RPC.
-	DealCards(ctx context.Context, in *MsgDealCards, opts ...grpc.CallOption) (*MsgDealCardsResponse, error)
-	// PerformAction defines the PerformAction RPC.
-	PerformAction(ctx context.Context, in *MsgPerformAction, opts ...grpc.CallOption) (*MsgPerformActionResponse, error)
-	// Mint defines the Mint RPC.
-	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
-	// Burn defines the Burn RPC.
-	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
-	// ProcessDeposit defines the ProcessDeposit RPC.
-	// Processes an Ethereum deposit by querying the deposit index from the bridge contract.
-	ProcessDeposit(ctx context.Context, in *MsgProcessDeposit, opts ...grpc.CallOption) (*MsgProcessDepositResponse, error)
-	// InitiateWithdrawal defines the InitiateWithdrawal RPC.
-	// Initiates a USDC withdrawal from Cosmos to Base chain.
-	InitiateWithdrawal(ctx context.Context, in *MsgInitiateWithdrawal, opts ...grpc.CallOption) (*MsgInitiateWithdrawalResponse, error)
-	// SignWithdrawal defines the SignWithdrawal RPC.
-	// Manually signs a pending withdrawal request (for validators only).
-	SignWithdrawal(ctx context.Context, in *MsgSignWithdrawal, opts ...grpc.CallOption) (*MsgSignWithdrawalResponse, error)
-	// UpdateEthBlockHeight defines the UpdateEthBlockHeight RPC.
-	// Updates the Ethereum block height used for deterministic deposit queries.
-	// This must be called via a transaction to ensure all validators use the same height.
-	UpdateEthBlockHeight(ctx context.Context, in *MsgUpdateEthBlockHeight, opts ...grpc.CallOption) (*MsgUpdateEthBlockHeightResponse, error)
-	// TopUp defines the TopUp RPC.
-	// Allows a player to add chips to their stack when not in an active hand.
-	TopUp(ctx context.Context, in *MsgTopUp, opts ...grpc.CallOption) (*MsgTopUpResponse, error)
+func (m *MsgRefundWithdrawal) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRefundWithdrawal) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRefundWithdrawal) GetEthBlockHeight() uint64 {
+	if m != nil {
+		return m.EthBlockHeight
+	}
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRefundWithdrawal) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error) {
-	out := new(MsgCreateGameResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/CreateGame", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRefundWithdrawal) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) JoinGame(ctx context.Context, in *MsgJoinGame, opts ...grpc.CallOption) (*MsgJoinGameResponse, error) {
-	out := new(MsgJoinGameResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/JoinGame", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRefundWithdrawal) GetReceiptProof() [][]byte {
+	if m != nil {
+		return m.ReceiptProof
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) LeaveGame(ctx context.Context, in *MsgLeaveGame, opts ...grpc.CallOption) (*MsgLeaveGameResponse, error) {
-	out := new(MsgLeaveGameResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/LeaveGame", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRefundWithdrawalResponse defines the MsgRefundWithdrawalResponse message.
+type MsgRefundWithdrawalResponse struct {
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (c *msgClient) DealCards(ctx context.Context, in *MsgDealCards, opts ...grpc.CallOption) (*MsgDealCardsResponse, error) {
-	out := new(MsgDealCardsResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/DealCards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRefundWithdrawalResponse) Reset()         { *m = MsgRefundWithdrawalResponse{} }
+func (m *MsgRefundWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundWithdrawalResponse) ProtoMessage()    {}
+func (*MsgRefundWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{27}
+}
+func (m *MsgRefundWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRefundWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundWithdrawalResponse.Merge(m, src)
+}
+func (m *MsgRefundWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundWithdrawalResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) PerformAction(ctx context.Context, in *MsgPerformAction, opts ...grpc.CallOption) (*MsgPerformActionResponse, error) {
-	out := new(MsgPerformActionResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/PerformAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRefundWithdrawalResponse proto.InternalMessageInfo
+
+func (m *MsgRefundWithdrawalResponse) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
-	out := new(MsgMintResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/Mint", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetBridgePaused defines the MsgSetBridgePaused message.
+// Circuit breaker for the bridge: while paused, ProcessDeposit, Mint and
+// InitiateWithdrawal are rejected and queued withdrawals are neither released
+// nor signed.
+type MsgSetBridgePaused struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
-	out := new(MsgBurnResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/Burn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBridgePaused) Reset()         { *m = MsgSetBridgePaused{} }
+func (m *MsgSetBridgePaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgePaused) ProtoMessage()    {}
+func (*MsgSetBridgePaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{28}
+}
+func (m *MsgSetBridgePaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBridgePaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBridgePaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetBridgePaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgePaused.Merge(m, src)
+}
+func (m *MsgSetBridgePaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBridgePaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgePaused.DiscardUnknown(m)
 }
 
-func (c *msgClient) ProcessDeposit(ctx context.Context, in *MsgProcessDeposit, opts ...grpc.CallOption) (*MsgProcessDepositResponse, error) {
-	out := new(MsgProcessDepositResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/ProcessDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetBridgePaused proto.InternalMessageInfo
+
+func (m *MsgSetBridgePaused) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) InitiateWithdrawal(ctx context.Context, in *MsgInitiateWithdrawal, opts ...grpc.CallOption) (*MsgInitiateWithdrawalResponse, error) {
-	out := new(MsgInitiateWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/InitiateWithdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBridgePaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) SignWithdrawal(ctx context.Context, in *MsgSignWithdrawal, opts ...grpc.CallOption) (*MsgSignWithdrawalResponse, error) {
-	out := new(MsgSignWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/SignWithdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBridgePaused) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateEthBlockHeight(ctx context.Context, in *MsgUpdateEthBlockHeight, opts ...grpc.CallOption) (*MsgUpdateEthBlockHeightResponse, error) {
-	out := new(MsgUpdateEthBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/UpdateEthBlockHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetBridgePausedResponse defines the MsgSetBridgePausedResponse message.
+type MsgSetBridgePausedResponse struct {
 }
 
-func (c *msgClient) TopUp(ctx context.Context, in *MsgTopUp, opts ...grpc.CallOption) (*MsgTopUpResponse, error) {
-	out := new(MsgTopUpResponse)
-	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/TopUp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetBridgePausedResponse) Reset()         { *m = MsgSetBridgePausedResponse{} }
+func (m *MsgSetBridgePausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgePausedResponse) ProtoMessage()    {}
+func (*MsgSetBridgePausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{29}
+}
+func (m *MsgSetBridgePausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBridgePausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBridgePausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateGame defines the CreateGame RPC.
-	CreateGame(context.Context, *MsgCreateGame) (*MsgCreateGameResponse, error)
-	// JoinGame defines the JoinGame RPC.
-	JoinGame(context.Context, *MsgJoinGame) (*MsgJoinGameResponse, error)
-	// LeaveGame defines the LeaveGame RPC.
-	LeaveGame(context.Context, *MsgLeaveGame) (*MsgLeaveGameResponse, error)
-	// DealCards defines the DealCards RPC.
-	DealCards(context.Context, *MsgDealCards) (*MsgDealCardsResponse, error)
-	// PerformAction defines the PerformAction RPC.
-	PerformAction(context.Context, *MsgPerformAction) (*MsgPerformActionResponse, error)
-	// Mint defines the Mint RPC.
-	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
-	// Burn defines the Burn RPC.
-	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
-	// ProcessDeposit defines the ProcessDeposit RPC.
-	// Processes an Ethereum deposit by querying the deposit index from the bridge contract.
-	ProcessDeposit(context.Context, *MsgProcessDeposit) (*MsgProcessDepositResponse, error)
-	// InitiateWithdrawal defines the InitiateWithdrawal RPC.
-	// Initiates a USDC withdrawal from Cosmos to Base chain.
-	InitiateWithdrawal(context.Context, *MsgInitiateWithdrawal) (*MsgInitiateWithdrawalResponse, error)
-	// SignWithdrawal defines the SignWithdrawal RPC.
-	// Manually signs a pending withdrawal request (for validators only).
-	SignWithdrawal(context.Context, *MsgSignWithdrawal) (*MsgSignWithdrawalResponse, error)
-	// UpdateEthBlockHeight defines the UpdateEthBlockHeight RPC.
-	// Updates the Ethereum block height used for deterministic deposit queries.
-	// This must be called via a transaction to ensure all validators use the same height.
-	UpdateEthBlockHeight(context.Context, *MsgUpdateEthBlockHeight) (*MsgUpdateEthBlockHeightResponse, error)
-	// TopUp defines the TopUp RPC.
-	// Allows a player to add chips to their stack when not in an active hand.
-	TopUp(context.Context, *MsgTopUp) (*MsgTopUpResponse, error)
+func (m *MsgSetBridgePausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgePausedResponse.Merge(m, src)
+}
+func (m *MsgSetBridgePausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBridgePausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgePausedResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSetBridgePausedResponse proto.InternalMessageInfo
+
+// MsgUpdateEthBlockHeight defines the MsgUpdateEthBlockHeight message.
+// Updates the Ethereum block height used for deterministic deposit queries.
+// This is CONSENSUS CRITICAL - all validators must use the same height when querying deposits.
+type MsgUpdateEthBlockHeight struct {
+	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	EthBlockHeight uint64 `protobuf:"varint,2,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUpdateEthBlockHeight) Reset()         { *m = MsgUpdateEthBlockHeight{} }
+func (m *MsgUpdateEthBlockHeight) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEthBlockHeight) ProtoMessage()    {}
+func (*MsgUpdateEthBlockHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{30}
 }
-func (*UnimplementedMsgServer) CreateGame(ctx context.Context, req *MsgCreateGame) (*MsgCreateGameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+func (m *MsgUpdateEthBlockHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) JoinGame(ctx context.Context, req *MsgJoinGame) (*MsgJoinGameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
+func (m *MsgUpdateEthBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEthBlockHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) LeaveGame(ctx context.Context, req *MsgLeaveGame) (*MsgLeaveGameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveGame not implemented")
+func (m *MsgUpdateEthBlockHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEthBlockHeight.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DealCards(ctx context.Context, req *MsgDealCards) (*MsgDealCardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DealCards not implemented")
+func (m *MsgUpdateEthBlockHeight) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) PerformAction(ctx context.Context, req *MsgPerformAction) (*MsgPerformActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PerformAction not implemented")
+func (m *MsgUpdateEthBlockHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEthBlockHeight.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMint) (*MsgMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+
+var xxx_messageInfo_MsgUpdateEthBlockHeight proto.InternalMessageInfo
+
+func (m *MsgUpdateEthBlockHeight) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+
+func (m *MsgUpdateEthBlockHeight) GetEthBlockHeight() uint64 {
+	if m != nil {
+		return m.EthBlockHeight
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) ProcessDeposit(ctx context.Context, req *MsgProcessDeposit) (*MsgProcessDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessDeposit not implemented")
+
+// MsgUpdateEthBlockHeightResponse defines the MsgUpdateEthBlockHeightResponse message.
+type MsgUpdateEthBlockHeightResponse struct {
+	OldHeight uint64 `protobuf:"varint,1,opt,name=old_height,json=oldHeight,proto3" json:"old_height,omitempty"`
+	NewHeight uint64 `protobuf:"varint,2,opt,name=new_height,json=newHeight,proto3" json:"new_height,omitempty"`
 }
-func (*UnimplementedMsgServer) InitiateWithdrawal(ctx context.Context, req *MsgInitiateWithdrawal) (*MsgInitiateWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitiateWithdrawal not implemented")
+
+func (m *MsgUpdateEthBlockHeightResponse) Reset()         { *m = MsgUpdateEthBlockHeightResponse{} }
+func (m *MsgUpdateEthBlockHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEthBlockHeightResponse) ProtoMessage()    {}
+func (*MsgUpdateEthBlockHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{31}
 }
-func (*UnimplementedMsgServer) SignWithdrawal(ctx context.Context, req *MsgSignWithdrawal) (*MsgSignWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignWithdrawal not implemented")
+func (m *MsgUpdateEthBlockHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateEthBlockHeight(ctx context.Context, req *MsgUpdateEthBlockHeight) (*MsgUpdateEthBlockHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEthBlockHeight not implemented")
+func (m *MsgUpdateEthBlockHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEthBlockHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) TopUp(ctx context.Context, req *MsgTopUp) (*MsgTopUpResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TopUp not implemented")
+func (m *MsgUpdateEthBlockHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEthBlockHeightResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgUpdateEthBlockHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgUpdateEthBlockHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEthBlockHeightResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateGame)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateGame(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/CreateGame",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateGame(ctx, req.(*MsgCreateGame))
+var xxx_messageInfo_MsgUpdateEthBlockHeightResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateEthBlockHeightResponse) GetOldHeight() uint64 {
+	if m != nil {
+		return m.OldHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_JoinGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinGame)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinGame(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/JoinGame",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinGame(ctx, req.(*MsgJoinGame))
+func (m *MsgUpdateEthBlockHeightResponse) GetNewHeight() uint64 {
+	if m != nil {
+		return m.NewHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_LeaveGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLeaveGame)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LeaveGame(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/LeaveGame",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LeaveGame(ctx, req.(*MsgLeaveGame))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgTopUp defines the MsgTopUp message.
+// Allows a player to add chips to their stack when not in an active hand.
+// Player must be in BUSTED, SITTING_OUT, or FOLDED status.
+// Total chips after top-up cannot exceed max_buy_in.
+type MsgTopUp struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func _Msg_DealCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDealCards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DealCards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/DealCards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DealCards(ctx, req.(*MsgDealCards))
+func (m *MsgTopUp) Reset()         { *m = MsgTopUp{} }
+func (m *MsgTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUp) ProtoMessage()    {}
+func (*MsgTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{32}
+}
+func (m *MsgTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUp.Merge(m, src)
+}
+func (m *MsgTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUp.DiscardUnknown(m)
 }
 
-func _Msg_PerformAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPerformAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PerformAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/PerformAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PerformAction(ctx, req.(*MsgPerformAction))
+var xxx_messageInfo_MsgTopUp proto.InternalMessageInfo
+
+func (m *MsgTopUp) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMint)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Mint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/Mint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
+func (m *MsgTopUp) GetGameId() string {
+	if m != nil {
+		return m.GameId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurn)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/Burn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+func (m *MsgTopUp) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ProcessDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProcessDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProcessDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/ProcessDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProcessDeposit(ctx, req.(*MsgProcessDeposit))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgTopUpResponse defines the MsgTopUpResponse message.
+type MsgTopUpResponse struct {
+	NewStack uint64 `protobuf:"varint,1,opt,name=new_stack,json=newStack,proto3" json:"new_stack,omitempty"`
 }
 
-func _Msg_InitiateWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInitiateWithdrawal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InitiateWithdrawal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/InitiateWithdrawal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InitiateWithdrawal(ctx, req.(*MsgInitiateWithdrawal))
+func (m *MsgTopUpResponse) Reset()         { *m = MsgTopUpResponse{} }
+func (m *MsgTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpResponse) ProtoMessage()    {}
+func (*MsgTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{33}
+}
+func (m *MsgTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpResponse.Merge(m, src)
+}
+func (m *MsgTopUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpResponse.DiscardUnknown(m)
 }
 
-func _Msg_SignWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSignWithdrawal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SignWithdrawal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/SignWithdrawal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SignWithdrawal(ctx, req.(*MsgSignWithdrawal))
+var xxx_messageInfo_MsgTopUpResponse proto.InternalMessageInfo
+
+func (m *MsgTopUpResponse) GetNewStack() uint64 {
+	if m != nil {
+		return m.NewStack
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateEthBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateEthBlockHeight)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateEthBlockHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/UpdateEthBlockHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateEthBlockHeight(ctx, req.(*MsgUpdateEthBlockHeight))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSubmitEthHeaders defines the MsgSubmitEthHeaders message.
+// Appends RLP-encoded source chain headers to the tracked header chain. Each
+// header must be the child of the current tip; the module authority may instead
+// re-anchor the chain at an arbitrary header.
+type MsgSubmitEthHeaders struct {
+	Relayer string   `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Headers [][]byte `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
 }
 
-func _Msg_TopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTopUp)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSubmitEthHeaders) Reset()         { *m = MsgSubmitEthHeaders{} }
+func (m *MsgSubmitEthHeaders) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthHeaders) ProtoMessage()    {}
+func (*MsgSubmitEthHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{34}
+}
+func (m *MsgSubmitEthHeaders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEthHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEthHeaders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).TopUp(ctx, in)
+}
+func (m *MsgSubmitEthHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthHeaders.Merge(m, src)
+}
+func (m *MsgSubmitEthHeaders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEthHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthHeaders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEthHeaders proto.InternalMessageInfo
+
+func (m *MsgSubmitEthHeaders) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pokerchain.poker.v1.Msg/TopUp",
+	return ""
+}
+
+func (m *MsgSubmitEthHeaders) GetHeaders() [][]byte {
+	if m != nil {
+		return m.Headers
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TopUp(ctx, req.(*MsgTopUp))
+	return nil
+}
+
+// MsgSubmitEthHeadersResponse defines the MsgSubmitEthHeadersResponse message.
+type MsgSubmitEthHeadersResponse struct {
+	Tip uint64 `protobuf:"varint,1,opt,name=tip,proto3" json:"tip,omitempty"`
+}
+
+func (m *MsgSubmitEthHeadersResponse) Reset()         { *m = MsgSubmitEthHeadersResponse{} }
+func (m *MsgSubmitEthHeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEthHeadersResponse) ProtoMessage()    {}
+func (*MsgSubmitEthHeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{35}
+}
+func (m *MsgSubmitEthHeadersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEthHeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEthHeadersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSubmitEthHeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEthHeadersResponse.Merge(m, src)
+}
+func (m *MsgSubmitEthHeadersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEthHeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEthHeadersResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pokerchain.poker.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateGame",
-			Handler:    _Msg_CreateGame_Handler,
-		},
-		{
-			MethodName: "JoinGame",
-			Handler:    _Msg_JoinGame_Handler,
-		},
-		{
-			MethodName: "LeaveGame",
-			Handler:    _Msg_LeaveGame_Handler,
-		},
-		{
-			MethodName: "DealCards",
-			Handler:    _Msg_DealCards_Handler,
-		},
-		{
-			MethodName: "PerformAction",
-			Handler:    _Msg_PerformAction_Handler,
-		},
-		{
-			MethodName: "Mint",
-			Handler:    _Msg_Mint_Handler,
-		},
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "ProcessDeposit",
-			Handler:    _Msg_ProcessDeposit_Handler,
-		},
-		{
-			MethodName: "InitiateWithdrawal",
-			Handler:    _Msg_InitiateWithdrawal_Handler,
-		},
-		{
-			MethodName: "SignWithdrawal",
-			Handler:    _Msg_SignWithdrawal_Handler,
-		},
-		{
-			MethodName: "UpdateEthBlockHeight",
-			Handler:    _Msg_UpdateEthBlockHeight_Handler,
-		},
-		{
-			MethodName: "TopUp",
-			Handler:    _Msg_TopUp_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pokerchain/poker/v1/tx.proto",
-}
+var xxx_messageInfo_MsgSubmitEthHeadersResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitEthHeadersResponse) GetTip() uint64 {
+	if m != nil {
+		return m.Tip
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetAutoPostBlinds defines the MsgSetAutoPostBlinds message.
+// While enabled, the module posts the player's small and big blinds at the
+// end of the block in which they are due, instead of waiting for the player
+// to send them.
+type MsgSetAutoPostBlinds struct {
+	Player  string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	GameId  string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetAutoPostBlinds) Reset()         { *m = MsgSetAutoPostBlinds{} }
+func (m *MsgSetAutoPostBlinds) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoPostBlinds) ProtoMessage()    {}
+func (*MsgSetAutoPostBlinds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{36}
+}
+func (m *MsgSetAutoPostBlinds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoPostBlinds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoPostBlinds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetAutoPostBlinds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoPostBlinds.Merge(m, src)
+}
+func (m *MsgSetAutoPostBlinds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoPostBlinds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoPostBlinds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoPostBlinds proto.InternalMessageInfo
+
+func (m *MsgSetAutoPostBlinds) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoPostBlinds) GetGameId() string {
+	if m != nil {
+		return m.GameId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAutoPostBlinds) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetAutoPostBlindsResponse defines the MsgSetAutoPostBlindsResponse message.
+type MsgSetAutoPostBlindsResponse struct {
 }
 
-func (m *MsgCreateGame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoPostBlindsResponse) Reset()         { *m = MsgSetAutoPostBlindsResponse{} }
+func (m *MsgSetAutoPostBlindsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoPostBlindsResponse) ProtoMessage()    {}
+func (*MsgSetAutoPostBlindsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{37}
+}
+func (m *MsgSetAutoPostBlindsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoPostBlindsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoPostBlindsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAutoPostBlindsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoPostBlindsResponse.Merge(m, src)
+}
+func (m *MsgSetAutoPostBlindsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoPostBlindsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoPostBlindsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateGame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAutoPostBlindsResponse proto.InternalMessageInfo
+
+// MsgUpdateAllowlist defines the MsgUpdateAllowlist message.
+// Only the table's creator may change who is allowed to sit. Players already
+// seated keep their seats when they are removed.
+type MsgUpdateAllowlist struct {
+	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	GameId  string   `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Add     []string `protobuf:"bytes,3,rep,name=add,proto3" json:"add,omitempty"`
+	Remove  []string `protobuf:"bytes,4,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (m *MsgCreateGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RakeOwner) > 0 {
-		i -= len(m.RakeOwner)
-		copy(dAtA[i:], m.RakeOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RakeOwner)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.RakeCap != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RakeCap))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.RakePercentage != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RakePercentage))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.RakeFreeThreshold != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RakeFreeThreshold))
-		i--
-		dAtA[i] = 0x50
+func (m *MsgUpdateAllowlist) Reset()         { *m = MsgUpdateAllowlist{} }
+func (m *MsgUpdateAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowlist) ProtoMessage()    {}
+func (*MsgUpdateAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{38}
+}
+func (m *MsgUpdateAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.GameType) > 0 {
-		i -= len(m.GameType)
-		copy(dAtA[i:], m.GameType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GameType)))
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *MsgUpdateAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowlist.Merge(m, src)
+}
+func (m *MsgUpdateAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowlist proto.InternalMessageInfo
+
+func (m *MsgUpdateAllowlist) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *MsgUpdateAllowlist) GetGameId() string {
+	if m != nil {
+		return m.GameId
 	}
-	if m.BigBlind != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BigBlind))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *MsgUpdateAllowlist) GetAdd() []string {
+	if m != nil {
+		return m.Add
 	}
-	if m.SmallBlind != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SmallBlind))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *MsgUpdateAllowlist) GetRemove() []string {
+	if m != nil {
+		return m.Remove
 	}
-	if m.MaxPlayers != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxPlayers))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+// MsgUpdateAllowlistResponse defines the MsgUpdateAllowlistResponse message.
+type MsgUpdateAllowlistResponse struct {
+}
+
+func (m *MsgUpdateAllowlistResponse) Reset()         { *m = MsgUpdateAllowlistResponse{} }
+func (m *MsgUpdateAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{39}
+}
+func (m *MsgUpdateAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MinPlayers != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MinPlayers))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxBuyIn != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxBuyIn))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MinBuyIn != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MinBuyIn))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateGameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowlistResponse.Merge(m, src)
 }
-
-func (m *MsgCreateGameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowlistResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateAllowlistResponse proto.InternalMessageInfo
+
+// MsgJoinWaitlist defines the MsgJoinWaitlist message.
+// Players are offered free seats in the order they joined. An offered seat
+// is held for the player for the params' seat reservation window, during
+// which only they can take it with MsgJoinGame.
+type MsgJoinWaitlist struct {
+	Player          string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	GameId          string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	InviteSignature []byte `protobuf:"bytes,4,opt,name=invite_signature,json=inviteSignature,proto3" json:"invite_signature,omitempty"`
 }
 
-func (m *MsgJoinGame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgJoinWaitlist) Reset()         { *m = MsgJoinWaitlist{} }
+func (m *MsgJoinWaitlist) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinWaitlist) ProtoMessage()    {}
+func (*MsgJoinWaitlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{40}
+}
+func (m *MsgJoinWaitlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinWaitlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinWaitlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgJoinGame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgJoinWaitlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinWaitlist.Merge(m, src)
+}
+func (m *MsgJoinWaitlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinWaitlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinWaitlist.DiscardUnknown(m)
 }
 
-func (m *MsgJoinGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BuyInAmount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BuyInAmount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Seat != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Seat))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.GameId) > 0 {
-		i -= len(m.GameId)
-		copy(dAtA[i:], m.GameId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Player) > 0 {
-		i -= len(m.Player)
-		copy(dAtA[i:], m.Player)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgJoinWaitlist proto.InternalMessageInfo
+
+func (m *MsgJoinWaitlist) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgJoinGameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgJoinWaitlist) GetGameId() string {
+	if m != nil {
+		return m.GameId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgJoinGameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgJoinWaitlist) GetInviteSignature() []byte {
+	if m != nil {
+		return m.InviteSignature
+	}
+	return nil
 }
 
-func (m *MsgJoinGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgJoinWaitlistResponse defines the MsgJoinWaitlistResponse message.
+type MsgJoinWaitlistResponse struct {
+	Position uint64 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (m *MsgLeaveGame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgJoinWaitlistResponse) Reset()         { *m = MsgJoinWaitlistResponse{} }
+func (m *MsgJoinWaitlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinWaitlistResponse) ProtoMessage()    {}
+func (*MsgJoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{41}
 }
-
-func (m *MsgLeaveGame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgJoinWaitlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgLeaveGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GameId) > 0 {
-		i -= len(m.GameId)
-		copy(dAtA[i:], m.GameId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgJoinWaitlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinWaitlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgJoinWaitlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinWaitlistResponse.Merge(m, src)
+}
+func (m *MsgJoinWaitlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinWaitlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinWaitlistResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLeaveGameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgJoinWaitlistResponse proto.InternalMessageInfo
+
+func (m *MsgJoinWaitlistResponse) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgLeaveGameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgLeaveWaitlist defines the MsgLeaveWaitlist message.
+type MsgLeaveWaitlist struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (m *MsgLeaveGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgLeaveWaitlist) Reset()         { *m = MsgLeaveWaitlist{} }
+func (m *MsgLeaveWaitlist) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveWaitlist) ProtoMessage()    {}
+func (*MsgLeaveWaitlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{42}
 }
-
-func (m *MsgDealCards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLeaveWaitlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveWaitlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveWaitlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDealCards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgLeaveWaitlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveWaitlist.Merge(m, src)
+}
+func (m *MsgLeaveWaitlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveWaitlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveWaitlist.DiscardUnknown(m)
 }
 
-func (m *MsgDealCards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GameId) > 0 {
-		i -= len(m.GameId)
-		copy(dAtA[i:], m.GameId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgLeaveWaitlist proto.InternalMessageInfo
+
+func (m *MsgLeaveWaitlist) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDealCardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLeaveWaitlist) GetGameId() string {
+	if m != nil {
+		return m.GameId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDealCardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgLeaveWaitlistResponse defines the MsgLeaveWaitlistResponse message.
+type MsgLeaveWaitlistResponse struct {
 }
 
-func (m *MsgDealCardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgLeaveWaitlistResponse) Reset()         { *m = MsgLeaveWaitlistResponse{} }
+func (m *MsgLeaveWaitlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveWaitlistResponse) ProtoMessage()    {}
+func (*MsgLeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{43}
 }
-
-func (m *MsgPerformAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLeaveWaitlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveWaitlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveWaitlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgPerformAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgLeaveWaitlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveWaitlistResponse.Merge(m, src)
+}
+func (m *MsgLeaveWaitlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveWaitlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveWaitlistResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPerformAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Action) > 0 {
-		i -= len(m.Action)
-		copy(dAtA[i:], m.Action)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Action)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GameId) > 0 {
-		i -= len(m.GameId)
-		copy(dAtA[i:], m.GameId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Player) > 0 {
-		i -= len(m.Player)
-		copy(dAtA[i:], m.Player)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgLeaveWaitlistResponse proto.InternalMessageInfo
+
+// MsgSetAutoTopUp defines the MsgSetAutoTopUp message.
+// Between hands, the module buys the player back in to target_stack once
+// they are busted (mode "rebuy"), or tops their stack up to target_stack
+// whenever it is below it (mode "top-up"), from their spendable balance of
+// the table currency. The instruction stops when the player cannot afford it.
+type MsgSetAutoTopUp struct {
+	Player      string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	GameId      string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Mode        string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	TargetStack uint64 `protobuf:"varint,4,opt,name=target_stack,json=targetStack,proto3" json:"target_stack,omitempty"`
 }
 
-func (m *MsgPerformActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoTopUp) Reset()         { *m = MsgSetAutoTopUp{} }
+func (m *MsgSetAutoTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoTopUp) ProtoMessage()    {}
+func (*MsgSetAutoTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{44}
+}
+func (m *MsgSetAutoTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgPerformActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAutoTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoTopUp.Merge(m, src)
 }
-
-func (m *MsgPerformActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetAutoTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoTopUp.DiscardUnknown(m)
 }
 
-func (m *MsgMint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetAutoTopUp proto.InternalMessageInfo
+
+func (m *MsgSetAutoTopUp) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAutoTopUp) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
 }
 
-func (m *MsgMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.EthTxHash) > 0 {
-		i -= len(m.EthTxHash)
-		copy(dAtA[i:], m.EthTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EthTxHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetAutoTopUp) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoTopUp) GetTargetStack() uint64 {
+	if m != nil {
+		return m.TargetStack
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetAutoTopUpResponse defines the MsgSetAutoTopUpResponse message.
+type MsgSetAutoTopUpResponse struct {
 }
 
-func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetAutoTopUpResponse) Reset()         { *m = MsgSetAutoTopUpResponse{} }
+func (m *MsgSetAutoTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoTopUpResponse) ProtoMessage()    {}
+func (*MsgSetAutoTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{45}
 }
-
-func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoTopUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAutoTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoTopUpResponse.Merge(m, src)
+}
+func (m *MsgSetAutoTopUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoTopUpResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAutoTopUpResponse proto.InternalMessageInfo
+
+// MsgCancelAutoTopUp defines the MsgCancelAutoTopUp message.
+type MsgCancelAutoTopUp struct {
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthRecipient) > 0 {
-		i -= len(m.EthRecipient)
-		copy(dAtA[i:], m.EthRecipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EthRecipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCancelAutoTopUp) Reset()         { *m = MsgCancelAutoTopUp{} }
+func (m *MsgCancelAutoTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAutoTopUp) ProtoMessage()    {}
+func (*MsgCancelAutoTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{46}
+}
+func (m *MsgCancelAutoTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAutoTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAutoTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelAutoTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAutoTopUp.Merge(m, src)
+}
+func (m *MsgCancelAutoTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAutoTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAutoTopUp.DiscardUnknown(m)
 }
 
-func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelAutoTopUp proto.InternalMessageInfo
+
+func (m *MsgCancelAutoTopUp) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelAutoTopUp) GetGameId() string {
+	if m != nil {
+		return m.GameId
+	}
+	return ""
 }
 
-func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCancelAutoTopUpResponse defines the MsgCancelAutoTopUpResponse message.
+type MsgCancelAutoTopUpResponse struct {
 }
 
-func (m *MsgProcessDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelAutoTopUpResponse) Reset()         { *m = MsgCancelAutoTopUpResponse{} }
+func (m *MsgCancelAutoTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAutoTopUpResponse) ProtoMessage()    {}
+func (*MsgCancelAutoTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_847cce1c2790a5e1, []int{47}
+}
+func (m *MsgCancelAutoTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAutoTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAutoTopUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelAutoTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAutoTopUpResponse.Merge(m, src)
+}
+func (m *MsgCancelAutoTopUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAutoTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAutoTopUpResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProcessDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelAutoTopUpResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "pokerchain.poker.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pokerchain.poker.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateGame)(nil), "pokerchain.poker.v1.MsgCreateGame")
+	proto.RegisterType((*MsgCreateGameResponse)(nil), "pokerchain.poker.v1.MsgCreateGameResponse")
+	proto.RegisterType((*MsgJoinGame)(nil), "pokerchain.poker.v1.MsgJoinGame")
+	proto.RegisterType((*MsgJoinGameResponse)(nil), "pokerchain.poker.v1.MsgJoinGameResponse")
+	proto.RegisterType((*MsgLeaveGame)(nil), "pokerchain.poker.v1.MsgLeaveGame")
+	proto.RegisterType((*MsgLeaveGameResponse)(nil), "pokerchain.poker.v1.MsgLeaveGameResponse")
+	proto.RegisterType((*MsgDealCards)(nil), "pokerchain.poker.v1.MsgDealCards")
+	proto.RegisterType((*MsgDealCardsResponse)(nil), "pokerchain.poker.v1.MsgDealCardsResponse")
+	proto.RegisterType((*MsgPerformAction)(nil), "pokerchain.poker.v1.MsgPerformAction")
+	proto.RegisterType((*MsgPerformActionResponse)(nil), "pokerchain.poker.v1.MsgPerformActionResponse")
+	proto.RegisterType((*MsgMint)(nil), "pokerchain.poker.v1.MsgMint")
+	proto.RegisterType((*MsgMintResponse)(nil), "pokerchain.poker.v1.MsgMintResponse")
+	proto.RegisterType((*MsgBurn)(nil), "pokerchain.poker.v1.MsgBurn")
+	proto.RegisterType((*MsgBurnResponse)(nil), "pokerchain.poker.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgProcessDeposit)(nil), "pokerchain.poker.v1.MsgProcessDeposit")
+	proto.RegisterType((*MsgProcessDepositResponse)(nil), "pokerchain.poker.v1.MsgProcessDepositResponse")
+	proto.RegisterType((*MsgInitiateWithdrawal)(nil), "pokerchain.poker.v1.MsgInitiateWithdrawal")
+	proto.RegisterType((*MsgInitiateWithdrawalResponse)(nil), "pokerchain.poker.v1.MsgInitiateWithdrawalResponse")
+	proto.RegisterType((*MsgSignWithdrawal)(nil), "pokerchain.poker.v1.MsgSignWithdrawal")
+	proto.RegisterType((*MsgSignWithdrawalResponse)(nil), "pokerchain.poker.v1.MsgSignWithdrawalResponse")
+	proto.RegisterType((*MsgCompleteWithdrawal)(nil), "pokerchain.poker.v1.MsgCompleteWithdrawal")
+	proto.RegisterType((*MsgCompleteWithdrawalResponse)(nil), "pokerchain.poker.v1.MsgCompleteWithdrawalResponse")
+	proto.RegisterType((*MsgCancelWithdrawal)(nil), "pokerchain.poker.v1.MsgCancelWithdrawal")
+	proto.RegisterType((*MsgCancelWithdrawalResponse)(nil), "pokerchain.poker.v1.MsgCancelWithdrawalResponse")
+	proto.RegisterType((*MsgRefundWithdrawal)(nil), "pokerchain.poker.v1.MsgRefundWithdrawal")
+	proto.RegisterType((*MsgRefundWithdrawalResponse)(nil), "pokerchain.poker.v1.MsgRefundWithdrawalResponse")
+	proto.RegisterType((*MsgSetBridgePaused)(nil), "pokerchain.poker.v1.MsgSetBridgePaused")
+	proto.RegisterType((*MsgSetBridgePausedResponse)(nil), "pokerchain.poker.v1.MsgSetBridgePausedResponse")
+	proto.RegisterType((*MsgUpdateEthBlockHeight)(nil), "pokerchain.poker.v1.MsgUpdateEthBlockHeight")
+	proto.RegisterType((*MsgUpdateEthBlockHeightResponse)(nil), "pokerchain.poker.v1.MsgUpdateEthBlockHeightResponse")
+	proto.RegisterType((*MsgTopUp)(nil), "pokerchain.poker.v1.MsgTopUp")
+	proto.RegisterType((*MsgTopUpResponse)(nil), "pokerchain.poker.v1.MsgTopUpResponse")
+	proto.RegisterType((*MsgSubmitEthHeaders)(nil), "pokerchain.poker.v1.MsgSubmitEthHeaders")
+	proto.RegisterType((*MsgSubmitEthHeadersResponse)(nil), "pokerchain.poker.v1.MsgSubmitEthHeadersResponse")
+	proto.RegisterType((*MsgSetAutoPostBlinds)(nil), "pokerchain.poker.v1.MsgSetAutoPostBlinds")
+	proto.RegisterType((*MsgSetAutoPostBlindsResponse)(nil), "pokerchain.poker.v1.MsgSetAutoPostBlindsResponse")
+	proto.RegisterType((*MsgUpdateAllowlist)(nil), "pokerchain.poker.v1.MsgUpdateAllowlist")
+	proto.RegisterType((*MsgUpdateAllowlistResponse)(nil), "pokerchain.poker.v1.MsgUpdateAllowlistResponse")
+	proto.RegisterType((*MsgJoinWaitlist)(nil), "pokerchain.poker.v1.MsgJoinWaitlist")
+	proto.RegisterType((*MsgJoinWaitlistResponse)(nil), "pokerchain.poker.v1.MsgJoinWaitlistResponse")
+	proto.RegisterType((*MsgLeaveWaitlist)(nil), "pokerchain.poker.v1.MsgLeaveWaitlist")
+	proto.RegisterType((*MsgLeaveWaitlistResponse)(nil), "pokerchain.poker.v1.MsgLeaveWaitlistResponse")
+	proto.RegisterType((*MsgSetAutoTopUp)(nil), "pokerchain.poker.v1.MsgSetAutoTopUp")
+	proto.RegisterType((*MsgSetAutoTopUpResponse)(nil), "pokerchain.poker.v1.MsgSetAutoTopUpResponse")
+	proto.RegisterType((*MsgCancelAutoTopUp)(nil), "pokerchain.poker.v1.MsgCancelAutoTopUp")
+	proto.RegisterType((*MsgCancelAutoTopUpResponse)(nil), "pokerchain.poker.v1.MsgCancelAutoTopUpResponse")
 }
 
-func (m *MsgProcessDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EthBlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.DepositIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DepositIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("pokerchain/poker/v1/tx.proto", fileDescriptor_847cce1c2790a5e1) }
+
+var fileDescriptor_847cce1c2790a5e1 = []byte{
+	// 2803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x9a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0xc0, 0xd3, 0xf6, 0xc4, 0x9e, 0x29, 0x7f, 0x8d, 0x3b, 0x4e, 0xdc, 0xe9, 0xd8, 0x8e, 0x77,
+	0x92, 0x6c, 0x1c, 0xaf, 0xe3, 0x71, 0xbc, 0x9b, 0xc0, 0x06, 0x04, 0xb2, 0x93, 0x5d, 0x92, 0x80,
+	0xb5, 0xd6, 0x38, 0xab, 0x95, 0xb8, 0xb4, 0x6a, 0xba, 0x2b, 0x3d, 0xb5, 0xe9, 0xe9, 0xee, 0xed,
+	0xaa, 0xb1, 0xc7, 0x48, 0x48, 0x68, 0xb9, 0xb0, 0x20, 0x24, 0x10, 0xa7, 0x05, 0x21, 0x16, 0x21,
+	0x3e, 0x2f, 0x2c, 0x12, 0x37, 0x24, 0xc4, 0x71, 0x8f, 0x2b, 0xb8, 0x20, 0x21, 0x10, 0xda, 0x45,
+	0xda, 0x7f, 0x00, 0xf6, 0x8c, 0x5e, 0x55, 0x75, 0xcd, 0xf4, 0x78, 0xbe, 0x6c, 0x9c, 0x13, 0x97,
+	0xa4, 0xeb, 0xbd, 0x57, 0x55, 0xbf, 0x7a, 0xf5, 0xea, 0xd5, 0x87, 0x07, 0x2d, 0xc4, 0xd1, 0x53,
+	0x92, 0xb8, 0x35, 0x4c, 0xc3, 0xb2, 0xf8, 0x2c, 0xef, 0xdf, 0x2a, 0xf3, 0xe6, 0x7a, 0x9c, 0x44,
+	0x3c, 0x32, 0xcf, 0xb5, 0xb4, 0xeb, 0xe2, 0x73, 0x7d, 0xff, 0x96, 0x3d, 0x8b, 0xeb, 0x34, 0x8c,
+	0xca, 0xe2, 0x5f, 0x69, 0x67, 0xcf, 0xbb, 0x11, 0xab, 0x47, 0xac, 0x5c, 0x67, 0x3e, 0xd4, 0xaf,
+	0x33, 0x5f, 0x29, 0x2e, 0x4a, 0x85, 0x23, 0x4a, 0x65, 0x59, 0x50, 0xaa, 0x39, 0x3f, 0xf2, 0x23,
+	0x29, 0x87, 0x2f, 0x25, 0x5d, 0xf0, 0xa3, 0xc8, 0x0f, 0x48, 0x19, 0xc7, 0xb4, 0x8c, 0xc3, 0x30,
+	0xe2, 0x98, 0xd3, 0x28, 0x4c, 0xeb, 0x2c, 0x77, 0xa3, 0x8d, 0x71, 0x82, 0xeb, 0xca, 0xa2, 0xf4,
+	0x27, 0x03, 0xcd, 0xec, 0x30, 0xff, 0xf5, 0xd8, 0xc3, 0x9c, 0xec, 0x0a, 0x8d, 0x79, 0x07, 0x15,
+	0x70, 0x83, 0xd7, 0xa2, 0x84, 0xf2, 0x43, 0xcb, 0x58, 0x36, 0x56, 0x0a, 0xdb, 0xd6, 0x9f, 0x7f,
+	0x7f, 0x73, 0x4e, 0xe1, 0x6c, 0x79, 0x5e, 0x42, 0x18, 0xdb, 0xe3, 0x09, 0x0d, 0xfd, 0x4a, 0xcb,
+	0xd4, 0xfc, 0x02, 0x1a, 0x93, 0x6d, 0x5b, 0x23, 0xcb, 0xc6, 0xca, 0xc4, 0xe6, 0xa5, 0xf5, 0x2e,
+	0xee, 0x58, 0x97, 0x9d, 0x6c, 0x17, 0x3e, 0xf8, 0xc7, 0xe5, 0x33, 0xbf, 0xfa, 0xe4, 0xfd, 0x55,
+	0xa3, 0xa2, 0x6a, 0xdd, 0xbd, 0xfd, 0xf6, 0x27, 0xef, 0xaf, 0xb6, 0xda, 0xfb, 0xf6, 0x27, 0xef,
+	0xaf, 0x96, 0xda, 0x06, 0xd0, 0x54, 0x43, 0xe8, 0xc0, 0x2d, 0x5d, 0x44, 0xf3, 0x1d, 0xa2, 0x0a,
+	0x61, 0x71, 0x14, 0x32, 0x52, 0xfa, 0x34, 0x8f, 0xa6, 0x76, 0x98, 0x7f, 0x2f, 0x21, 0x98, 0x93,
+	0x2f, 0xe1, 0x3a, 0x31, 0x37, 0xd1, 0xb8, 0x0b, 0xa5, 0x28, 0x19, 0x38, 0xb2, 0xd4, 0xd0, 0x5c,
+	0x40, 0xa8, 0x4e, 0x43, 0xa7, 0xda, 0x38, 0x74, 0x68, 0x28, 0xc6, 0x96, 0xab, 0xe4, 0xeb, 0x34,
+	0xdc, 0x6e, 0x1c, 0x3e, 0x0c, 0x85, 0x16, 0x37, 0x53, 0xed, 0xa8, 0xd2, 0xe2, 0xa6, 0xd4, 0x5e,
+	0x46, 0x13, 0x50, 0x37, 0x0e, 0xf0, 0x21, 0x49, 0x98, 0x95, 0x5b, 0x36, 0x56, 0x46, 0x2b, 0xd0,
+	0xdc, 0xae, 0x94, 0x08, 0x03, 0xdc, 0xd4, 0x06, 0x67, 0x95, 0x01, 0x6e, 0xb6, 0x19, 0xb0, 0x3a,
+	0x0e, 0x02, 0xa7, 0x1a, 0xd0, 0xd0, 0xb3, 0xc6, 0x44, 0x07, 0x48, 0x88, 0xb6, 0x41, 0x62, 0x5e,
+	0x42, 0x85, 0x2a, 0xf5, 0x95, 0x7a, 0x5c, 0xf6, 0x5f, 0xa5, 0xbe, 0x54, 0x5a, 0x68, 0x9c, 0xd3,
+	0x3a, 0x89, 0x1a, 0xdc, 0xca, 0x8b, 0xa6, 0xd3, 0x22, 0x54, 0xf3, 0x71, 0x9d, 0x38, 0xfc, 0x30,
+	0x26, 0x56, 0x01, 0x7c, 0x51, 0xc9, 0x83, 0xe0, 0xf1, 0x61, 0x4c, 0xcc, 0x75, 0x74, 0x2e, 0xc1,
+	0x4f, 0x89, 0xf3, 0x24, 0x21, 0xc4, 0xe1, 0xb5, 0x84, 0xb0, 0x5a, 0x14, 0x78, 0x16, 0x12, 0xad,
+	0xcf, 0x82, 0xea, 0xd5, 0x84, 0x90, 0xc7, 0xa9, 0xc2, 0xbc, 0x8e, 0x66, 0x84, 0x7d, 0x4c, 0x12,
+	0x97, 0x84, 0x1c, 0xfb, 0xc4, 0x9a, 0x58, 0x36, 0x56, 0xa6, 0x2a, 0xd3, 0x20, 0xde, 0xd5, 0x52,
+	0xf3, 0x22, 0xca, 0x0b, 0x43, 0x17, 0xc7, 0xd6, 0xa4, 0x68, 0x6d, 0x1c, 0xca, 0xf7, 0x70, 0x6c,
+	0x2e, 0x22, 0x24, 0x54, 0xd1, 0x41, 0x48, 0x12, 0x6b, 0x4a, 0x10, 0x15, 0x40, 0xf2, 0x1a, 0x08,
+	0xcc, 0x39, 0x74, 0xd6, 0x23, 0x61, 0x54, 0xb7, 0xa6, 0x85, 0x46, 0x16, 0xcc, 0x25, 0x84, 0xf6,
+	0x29, 0xa3, 0x55, 0x1a, 0x40, 0xb0, 0xce, 0x08, 0x55, 0x9b, 0xc4, 0x5c, 0x40, 0x05, 0x1c, 0x04,
+	0xd1, 0x41, 0x40, 0x19, 0xb7, 0x8a, 0xcb, 0xa3, 0xd0, 0xa6, 0x16, 0x98, 0xd7, 0xd0, 0x74, 0x42,
+	0xde, 0x6a, 0xd0, 0x84, 0x78, 0x8e, 0x6c, 0xdc, 0x14, 0x2d, 0x4c, 0xa5, 0xd2, 0xfb, 0xa2, 0x13,
+	0x18, 0x5d, 0x6a, 0x86, 0xeb, 0x51, 0x23, 0xe4, 0xd6, 0x39, 0xc1, 0xae, 0x6b, 0x6f, 0x09, 0xa9,
+	0x69, 0xa2, 0x1c, 0x0e, 0x39, 0xb1, 0xe6, 0x84, 0x56, 0x7c, 0x83, 0x9f, 0xe1, 0x7f, 0xe9, 0xe7,
+	0xf3, 0xd2, 0xcf, 0x20, 0x10, 0x7e, 0xb6, 0x51, 0x9e, 0xf1, 0x04, 0x7b, 0x5e, 0x40, 0xac, 0x0b,
+	0x52, 0x97, 0x96, 0xcd, 0x35, 0x64, 0x56, 0xa3, 0x7a, 0xd5, 0x89, 0x23, 0x0e, 0xf3, 0xf0, 0x56,
+	0x83, 0x84, 0xee, 0xa1, 0x35, 0x2f, 0x9a, 0x2e, 0x82, 0x66, 0x37, 0xe2, 0xaf, 0xa6, 0x72, 0xb3,
+	0x84, 0xa6, 0xb4, 0xb5, 0x60, 0xb0, 0x84, 0xe1, 0x84, 0x32, 0xdc, 0x02, 0x94, 0x1b, 0x68, 0x16,
+	0x62, 0x8d, 0x51, 0xee, 0x44, 0x0d, 0xee, 0xd4, 0x70, 0xe8, 0x31, 0xeb, 0xa2, 0x1c, 0x49, 0x1d,
+	0x37, 0xf7, 0x28, 0x7f, 0xad, 0xc1, 0x1f, 0x80, 0xd4, 0xbc, 0x89, 0xce, 0xb5, 0x9b, 0xd6, 0x69,
+	0xd8, 0xe0, 0x84, 0x59, 0xb6, 0xec, 0x5d, 0x1b, 0xef, 0x48, 0x39, 0xb0, 0xc6, 0x11, 0x03, 0x3b,
+	0xc6, 0x88, 0x27, 0x63, 0x91, 0x59, 0x97, 0x96, 0x8d, 0x95, 0x7c, 0xa5, 0x08, 0x9a, 0x1d, 0xa1,
+	0x10, 0x31, 0xc9, 0x60, 0xd2, 0x58, 0x4c, 0x5c, 0x0e, 0xab, 0x8b, 0x59, 0x0b, 0x72, 0xd2, 0x5a,
+	0x12, 0xf3, 0x0e, 0x9a, 0xd7, 0x25, 0xc7, 0x23, 0x01, 0x3e, 0x74, 0xb0, 0x2b, 0xf2, 0x9a, 0xb5,
+	0x28, 0x00, 0xce, 0x6b, 0xf5, 0x7d, 0xd0, 0x6e, 0x49, 0x65, 0xb7, 0x7a, 0x8c, 0xb8, 0x11, 0xa0,
+	0x2c, 0x75, 0xab, 0xb7, 0x27, 0x95, 0xe6, 0x2a, 0x9a, 0xa5, 0xe1, 0x3e, 0xe5, 0xc4, 0x89, 0x1b,
+	0xd5, 0x80, 0xba, 0xce, 0x53, 0x72, 0x68, 0x5d, 0x16, 0x58, 0x33, 0x52, 0xb1, 0x2b, 0xe4, 0x5f,
+	0x26, 0x87, 0x77, 0x27, 0x21, 0x49, 0xa5, 0xa9, 0xe1, 0x51, 0x2e, 0x3f, 0x5b, 0x34, 0x2b, 0x45,
+	0x55, 0xdb, 0x8d, 0x3c, 0xe2, 0xd4, 0x30, 0xab, 0x95, 0xe6, 0xd1, 0xf9, 0x4c, 0xde, 0xd1, 0x19,
+	0xe9, 0x6f, 0x06, 0x9a, 0xd8, 0x61, 0xfe, 0xa3, 0x88, 0x86, 0x20, 0x37, 0x37, 0xd0, 0x98, 0x5c,
+	0xfa, 0x03, 0xd3, 0x91, 0xb2, 0x33, 0xe7, 0xd1, 0xb8, 0x58, 0xb7, 0xd4, 0x13, 0xa9, 0xa8, 0x50,
+	0x19, 0x83, 0xe2, 0x43, 0x0f, 0x82, 0x8f, 0x11, 0xcc, 0x55, 0x0a, 0x12, 0xdf, 0x22, 0x2a, 0x44,
+	0x62, 0x4a, 0xe3, 0x36, 0xa7, 0xa2, 0x02, 0x92, 0x93, 0x0a, 0xda, 0x1b, 0x28, 0xe5, 0x67, 0xd4,
+	0x0f, 0x31, 0x6f, 0x24, 0x44, 0x64, 0x99, 0xc9, 0x74, 0xf0, 0x7b, 0xa9, 0xf8, 0xee, 0x04, 0x0c,
+	0x5e, 0x81, 0x3c, 0xca, 0xe5, 0xcf, 0x16, 0xc7, 0x2a, 0x13, 0x6d, 0x63, 0x2f, 0x9d, 0x47, 0xe7,
+	0xda, 0x06, 0xa7, 0x07, 0x4d, 0xd1, 0xe4, 0x0e, 0xf3, 0xbf, 0x42, 0xf0, 0xfe, 0xc9, 0x93, 0x70,
+	0xaf, 0x61, 0x67, 0x27, 0xa4, 0x74, 0x01, 0xcd, 0xb5, 0x77, 0xd5, 0x81, 0x70, 0x9f, 0xe0, 0xe0,
+	0x1e, 0x4e, 0x3c, 0xf6, 0xec, 0x11, 0x74, 0x57, 0x1a, 0xe1, 0x87, 0x06, 0x2a, 0xee, 0x30, 0x7f,
+	0x97, 0x24, 0x4f, 0xa2, 0xa4, 0x2e, 0x63, 0xf6, 0x34, 0xe7, 0xff, 0x02, 0x1a, 0x93, 0xab, 0x44,
+	0x44, 0x40, 0xa1, 0xa2, 0x4a, 0x42, 0xde, 0x3e, 0xf9, 0xaa, 0x94, 0x99, 0xcc, 0x92, 0x8d, 0xac,
+	0x4e, 0x36, 0x0d, 0xfe, 0xc7, 0x11, 0x34, 0xbe, 0xc3, 0xfc, 0x1d, 0x1a, 0xf2, 0x13, 0xee, 0x9f,
+	0x85, 0x84, 0xb8, 0x34, 0xa6, 0x24, 0xe4, 0x8a, 0xb9, 0x25, 0x68, 0xc3, 0x1b, 0x6d, 0xc7, 0x33,
+	0x97, 0xd0, 0x04, 0xe1, 0x35, 0x87, 0x37, 0xc5, 0x8a, 0x12, 0xec, 0x85, 0x4a, 0x81, 0xf0, 0xda,
+	0xe3, 0xe6, 0x03, 0xcc, 0x6a, 0xb0, 0x1f, 0x84, 0x51, 0xe8, 0x12, 0xb1, 0x65, 0xe6, 0x2a, 0xb2,
+	0x60, 0xae, 0xa0, 0x22, 0xd4, 0xaa, 0x06, 0x91, 0xfb, 0xd4, 0xa9, 0x11, 0xea, 0xd7, 0xb8, 0xda,
+	0x32, 0xa7, 0x09, 0xaf, 0x6d, 0x83, 0xf8, 0x81, 0x90, 0xc2, 0x4e, 0xc4, 0x9b, 0x0e, 0x0d, 0x3d,
+	0xd2, 0x54, 0xbb, 0xe6, 0x38, 0x6f, 0x3e, 0x84, 0x22, 0xa4, 0xec, 0x20, 0xf2, 0x95, 0x2e, 0x2f,
+	0x77, 0xd4, 0x20, 0xf2, 0xa5, 0xf2, 0x0a, 0x9a, 0x4a, 0x88, 0x4b, 0x68, 0xcc, 0xe1, 0x94, 0x16,
+	0x3d, 0xb1, 0x0a, 0xcb, 0xa3, 0x2b, 0x93, 0x95, 0x49, 0x25, 0xdc, 0x05, 0x59, 0x47, 0x44, 0xcc,
+	0xa2, 0x19, 0xe5, 0x3f, 0xed, 0xd3, 0x6f, 0x19, 0xc2, 0xa7, 0xdb, 0x8d, 0x24, 0x3c, 0x91, 0x4f,
+	0x5b, 0x5e, 0x1b, 0xc9, 0x78, 0xed, 0x0a, 0x9a, 0x82, 0xf1, 0xb7, 0xfc, 0x2d, 0x63, 0x61, 0x92,
+	0xf0, 0x5a, 0x25, 0x95, 0x75, 0xa5, 0x03, 0x12, 0x4d, 0xf7, 0x93, 0x11, 0x34, 0x0b, 0xe1, 0x90,
+	0x44, 0x2e, 0x61, 0xec, 0x3e, 0x89, 0x23, 0x46, 0x4f, 0x36, 0xf7, 0x57, 0xd0, 0x94, 0x27, 0xab,
+	0x2b, 0x77, 0x4a, 0xdc, 0x49, 0x25, 0x94, 0x2e, 0xed, 0x36, 0x69, 0xa3, 0x5d, 0x27, 0x2d, 0x13,
+	0x4a, 0xb9, 0xce, 0x50, 0x6a, 0x9f, 0xd2, 0xb3, 0x7d, 0xa6, 0x74, 0x6c, 0xd0, 0x94, 0x8e, 0x0f,
+	0x9c, 0xd2, 0xf7, 0x0c, 0x74, 0xf1, 0x88, 0x87, 0x52, 0xff, 0x65, 0x31, 0x8d, 0xde, 0x11, 0xaf,
+	0x16, 0x70, 0x6b, 0xee, 0xb2, 0xbe, 0x1a, 0x1d, 0xd2, 0x57, 0xb9, 0x6e, 0xbe, 0x2a, 0xfd, 0xdd,
+	0x10, 0x9b, 0xd0, 0xc3, 0x90, 0x72, 0x8a, 0x39, 0x79, 0x83, 0xf2, 0x9a, 0x97, 0xe0, 0x03, 0x1c,
+	0x9c, 0x6a, 0xc0, 0x3d, 0x87, 0x26, 0xab, 0x98, 0x11, 0x07, 0xcb, 0x6a, 0x2a, 0xde, 0x26, 0x40,
+	0xa6, 0x5a, 0x32, 0xaf, 0xa2, 0x69, 0x5a, 0x75, 0x1d, 0xb7, 0x86, 0xc3, 0x90, 0x04, 0x90, 0xb8,
+	0xe4, 0xcc, 0x4d, 0xd2, 0xaa, 0x7b, 0x4f, 0x0a, 0x1f, 0x7a, 0xd0, 0x10, 0x58, 0x09, 0x9f, 0xef,
+	0x93, 0x44, 0x4c, 0x60, 0xa1, 0x32, 0x41, 0xab, 0x6e, 0x45, 0x89, 0x3a, 0xa6, 0xe0, 0x1d, 0x03,
+	0x2d, 0x76, 0x1d, 0x9f, 0x9e, 0x06, 0x9d, 0x22, 0xe4, 0x14, 0xc8, 0x82, 0x59, 0x44, 0xa3, 0x4f,
+	0x08, 0x51, 0xc3, 0x80, 0x4f, 0x38, 0x79, 0x86, 0x84, 0x3b, 0x99, 0x34, 0x54, 0x08, 0x09, 0xdf,
+	0xd2, 0x43, 0x04, 0x32, 0x26, 0x8f, 0x5a, 0x24, 0xdd, 0x43, 0x69, 0xd5, 0xdd, 0x53, 0xa2, 0xd2,
+	0xcf, 0x0c, 0xb1, 0x60, 0x60, 0xa7, 0x6c, 0xf3, 0xf3, 0x06, 0x1a, 0x83, 0x2d, 0x75, 0x98, 0xe4,
+	0x2e, 0xed, 0x5a, 0xc4, 0x23, 0xed, 0xc4, 0x0b, 0xa8, 0xd0, 0xda, 0x9a, 0x73, 0x62, 0x6b, 0x6e,
+	0x09, 0x54, 0x1e, 0x97, 0x0d, 0x3c, 0xca, 0xe5, 0x47, 0x8b, 0xb9, 0xca, 0xf9, 0x7d, 0x1c, 0x50,
+	0x4f, 0x1c, 0x83, 0x20, 0x58, 0x9e, 0x92, 0x43, 0xa7, 0x46, 0x9a, 0xa5, 0x97, 0xd1, 0xc5, 0x23,
+	0x90, 0xed, 0x31, 0xdb, 0xea, 0xc4, 0xe8, 0xe8, 0xa4, 0xf4, 0xa9, 0x0c, 0xa6, 0x7b, 0x51, 0x3d,
+	0x0e, 0xc8, 0xff, 0x1c, 0x4c, 0xdd, 0x87, 0x39, 0x7c, 0x1a, 0x68, 0x5f, 0xe8, 0xb9, 0x3e, 0x0b,
+	0xfd, 0xec, 0xa0, 0x85, 0x3e, 0x36, 0x70, 0xa1, 0x5f, 0x46, 0x8b, 0x5d, 0xc7, 0xad, 0x73, 0x65,
+	0x5d, 0x9c, 0x79, 0xee, 0xe1, 0xd0, 0x25, 0xc1, 0xb3, 0x70, 0x4b, 0x07, 0xcf, 0x6d, 0x74, 0xa9,
+	0x4b, 0x77, 0x7a, 0x16, 0x2f, 0xa0, 0x31, 0xc6, 0x31, 0x6f, 0x30, 0x15, 0xf3, 0xaa, 0x54, 0xfa,
+	0xb7, 0x21, 0x30, 0x2b, 0xe4, 0x49, 0x23, 0xf4, 0xfe, 0x7f, 0x66, 0x4f, 0x7a, 0xab, 0x73, 0xd4,
+	0xed, 0xde, 0x52, 0x8b, 0xde, 0x68, 0x4f, 0x6a, 0xa5, 0x6f, 0x1a, 0xc8, 0x84, 0x95, 0x42, 0xf8,
+	0x76, 0x42, 0x3d, 0x9f, 0xec, 0xe2, 0x06, 0x23, 0xde, 0x09, 0xd6, 0xf3, 0x05, 0x78, 0x12, 0x81,
+	0xba, 0xc2, 0x57, 0xf9, 0x8a, 0x2a, 0x81, 0x3c, 0x21, 0x98, 0xb5, 0xce, 0x6a, 0xb2, 0x94, 0x59,
+	0xcb, 0xa5, 0x05, 0x64, 0x1f, 0x85, 0xd0, 0x71, 0xf7, 0x1d, 0xa3, 0xed, 0xdd, 0xe3, 0x95, 0xac,
+	0x87, 0x4f, 0xfa, 0x82, 0xd3, 0x6d, 0x0e, 0x47, 0xba, 0xcd, 0xe1, 0xdd, 0xe9, 0xec, 0x5b, 0x4d,
+	0xc9, 0x41, 0x97, 0x7b, 0xc0, 0x68, 0x67, 0x2f, 0x22, 0x14, 0x05, 0x5e, 0xda, 0xac, 0x74, 0x78,
+	0x21, 0x0a, 0x3c, 0xc5, 0x2c, 0x92, 0xf0, 0x41, 0xb6, 0xd7, 0x42, 0x48, 0x0e, 0xd4, 0x6e, 0xf6,
+	0x35, 0x94, 0xdf, 0x61, 0xfe, 0xe3, 0x28, 0x7e, 0x3d, 0x3e, 0xed, 0x43, 0x73, 0x97, 0xd3, 0x67,
+	0xf6, 0x70, 0x5c, 0x46, 0xc5, 0xb4, 0x6f, 0x3d, 0x9a, 0x4b, 0x08, 0xe0, 0x1c, 0xc6, 0xb1, 0xfb,
+	0x54, 0x0d, 0x26, 0x1f, 0x92, 0x83, 0x3d, 0x28, 0x97, 0xde, 0x12, 0x8b, 0x6d, 0xaf, 0x51, 0xad,
+	0x53, 0xfe, 0x0a, 0xaf, 0x3d, 0x20, 0xd8, 0x23, 0x89, 0xb8, 0x74, 0x24, 0x64, 0x38, 0xf0, 0xd4,
+	0x10, 0x1e, 0x70, 0x6a, 0xb2, 0xba, 0x35, 0x22, 0xc2, 0x3d, 0x2d, 0xaa, 0x48, 0x57, 0x76, 0xa5,
+	0x32, 0xba, 0xd4, 0xa5, 0x4b, 0x8d, 0x5b, 0x44, 0xa3, 0x9c, 0xc6, 0x0a, 0x14, 0x3e, 0xe1, 0x04,
+	0x3a, 0x27, 0xc3, 0x6b, 0xab, 0xc1, 0xa3, 0xdd, 0x88, 0x71, 0x75, 0x3b, 0x3f, 0x45, 0xef, 0x5a,
+	0x68, 0x9c, 0x84, 0xb8, 0x1a, 0x10, 0x4f, 0xb8, 0x37, 0x5f, 0x49, 0x8b, 0x59, 0xff, 0x2e, 0xa1,
+	0x85, 0x6e, 0x24, 0x3a, 0xd4, 0xdf, 0x95, 0xcb, 0x51, 0x46, 0xd7, 0x96, 0x7e, 0xbd, 0x39, 0xcd,
+	0x3b, 0x1c, 0x38, 0x08, 0x7b, 0x80, 0x09, 0x4f, 0x44, 0xf0, 0x29, 0xd7, 0x68, 0x3d, 0xda, 0x87,
+	0x2d, 0x77, 0x54, 0xae, 0x51, 0x28, 0x75, 0x64, 0x18, 0xb9, 0x48, 0x3b, 0xd0, 0x34, 0xf9, 0x2f,
+	0xe5, 0xf3, 0x2a, 0xdc, 0x88, 0xdf, 0xc0, 0x94, 0x0b, 0xec, 0x53, 0xf4, 0x6f, 0xb7, 0xab, 0x7b,
+	0x6e, 0xa8, 0xab, 0x3b, 0x9c, 0x12, 0x32, 0x57, 0xf7, 0xdb, 0x68, 0xbe, 0x03, 0x54, 0xc7, 0x8e,
+	0x8d, 0xf2, 0xe2, 0x00, 0x0a, 0x57, 0x4b, 0x15, 0xe9, 0x69, 0xb9, 0xf4, 0x26, 0x2a, 0xa6, 0xf7,
+	0xed, 0x67, 0x30, 0xc0, 0x6e, 0x77, 0xd4, 0x4c, 0x5f, 0xda, 0xd1, 0xef, 0x49, 0x47, 0xab, 0x18,
+	0x3a, 0xf5, 0x34, 0x61, 0xa2, 0x5c, 0x3d, 0xf2, 0x88, 0xca, 0xd6, 0xe2, 0x1b, 0x8e, 0x85, 0x1c,
+	0x27, 0x3e, 0xe1, 0x2a, 0x09, 0xa8, 0x63, 0xa1, 0x94, 0x89, 0x3c, 0x90, 0xc5, 0x97, 0xef, 0xd4,
+	0xed, 0x84, 0x9a, 0x3e, 0x40, 0xa6, 0xde, 0xd4, 0x9f, 0x05, 0x7f, 0x16, 0x44, 0x86, 0x6c, 0x47,
+	0x6f, 0x29, 0xcb, 0xe6, 0x7f, 0x16, 0xd1, 0xe8, 0x0e, 0xf3, 0xcd, 0x77, 0x0d, 0x34, 0x99, 0xf9,
+	0xb3, 0xc0, 0xd5, 0xae, 0xcf, 0xf9, 0x1d, 0x4f, 0xef, 0xf6, 0xda, 0x30, 0x56, 0x7a, 0xe0, 0xb7,
+	0xdf, 0xfe, 0xcb, 0xbf, 0x7e, 0x30, 0x52, 0xbe, 0x6b, 0xac, 0x96, 0x56, 0xcb, 0x62, 0xdb, 0xb9,
+	0xbd, 0x59, 0xee, 0xf6, 0x47, 0x8b, 0x86, 0xa8, 0xed, 0xc8, 0xbf, 0x14, 0x98, 0xdf, 0x37, 0x10,
+	0x6a, 0x7b, 0xd4, 0x2f, 0xf5, 0xea, 0xb3, 0x65, 0x63, 0xaf, 0x0e, 0xb6, 0xd1, 0x54, 0x2f, 0x0a,
+	0xaa, 0x9b, 0x40, 0xb5, 0xd2, 0x97, 0x4a, 0x24, 0x01, 0xe2, 0x80, 0x93, 0xcd, 0x77, 0x0c, 0x94,
+	0xd7, 0xcf, 0x7a, 0xcb, 0xbd, 0x7a, 0x4b, 0x2d, 0xec, 0x95, 0x41, 0x16, 0x9a, 0xe6, 0x96, 0xa0,
+	0x79, 0x01, 0x68, 0x9e, 0xef, 0x4b, 0xf3, 0x66, 0x44, 0x43, 0xc9, 0xf2, 0x5d, 0x03, 0x15, 0x5a,
+	0xcf, 0x6d, 0xcf, 0xf5, 0xea, 0x4a, 0x9b, 0xd8, 0x37, 0x06, 0x9a, 0x68, 0x9c, 0x4d, 0x81, 0xb3,
+	0x06, 0x38, 0xd7, 0xfb, 0xe2, 0x04, 0x50, 0xb5, 0xc5, 0xd3, 0x7a, 0x7b, 0xeb, 0xc9, 0xa3, 0x4d,
+	0xec, 0x1b, 0x03, 0x4d, 0x8e, 0xcf, 0xe3, 0x11, 0x1c, 0x38, 0xae, 0x20, 0xf8, 0xb1, 0x81, 0xa6,
+	0xb2, 0xef, 0x70, 0xd7, 0x7a, 0x75, 0x98, 0x31, 0xb3, 0x6f, 0x0e, 0x65, 0xa6, 0xd9, 0xee, 0x08,
+	0xb6, 0x0d, 0x60, 0x7b, 0xa1, 0x2f, 0x5b, 0x2c, 0xab, 0xab, 0x67, 0x6e, 0xb3, 0x89, 0x72, 0xe2,
+	0xb5, 0x6d, 0xa1, 0x57, 0x77, 0xa0, 0xb5, 0xaf, 0xf6, 0xd3, 0x6a, 0x86, 0x35, 0xc1, 0xf0, 0x3c,
+	0x30, 0x3c, 0xd7, 0x97, 0xa1, 0x0e, 0x3d, 0x36, 0x51, 0x4e, 0xbc, 0x49, 0xf5, 0xec, 0x19, 0xb4,
+	0xf6, 0xd5, 0x7e, 0xda, 0xe3, 0xf7, 0x5c, 0x85, 0x1e, 0x7f, 0x6a, 0xa0, 0xe9, 0x8e, 0x07, 0xa7,
+	0xe7, 0x7b, 0x7a, 0x3b, 0x63, 0x67, 0xaf, 0x0f, 0x67, 0xa7, 0xc1, 0x3e, 0x23, 0xc0, 0x6e, 0x01,
+	0xd8, 0x5a, 0xff, 0x69, 0x91, 0xf5, 0x1d, 0xf5, 0x02, 0x63, 0xfe, 0xce, 0x40, 0x66, 0x97, 0xf7,
+	0x94, 0x9e, 0xb9, 0xe5, 0xa8, 0xad, 0xbd, 0x39, 0xbc, 0xad, 0xe6, 0xfd, 0x9c, 0xe0, 0xbd, 0x0d,
+	0xbc, 0x1b, 0x7d, 0x79, 0xa9, 0x6a, 0xc3, 0x39, 0x68, 0xc1, 0x81, 0x5f, 0x3b, 0xde, 0x25, 0x7a,
+	0xfa, 0x35, 0x6b, 0x67, 0xaf, 0x0f, 0x67, 0x77, 0x7c, 0xbf, 0xc2, 0x69, 0xa5, 0x9d, 0x11, 0xfc,
+	0xda, 0xe5, 0x69, 0xa1, 0x77, 0xce, 0x3e, 0x62, 0x6b, 0x6f, 0x0e, 0x6f, 0x7b, 0x7c, 0xbf, 0xba,
+	0xaa, 0x8d, 0x76, 0xe6, 0x5f, 0x1b, 0xa8, 0x78, 0xe4, 0xd6, 0xdf, 0x33, 0xab, 0x77, 0x5a, 0xda,
+	0x1b, 0xc3, 0x5a, 0x6a, 0xda, 0x97, 0x05, 0xed, 0x8b, 0x40, 0xbb, 0xde, 0x9f, 0x56, 0xb4, 0xd0,
+	0xc9, 0x7a, 0xe4, 0xea, 0xdf, 0x93, 0xb5, 0xd3, 0xd2, 0xde, 0x18, 0xd6, 0xf2, 0xf8, 0xac, 0x89,
+	0x68, 0xa1, 0x9d, 0xf5, 0x17, 0x06, 0x9a, 0xe9, 0xbc, 0x78, 0x5f, 0xef, 0x19, 0x88, 0x59, 0x43,
+	0xbb, 0x3c, 0xa4, 0xe1, 0xf1, 0x41, 0x19, 0xe1, 0x4e, 0x55, 0xb4, 0xe0, 0xa8, 0x3b, 0xfc, 0x1f,
+	0x0c, 0x34, 0xd7, 0xf5, 0xf6, 0x3d, 0xe0, 0x08, 0x94, 0xb5, 0xb6, 0x5f, 0x3a, 0x8e, 0xb5, 0xe6,
+	0xfe, 0xa2, 0xe0, 0x7e, 0x19, 0xb8, 0x5f, 0x1a, 0xe6, 0xe0, 0xd4, 0x79, 0xad, 0x37, 0x7f, 0x63,
+	0xa0, 0xe2, 0x91, 0x0b, 0x6a, 0xcf, 0x90, 0xe8, 0xb4, 0xb4, 0x37, 0x86, 0xb5, 0xd4, 0xc4, 0x77,
+	0x05, 0xf1, 0x4b, 0x40, 0x5c, 0xee, 0xef, 0x69, 0xd1, 0x82, 0x20, 0x56, 0x57, 0x5d, 0xf3, 0xeb,
+	0xe8, 0xac, 0x3c, 0x12, 0x2f, 0xf6, 0xea, 0x56, 0xa8, 0xed, 0x6b, 0x7d, 0xd5, 0x1a, 0x65, 0x5d,
+	0xa0, 0xac, 0x00, 0xca, 0x95, 0xbe, 0x28, 0x3c, 0x8a, 0x9d, 0x46, 0x6c, 0xfe, 0xd6, 0x40, 0xb3,
+	0x47, 0xef, 0xc9, 0x37, 0xfa, 0xc4, 0x5a, 0xd6, 0xd4, 0xbe, 0x35, 0xb4, 0xa9, 0x66, 0xfc, 0xbc,
+	0x60, 0xbc, 0x03, 0x8c, 0xb7, 0x06, 0x06, 0x26, 0x6e, 0xf0, 0xc8, 0x11, 0x7f, 0x80, 0x97, 0x7f,
+	0x79, 0x37, 0x7f, 0x6e, 0xa0, 0x99, 0xce, 0xeb, 0xf2, 0xf5, 0xfe, 0x81, 0xa6, 0x0d, 0xed, 0xf2,
+	0x90, 0x86, 0x9a, 0xf5, 0xb3, 0x82, 0x75, 0x13, 0x58, 0x6f, 0x0e, 0x13, 0x8c, 0xad, 0x1f, 0x60,
+	0xc0, 0x25, 0x23, 0x73, 0x39, 0xbe, 0xda, 0xef, 0x58, 0x9c, 0x5a, 0xd9, 0x6b, 0xc3, 0x58, 0x1d,
+	0xff, 0x92, 0x21, 0x0e, 0xd0, 0x07, 0x29, 0x0a, 0x1c, 0x12, 0xb3, 0x17, 0xdb, 0x6b, 0x7d, 0x4f,
+	0xc9, 0x9a, 0xee, 0xe6, 0x50, 0x66, 0xc7, 0x3f, 0x24, 0xca, 0x03, 0xb5, 0xe6, 0xfb, 0x91, 0x81,
+	0x26, 0x33, 0xf7, 0xdd, 0xab, 0x03, 0xa2, 0x4c, 0xae, 0x91, 0xb5, 0x61, 0xac, 0x4e, 0xb0, 0xa5,
+	0xa7, 0x61, 0xa8, 0xd6, 0x0c, 0xbc, 0x7c, 0x74, 0x5e, 0x68, 0xaf, 0xf7, 0xdf, 0xf3, 0x5a, 0x8c,
+	0xe5, 0x21, 0x0d, 0x8f, 0x9f, 0x5c, 0xd4, 0xde, 0xd8, 0x46, 0x6a, 0x9f, 0xfd, 0x06, 0xfc, 0x0a,
+	0x6d, 0xfb, 0x95, 0x0f, 0x3e, 0x5a, 0x32, 0x3e, 0xfc, 0x68, 0xc9, 0xf8, 0xe7, 0x47, 0x4b, 0xc6,
+	0xf7, 0x3e, 0x5e, 0x3a, 0xf3, 0xe1, 0xc7, 0x4b, 0x67, 0xfe, 0xfa, 0xf1, 0xd2, 0x99, 0xaf, 0xbe,
+	0xe0, 0x53, 0x5e, 0x6b, 0x54, 0xd7, 0xdd, 0xa8, 0xde, 0xad, 0xed, 0xf4, 0x77, 0x69, 0xf0, 0x03,
+	0x1f, 0x56, 0x1d, 0x13, 0xbf, 0xab, 0x7b, 0xf1, 0xbf, 0x03, 0x00, 0xe3, 0x27, 0x72, 0xb7, 0x29,
+	0x28, 0x00, 0x00,
 }
 
-func (m *MsgProcessDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateGame defines the CreateGame RPC.
+	CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error)
+	// JoinGame defines the JoinGame RPC.
+	JoinGame(ctx context.Context, in *MsgJoinGame, opts ...grpc.CallOption) (*MsgJoinGameResponse, error)
+	// LeaveGame defines the LeaveGame RPC.
+	LeaveGame(ctx context.Context, in *MsgLeaveGame, opts ...grpc.CallOption) (*MsgLeaveGameResponse, error)
+	// DealCards defines the DealCards RPC.
+	DealCards(ctx context.Context, in *MsgDealCards, opts ...grpc.CallOption) (*MsgDealCardsResponse, error)
+	// PerformAction defines the PerformAction RPC.
+	PerformAction(ctx context.Context, in *MsgPerformAction, opts ...grpc.CallOption) (*MsgPerformActionResponse, error)
+	// Mint defines the Mint RPC.
+	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// Burn defines the Burn RPC.
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// ProcessDeposit defines the ProcessDeposit RPC.
+	// Processes an Ethereum deposit by querying the deposit index from the bridge contract.
+	ProcessDeposit(ctx context.Context, in *MsgProcessDeposit, opts ...grpc.CallOption) (*MsgProcessDepositResponse, error)
+	// InitiateWithdrawal defines the InitiateWithdrawal RPC.
+	// Initiates a USDC withdrawal from Cosmos to Base chain.
+	InitiateWithdrawal(ctx context.Context, in *MsgInitiateWithdrawal, opts ...grpc.CallOption) (*MsgInitiateWithdrawalResponse, error)
+	// SignWithdrawal defines the SignWithdrawal RPC.
+	// Manually signs a pending withdrawal request (for validators only).
+	SignWithdrawal(ctx context.Context, in *MsgSignWithdrawal, opts ...grpc.CallOption) (*MsgSignWithdrawalResponse, error)
+	// CompleteWithdrawal defines the CompleteWithdrawal RPC.
+	// Marks a signed withdrawal completed by proving its Withdrawn event on Base.
+	CompleteWithdrawal(ctx context.Context, in *MsgCompleteWithdrawal, opts ...grpc.CallOption) (*MsgCompleteWithdrawalResponse, error)
+	// CancelWithdrawal defines the CancelWithdrawal RPC.
+	// Cancels an expired withdrawal that has not been claimed on Base.
+	CancelWithdrawal(ctx context.Context, in *MsgCancelWithdrawal, opts ...grpc.CallOption) (*MsgCancelWithdrawalResponse, error)
+	// RefundWithdrawal defines the RefundWithdrawal RPC.
+	// Re-mints a cancelled withdrawal by proving its nonce was invalidated on Base.
+	RefundWithdrawal(ctx context.Context, in *MsgRefundWithdrawal, opts ...grpc.CallOption) (*MsgRefundWithdrawalResponse, error)
+	// SetBridgePaused defines the SetBridgePaused RPC.
+	// Pauses (guardians or authority) or unpauses (authority only) the bridge.
+	SetBridgePaused(ctx context.Context, in *MsgSetBridgePaused, opts ...grpc.CallOption) (*MsgSetBridgePausedResponse, error)
+	// UpdateEthBlockHeight defines the UpdateEthBlockHeight RPC.
+	// Updates the Ethereum block height used for deterministic deposit queries.
+	// This must be called via a transaction to ensure all validators use the same height.
+	UpdateEthBlockHeight(ctx context.Context, in *MsgUpdateEthBlockHeight, opts ...grpc.CallOption) (*MsgUpdateEthBlockHeightResponse, error)
+	// SubmitEthHeaders defines the SubmitEthHeaders RPC.
+	// Extends the tracked Base/Ethereum header chain used to verify deposit proofs.
+	SubmitEthHeaders(ctx context.Context, in *MsgSubmitEthHeaders, opts ...grpc.CallOption) (*MsgSubmitEthHeadersResponse, error)
+	// TopUp defines the TopUp RPC.
+	// Allows a player to add chips to their stack when not in an active hand.
+	TopUp(ctx context.Context, in *MsgTopUp, opts ...grpc.CallOption) (*MsgTopUpResponse, error)
+	// SetAutoPostBlinds defines the SetAutoPostBlinds RPC.
+	// Opts a seated player in or out of having their blinds posted automatically.
+	SetAutoPostBlinds(ctx context.Context, in *MsgSetAutoPostBlinds, opts ...grpc.CallOption) (*MsgSetAutoPostBlindsResponse, error)
+	// UpdateAllowlist defines the UpdateAllowlist RPC.
+	// Lets a table's creator add and remove the addresses allowed to sit.
+	UpdateAllowlist(ctx context.Context, in *MsgUpdateAllowlist, opts ...grpc.CallOption) (*MsgUpdateAllowlistResponse, error)
+	// JoinWaitlist defines the JoinWaitlist RPC.
+	// Queues a player for the next free seat at a full table.
+	JoinWaitlist(ctx context.Context, in *MsgJoinWaitlist, opts ...grpc.CallOption) (*MsgJoinWaitlistResponse, error)
+	// LeaveWaitlist defines the LeaveWaitlist RPC.
+	// Takes a player off a table's waitlist, giving up any seat held for them.
+	LeaveWaitlist(ctx context.Context, in *MsgLeaveWaitlist, opts ...grpc.CallOption) (*MsgLeaveWaitlistResponse, error)
+	// SetAutoTopUp defines the SetAutoTopUp RPC.
+	// Has the module rebuy or top up a seated player's stack between hands.
+	SetAutoTopUp(ctx context.Context, in *MsgSetAutoTopUp, opts ...grpc.CallOption) (*MsgSetAutoTopUpResponse, error)
+	// CancelAutoTopUp defines the CancelAutoTopUp RPC.
+	// Cancels a player's auto-rebuy or auto-top-up at a table.
+	CancelAutoTopUp(ctx context.Context, in *MsgCancelAutoTopUp, opts ...grpc.CallOption) (*MsgCancelAutoTopUpResponse, error)
 }
 
-func (m *MsgProcessDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgProcessDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EthBlockHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.DepositIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DepositIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgInitiateWithdrawal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInitiateWithdrawal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgInitiateWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BaseAddress) > 0 {
-		i -= len(m.BaseAddress)
-		copy(dAtA[i:], m.BaseAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error) {
+	out := new(MsgCreateGameResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/CreateGame", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgInitiateWithdrawalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) JoinGame(ctx context.Context, in *MsgJoinGame, opts ...grpc.CallOption) (*MsgJoinGameResponse, error) {
+	out := new(MsgJoinGameResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/JoinGame", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInitiateWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgInitiateWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) LeaveGame(ctx context.Context, in *MsgLeaveGame, opts ...grpc.CallOption) (*MsgLeaveGameResponse, error) {
+	out := new(MsgLeaveGameResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/LeaveGame", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSignWithdrawal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DealCards(ctx context.Context, in *MsgDealCards, opts ...grpc.CallOption) (*MsgDealCardsResponse, error) {
+	out := new(MsgDealCardsResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/DealCards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSignWithdrawal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSignWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorEthKeyHex) > 0 {
-		i -= len(m.ValidatorEthKeyHex)
-		copy(dAtA[i:], m.ValidatorEthKeyHex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorEthKeyHex)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) PerformAction(ctx context.Context, in *MsgPerformAction, opts ...grpc.CallOption) (*MsgPerformActionResponse, error) {
+	out := new(MsgPerformActionResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/PerformAction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSignWithdrawalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/Mint", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSignWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSignWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ProcessDeposit(ctx context.Context, in *MsgProcessDeposit, opts ...grpc.CallOption) (*MsgProcessDepositResponse, error) {
+	out := new(MsgProcessDepositResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/ProcessDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateEthBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) InitiateWithdrawal(ctx context.Context, in *MsgInitiateWithdrawal, opts ...grpc.CallOption) (*MsgInitiateWithdrawalResponse, error) {
+	out := new(MsgInitiateWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/InitiateWithdrawal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateEthBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SignWithdrawal(ctx context.Context, in *MsgSignWithdrawal, opts ...grpc.CallOption) (*MsgSignWithdrawalResponse, error) {
+	out := new(MsgSignWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/SignWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateEthBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EthBlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CompleteWithdrawal(ctx context.Context, in *MsgCompleteWithdrawal, opts ...grpc.CallOption) (*MsgCompleteWithdrawalResponse, error) {
+	out := new(MsgCompleteWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/CompleteWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateEthBlockHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelWithdrawal(ctx context.Context, in *MsgCancelWithdrawal, opts ...grpc.CallOption) (*MsgCancelWithdrawalResponse, error) {
+	out := new(MsgCancelWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/CancelWithdrawal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateEthBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RefundWithdrawal(ctx context.Context, in *MsgRefundWithdrawal, opts ...grpc.CallOption) (*MsgRefundWithdrawalResponse, error) {
+	out := new(MsgRefundWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/RefundWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateEthBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.OldHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OldHeight))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) SetBridgePaused(ctx context.Context, in *MsgSetBridgePaused, opts ...grpc.CallOption) (*MsgSetBridgePausedResponse, error) {
+	out := new(MsgSetBridgePausedResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/SetBridgePaused", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgTopUp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateEthBlockHeight(ctx context.Context, in *MsgUpdateEthBlockHeight, opts ...grpc.CallOption) (*MsgUpdateEthBlockHeightResponse, error) {
+	out := new(MsgUpdateEthBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/UpdateEthBlockHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgTopUp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitEthHeaders(ctx context.Context, in *MsgSubmitEthHeaders, opts ...grpc.CallOption) (*MsgSubmitEthHeadersResponse, error) {
+	out := new(MsgSubmitEthHeadersResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/SubmitEthHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.GameId) > 0 {
-		i -= len(m.GameId)
-		copy(dAtA[i:], m.GameId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Player) > 0 {
-		i -= len(m.Player)
-		copy(dAtA[i:], m.Player)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) TopUp(ctx context.Context, in *MsgTopUp, opts ...grpc.CallOption) (*MsgTopUpResponse, error) {
+	out := new(MsgTopUpResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/TopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgTopUpResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAutoPostBlinds(ctx context.Context, in *MsgSetAutoPostBlinds, opts ...grpc.CallOption) (*MsgSetAutoPostBlindsResponse, error) {
+	out := new(MsgSetAutoPostBlindsResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/SetAutoPostBlinds", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateAllowlist(ctx context.Context, in *MsgUpdateAllowlist, opts ...grpc.CallOption) (*MsgUpdateAllowlistResponse, error) {
+	out := new(MsgUpdateAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/UpdateAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewStack != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewStack))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) JoinWaitlist(ctx context.Context, in *MsgJoinWaitlist, opts ...grpc.CallOption) (*MsgJoinWaitlistResponse, error) {
+	out := new(MsgJoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/JoinWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) LeaveWaitlist(ctx context.Context, in *MsgLeaveWaitlist, opts ...grpc.CallOption) (*MsgLeaveWaitlistResponse, error) {
+	out := new(MsgLeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/LeaveWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) SetAutoTopUp(ctx context.Context, in *MsgSetAutoTopUp, opts ...grpc.CallOption) (*MsgSetAutoTopUpResponse, error) {
+	out := new(MsgSetAutoTopUpResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/SetAutoTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CancelAutoTopUp(ctx context.Context, in *MsgCancelAutoTopUp, opts ...grpc.CallOption) (*MsgCancelAutoTopUpResponse, error) {
+	out := new(MsgCancelAutoTopUpResponse)
+	err := c.cc.Invoke(ctx, "/pokerchain.poker.v1.Msg/CancelAutoTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateGame) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MinBuyIn != 0 {
-		n += 1 + sovTx(uint64(m.MinBuyIn))
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateGame defines the CreateGame RPC.
+	CreateGame(context.Context, *MsgCreateGame) (*MsgCreateGameResponse, error)
+	// JoinGame defines the JoinGame RPC.
+	JoinGame(context.Context, *MsgJoinGame) (*MsgJoinGameResponse, error)
+	// LeaveGame defines the LeaveGame RPC.
+	LeaveGame(context.Context, *MsgLeaveGame) (*MsgLeaveGameResponse, error)
+	// DealCards defines the DealCards RPC.
+	DealCards(context.Context, *MsgDealCards) (*MsgDealCardsResponse, error)
+	// PerformAction defines the PerformAction RPC.
+	PerformAction(context.Context, *MsgPerformAction) (*MsgPerformActionResponse, error)
+	// Mint defines the Mint RPC.
+	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
+	// Burn defines the Burn RPC.
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	// ProcessDeposit defines the ProcessDeposit RPC.
+	// Processes an Ethereum deposit by querying the deposit index from the bridge contract.
+	ProcessDeposit(context.Context, *MsgProcessDeposit) (*MsgProcessDepositResponse, error)
+	// InitiateWithdrawal defines the InitiateWithdrawal RPC.
+	// Initiates a USDC withdrawal from Cosmos to Base chain.
+	InitiateWithdrawal(context.Context, *MsgInitiateWithdrawal) (*MsgInitiateWithdrawalResponse, error)
+	// SignWithdrawal defines the SignWithdrawal RPC.
+	// Manually signs a pending withdrawal request (for validators only).
+	SignWithdrawal(context.Context, *MsgSignWithdrawal) (*MsgSignWithdrawalResponse, error)
+	// CompleteWithdrawal defines the CompleteWithdrawal RPC.
+	// Marks a signed withdrawal completed by proving its Withdrawn event on Base.
+	CompleteWithdrawal(context.Context, *MsgCompleteWithdrawal) (*MsgCompleteWithdrawalResponse, error)
+	// CancelWithdrawal defines the CancelWithdrawal RPC.
+	// Cancels an expired withdrawal that has not been claimed on Base.
+	CancelWithdrawal(context.Context, *MsgCancelWithdrawal) (*MsgCancelWithdrawalResponse, error)
+	// RefundWithdrawal defines the RefundWithdrawal RPC.
+	// Re-mints a cancelled withdrawal by proving its nonce was invalidated on Base.
+	RefundWithdrawal(context.Context, *MsgRefundWithdrawal) (*MsgRefundWithdrawalResponse, error)
+	// SetBridgePaused defines the SetBridgePaused RPC.
+	// Pauses (guardians or authority) or unpauses (authority only) the bridge.
+	SetBridgePaused(context.Context, *MsgSetBridgePaused) (*MsgSetBridgePausedResponse, error)
+	// UpdateEthBlockHeight defines the UpdateEthBlockHeight RPC.
+	// Updates the Ethereum block height used for deterministic deposit queries.
+	// This must be called via a transaction to ensure all validators use the same height.
+	UpdateEthBlockHeight(context.Context, *MsgUpdateEthBlockHeight) (*MsgUpdateEthBlockHeightResponse, error)
+	// SubmitEthHeaders defines the SubmitEthHeaders RPC.
+	// Extends the tracked Base/Ethereum header chain used to verify deposit proofs.
+	SubmitEthHeaders(context.Context, *MsgSubmitEthHeaders) (*MsgSubmitEthHeadersResponse, error)
+	// TopUp defines the TopUp RPC.
+	// Allows a player to add chips to their stack when not in an active hand.
+	TopUp(context.Context, *MsgTopUp) (*MsgTopUpResponse, error)
+	// SetAutoPostBlinds defines the SetAutoPostBlinds RPC.
+	// Opts a seated player in or out of having their blinds posted automatically.
+	SetAutoPostBlinds(context.Context, *MsgSetAutoPostBlinds) (*MsgSetAutoPostBlindsResponse, error)
+	// UpdateAllowlist defines the UpdateAllowlist RPC.
+	// Lets a table's creator add and remove the addresses allowed to sit.
+	UpdateAllowlist(context.Context, *MsgUpdateAllowlist) (*MsgUpdateAllowlistResponse, error)
+	// JoinWaitlist defines the JoinWaitlist RPC.
+	// Queues a player for the next free seat at a full table.
+	JoinWaitlist(context.Context, *MsgJoinWaitlist) (*MsgJoinWaitlistResponse, error)
+	// LeaveWaitlist defines the LeaveWaitlist RPC.
+	// Takes a player off a table's waitlist, giving up any seat held for them.
+	LeaveWaitlist(context.Context, *MsgLeaveWaitlist) (*MsgLeaveWaitlistResponse, error)
+	// SetAutoTopUp defines the SetAutoTopUp RPC.
+	// Has the module rebuy or top up a seated player's stack between hands.
+	SetAutoTopUp(context.Context, *MsgSetAutoTopUp) (*MsgSetAutoTopUpResponse, error)
+	// CancelAutoTopUp defines the CancelAutoTopUp RPC.
+	// Cancels a player's auto-rebuy or auto-top-up at a table.
+	CancelAutoTopUp(context.Context, *MsgCancelAutoTopUp) (*MsgCancelAutoTopUpResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateGame(ctx context.Context, req *MsgCreateGame) (*MsgCreateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (*UnimplementedMsgServer) JoinGame(ctx context.Context, req *MsgJoinGame) (*MsgJoinGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
+}
+func (*UnimplementedMsgServer) LeaveGame(ctx context.Context, req *MsgLeaveGame) (*MsgLeaveGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGame not implemented")
+}
+func (*UnimplementedMsgServer) DealCards(ctx context.Context, req *MsgDealCards) (*MsgDealCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DealCards not implemented")
+}
+func (*UnimplementedMsgServer) PerformAction(ctx context.Context, req *MsgPerformAction) (*MsgPerformActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerformAction not implemented")
+}
+func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMint) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (*UnimplementedMsgServer) ProcessDeposit(ctx context.Context, req *MsgProcessDeposit) (*MsgProcessDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessDeposit not implemented")
+}
+func (*UnimplementedMsgServer) InitiateWithdrawal(ctx context.Context, req *MsgInitiateWithdrawal) (*MsgInitiateWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) SignWithdrawal(ctx context.Context, req *MsgSignWithdrawal) (*MsgSignWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) CompleteWithdrawal(ctx context.Context, req *MsgCompleteWithdrawal) (*MsgCompleteWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) CancelWithdrawal(ctx context.Context, req *MsgCancelWithdrawal) (*MsgCancelWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) RefundWithdrawal(ctx context.Context, req *MsgRefundWithdrawal) (*MsgRefundWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) SetBridgePaused(ctx context.Context, req *MsgSetBridgePaused) (*MsgSetBridgePausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgePaused not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEthBlockHeight(ctx context.Context, req *MsgUpdateEthBlockHeight) (*MsgUpdateEthBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEthBlockHeight not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEthHeaders(ctx context.Context, req *MsgSubmitEthHeaders) (*MsgSubmitEthHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEthHeaders not implemented")
+}
+func (*UnimplementedMsgServer) TopUp(ctx context.Context, req *MsgTopUp) (*MsgTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUp not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoPostBlinds(ctx context.Context, req *MsgSetAutoPostBlinds) (*MsgSetAutoPostBlindsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoPostBlinds not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAllowlist(ctx context.Context, req *MsgUpdateAllowlist) (*MsgUpdateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) JoinWaitlist(ctx context.Context, req *MsgJoinWaitlist) (*MsgJoinWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (*UnimplementedMsgServer) LeaveWaitlist(ctx context.Context, req *MsgLeaveWaitlist) (*MsgLeaveWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoTopUp(ctx context.Context, req *MsgSetAutoTopUp) (*MsgSetAutoTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoTopUp not implemented")
+}
+func (*UnimplementedMsgServer) CancelAutoTopUp(ctx context.Context, req *MsgCancelAutoTopUp) (*MsgCancelAutoTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAutoTopUp not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.MaxBuyIn != 0 {
-		n += 1 + sovTx(uint64(m.MaxBuyIn))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.MinPlayers != 0 {
-		n += 1 + sovTx(uint64(m.MinPlayers))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/UpdateParams",
 	}
-	if m.MaxPlayers != 0 {
-		n += 1 + sovTx(uint64(m.MaxPlayers))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	if m.SmallBlind != 0 {
-		n += 1 + sovTx(uint64(m.SmallBlind))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGame)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BigBlind != 0 {
-		n += 1 + sovTx(uint64(m.BigBlind))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGame(ctx, in)
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovTx(uint64(m.Timeout))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/CreateGame",
 	}
-	l = len(m.GameType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGame(ctx, req.(*MsgCreateGame))
 	}
-	if m.RakeFreeThreshold != 0 {
-		n += 1 + sovTx(uint64(m.RakeFreeThreshold))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinGame)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RakePercentage != 0 {
-		n += 1 + sovTx(uint64(m.RakePercentage))
+	if interceptor == nil {
+		return srv.(MsgServer).JoinGame(ctx, in)
 	}
-	if m.RakeCap != 0 {
-		n += 1 + sovTx(uint64(m.RakeCap))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/JoinGame",
 	}
-	l = len(m.RakeOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinGame(ctx, req.(*MsgJoinGame))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateGameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LeaveGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveGame)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/LeaveGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveGame(ctx, req.(*MsgLeaveGame))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgJoinGame) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Player)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_DealCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDealCards)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.GameId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DealCards(ctx, in)
 	}
-	if m.Seat != 0 {
-		n += 1 + sovTx(uint64(m.Seat))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/DealCards",
 	}
-	if m.BuyInAmount != 0 {
-		n += 1 + sovTx(uint64(m.BuyInAmount))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DealCards(ctx, req.(*MsgDealCards))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgJoinGameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PerformAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPerformAction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgLeaveGame) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).PerformAction(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/PerformAction",
 	}
-	l = len(m.GameId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PerformAction(ctx, req.(*MsgPerformAction))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLeaveGameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMint)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDealCards) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/Mint",
 	}
-	l = len(m.GameId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDealCardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPerformAction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Player)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_ProcessDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProcessDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.GameId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ProcessDeposit(ctx, in)
 	}
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/ProcessDeposit",
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProcessDeposit(ctx, req.(*MsgProcessDeposit))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPerformActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_InitiateWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgMint) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateWithdrawal(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/InitiateWithdrawal",
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateWithdrawal(ctx, req.(*MsgInitiateWithdrawal))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SignWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.EthTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SignWithdrawal(ctx, in)
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/SignWithdrawal",
 	}
-	return n
-}
-
-func (m *MsgMintResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignWithdrawal(ctx, req.(*MsgSignWithdrawal))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurn) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CompleteWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteWithdrawal(ctx, in)
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/CompleteWithdrawal",
 	}
-	l = len(m.EthRecipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteWithdrawal(ctx, req.(*MsgCompleteWithdrawal))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/CancelWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelWithdrawal(ctx, req.(*MsgCancelWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProcessDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RefundWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RefundWithdrawal(ctx, in)
 	}
-	if m.DepositIndex != 0 {
-		n += 1 + sovTx(uint64(m.DepositIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/RefundWithdrawal",
 	}
-	if m.EthBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.EthBlockHeight))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundWithdrawal(ctx, req.(*MsgRefundWithdrawal))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProcessDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_SetBridgePaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgePaused)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgePaused(ctx, in)
 	}
-	if m.DepositIndex != 0 {
-		n += 1 + sovTx(uint64(m.DepositIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/SetBridgePaused",
 	}
-	if m.EthBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.EthBlockHeight))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgePaused(ctx, req.(*MsgSetBridgePaused))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInitiateWithdrawal) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateEthBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEthBlockHeight)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEthBlockHeight(ctx, in)
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/UpdateEthBlockHeight",
 	}
-	l = len(m.BaseAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEthBlockHeight(ctx, req.(*MsgUpdateEthBlockHeight))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInitiateWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitEthHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEthHeaders)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEthHeaders(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgSignWithdrawal) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/SubmitEthHeaders",
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEthHeaders(ctx, req.(*MsgSubmitEthHeaders))
 	}
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorEthKeyHex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TopUp(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/TopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUp(ctx, req.(*MsgTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSignWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAutoPostBlinds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoPostBlinds)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoPostBlinds(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/SetAutoPostBlinds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoPostBlinds(ctx, req.(*MsgSetAutoPostBlinds))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateEthBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowlist(ctx, in)
 	}
-	if m.EthBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.EthBlockHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/UpdateAllowlist",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowlist(ctx, req.(*MsgUpdateAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateEthBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinWaitlist)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.OldHeight != 0 {
-		n += 1 + sovTx(uint64(m.OldHeight))
+	if interceptor == nil {
+		return srv.(MsgServer).JoinWaitlist(ctx, in)
 	}
-	if m.NewHeight != 0 {
-		n += 1 + sovTx(uint64(m.NewHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/JoinWaitlist",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinWaitlist(ctx, req.(*MsgJoinWaitlist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTopUp) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveWaitlist)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Player)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveWaitlist(ctx, in)
 	}
-	l = len(m.GameId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/LeaveWaitlist",
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveWaitlist(ctx, req.(*MsgLeaveWaitlist))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTopUpResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAutoTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/SetAutoTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoTopUp(ctx, req.(*MsgSetAutoTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAutoTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAutoTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAutoTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pokerchain.poker.v1.Msg/CancelAutoTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAutoTopUp(ctx, req.(*MsgCancelAutoTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pokerchain.poker.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateGame",
+			Handler:    _Msg_CreateGame_Handler,
+		},
+		{
+			MethodName: "JoinGame",
+			Handler:    _Msg_JoinGame_Handler,
+		},
+		{
+			MethodName: "LeaveGame",
+			Handler:    _Msg_LeaveGame_Handler,
+		},
+		{
+			MethodName: "DealCards",
+			Handler:    _Msg_DealCards_Handler,
+		},
+		{
+			MethodName: "PerformAction",
+			Handler:    _Msg_PerformAction_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "ProcessDeposit",
+			Handler:    _Msg_ProcessDeposit_Handler,
+		},
+		{
+			MethodName: "InitiateWithdrawal",
+			Handler:    _Msg_InitiateWithdrawal_Handler,
+		},
+		{
+			MethodName: "SignWithdrawal",
+			Handler:    _Msg_SignWithdrawal_Handler,
+		},
+		{
+			MethodName: "CompleteWithdrawal",
+			Handler:    _Msg_CompleteWithdrawal_Handler,
+		},
+		{
+			MethodName: "CancelWithdrawal",
+			Handler:    _Msg_CancelWithdrawal_Handler,
+		},
+		{
+			MethodName: "RefundWithdrawal",
+			Handler:    _Msg_RefundWithdrawal_Handler,
+		},
+		{
+			MethodName: "SetBridgePaused",
+			Handler:    _Msg_SetBridgePaused_Handler,
+		},
+		{
+			MethodName: "UpdateEthBlockHeight",
+			Handler:    _Msg_UpdateEthBlockHeight_Handler,
+		},
+		{
+			MethodName: "SubmitEthHeaders",
+			Handler:    _Msg_SubmitEthHeaders_Handler,
+		},
+		{
+			MethodName: "TopUp",
+			Handler:    _Msg_TopUp_Handler,
+		},
+		{
+			MethodName: "SetAutoPostBlinds",
+			Handler:    _Msg_SetAutoPostBlinds_Handler,
+		},
+		{
+			MethodName: "UpdateAllowlist",
+			Handler:    _Msg_UpdateAllowlist_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _Msg_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _Msg_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "SetAutoTopUp",
+			Handler:    _Msg_SetAutoTopUp_Handler,
+		},
+		{
+			MethodName: "CancelAutoTopUp",
+			Handler:    _Msg_CancelAutoTopUp_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pokerchain/poker/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewStack != 0 {
-		n += 1 + sovTx(uint64(m.NewStack))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvitePublicKey) > 0 {
+		i -= len(m.InvitePublicKey)
+		copy(dAtA[i:], m.InvitePublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InvitePublicKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.SpectatorDelaySeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpectatorDelaySeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.SpectatorDelayActions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpectatorDelayActions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.Spectators) > 0 {
+		i -= len(m.Spectators)
+		copy(dAtA[i:], m.Spectators)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Spectators)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.PostMissedBlinds {
+		i--
+		if m.PostMissedBlinds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxSitOutMinutes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSitOutMinutes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MaxSitOutHands != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSitOutHands))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.BombPotAnte != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BombPotAnte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.BombPotFrequency != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BombPotFrequency))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.Straddle) > 0 {
+		i -= len(m.Straddle)
+		copy(dAtA[i:], m.Straddle)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Straddle)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.AnteType) > 0 {
+		i -= len(m.AnteType)
+		copy(dAtA[i:], m.AnteType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AnteType)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Ante != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Ante))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RequiredAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequiredAmount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.RequiredDenom) > 0 {
+		i -= len(m.RequiredDenom)
+		copy(dAtA[i:], m.RequiredDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequiredDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Visibility) > 0 {
+		i -= len(m.Visibility)
+		copy(dAtA[i:], m.Visibility)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Visibility)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RakeOwner) > 0 {
+		i -= len(m.RakeOwner)
+		copy(dAtA[i:], m.RakeOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RakeOwner)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RakeCap != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RakeCap))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RakePercentage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RakePercentage))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RakeFreeThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RakeFreeThreshold))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.GameType) > 0 {
+		i -= len(m.GameType)
+		copy(dAtA[i:], m.GameType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameType)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BigBlind != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BigBlind))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SmallBlind != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SmallBlind))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxPlayers != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPlayers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinPlayers != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinPlayers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBuyIn != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxBuyIn))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinBuyIn != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinBuyIn))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InviteSignature) > 0 {
+		i -= len(m.InviteSignature)
+		copy(dAtA[i:], m.InviteSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InviteSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BuyInAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BuyInAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Seat != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinGameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinGameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveGameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDealCards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDealCards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDealCards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDealCardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDealCardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDealCardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPerformAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPerformAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPerformAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPerformActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPerformActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPerformActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptProof) > 0 {
+		for iNdEx := len(m.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptProof[iNdEx])
+			copy(dAtA[i:], m.ReceiptProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptProof[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthRecipient) > 0 {
+		i -= len(m.EthRecipient)
+		copy(dAtA[i:], m.EthRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProcessDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptProof) > 0 {
+		for iNdEx := len(m.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptProof[iNdEx])
+			copy(dAtA[i:], m.ReceiptProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptProof[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DepositIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DepositIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProcessDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DepositIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DepositIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcReceiver) > 0 {
+		i -= len(m.IbcReceiver)
+		copy(dAtA[i:], m.IbcReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IbcChannelId) > 0 {
+		i -= len(m.IbcChannelId)
+		copy(dAtA[i:], m.IbcChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseAddress) > 0 {
+		i -= len(m.BaseAddress)
+		copy(dAtA[i:], m.BaseAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IbcSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IbcSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NetAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NetAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Fee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptProof) > 0 {
+		for iNdEx := len(m.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptProof[iNdEx])
+			copy(dAtA[i:], m.ReceiptProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptProof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptProof) > 0 {
+		for iNdEx := len(m.ReceiptProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiptProof[iNdEx])
+			copy(dAtA[i:], m.ReceiptProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptProof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgePaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgePaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgePaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgePausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgePausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgePausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEthBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEthBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEthBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEthBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEthBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEthBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OldHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewStack != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewStack))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEthHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthHeaders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Headers[iNdEx])
+			copy(dAtA[i:], m.Headers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Headers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEthHeadersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEthHeadersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEthHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tip != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Tip))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoPostBlinds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoPostBlinds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoPostBlinds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoPostBlindsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoPostBlindsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoPostBlindsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinWaitlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinWaitlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinWaitlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InviteSignature) > 0 {
+		i -= len(m.InviteSignature)
+		copy(dAtA[i:], m.InviteSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InviteSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinWaitlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinWaitlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinWaitlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveWaitlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveWaitlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveWaitlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveWaitlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveWaitlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveWaitlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetStack != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetStack))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAutoTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAutoTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAutoTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameId) > 0 {
+		i -= len(m.GameId)
+		copy(dAtA[i:], m.GameId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAutoTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAutoTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAutoTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinBuyIn != 0 {
+		n += 1 + sovTx(uint64(m.MinBuyIn))
+	}
+	if m.MaxBuyIn != 0 {
+		n += 1 + sovTx(uint64(m.MaxBuyIn))
+	}
+	if m.MinPlayers != 0 {
+		n += 1 + sovTx(uint64(m.MinPlayers))
+	}
+	if m.MaxPlayers != 0 {
+		n += 1 + sovTx(uint64(m.MaxPlayers))
+	}
+	if m.SmallBlind != 0 {
+		n += 1 + sovTx(uint64(m.SmallBlind))
+	}
+	if m.BigBlind != 0 {
+		n += 1 + sovTx(uint64(m.BigBlind))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovTx(uint64(m.Timeout))
+	}
+	l = len(m.GameType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RakeFreeThreshold != 0 {
+		n += 1 + sovTx(uint64(m.RakeFreeThreshold))
+	}
+	if m.RakePercentage != 0 {
+		n += 1 + sovTx(uint64(m.RakePercentage))
+	}
+	if m.RakeCap != 0 {
+		n += 1 + sovTx(uint64(m.RakeCap))
+	}
+	l = len(m.RakeOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Visibility)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.RequiredDenom)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.RequiredAmount != 0 {
+		n += 2 + sovTx(uint64(m.RequiredAmount))
+	}
+	if m.Ante != 0 {
+		n += 2 + sovTx(uint64(m.Ante))
+	}
+	l = len(m.AnteType)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	l = len(m.Straddle)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.BombPotFrequency != 0 {
+		n += 2 + sovTx(uint64(m.BombPotFrequency))
+	}
+	if m.BombPotAnte != 0 {
+		n += 2 + sovTx(uint64(m.BombPotAnte))
+	}
+	if m.MaxSitOutHands != 0 {
+		n += 2 + sovTx(uint64(m.MaxSitOutHands))
+	}
+	if m.MaxSitOutMinutes != 0 {
+		n += 2 + sovTx(uint64(m.MaxSitOutMinutes))
+	}
+	if m.PostMissedBlinds {
+		n += 3
+	}
+	l = len(m.Spectators)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.SpectatorDelayActions != 0 {
+		n += 2 + sovTx(uint64(m.SpectatorDelayActions))
+	}
+	if m.SpectatorDelaySeconds != 0 {
+		n += 2 + sovTx(uint64(m.SpectatorDelaySeconds))
+	}
+	l = len(m.InvitePublicKey)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgJoinGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Seat != 0 {
+		n += 1 + sovTx(uint64(m.Seat))
+	}
+	if m.BuyInAmount != 0 {
+		n += 1 + sovTx(uint64(m.BuyInAmount))
+	}
+	l = len(m.InviteSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinGameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLeaveGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLeaveGameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDealCards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDealCardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPerformAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgPerformActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.EthBlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	if len(m.ReceiptProof) > 0 {
+		for _, b := range m.ReceiptProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.EthRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProcessDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DepositIndex != 0 {
+		n += 1 + sovTx(uint64(m.DepositIndex))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.EthBlockHeight))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	if len(m.ReceiptProof) > 0 {
+		for _, b := range m.ReceiptProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProcessDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DepositIndex != 0 {
+		n += 1 + sovTx(uint64(m.DepositIndex))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.EthBlockHeight))
+	}
+	return n
+}
+
+func (m *MsgInitiateWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.BaseAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IbcChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IbcReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitiateWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovTx(uint64(m.Fee))
+	}
+	if m.NetAmount != 0 {
+		n += 1 + sovTx(uint64(m.NetAmount))
+	}
+	if m.IbcSequence != 0 {
+		n += 1 + sovTx(uint64(m.IbcSequence))
+	}
+	return n
+}
+
+func (m *MsgSignWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCompleteWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.EthBlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	if len(m.ReceiptProof) > 0 {
+		for _, b := range m.ReceiptProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCompleteWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.EthBlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	if len(m.ReceiptProof) > 0 {
+		for _, b := range m.ReceiptProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRefundWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgSetBridgePaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBridgePausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateEthBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.EthBlockHeight))
+	}
+	return n
+}
+
+func (m *MsgUpdateEthBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldHeight != 0 {
+		n += 1 + sovTx(uint64(m.OldHeight))
+	}
+	if m.NewHeight != 0 {
+		n += 1 + sovTx(uint64(m.NewHeight))
+	}
+	return n
+}
+
+func (m *MsgTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewStack != 0 {
+		n += 1 + sovTx(uint64(m.NewStack))
+	}
+	return n
+}
+
+func (m *MsgSubmitEthHeaders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, b := range m.Headers {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitEthHeadersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tip != 0 {
+		n += 1 + sovTx(uint64(m.Tip))
+	}
+	return n
+}
+
+func (m *MsgSetAutoPostBlinds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoPostBlindsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgJoinWaitlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InviteSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinWaitlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != 0 {
+		n += 1 + sovTx(uint64(m.Position))
+	}
+	return n
+}
+
+func (m *MsgLeaveWaitlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLeaveWaitlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TargetStack != 0 {
+		n += 1 + sovTx(uint64(m.TargetStack))
+	}
+	return n
+}
+
+func (m *MsgSetAutoTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAutoTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAutoTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBuyIn", wireType)
+			}
+			m.MinBuyIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBuyIn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBuyIn", wireType)
+			}
+			m.MaxBuyIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBuyIn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPlayers", wireType)
+			}
+			m.MinPlayers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPlayers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPlayers", wireType)
+			}
+			m.MaxPlayers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPlayers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmallBlind", wireType)
+			}
+			m.SmallBlind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SmallBlind |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BigBlind", wireType)
+			}
+			m.BigBlind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BigBlind |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RakeFreeThreshold", wireType)
+			}
+			m.RakeFreeThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RakeFreeThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RakePercentage", wireType)
+			}
+			m.RakePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RakePercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RakeCap", wireType)
+			}
+			m.RakeCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RakeCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RakeOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RakeOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visibility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Visibility = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAmount", wireType)
+			}
+			m.RequiredAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ante", wireType)
+			}
+			m.Ante = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ante |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnteType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnteType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Straddle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Straddle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BombPotFrequency", wireType)
+			}
+			m.BombPotFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BombPotFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BombPotAnte", wireType)
+			}
+			m.BombPotAnte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BombPotAnte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSitOutHands", wireType)
+			}
+			m.MaxSitOutHands = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSitOutHands |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSitOutMinutes", wireType)
+			}
+			m.MaxSitOutMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSitOutMinutes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostMissedBlinds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostMissedBlinds = bool(v != 0)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spectators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spectators = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpectatorDelayActions", wireType)
+			}
+			m.SpectatorDelayActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpectatorDelayActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpectatorDelaySeconds", wireType)
+			}
+			m.SpectatorDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpectatorDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitePublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvitePublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinGame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinGame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinGame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seat", wireType)
+			}
+			m.Seat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyInAmount", wireType)
+			}
+			m.BuyInAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyInAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviteSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InviteSignature = append(m.InviteSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.InviteSignature == nil {
+				m.InviteSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinGameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinGameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveGame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveGame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveGame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveGameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveGameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDealCards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDealCards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDealCards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDealCardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDealCardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDealCardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPerformAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPerformAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPerformAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPerformActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPerformActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPerformActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptProof = append(m.ReceiptProof, make([]byte, postIndex-iNdEx))
+			copy(m.ReceiptProof[len(m.ReceiptProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIndex", wireType)
+			}
+			m.DepositIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptProof = append(m.ReceiptProof, make([]byte, postIndex-iNdEx))
+			copy(m.ReceiptProof[len(m.ReceiptProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIndex", wireType)
+			}
+			m.DepositIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			m.NetAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequence", wireType)
+			}
+			m.IbcSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSignWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3599,15 +10035,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3635,13 +10071,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3651,23 +10087,56 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3691,7 +10160,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSignWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3714,12 +10183,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3741,7 +10244,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3764,10 +10267,10 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGame: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3798,146 +10301,13 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBuyIn", wireType)
-			}
-			m.MinBuyIn = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinBuyIn |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBuyIn", wireType)
-			}
-			m.MaxBuyIn = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxBuyIn |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPlayers", wireType)
-			}
-			m.MinPlayers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinPlayers |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPlayers", wireType)
-			}
-			m.MaxPlayers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxPlayers |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SmallBlind", wireType)
-			}
-			m.SmallBlind = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SmallBlind |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BigBlind", wireType)
-			}
-			m.BigBlind = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BigBlind |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3965,13 +10335,13 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameType = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RakeFreeThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
 			}
-			m.RakeFreeThreshold = 0
+			m.EthBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3981,16 +10351,16 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RakeFreeThreshold |= uint64(b&0x7F) << shift
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RakePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			m.RakePercentage = 0
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4000,16 +10370,16 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RakePercentage |= uint32(b&0x7F) << shift
+				m.TxIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RakeCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
 			}
-			m.RakeCap = 0
+			m.LogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4019,16 +10389,16 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RakeCap |= uint64(b&0x7F) << shift
+				m.LogIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RakeOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4038,23 +10408,23 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RakeOwner = string(dAtA[iNdEx:postIndex])
+			m.ReceiptProof = append(m.ReceiptProof, make([]byte, postIndex-iNdEx))
+			copy(m.ReceiptProof[len(m.ReceiptProof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4077,7 +10447,7 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompleteWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4100,10 +10470,10 @@ func (m *MsgCreateGameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompleteWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompleteWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4127,7 +10497,7 @@ func (m *MsgCreateGameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinGame) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4150,15 +10520,15 @@ func (m *MsgJoinGame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinGame: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4186,11 +10556,11 @@ func (m *MsgJoinGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Player = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4218,46 +10588,8 @@ func (m *MsgJoinGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameId = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seat", wireType)
-			}
-			m.Seat = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Seat |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuyInAmount", wireType)
-			}
-			m.BuyInAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BuyInAmount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4279,7 +10611,7 @@ func (m *MsgJoinGame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinGameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4302,12 +10634,44 @@ func (m *MsgJoinGameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinGameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4329,7 +10693,7 @@ func (m *MsgJoinGameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGame) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4352,10 +10716,10 @@ func (m *MsgLeaveGame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGame: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4392,7 +10756,7 @@ func (m *MsgLeaveGame) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4420,7 +10784,96 @@ func (m *MsgLeaveGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameId = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptProof = append(m.ReceiptProof, make([]byte, postIndex-iNdEx))
+			copy(m.ReceiptProof[len(m.ReceiptProof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4443,7 +10896,7 @@ func (m *MsgLeaveGame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4466,12 +10919,31 @@ func (m *MsgLeaveGameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4493,7 +10965,7 @@ func (m *MsgLeaveGameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDealCards) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgePaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4516,15 +10988,15 @@ func (m *MsgDealCards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDealCards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgePaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDealCards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgePaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4552,11 +11024,31 @@ func (m *MsgDealCards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4584,7 +11076,7 @@ func (m *MsgDealCards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GameId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4607,7 +11099,7 @@ func (m *MsgDealCards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDealCardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBridgePausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4630,10 +11122,10 @@ func (m *MsgDealCardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDealCardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBridgePausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDealCardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBridgePausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4657,7 +11149,7 @@ func (m *MsgDealCardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPerformAction) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateEthBlockHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4680,15 +11172,15 @@ func (m *MsgPerformAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPerformAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateEthBlockHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPerformAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateEthBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4716,77 +11208,13 @@ func (m *MsgPerformAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Player = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GameId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
 			}
-			m.Amount = 0
+			m.EthBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4796,7 +11224,7 @@ func (m *MsgPerformAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4822,7 +11250,7 @@ func (m *MsgPerformAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPerformActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateEthBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4845,12 +11273,50 @@ func (m *MsgPerformActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPerformActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateEthBlockHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPerformActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateEthBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeight", wireType)
+			}
+			m.OldHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeight", wireType)
+			}
+			m.NewHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4872,7 +11338,7 @@ func (m *MsgPerformActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4895,15 +11361,15 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4931,11 +11397,11 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Player = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4963,7 +11429,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.GameId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -4984,57 +11450,6 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5056,7 +11471,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5079,12 +11494,31 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStack", wireType)
+			}
+			m.NewStack = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStack |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5106,7 +11540,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEthHeaders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5129,15 +11563,15 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEthHeaders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEthHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5158,39 +11592,20 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5200,23 +11615,23 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthRecipient = string(dAtA[iNdEx:postIndex])
+			m.Headers = append(m.Headers, make([]byte, postIndex-iNdEx))
+			copy(m.Headers[len(m.Headers)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5239,7 +11654,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEthHeadersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5262,12 +11677,31 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEthHeadersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEthHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			m.Tip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tip |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5289,7 +11723,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProcessDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoPostBlinds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5312,15 +11746,15 @@ func (m *MsgProcessDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProcessDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoPostBlinds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProcessDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoPostBlinds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5348,13 +11782,13 @@ func (m *MsgProcessDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Player = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
-			m.DepositIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5364,16 +11798,29 @@ func (m *MsgProcessDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.EthBlockHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5383,11 +11830,12 @@ func (m *MsgProcessDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5409,7 +11857,7 @@ func (m *MsgProcessDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProcessDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoPostBlindsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5432,15 +11880,65 @@ func (m *MsgProcessDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProcessDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoPostBlindsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProcessDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoPostBlindsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5468,11 +11966,11 @@ func (m *MsgProcessDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5500,13 +11998,13 @@ func (m *MsgProcessDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.GameId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
 			}
-			m.DepositIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5516,16 +12014,29 @@ func (m *MsgProcessDepositResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			m.EthBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5535,11 +12046,24 @@ func (m *MsgProcessDepositResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5561,7 +12085,7 @@ func (m *MsgProcessDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateWithdrawal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5584,15 +12108,65 @@ func (m *MsgInitiateWithdrawal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateWithdrawal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinWaitlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinWaitlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinWaitlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5620,13 +12194,13 @@ func (m *MsgInitiateWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Player = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5636,16 +12210,29 @@ func (m *MsgInitiateWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InviteSignature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5655,23 +12242,25 @@ func (m *MsgInitiateWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAddress = string(dAtA[iNdEx:postIndex])
+			m.InviteSignature = append(m.InviteSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.InviteSignature == nil {
+				m.InviteSignature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5694,7 +12283,7 @@ func (m *MsgInitiateWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitiateWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinWaitlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5717,17 +12306,17 @@ func (m *MsgInitiateWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitiateWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinWaitlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitiateWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinWaitlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			var stringLen uint64
+			m.Position = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5737,24 +12326,11 @@ func (m *MsgInitiateWithdrawalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Position |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5776,7 +12352,7 @@ func (m *MsgInitiateWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignWithdrawal) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveWaitlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5799,15 +12375,15 @@ func (m *MsgSignWithdrawal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignWithdrawal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveWaitlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveWaitlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5835,43 +12411,11 @@ func (m *MsgSignWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Player = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEthKeyHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5899,7 +12443,7 @@ func (m *MsgSignWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorEthKeyHex = string(dAtA[iNdEx:postIndex])
+			m.GameId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5922,7 +12466,7 @@ func (m *MsgSignWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveWaitlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5945,46 +12489,12 @@ func (m *MsgSignWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveWaitlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveWaitlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6006,7 +12516,7 @@ func (m *MsgSignWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEthBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoTopUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6029,15 +12539,15 @@ func (m *MsgUpdateEthBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEthBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoTopUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEthBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6065,13 +12575,77 @@ func (m *MsgUpdateEthBlockHeight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Player = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetStack", wireType)
 			}
-			m.EthBlockHeight = 0
+			m.TargetStack = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6081,7 +12655,7 @@ func (m *MsgUpdateEthBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				m.TargetStack |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6107,7 +12681,7 @@ func (m *MsgUpdateEthBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEthBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoTopUpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6130,50 +12704,12 @@ func (m *MsgUpdateEthBlockHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEthBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoTopUpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEthBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldHeight", wireType)
-			}
-			m.OldHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OldHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewHeight", wireType)
-			}
-			m.NewHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6195,7 +12731,7 @@ func (m *MsgUpdateEthBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTopUp) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAutoTopUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6218,10 +12754,10 @@ func (m *MsgTopUp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTopUp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAutoTopUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAutoTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6288,25 +12824,6 @@ func (m *MsgTopUp) Unmarshal(dAtA []byte) error {
 			}
 			m.GameId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6328,7 +12845,7 @@ func (m *MsgTopUp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTopUpResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAutoTopUpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6351,31 +12868,12 @@ func (m *MsgTopUpResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTopUpResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAutoTopUpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAutoTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStack", wireType)
-			}
-			m.NewStack = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewStack |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
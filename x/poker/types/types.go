@@ -2,11 +2,13 @@ package types
 
 import (
 	"encoding/json"
+	"slices"
 	"time"
 )
 
 const (
-	// TokenDenom is the denomination of the game token
+	// TokenDenom is the default table denomination, used by games created
+	// before tables had their own denomination
 	TokenDenom = "usdc"
 
 	// GameCreationCost is the cost in tokens to create a new game
@@ -96,6 +98,15 @@ type GameOptionsDTO struct {
 	Type         *GameType              `json:"type,omitempty"`
 	Rake         *RakeConfigDTO         `json:"rake,omitempty"`         // Optional rake configuration
 	Owner        *string                `json:"owner,omitempty"`        // Table owner who collects rake fees
+	// Forced bets beyond the blinds (see Game.Ante and friends)
+	Ante             *string       `json:"ante,omitempty"`
+	AnteType         *AnteType     `json:"anteType,omitempty"`
+	Straddle         *StraddleType `json:"straddle,omitempty"`
+	BombPotFrequency *int          `json:"bombPotFrequency,omitempty"`
+	BombPotAnte      *string       `json:"bombPotAnte,omitempty"`
+	// DeadBlinds are the missed blinds, by player, that returning players
+	// post as dead money when they are next dealt in
+	DeadBlinds map[string]string `json:"deadBlinds,omitempty"`
 	OtherOptions map[string]interface{} `json:"otherOptions,omitempty"`
 }
 
@@ -114,6 +125,12 @@ type PlayerDTO struct {
 	SumOfBets    string           `json:"sumOfBets"`
 	Timeout      int              `json:"timeout"`
 	Signature    string           `json:"signature"`
+	// TimeBank is the player's remaining time bank in seconds. The keeper
+	// fills it in on queries; the game engine never sees it.
+	TimeBank *int `json:"timeBank,omitempty"`
+	// LeavingAfterHand is set, on queries, for a player who asked to leave
+	// during the hand and is settled once it ends
+	LeavingAfterHand bool `json:"leavingAfterHand,omitempty"`
 }
 
 // ActionDTO represents a player action
@@ -193,6 +210,254 @@ type Game struct {
 	RakePercentage    uint32 `json:"rakePercentage,omitempty"`    // Percentage of pot taken as rake (0-100)
 	RakeCap           uint64 `json:"rakeCap,omitempty"`           // Maximum rake per hand
 	RakeOwner         string `json:"rakeOwner,omitempty"`         // Address receiving rake (defaults to creator)
+	// Denom is the table currency all buy-ins, payouts and rake use
+	Denom string `json:"denom,omitempty"`
+	// Status is whether enough players are seated to deal (see SeatedStatus)
+	Status TableStatus `json:"status,omitempty"`
+	// AutoPostBlinds lists the seated players whose blinds the module posts
+	// for them (see MsgSetAutoPostBlinds)
+	AutoPostBlinds []string `json:"autoPostBlinds,omitempty"`
+	// TimeBanks are the seated players' time banks. Players without an entry
+	// have the params' initial time bank.
+	TimeBanks []PlayerTimeBank `json:"timeBanks,omitempty"`
+	// Visibility is whether the table appears in the lobby (see IsListed)
+	Visibility TableVisibility `json:"visibility,omitempty"`
+	// Access policy (see HasAccessPolicy). A player may sit if they satisfy
+	// any of it.
+	Allowlist       []string `json:"allowlist,omitempty"`       // Addresses allowed to sit
+	InvitePublicKey string   `json:"invitePublicKey,omitempty"` // Public key derived from the invite code (see InviteKey); never shown by queries
+	HasInviteCode   bool     `json:"hasInviteCode,omitempty"`   // Set in query responses in place of InvitePublicKey (see Public)
+	RequiredDenom   string   `json:"requiredDenom,omitempty"`   // Denom a player must hold to sit
+	RequiredAmount  uint64   `json:"requiredAmount,omitempty"`  // Amount of RequiredDenom a player must hold
+	// Waitlist lists the players waiting for a seat, in the order they are
+	// offered one (see MsgJoinWaitlist)
+	Waitlist []string `json:"waitlist,omitempty"`
+	// Reservations are the free seats held for players offered them from the
+	// waitlist
+	Reservations []SeatReservation `json:"reservations,omitempty"`
+	// PendingLeaves lists the players who asked to leave during a hand. They
+	// fold when it is their turn and are cashed out once the hand ends.
+	PendingLeaves []string `json:"pendingLeaves,omitempty"`
+	// Forced bets beyond the blinds (see forced_bets.go)
+	Ante             uint64       `json:"ante,omitempty"`             // Ante posted each hand
+	AnteType         AnteType     `json:"anteType,omitempty"`         // Who posts the ante
+	Straddle         StraddleType `json:"straddle,omitempty"`         // Seat that straddles each hand, if any
+	BombPotFrequency uint64       `json:"bombPotFrequency,omitempty"` // Every nth hand is a bomb pot
+	BombPotAnte      uint64       `json:"bombPotAnte,omitempty"`      // What every player antes in a bomb pot
+	// Sit-out rules (see sit_out.go)
+	MaxSitOutHands   uint64 `json:"maxSitOutHands,omitempty"`   // Hands a player may sit out before removal
+	MaxSitOutMinutes uint64 `json:"maxSitOutMinutes,omitempty"` // Minutes a player may sit out before removal
+	PostMissedBlinds bool   `json:"postMissedBlinds,omitempty"` // Returning players post missed blinds dead
+	// SitOuts are the players sitting out and what they have missed
+	SitOuts []SitOut `json:"sitOuts,omitempty"`
+	// DeadBlinds are the missed blinds returning players post when they are
+	// next dealt in
+	DeadBlinds []DeadBlind `json:"deadBlinds,omitempty"`
+	// AutoTopUps are the seated players' standing rebuy and top-up
+	// instructions (see MsgSetAutoTopUp)
+	AutoTopUps []AutoTopUp `json:"autoTopUps,omitempty"`
+	// Spectator policy (see spectators.go)
+	Spectators            SpectatorMode `json:"spectators,omitempty"`            // Who may watch the table
+	SpectatorDelayActions uint64        `json:"spectatorDelayActions,omitempty"` // Actions delayed spectators trail by
+	SpectatorDelaySeconds uint64        `json:"spectatorDelaySeconds,omitempty"` // Seconds delayed spectators trail by
+}
+
+// SeatReservation is a free seat held for a waitlisted player until
+// ExpiresAt, during which only they can take it
+type SeatReservation struct {
+	Player    string    `json:"player"`
+	Seat      uint64    `json:"seat"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// PlayerTimeBank is a player's reserve of seconds to draw on once the table's
+// action timeout has run out
+type PlayerTimeBank struct {
+	Address string `json:"address"`
+	Seconds uint64 `json:"seconds"`
+	// Hands is the number of hands dealt to the player since the time bank
+	// was last replenished
+	Hands uint64 `json:"hands"`
+}
+
+// TableStatus is a table's lifecycle state
+type TableStatus string
+
+const (
+	// TableStatusWaiting means fewer than MinPlayers are seated
+	TableStatusWaiting TableStatus = "waiting"
+	// TableStatusActive means at least MinPlayers are seated
+	TableStatusActive TableStatus = "active"
+)
+
+// TableVisibility is who can find a table
+type TableVisibility string
+
+const (
+	// TableVisibilityPublic tables are listed in the lobby. Games stored
+	// before tables had a visibility are public.
+	TableVisibilityPublic TableVisibility = "public"
+	// TableVisibilityUnlisted tables are left out of the lobby, but anyone
+	// with the game ID can sit unless the table has an access policy
+	TableVisibilityUnlisted TableVisibility = "unlisted"
+	// TableVisibilityPrivate tables are left out of the lobby, and only the
+	// creator and players admitted by the access policy can sit
+	TableVisibilityPrivate TableVisibility = "private"
+)
+
+// IsValid reports whether v is a known visibility. Empty means public.
+func (v TableVisibility) IsValid() bool {
+	switch v {
+	case "", TableVisibilityPublic, TableVisibilityUnlisted, TableVisibilityPrivate:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsListed reports whether the table appears in lobby queries
+func (g Game) IsListed() bool {
+	return g.Visibility == "" || g.Visibility == TableVisibilityPublic
+}
+
+// HasAccessPolicy reports whether the table limits who can sit. Private
+// tables always do, admitting only the creator when no policy is set.
+func (g Game) HasAccessPolicy() bool {
+	return g.Visibility == TableVisibilityPrivate || len(g.Allowlist) > 0 || g.InvitePublicKey != "" || g.HasInviteCode || g.RequiredDenom != ""
+}
+
+// Public returns the game as queries show it: the invite public key is left
+// out, since weak codes could be guessed against it offline, and
+// HasInviteCode records whether the table has one
+func (g Game) Public() Game {
+	g.HasInviteCode = g.HasInviteCode || g.InvitePublicKey != ""
+	g.InvitePublicKey = ""
+	return g
+}
+
+// IsAllowlisted reports whether the player is on the table's allowlist
+func (g Game) IsAllowlisted(player string) bool {
+	return slices.Contains(g.Allowlist, player)
+}
+
+// UpdateAllowlist adds and removes players from the table's allowlist,
+// keeping the order players were first added in
+func (g *Game) UpdateAllowlist(add, remove []string) {
+	allowlist := make([]string, 0, len(g.Allowlist)+len(add))
+	for _, p := range append(slices.Clone(g.Allowlist), add...) {
+		if !slices.Contains(remove, p) && !slices.Contains(allowlist, p) {
+			allowlist = append(allowlist, p)
+		}
+	}
+	if len(allowlist) == 0 {
+		allowlist = nil
+	}
+	g.Allowlist = allowlist
+}
+
+// SeatedStatus returns the status the game's seated players imply. Handlers
+// that change Players set Status from it before storing the game.
+func (g Game) SeatedStatus() TableStatus {
+	if int64(len(g.Players)) >= g.MinPlayersToDeal() {
+		return TableStatusActive
+	}
+	return TableStatusWaiting
+}
+
+// MinPlayersToDeal is the number of players a hand needs: the table's
+// MinPlayers, but never fewer than two
+func (g Game) MinPlayersToDeal() int64 {
+	return max(g.MinPlayers, 2)
+}
+
+// AutoPostsBlinds reports whether the player has opted into having their
+// blinds posted automatically
+func (g Game) AutoPostsBlinds(player string) bool {
+	for _, p := range g.AutoPostBlinds {
+		if p == player {
+			return true
+		}
+	}
+	return false
+}
+
+// SetAutoPostBlinds opts a player in or out of automatic blind posting
+func (g *Game) SetAutoPostBlinds(player string, enabled bool) {
+	players := make([]string, 0, len(g.AutoPostBlinds)+1)
+	for _, p := range g.AutoPostBlinds {
+		if p != player {
+			players = append(players, p)
+		}
+	}
+	if enabled {
+		players = append(players, player)
+	}
+	if len(players) == 0 {
+		players = nil
+	}
+	g.AutoPostBlinds = players
+}
+
+// IsLeaving reports whether the player is leaving once the hand ends
+func (g Game) IsLeaving(player string) bool {
+	return slices.Contains(g.PendingLeaves, player)
+}
+
+// SetLeaving queues or clears a player's departure at the end of the hand
+func (g *Game) SetLeaving(player string, leaving bool) {
+	pending := slices.DeleteFunc(slices.Clone(g.PendingLeaves), func(p string) bool { return p == player })
+	if leaving {
+		pending = append(pending, player)
+	}
+	if len(pending) == 0 {
+		pending = nil
+	}
+	g.PendingLeaves = pending
+}
+
+// TimeBank returns the player's time bank, which is initial seconds if they
+// have not used or replenished it yet
+func (g Game) TimeBank(player string, initial uint64) PlayerTimeBank {
+	for _, bank := range g.TimeBanks {
+		if bank.Address == player {
+			return bank
+		}
+	}
+	return PlayerTimeBank{Address: player, Seconds: initial}
+}
+
+// SetTimeBank stores a player's time bank
+func (g *Game) SetTimeBank(bank PlayerTimeBank) {
+	for i := range g.TimeBanks {
+		if g.TimeBanks[i].Address == bank.Address {
+			g.TimeBanks[i] = bank
+			return
+		}
+	}
+	g.TimeBanks = append(g.TimeBanks, bank)
+}
+
+// RemoveTimeBank forgets a player's time bank when they leave the table
+func (g *Game) RemoveTimeBank(player string) {
+	banks := make([]PlayerTimeBank, 0, len(g.TimeBanks))
+	for _, bank := range g.TimeBanks {
+		if bank.Address != player {
+			banks = append(banks, bank)
+		}
+	}
+	if len(banks) == 0 {
+		banks = nil
+	}
+	g.TimeBanks = banks
+}
+
+// TableDenom returns the game's currency, TokenDenom for games stored before
+// tables had their own denomination
+func (g Game) TableDenom() string {
+	if g.Denom == "" {
+		return TokenDenom
+	}
+	return g.Denom
 }
 
 // Marshal implements the protobuf marshaling interface
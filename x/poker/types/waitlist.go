@@ -0,0 +1,81 @@
+package types
+
+import "slices"
+
+// WaitlistSeatsMultiple caps a table's waitlist at this many players per seat
+const WaitlistSeatsMultiple = 2
+
+// WaitlistFull reports whether the waitlist holds WaitlistSeatsMultiple
+// players for each of the table's seats
+func (g Game) WaitlistFull() bool {
+	return int64(len(g.Waitlist)) >= WaitlistSeatsMultiple*max(g.MaxPlayers, 1)
+}
+
+// WaitlistPosition returns the player's 1-based place in the waitlist, or 0
+// if they are not waiting
+func (g Game) WaitlistPosition(player string) int {
+	return slices.Index(g.Waitlist, player) + 1
+}
+
+// Reservation returns the seat reservation held for the player
+func (g Game) Reservation(player string) (SeatReservation, bool) {
+	for _, r := range g.Reservations {
+		if r.Player == player {
+			return r, true
+		}
+	}
+	return SeatReservation{}, false
+}
+
+// RemoveFromWaitlist takes the player off the waitlist and releases any seat
+// held for them, reporting whether anything changed
+func (g *Game) RemoveFromWaitlist(player string) bool {
+	waitlist := slices.DeleteFunc(slices.Clone(g.Waitlist), func(p string) bool { return p == player })
+	reservations := slices.DeleteFunc(slices.Clone(g.Reservations), func(r SeatReservation) bool { return r.Player == player })
+	if len(waitlist) == len(g.Waitlist) && len(reservations) == len(g.Reservations) {
+		return false
+	}
+	g.Waitlist = slices.Clip(waitlist)
+	if len(g.Waitlist) == 0 {
+		g.Waitlist = nil
+	}
+	g.Reservations = slices.Clip(reservations)
+	if len(g.Reservations) == 0 {
+		g.Reservations = nil
+	}
+	return true
+}
+
+// FreeSeats returns the seats no player in the state occupies and no
+// reservation holds, lowest first
+func (g Game) FreeSeats(state TexasHoldemStateDTO) []uint64 {
+	var free []uint64
+	for seat := uint64(1); int64(seat) <= g.MaxPlayers; seat++ {
+		taken := slices.ContainsFunc(state.Players, func(p PlayerDTO) bool { return uint64(p.Seat) == seat })
+		reserved := slices.ContainsFunc(g.Reservations, func(r SeatReservation) bool { return r.Seat == seat })
+		if !taken && !reserved {
+			free = append(free, seat)
+		}
+	}
+	return free
+}
+
+// OpenSeats returns the seats the player may take, lowest first: the seat
+// held for them, and the free seats left over once one is set aside for each
+// player ahead of them in the waitlist
+func (g Game) OpenSeats(state TexasHoldemStateDTO, player string) []uint64 {
+	var open []uint64
+	if r, ok := g.Reservation(player); ok {
+		open = append(open, r.Seat)
+	}
+	free := g.FreeSeats(state)
+	ahead := len(g.Waitlist)
+	if position := g.WaitlistPosition(player); position > 0 {
+		ahead = position - 1
+	}
+	if ahead < len(free) {
+		open = append(open, free[ahead:]...)
+	}
+	slices.Sort(open)
+	return open
+}